@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"portfolio/domain"
+	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
 )
 
@@ -11,3 +13,33 @@ type AbstractHandler struct {
 // func (ah *AbstractHandler) getUserFromContext(w http.ResponseWriter, r *http.Request) (*entities.User, bool) {
 // 	return middlewares.GetUserFromContext(r)
 // }
+
+// onlyPublished limits a public list to published content, as drafts,
+// scheduled and archived content are hidden from visitors.
+func onlyPublished(query *entities.ListQuery) error {
+	if state, ok := query.Filters["state"]; ok && state != entities.PublishingStatePublished {
+		return domain.NewValidationError("Only published content is listed", "filter[state]", nil)
+	}
+	query.Filters["state"] = entities.PublishingStatePublished
+	return nil
+}
+
+// onlyActiveProjects limits a public project list to active projects. The
+// other statuses are hidden from visitors, so they cannot filter on status.
+func onlyActiveProjects(query *entities.ListQuery) error {
+	if _, ok := query.Filters["status"]; ok {
+		return domain.NewValidationError("Projects cannot be filtered by status", "filter[status]", nil)
+	}
+	query.Filters["status"] = "active"
+	return nil
+}
+
+// onlyApproved limits a public list of testimonials to approved ones, as
+// pending and rejected testimonials are hidden from visitors.
+func onlyApproved(query *entities.ListQuery) error {
+	if status, ok := query.Filters["status"]; ok && status != entities.TestimonialStatusApproved {
+		return domain.NewValidationError("Only approved testimonials are listed", "filter[status]", nil)
+	}
+	query.Filters["status"] = entities.TestimonialStatusApproved
+	return nil
+}
@@ -1,11 +1,14 @@
 package admin
 
 import (
+	"context"
 	"net/http"
 	"portfolio/api/http/middlewares"
 	"portfolio/api/http/utils"
 	"portfolio/domain"
 	"portfolio/domain/usecases"
+	"strconv"
+	"strings"
 )
 
 type AbstractHandler struct {
@@ -21,3 +24,131 @@ func (ah *AbstractHandler) getUserIDFromContext(w http.ResponseWriter, r *http.R
 	}
 	return userID, true
 }
+
+// isAtomicRequest reports whether a bulk request asked for atomic=true, in
+// which case any failure rolls back the whole batch instead of answering 207.
+func (ah *AbstractHandler) isAtomicRequest(w http.ResponseWriter, r *http.Request) (bool, bool) {
+	value := r.URL.Query().Get("atomic")
+	if value == "" {
+		return false, true
+	}
+
+	atomic, err := strconv.ParseBool(value)
+	if err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("atomic must be true or false", "atomic", &err))
+		return false, false
+	}
+	return atomic, true
+}
+
+// withIfMatch returns the request context carrying the versions named by the
+// If-Match header. Tags are compared strongly, as RFC 9110 requires: a weak tag,
+// or a strong one that names no version, matches no row and the write answers
+// 412. Without the header, or with "*", the write is unconditional, unless
+// RequireIfMatchMiddleware made the header mandatory on PUT, PATCH and DELETE.
+func (ah *AbstractHandler) withIfMatch(w http.ResponseWriter, r *http.Request) (context.Context, bool) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" {
+		if middlewares.IfMatchRequired(r) && r.Method != http.MethodPost {
+			utils.WriteErrorResponse(w, domain.NewPreconditionRequiredError("If-Match"))
+			return nil, false
+		}
+		return r.Context(), true
+	}
+	if value == "*" {
+		return r.Context(), true
+	}
+
+	tags, ok := strongETags(value)
+	if !ok {
+		utils.WriteErrorResponse(w, domain.NewInvalidFormatError("If-Match", `a list of ETags such as "3"`))
+		return nil, false
+	}
+
+	versions := make([]int, 0, len(tags))
+	for _, tag := range tags {
+		if version, err := strconv.Atoi(tag); err == nil && version > 0 {
+			versions = append(versions, version)
+		}
+	}
+	return domain.WithExpectedVersion(r.Context(), versions...), true
+}
+
+// strongETags returns the opaque tags of the strong entity tags of an If-Match
+// list, skipping the weak ones. It reports false when value is not a list of
+// entity tags.
+func strongETags(value string) ([]string, bool) {
+	var tags []string
+	for {
+		value = strings.TrimLeft(value, " \t,")
+		if value == "" {
+			return tags, true
+		}
+
+		weak := strings.HasPrefix(value, "W/")
+		value = strings.TrimPrefix(value, "W/")
+		if !strings.HasPrefix(value, `"`) {
+			return nil, false
+		}
+		end := strings.IndexByte(value[1:], '"')
+		if end < 0 {
+			return nil, false
+		}
+		if !weak {
+			tags = append(tags, value[1:end+1])
+		}
+
+		value = value[end+2:]
+		if rest := strings.TrimLeft(value, " \t"); rest != "" && rest[0] != ',' {
+			return nil, false
+		}
+	}
+}
+
+// setETag sets the strong validator clients send back in If-Match.
+func setETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", `"`+strconv.Itoa(version)+`"`)
+}
+
+// writeVersionedError answers a failed write. When the client's If-Match was
+// stale, the 412 carries the current representation and its ETag so the
+// client can merge without another round trip.
+func writeVersionedError(ctx context.Context, w http.ResponseWriter, err error, id int, current func(ctx context.Context, id int) (any, int, error)) {
+	if domainErr, ok := domain.AsDomainError(err); ok && domainErr.Code == domain.ErrCodePreconditionFailed {
+		if representation, version, currentErr := current(ctx, id); currentErr == nil {
+			domainErr.Details["current"] = representation
+			setETag(w, version)
+		}
+	}
+	utils.WriteErrorResponse(w, err)
+}
+
+// ownedProjectID returns the project ID of the path once the project is known
+// to belong to the authenticated admin user.
+func (ah *AbstractHandler) ownedProjectID(w http.ResponseWriter, r *http.Request, projectUseCase *usecases.ProjectUseCase) (int, bool) {
+	projectID, ok := pathID(w, r, "id", "Invalid project ID")
+	if !ok {
+		return 0, false
+	}
+
+	userID, ok := ah.getUserIDFromContext(w, r)
+	if !ok {
+		return 0, false
+	}
+
+	if err := projectUseCase.ValidateProjectOwnership(r.Context(), projectID, userID); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return 0, false
+	}
+	return projectID, true
+}
+
+// pathID parses the positive integer path value name.
+func pathID(w http.ResponseWriter, r *http.Request, name, message string) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue(name))
+	if err != nil || id <= 0 {
+		utils.WriteErrorResponse(w, domain.NewValidationError(message, name, &err))
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"portfolio/api/http/middlewares"
+	"portfolio/domain"
+	"testing"
+)
+
+func TestWithIfMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		ifMatch  string
+		required bool
+		status   int   // answered by withIfMatch, or 0 when the write goes on
+		matches  []int // versions the write may still apply to
+		rejects  []int
+	}{
+		{name: "absent", method: http.MethodPut, matches: []int{1, 7}},
+		{name: "any", method: http.MethodPut, ifMatch: "*", required: true, matches: []int{1, 7}},
+		{name: "strong", method: http.MethodPut, ifMatch: `"3"`, matches: []int{3}, rejects: []int{2, 4}},
+		{name: "list", method: http.MethodPatch, ifMatch: `"3", W/"4" ,"5"`, matches: []int{3, 5}, rejects: []int{4}},
+		{name: "weak", method: http.MethodDelete, ifMatch: `W/"3"`, rejects: []int{3}},
+		{name: "not a version", method: http.MethodPut, ifMatch: `"abc"`, rejects: []int{1}},
+		{name: "unquoted", method: http.MethodPut, ifMatch: "3", status: http.StatusBadRequest},
+		{name: "unterminated", method: http.MethodPut, ifMatch: `"3`, status: http.StatusBadRequest},
+		{name: "required", method: http.MethodDelete, required: true, status: http.StatusPreconditionRequired},
+		{name: "required on create", method: http.MethodPost, required: true, matches: []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/projects/1", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := httptest.NewRecorder()
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctx, ok := (&AbstractHandler{}).withIfMatch(w, r)
+				if !ok {
+					return
+				}
+				for _, version := range tt.matches {
+					if !domain.MatchesExpectedVersion(ctx, version) {
+						t.Errorf("version %d does not match If-Match %q", version, tt.ifMatch)
+					}
+				}
+				for _, version := range tt.rejects {
+					if domain.MatchesExpectedVersion(ctx, version) {
+						t.Errorf("version %d matches If-Match %q", version, tt.ifMatch)
+					}
+				}
+			})
+			if tt.required {
+				middlewares.RequireIfMatchMiddleware(handler).ServeHTTP(w, r)
+			} else {
+				handler.ServeHTTP(w, r)
+			}
+
+			status := 0
+			if w.Body.Len() > 0 {
+				status = w.Code
+			}
+			if status != tt.status {
+				t.Errorf("status = %d, want %d (body %s)", status, tt.status, w.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package admin
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	auditDto "portfolio/dto/audit"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+const defaultAuditPageSize = 50
+
+type auditHandler struct {
+	AbstractHandler
+	auditUseCase *usecases.AuditUseCase
+	logger       *logger.Logger
+}
+
+func NewAuditHandler(settingUseCase *usecases.SettingUseCase, auditUseCase *usecases.AuditUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	auditHandler := auditHandler{
+		AbstractHandler: AbstractHandler{settingUseCase: settingUseCase},
+		auditUseCase:    auditUseCase,
+		logger:          logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminAuditHandler",
+			Pattern: "GET /audit",
+			Handler: auditHandler.GetAuditLogs,
+		},
+	}
+}
+
+// NewAuditExportHandler serves exports, which are files rather than API
+// responses; mount it outside the response middleware, like the debug area.
+func NewAuditExportHandler(settingUseCase *usecases.SettingUseCase, auditUseCase *usecases.AuditUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	auditHandler := auditHandler{
+		AbstractHandler: AbstractHandler{settingUseCase: settingUseCase},
+		auditUseCase:    auditUseCase,
+		logger:          logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "ExportAdminAuditHandler",
+			Pattern: "GET /audit/export",
+			Handler: auditHandler.ExportAuditLogs,
+		},
+	}
+}
+
+// GetAuditLogs
+//
+//	@Summary		List the audit log
+//	@Description	Retrieve successful admin writes, newest first. The meta holds page, per_page and total
+//	@Tags			Admin Audit
+//	@Produce		json
+//	@Param			page			query		int		false	"Page number, from 1"
+//	@Param			per_page		query		int		false	"Page size, up to 200"
+//	@Param			user_id			query		int		false	"Only writes by this user"
+//	@Param			action			query		string	false	"Only this action, e.g. create, update, patch, delete, restore"
+//	@Param			resource_type	query		string	false	"Only this resource type, e.g. projects, settings"
+//	@Param			resource_id		query		string	false	"Only this resource ID"
+//	@Param			since			query		string	false	"Only writes at or after this RFC 3339 time"
+//	@Param			until			query		string	false	"Only writes before this RFC 3339 time"
+//	@Success		200				{object}	shared.APIResponse{data=dto.AuditLogListResponse}
+//	@Failure		400				{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401				{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500				{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/audit [get]
+//	@Security		BearerAuth
+func (ah *auditHandler) GetAuditLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := parseAuditFilter(r)
+	if err != nil {
+		ah.logger.Error("Invalid audit filter: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	page, err := parsePositiveQueryInt(r, "page", 1)
+	if err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	perPage, err := parsePositiveQueryInt(r, "per_page", defaultAuditPageSize)
+	if err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	filter.Limit = perPage
+	filter.Offset = (page - 1) * perPage
+
+	auditLogs, total, err := ah.auditUseCase.GetAuditLogs(ctx, filter)
+	if err != nil {
+		ah.logger.Error("Failed to get audit logs: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := auditDto.FromAuditLogsEntityToResponse(auditLogs,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+			"page":       page,
+			"per_page":   perPage,
+			"total":      total,
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// ExportAuditLogs
+//
+//	@Summary		Export the audit log
+//	@Description	Download every audit record matching the filters as CSV or as a JSON array
+//	@Tags			Admin Audit
+//	@Produce		text/csv
+//	@Produce		json
+//	@Param			format			query		string	false	"Export format"	Enums(csv, json)	default(csv)
+//	@Param			user_id			query		int		false	"Only writes by this user"
+//	@Param			action			query		string	false	"Only this action"
+//	@Param			resource_type	query		string	false	"Only this resource type"
+//	@Param			resource_id		query		string	false	"Only this resource ID"
+//	@Param			since			query		string	false	"Only writes at or after this RFC 3339 time"
+//	@Param			until			query		string	false	"Only writes before this RFC 3339 time"
+//	@Success		200				{array}		dto.AuditLog
+//	@Failure		400				{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401				{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500				{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/audit/export [get]
+//	@Security		BearerAuth
+func (ah *auditHandler) ExportAuditLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "json" {
+		ah.logger.Error("Invalid audit export format: %s", format)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Format must be csv or json", "format", nil))
+		return
+	}
+
+	filter, err := parseAuditFilter(r)
+	if err != nil {
+		ah.logger.Error("Invalid audit filter: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	auditLogs, _, err := ah.auditUseCase.GetAuditLogs(ctx, filter)
+	if err != nil {
+		ah.logger.Error("Failed to export audit logs: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	filename := "audit-" + time.Now().Format("20060102-150405") + "." + format
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+
+	if format == "json" {
+		records := make([]*auditDto.AuditLog, 0, len(auditLogs))
+		for _, auditLog := range auditLogs {
+			records = append(records, auditDto.FromAuditLogEntity(auditLog))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(records); err != nil {
+			ah.logger.Error("Failed to write audit JSON export: %v", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	records := [][]string{auditDto.AuditLogCSVHeader}
+	for _, auditLog := range auditLogs {
+		records = append(records, auditDto.FromAuditLogEntity(auditLog).ToCSVRecord())
+	}
+	if err := writer.WriteAll(records); err != nil {
+		ah.logger.Error("Failed to write audit CSV export: %v", err)
+	}
+}
+
+func parseAuditFilter(r *http.Request) (*entities.AuditLogFilter, error) {
+	query := r.URL.Query()
+	filter := &entities.AuditLogFilter{
+		Action:       query.Get("action"),
+		ResourceType: query.Get("resource_type"),
+		ResourceID:   query.Get("resource_id"),
+	}
+
+	if value := query.Get("user_id"); value != "" {
+		userID, err := strconv.Atoi(value)
+		if err != nil || userID <= 0 {
+			return nil, domain.NewValidationError("User ID must be a positive integer", "user_id", nil)
+		}
+		filter.UserID = userID
+	}
+
+	for field, target := range map[string]**time.Time{"since": &filter.Since, "until": &filter.Until} {
+		value := query.Get(field)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, domain.NewInvalidFormatError(field, "RFC 3339")
+		}
+		*target = &parsed
+	}
+
+	return filter, nil
+}
+
+func parsePositiveQueryInt(r *http.Request, field string, defaultValue int) (int, error) {
+	value := r.URL.Query().Get(field)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed <= 0 {
+		return 0, domain.NewValidationError(field+" must be a positive integer", field, nil)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,126 @@
+package admin
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	auditDto "portfolio/dto/audit"
+	"portfolio/infrastructure/memory"
+	"portfolio/logger"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func newAuditExportHandler(t *testing.T) *auditHandler {
+	t.Helper()
+
+	logger := logger.NewWriterLogger(io.Discard)
+	repo := memory.NewAuditLogRepository(memory.NewStore(), logger)
+	userID := 1
+	for _, auditLog := range []*entities.AuditLog{
+		{Action: "create", ResourceType: "projects", ResourceID: "1", After: `{"title":"Portfolio, v1"}`},
+		{Action: "update", ResourceType: "projects", ResourceID: "1", Before: `{"title":"Portfolio, v1"}`, After: "{\"title\":\"Portfolio\nv2\"}"},
+		{Action: "delete", ResourceType: "skills", ResourceID: "4"},
+	} {
+		auditLog.UserID = &userID
+		auditLog.IP = "192.0.2.1"
+		if _, err := repo.Create(t.Context(), auditLog); err != nil {
+			t.Fatalf("Create audit log failed: %v", err)
+		}
+	}
+
+	return &auditHandler{auditUseCase: usecases.NewAuditUseCase(repo, logger), logger: logger}
+}
+
+func TestExportAuditLogsAsCSV(t *testing.T) {
+	handler := newAuditExportHandler(t)
+
+	w := httptest.NewRecorder()
+	handler.ExportAuditLogs(w, httptest.NewRequest(http.MethodGet, "/audit/export?resource_type=projects", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d (body %s)", w.Code, http.StatusOK, w.Body)
+	}
+	if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/csv") {
+		t.Errorf("Content-Type = %q, want text/csv", contentType)
+	}
+	if disposition := w.Header().Get("Content-Disposition"); !strings.Contains(disposition, `.csv"`) {
+		t.Errorf("Content-Disposition = %q, want a .csv attachment", disposition)
+	}
+
+	// Commas and newlines in the snapshots survive the round trip.
+	records, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not valid CSV: %v", err)
+	}
+	if len(records) != 3 || !slices.Equal(records[0], auditDto.AuditLogCSVHeader) {
+		t.Fatalf("records = %q, want the header and 2 project writes", records)
+	}
+	if update := records[1]; update[3] != "update" || update[7] != "{\"title\":\"Portfolio\nv2\"}" {
+		t.Errorf("newest record = %q, want the update with its snapshot intact", update)
+	}
+	if create := records[2]; create[3] != "create" || create[7] != `{"title":"Portfolio, v1"}` {
+		t.Errorf("oldest record = %q, want the create with its snapshot intact", create)
+	}
+}
+
+func TestExportAuditLogsAsJSON(t *testing.T) {
+	handler := newAuditExportHandler(t)
+
+	export := func(query string) []*auditDto.AuditLog {
+		t.Helper()
+
+		w := httptest.NewRecorder()
+		handler.ExportAuditLogs(w, httptest.NewRequest(http.MethodGet, "/audit/export?format=json&"+query, nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d, want %d (body %s)", query, w.Code, http.StatusOK, w.Body)
+		}
+		if contentType := w.Header().Get("Content-Type"); contentType != "application/json" {
+			t.Errorf("%s: Content-Type = %q, want application/json", query, contentType)
+		}
+		var records []*auditDto.AuditLog
+		if err := json.NewDecoder(w.Body).Decode(&records); err != nil {
+			t.Fatalf("%s: export is not a JSON array: %v", query, err)
+		}
+		if records == nil {
+			t.Fatalf("%s: export is null, want an array", query)
+		}
+		return records
+	}
+
+	records := export("user_id=1")
+	actions := make([]string, 0, len(records))
+	for _, record := range records {
+		actions = append(actions, record.Action)
+	}
+	if !slices.Equal(actions, []string{"delete", "update", "create"}) {
+		t.Errorf("exported actions = %q, want the newest first", actions)
+	}
+
+	if records := export("resource_type=skills&resource_id=4"); len(records) != 1 || records[0].Action != "delete" {
+		t.Errorf("filtered export = %+v, want the skill deletion", records)
+	}
+	if records := export("until=2000-01-01T00:00:00Z"); len(records) != 0 {
+		t.Errorf("export before any write = %+v, want none", records)
+	}
+}
+
+func TestExportAuditLogsRejectsInvalidQueries(t *testing.T) {
+	handler := newAuditExportHandler(t)
+
+	for _, query := range []string{"format=xml", "since=yesterday", "user_id=-1"} {
+		w := httptest.NewRecorder()
+		handler.ExportAuditLogs(w, httptest.NewRequest(http.MethodGet, "/audit/export?"+query, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", query, w.Code, http.StatusBadRequest)
+		}
+		if w.Header().Get("Content-Disposition") != "" {
+			t.Errorf("%s: an error was sent as an attachment", query)
+		}
+	}
+}
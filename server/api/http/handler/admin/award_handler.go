@@ -0,0 +1,538 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	awardDto "portfolio/dto/award"
+	orderDto "portfolio/dto/order"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type awardHandler struct {
+	AbstractHandler
+	awardUseCase *usecases.AwardUseCase
+	logger       *logger.Logger
+}
+
+func NewAwardHandler(settingUseCase *usecases.SettingUseCase, awardUseCase *usecases.AwardUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	awardHandler := awardHandler{
+		AbstractHandler: AbstractHandler{settingUseCase: settingUseCase},
+		awardUseCase:    awardUseCase,
+		logger:          logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminAwardsHandler",
+			Pattern: "GET /awards",
+			Handler: awardHandler.GetAwards,
+		},
+		{
+			Name:    "PostAdminAwardHandler",
+			Pattern: "POST /awards",
+			Handler: awardHandler.CreateAward,
+		},
+		{
+			Name:    "PostBulkAdminAwardHandler",
+			Pattern: "POST /awards/bulk",
+			Handler: awardHandler.CreateBulkAwards,
+		},
+		{
+			Name:    "PutAdminAwardsOrderHandler",
+			Pattern: "PUT /awards/order",
+			Handler: awardHandler.ReorderAwards,
+		},
+		{
+			Name:    "GetAdminAwardHandler",
+			Pattern: "GET /awards/{id}",
+			Handler: awardHandler.GetAward,
+		},
+		{
+			Name:    "PutAdminAwardHandler",
+			Pattern: "PUT /awards/{id}",
+			Handler: awardHandler.UpdateAward,
+		},
+		{
+			Name:    "PatchAdminAwardHandler",
+			Pattern: "PATCH /awards/{id}",
+			Handler: awardHandler.PatchAward,
+		},
+		{
+			Name:    "DeleteAdminAwardHandler",
+			Pattern: "DELETE /awards/{id}",
+			Handler: awardHandler.DeleteAward,
+		},
+	}
+}
+
+// GetAwards
+//
+//	@Summary		Get all admin awards
+//	@Description	Retrieve all awards and honors of the authenticated admin user
+//	@Tags			Admin Awards
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, date, title, issuer, created_at; defaults to the manual order"
+//	@Param			filter[issuer]	query		string	false	"Filter by issuer"
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
+//	@Success		200	{object}	shared.APIResponse{data=dto.AwardListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}	"Unauthorized"
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/awards [get]
+func (ah *awardHandler) GetAwards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ah.getUserIDFromContext(w, r)
+	if !ok {
+		ah.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.AwardListSpec)
+	if err != nil {
+		ah.logger.Error("Invalid award list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	awards, err := ah.awardUseCase.GetAwardsByUserID(ctx, userID, query)
+	if err != nil {
+		ah.logger.Error("Failed to get awards for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := awardDto.FromAwardsEntityToResponse(awards.Items,
+		utils.WithListMeta(&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		}, r, query, awards))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetAward
+//
+//	@Summary		Get a specific admin award
+//	@Description	Retrieve a specific award by ID for admin management
+//	@Tags			Admin Awards
+//	@Produce		json
+//	@Param			id	path	int	true	"Award ID"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.AwardResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/awards/{id} [get]
+func (ah *awardHandler) GetAward(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := pathID(w, r, "id", "Invalid award ID")
+	if !ok {
+		return
+	}
+
+	award, err := ah.awardUseCase.GetAwardByID(ctx, id)
+	if err != nil {
+		ah.logger.Error("Failed to get award %d: %v", id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := awardDto.FromAwardEntityToResponse(award,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, award.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// CreateAward
+//
+//	@Summary		Create a new award
+//	@Description	Create a new award or honor for the authenticated admin user
+//	@Tags			Admin Awards
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.CreateAwardRequest	true	"Award creation request"
+//	@Security		BearerAuth
+//	@Success		201	{object}	shared.APIResponse{data=dto.AwardResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/awards [post]
+func (ah *awardHandler) CreateAward(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var request awardDto.CreateAwardRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ah.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		ah.logger.Error("Invalid request: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := ah.getUserIDFromContext(w, r)
+	if !ok {
+		ah.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	awardEntity, err := request.ToEntity(userID)
+	if err != nil {
+		ah.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid award data", "award", &err))
+		return
+	}
+
+	createdAward, err := ah.awardUseCase.CreateAward(ctx, awardEntity)
+	if err != nil {
+		ah.logger.Error("Failed to create award: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := awardDto.FromAwardEntityToResponse(createdAward,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+// CreateBulkAwards
+//
+//	@Summary		Create multiple awards in bulk
+//	@Description	Create multiple awards for the authenticated admin user
+//	@Tags			Admin Awards
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.CreateBulkAwardsRequest	true	"Bulk awards creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
+//	@Success		201		{object}	shared.APIResponse{data=dto.AwardListResponse}
+//	@Success		207		{object}	shared.APIResponse{data=dto.AwardListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/awards/bulk [post]
+//	@Security		BearerAuth
+func (ah *awardHandler) CreateBulkAwards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	atomic, ok := ah.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var request awardDto.CreateBulkAwardsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ah.logger.Error("Failed to decode bulk awards request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := ah.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	awardEntities, err := request.ToEntities(userID)
+	if err != nil {
+		ah.logger.Error("Failed to convert bulk request to entities: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid awards data", "awards", &err))
+		return
+	}
+
+	if atomic {
+		createdAwards, err := ah.awardUseCase.CreateAwardsAtomically(ctx, awardEntities)
+		if err != nil {
+			ah.logger.Error("Atomic bulk award creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := awardDto.FromAwardsEntityForBulkToResponse(createdAwards, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
+	var createdAwards []*entities.Award
+	var errs []error
+
+	for i, awardEntity := range awardEntities {
+		createdAward, err := ah.awardUseCase.CreateAward(ctx, awardEntity)
+		if err != nil {
+			ah.logger.Error("Failed to create award at index %d (title: %s): %v", i, awardEntity.Title, err)
+			errs = append(errs, err)
+		} else {
+			createdAwards = append(createdAwards, createdAward)
+		}
+	}
+
+	statusCode := http.StatusCreated
+	if len(awardEntities) == len(errs) {
+		ah.logger.Error("All awards failed to create, returning errors")
+		utils.WriteErrorResponse(w, errs...)
+		return
+	} else if len(errs) > 0 {
+		statusCode = http.StatusMultiStatus
+	}
+
+	response := awardDto.FromAwardsEntityForBulkToResponse(createdAwards, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	domainErrors := make([]*shared.APIError, len(errs))
+	for i, err := range errs {
+		if domainErr, ok := domain.AsDomainError(err); ok {
+			domainErrors[i] = utils.DomainErrorToAPIError(domainErr)
+		}
+	}
+	response.Errors = domainErrors
+	utils.WriteSuccessResponse(w, statusCode, response)
+}
+
+// UpdateAward
+//
+//	@Summary		Update an existing award
+//	@Description	Replace the fields of an award by ID for the authenticated admin user
+//	@Tags			Admin Awards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int								true	"Award ID"
+//	@Param			request	body	dto.UpdateAwardRequest	true	"Award update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the award changed since"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.AwardResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/awards/{id} [put]
+func (ah *awardHandler) UpdateAward(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ah.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid award ID")
+	if !ok {
+		return
+	}
+
+	var request awardDto.UpdateAwardRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ah.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := ah.getUserIDFromContext(w, r)
+	if !ok {
+		ah.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	awardEntity, err := request.ToEntity(id, userID)
+	if err != nil {
+		ah.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid award data", "award", &err))
+		return
+	}
+
+	updatedAward, err := ah.awardUseCase.UpdateAward(ctx, id, awardEntity)
+	if err != nil {
+		ah.logger.Error("Failed to update award %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ah.currentAward)
+		return
+	}
+
+	response := awardDto.FromAwardEntityToResponse(updatedAward,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, updatedAward.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// PatchAward
+//
+//	@Summary		Partially update an award
+//	@Description	Partially update an award by ID for the authenticated admin user
+//	@Tags			Admin Awards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Award ID"
+//	@Param			request	body		dto.PatchAwardRequest	true	"Patch award request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the award changed since"
+//	@Success		200		{object}	shared.APIResponse{data=dto.AwardResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/awards/{id} [patch]
+//	@Security		BearerAuth
+func (ah *awardHandler) PatchAward(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ah.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid award ID")
+	if !ok {
+		return
+	}
+
+	if _, ok := ah.getUserIDFromContext(w, r); !ok {
+		ah.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	var request awardDto.PatchAwardRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ah.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	patchedAward, err := ah.awardUseCase.PatchAward(ctx, id, &request)
+	if err != nil {
+		ah.logger.Error("Failed to patch award %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ah.currentAward)
+		return
+	}
+
+	response := awardDto.FromAwardEntityToResponse(patchedAward,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, patchedAward.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeleteAward
+//
+//	@Summary		Delete an award
+//	@Description	Move an award to the trash by ID for the authenticated admin user
+//	@Tags			Admin Awards
+//	@Produce		json
+//	@Param			id	path	int	true	"Award ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the award changed since"
+//	@Security		BearerAuth
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/awards/{id} [delete]
+func (ah *awardHandler) DeleteAward(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ah.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid award ID")
+	if !ok {
+		return
+	}
+
+	if err := ah.awardUseCase.DeleteAward(ctx, id); err != nil {
+		ah.logger.Error("Failed to delete award %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ah.currentAward)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderAwards
+//
+//	@Summary		Reorder awards
+//	@Description	Set the display order of the awards of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Awards
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Award IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/awards/order [put]
+//	@Security		BearerAuth
+func (ah *awardHandler) ReorderAwards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ah.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := ah.awardUseCase.ReorderAwards(ctx, userID, &request); err != nil {
+		ah.logger.Error("Failed to reorder awards: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ah *awardHandler) currentAward(ctx context.Context, id int) (any, int, error) {
+	award, err := ah.awardUseCase.GetAwardByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return awardDto.FromAwardEntityToResponse(award, nil).Award, award.Version, nil
+}
@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	cacheDto "portfolio/dto/cache"
+	"portfolio/logger"
+	"portfolio/service"
+	"portfolio/shared"
+	"time"
+)
+
+type cacheHandler struct {
+	cache  *service.CacheService
+	logger *logger.Logger
+}
+
+func NewCacheHandler(cache *service.CacheService, logger *logger.Logger) []*routes.NamedRoute {
+	cacheHandler := cacheHandler{
+		cache:  cache,
+		logger: logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetCacheStatsHandler",
+			Pattern: "GET /cache/stats",
+			Handler: cacheHandler.GetStats,
+		},
+		{
+			Name:    "FlushCacheHandler",
+			Pattern: "DELETE /cache",
+			Handler: cacheHandler.Flush,
+		},
+	}
+}
+
+// GetStats
+//
+//	@Summary		Get response cache statistics
+//	@Description	Report size, hit/miss counters and configuration of the public read cache
+//	@Tags			Admin Cache
+//	@Produce		json
+//	@Success		200	{object}	shared.APIResponse{data=dto.CacheStatsResponse}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/cache/stats [get]
+//	@Security		BearerAuth
+func (ch *cacheHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	response := cacheDto.FromCacheStatsToResponse(ch.cache.Stats(),
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(r.Context()),
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// Flush
+//
+//	@Summary		Flush the response cache
+//	@Description	Drop every cached entry; the next public reads go to the database
+//	@Tags			Admin Cache
+//	@Success		204
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/cache [delete]
+//	@Security		BearerAuth
+func (ch *cacheHandler) Flush(w http.ResponseWriter, r *http.Request) {
+	ch.cache.Flush()
+	ch.logger.Info("Response cache flushed")
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,542 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	certificationDto "portfolio/dto/certification"
+	orderDto "portfolio/dto/order"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type certificationHandler struct {
+	AbstractHandler
+	certificationUseCase *usecases.CertificationUseCase
+	logger               *logger.Logger
+}
+
+func NewCertificationHandler(settingUseCase *usecases.SettingUseCase, certificationUseCase *usecases.CertificationUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	certificationHandler := certificationHandler{
+		AbstractHandler:      AbstractHandler{settingUseCase: settingUseCase},
+		certificationUseCase: certificationUseCase,
+		logger:               logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminCertificationsHandler",
+			Pattern: "GET /certifications",
+			Handler: certificationHandler.GetCertifications,
+		},
+		{
+			Name:    "PostAdminCertificationHandler",
+			Pattern: "POST /certifications",
+			Handler: certificationHandler.CreateCertification,
+		},
+		{
+			Name:    "PostBulkAdminCertificationHandler",
+			Pattern: "POST /certifications/bulk",
+			Handler: certificationHandler.CreateBulkCertifications,
+		},
+		{
+			Name:    "PutAdminCertificationsOrderHandler",
+			Pattern: "PUT /certifications/order",
+			Handler: certificationHandler.ReorderCertifications,
+		},
+		{
+			Name:    "GetAdminCertificationHandler",
+			Pattern: "GET /certifications/{id}",
+			Handler: certificationHandler.GetCertification,
+		},
+		{
+			Name:    "PutAdminCertificationHandler",
+			Pattern: "PUT /certifications/{id}",
+			Handler: certificationHandler.UpdateCertification,
+		},
+		{
+			Name:    "PatchAdminCertificationHandler",
+			Pattern: "PATCH /certifications/{id}",
+			Handler: certificationHandler.PatchCertification,
+		},
+		{
+			Name:    "DeleteAdminCertificationHandler",
+			Pattern: "DELETE /certifications/{id}",
+			Handler: certificationHandler.DeleteCertification,
+		},
+	}
+}
+
+// GetCertifications
+//
+//	@Summary		Get all admin certifications
+//	@Description	Retrieve all certifications and licenses of the authenticated admin user. Use filter[expiry] to find the ones that have expired or expire within 90 days
+//	@Tags			Admin Certifications
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, issue_date, expiry_date, name, issuer, created_at; certifications that never expire sort last by expiry_date; defaults to the manual order"
+//	@Param			filter[issuer]	query		string	false	"Filter by issuer"
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
+//	@Param			filter[expiry]	query		string	false	"Filter by expiry status on the current date"	Enums(valid, expiring_soon, expired)
+//	@Success		200	{object}	shared.APIResponse{data=dto.CertificationListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}	"Unauthorized"
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/certifications [get]
+func (ch *certificationHandler) GetCertifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		ch.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.CertificationListSpec)
+	if err != nil {
+		ch.logger.Error("Invalid certification list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	certifications, err := ch.certificationUseCase.GetCertificationsByUserID(ctx, userID, query)
+	if err != nil {
+		ch.logger.Error("Failed to get certifications for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := certificationDto.FromCertificationsEntityToResponse(certifications.Items,
+		utils.WithListMeta(&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		}, r, query, certifications))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetCertification
+//
+//	@Summary		Get a specific admin certification
+//	@Description	Retrieve a specific certification by ID for admin management
+//	@Tags			Admin Certifications
+//	@Produce		json
+//	@Param			id	path	int	true	"Certification ID"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.CertificationResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/certifications/{id} [get]
+func (ch *certificationHandler) GetCertification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := pathID(w, r, "id", "Invalid certification ID")
+	if !ok {
+		return
+	}
+
+	certification, err := ch.certificationUseCase.GetCertificationByID(ctx, id)
+	if err != nil {
+		ch.logger.Error("Failed to get certification %d: %v", id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := certificationDto.FromCertificationEntityToResponse(certification,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, certification.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// CreateCertification
+//
+//	@Summary		Create a new certification
+//	@Description	Create a new certification or license for the authenticated admin user. A credential ID can only be used once per issuer
+//	@Tags			Admin Certifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.CreateCertificationRequest	true	"Certification creation request"
+//	@Security		BearerAuth
+//	@Success		201	{object}	shared.APIResponse{data=dto.CertificationResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		409	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/certifications [post]
+func (ch *certificationHandler) CreateCertification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var request certificationDto.CreateCertificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ch.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		ch.logger.Error("Invalid request: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		ch.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	certificationEntity, err := request.ToEntity(userID)
+	if err != nil {
+		ch.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid certification data", "certification", &err))
+		return
+	}
+
+	createdCertification, err := ch.certificationUseCase.CreateCertification(ctx, certificationEntity)
+	if err != nil {
+		ch.logger.Error("Failed to create certification: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := certificationDto.FromCertificationEntityToResponse(createdCertification,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+// CreateBulkCertifications
+//
+//	@Summary		Create multiple certifications in bulk
+//	@Description	Create multiple certifications for the authenticated admin user
+//	@Tags			Admin Certifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.CreateBulkCertificationsRequest	true	"Bulk certifications creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
+//	@Success		201		{object}	shared.APIResponse{data=dto.CertificationListResponse}
+//	@Success		207		{object}	shared.APIResponse{data=dto.CertificationListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/certifications/bulk [post]
+//	@Security		BearerAuth
+func (ch *certificationHandler) CreateBulkCertifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	atomic, ok := ch.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var request certificationDto.CreateBulkCertificationsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ch.logger.Error("Failed to decode bulk certifications request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	certificationEntities, err := request.ToEntities(userID)
+	if err != nil {
+		ch.logger.Error("Failed to convert bulk request to entities: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid certifications data", "certifications", &err))
+		return
+	}
+
+	if atomic {
+		createdCertifications, err := ch.certificationUseCase.CreateCertificationsAtomically(ctx, certificationEntities)
+		if err != nil {
+			ch.logger.Error("Atomic bulk certification creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := certificationDto.FromCertificationsEntityForBulkToResponse(createdCertifications, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
+	var createdCertifications []*entities.Certification
+	var errs []error
+
+	for i, certificationEntity := range certificationEntities {
+		createdCertification, err := ch.certificationUseCase.CreateCertification(ctx, certificationEntity)
+		if err != nil {
+			ch.logger.Error("Failed to create certification at index %d (name: %s): %v", i, certificationEntity.Name, err)
+			errs = append(errs, err)
+		} else {
+			createdCertifications = append(createdCertifications, createdCertification)
+		}
+	}
+
+	statusCode := http.StatusCreated
+	if len(certificationEntities) == len(errs) {
+		ch.logger.Error("All certifications failed to create, returning errors")
+		utils.WriteErrorResponse(w, errs...)
+		return
+	} else if len(errs) > 0 {
+		statusCode = http.StatusMultiStatus
+	}
+
+	response := certificationDto.FromCertificationsEntityForBulkToResponse(createdCertifications, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	domainErrors := make([]*shared.APIError, len(errs))
+	for i, err := range errs {
+		if domainErr, ok := domain.AsDomainError(err); ok {
+			domainErrors[i] = utils.DomainErrorToAPIError(domainErr)
+		}
+	}
+	response.Errors = domainErrors
+	utils.WriteSuccessResponse(w, statusCode, response)
+}
+
+// UpdateCertification
+//
+//	@Summary		Update an existing certification
+//	@Description	Replace the fields of a certification by ID for the authenticated admin user; an expiry_date left out means it never expires
+//	@Tags			Admin Certifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int								true	"Certification ID"
+//	@Param			request	body	dto.UpdateCertificationRequest	true	"Certification update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the certification changed since"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.CertificationResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		409	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/certifications/{id} [put]
+func (ch *certificationHandler) UpdateCertification(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ch.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid certification ID")
+	if !ok {
+		return
+	}
+
+	var request certificationDto.UpdateCertificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ch.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		ch.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	certificationEntity, err := request.ToEntity(id, userID)
+	if err != nil {
+		ch.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid certification data", "certification", &err))
+		return
+	}
+
+	updatedCertification, err := ch.certificationUseCase.UpdateCertification(ctx, id, certificationEntity)
+	if err != nil {
+		ch.logger.Error("Failed to update certification %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ch.currentCertification)
+		return
+	}
+
+	response := certificationDto.FromCertificationEntityToResponse(updatedCertification,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, updatedCertification.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// PatchCertification
+//
+//	@Summary		Partially update a certification
+//	@Description	Partially update a certification by ID for the authenticated admin user
+//	@Tags			Admin Certifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Certification ID"
+//	@Param			request	body		dto.PatchCertificationRequest	true	"Patch certification request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the certification changed since"
+//	@Success		200		{object}	shared.APIResponse{data=dto.CertificationResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		409		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/certifications/{id} [patch]
+//	@Security		BearerAuth
+func (ch *certificationHandler) PatchCertification(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ch.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid certification ID")
+	if !ok {
+		return
+	}
+
+	if _, ok := ch.getUserIDFromContext(w, r); !ok {
+		ch.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	var request certificationDto.PatchCertificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ch.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	patchedCertification, err := ch.certificationUseCase.PatchCertification(ctx, id, &request)
+	if err != nil {
+		ch.logger.Error("Failed to patch certification %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ch.currentCertification)
+		return
+	}
+
+	response := certificationDto.FromCertificationEntityToResponse(patchedCertification,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, patchedCertification.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeleteCertification
+//
+//	@Summary		Delete a certification
+//	@Description	Move a certification to the trash by ID for the authenticated admin user
+//	@Tags			Admin Certifications
+//	@Produce		json
+//	@Param			id	path	int	true	"Certification ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the certification changed since"
+//	@Security		BearerAuth
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/certifications/{id} [delete]
+func (ch *certificationHandler) DeleteCertification(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ch.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid certification ID")
+	if !ok {
+		return
+	}
+
+	if err := ch.certificationUseCase.DeleteCertification(ctx, id); err != nil {
+		ch.logger.Error("Failed to delete certification %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ch.currentCertification)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderCertifications
+//
+//	@Summary		Reorder certifications
+//	@Description	Set the display order of the certifications of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Certifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Certification IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/certifications/order [put]
+//	@Security		BearerAuth
+func (ch *certificationHandler) ReorderCertifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := ch.certificationUseCase.ReorderCertifications(ctx, userID, &request); err != nil {
+		ch.logger.Error("Failed to reorder certifications: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ch *certificationHandler) currentCertification(ctx context.Context, id int) (any, int, error) {
+	certification, err := ch.certificationUseCase.GetCertificationByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return certificationDto.FromCertificationEntityToResponse(certification, nil).Certification, certification.Version, nil
+}
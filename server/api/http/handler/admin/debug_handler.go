@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/pprof"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/config"
+	"portfolio/domain"
+	"portfolio/infrastructure/postgres"
+	"portfolio/infrastructure/sqlite"
+	"portfolio/logger"
+	"portfolio/shared"
+	"runtime"
+	runtimePprof "runtime/pprof"
+	"time"
+)
+
+type debugHandler struct {
+	db        *sql.DB
+	driver    string
+	logger    *logger.Logger
+	startedAt time.Time
+}
+
+// NewDebugHandler exposes runtime diagnostics. The routes are mounted outside
+// the JSON response middleware because pprof serves binary and text payloads.
+func NewDebugHandler(db *sql.DB, driver string, logger *logger.Logger) []*routes.NamedRoute {
+	debugHandler := debugHandler{
+		db:        db,
+		driver:    driver,
+		logger:    logger,
+		startedAt: time.Now(),
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminDebugHandler",
+			Pattern: "GET /debug/{$}",
+			Handler: debugHandler.GetRuntime,
+		},
+		{
+			Name:    "GetAdminDebugGoroutinesHandler",
+			Pattern: "GET /debug/goroutines",
+			Handler: debugHandler.GetGoroutines,
+		},
+		{
+			Name:    "GetAdminDebugDatabaseHandler",
+			Pattern: "GET /debug/database",
+			Handler: debugHandler.GetDatabase,
+		},
+		{
+			Name:    "GetAdminDebugLoggerHandler",
+			Pattern: "GET /debug/logger",
+			Handler: debugHandler.GetLogger,
+		},
+		{
+			Name:    "GetAdminDebugPprofIndexHandler",
+			Pattern: "GET /debug/pprof/",
+			Handler: pprof.Index,
+		},
+		{
+			Name:    "GetAdminDebugPprofCmdlineHandler",
+			Pattern: "GET /debug/pprof/cmdline",
+			Handler: pprof.Cmdline,
+		},
+		{
+			Name:    "GetAdminDebugPprofProfileHandler",
+			Pattern: "GET /debug/pprof/profile",
+			Handler: pprof.Profile,
+		},
+		{
+			Name:    "GetAdminDebugPprofSymbolHandler",
+			Pattern: "GET /debug/pprof/symbol",
+			Handler: pprof.Symbol,
+		},
+		{
+			Name:    "PostAdminDebugPprofSymbolHandler",
+			Pattern: "POST /debug/pprof/symbol",
+			Handler: pprof.Symbol,
+		},
+		{
+			Name:    "GetAdminDebugPprofTraceHandler",
+			Pattern: "GET /debug/pprof/trace",
+			Handler: pprof.Trace,
+		},
+	}
+}
+
+// GetRuntime
+//
+//	@Summary		Get runtime diagnostics
+//	@Description	Retrieve Go runtime and memory statistics (only available when debug is enabled)
+//	@Tags			Admin Debug
+//	@Produce		json
+//	@Success		200	{object}	shared.APIResponse{data=map[string]any}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/debug/ [get]
+//	@Security		BearerAuth
+func (dh *debugHandler) GetRuntime(w http.ResponseWriter, r *http.Request) {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	dh.writeData(w, r, map[string]any{
+		"go_version":     runtime.Version(),
+		"goos":           runtime.GOOS,
+		"goarch":         runtime.GOARCH,
+		"num_cpu":        runtime.NumCPU(),
+		"gomaxprocs":     runtime.GOMAXPROCS(0),
+		"num_goroutine":  runtime.NumGoroutine(),
+		"uptime":         time.Since(dh.startedAt).Round(time.Second).String(),
+		"heap_alloc":     memStats.HeapAlloc,
+		"heap_sys":       memStats.HeapSys,
+		"heap_objects":   memStats.HeapObjects,
+		"total_alloc":    memStats.TotalAlloc,
+		"sys":            memStats.Sys,
+		"num_gc":         memStats.NumGC,
+		"pause_total_ns": memStats.PauseTotalNs,
+		"endpoints": []string{
+			"/admin/debug/goroutines",
+			"/admin/debug/database",
+			"/admin/debug/logger",
+			"/admin/debug/pprof/",
+		},
+	})
+}
+
+// GetGoroutines
+//
+//	@Summary		Dump goroutines
+//	@Description	Return a plain-text dump of all goroutine stacks
+//	@Tags			Admin Debug
+//	@Produce		plain
+//	@Success		200	{string}	string
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/debug/goroutines [get]
+//	@Security		BearerAuth
+func (dh *debugHandler) GetGoroutines(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	if err := runtimePprof.Lookup("goroutine").WriteTo(w, 2); err != nil {
+		dh.logger.Error("Failed to dump goroutines: %v", err)
+	}
+}
+
+// GetDatabase
+//
+//	@Summary		Get database diagnostics
+//	@Description	Retrieve database/sql connection pool statistics and the current driver settings (SQLite pragmas or PostgreSQL parameters)
+//	@Tags			Admin Debug
+//	@Produce		json
+//	@Success		200	{object}	shared.APIResponse{data=map[string]any}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		503	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/debug/database [get]
+//	@Security		BearerAuth
+func (dh *debugHandler) GetDatabase(w http.ResponseWriter, r *http.Request) {
+	if dh.db == nil {
+		utils.WriteErrorResponse(w, domain.NewServiceUnavailableError("No database connection (demo mode)"))
+		return
+	}
+
+	diagnose := sqlite.DiagnoseConnection
+	if dh.driver == config.DriverPostgres {
+		diagnose = postgres.DiagnoseConnection
+	}
+
+	settings, err := diagnose(dh.db, dh.logger)
+	if err != nil {
+		dh.logger.Error("Failed to diagnose database connection: %v", err)
+		utils.WriteErrorResponse(w, domain.NewDatabaseError("diagnose connection", err))
+		return
+	}
+
+	stats := dh.db.Stats()
+	dh.writeData(w, r, map[string]any{
+		"stats": map[string]any{
+			"max_open_connections": stats.MaxOpenConnections,
+			"open_connections":     stats.OpenConnections,
+			"in_use":               stats.InUse,
+			"idle":                 stats.Idle,
+			"wait_count":           stats.WaitCount,
+			"wait_duration":        stats.WaitDuration.String(),
+			"max_idle_closed":      stats.MaxIdleClosed,
+			"max_idle_time_closed": stats.MaxIdleTimeClosed,
+			"max_lifetime_closed":  stats.MaxLifetimeClosed,
+		},
+		"driver":   dh.driver,
+		"settings": settings,
+	})
+}
+
+// GetLogger
+//
+//	@Summary		Get logger diagnostics
+//	@Description	Retrieve the log rotation status and backup files
+//	@Tags			Admin Debug
+//	@Produce		json
+//	@Success		200	{object}	shared.APIResponse{data=map[string]any}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/debug/logger [get]
+//	@Security		BearerAuth
+func (dh *debugHandler) GetLogger(w http.ResponseWriter, r *http.Request) {
+	dh.writeData(w, r, map[string]any{
+		"rotation_status": dh.logger.CheckRotationStatus(),
+		"rotation_info":   dh.logger.GetRotationInfo(),
+		"backup_files":    dh.logger.GetBackupFiles(),
+	})
+}
+
+// writeData wraps the payload the same way ResponseMiddleware does for the
+// other admin routes, since the debug area is served outside of it.
+func (dh *debugHandler) writeData(w http.ResponseWriter, r *http.Request, data any) {
+	utils.WriteSuccessResponse(w, http.StatusOK, shared.APIResponse{
+		Data: data,
+		Meta: shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(r.Context()),
+		},
+	})
+}
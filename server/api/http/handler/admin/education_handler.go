@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"portfolio/api/http/routes"
@@ -9,6 +10,7 @@ import (
 	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
 	educationDto "portfolio/dto/education"
+	orderDto "portfolio/dto/order"
 	"portfolio/logger"
 	"portfolio/shared"
 	"strconv"
@@ -44,6 +46,11 @@ func NewEducationHandler(settingUseCase *usecases.SettingUseCase, educationUseCa
 			Pattern: "POST /educations/bulk",
 			Handler: educationHandler.CreateBulkEducations,
 		},
+		{
+			Name:    "PutAdminEducationsOrderHandler",
+			Pattern: "PUT /educations/order",
+			Handler: educationHandler.ReorderEducations,
+		},
 		{
 			Name:    "GetAdminEducationHandler",
 			Pattern: "GET /educations/{id}",
@@ -74,7 +81,14 @@ func NewEducationHandler(settingUseCase *usecases.SettingUseCase, educationUseCa
 //	@Tags			Admin Educations
 //	@Produce		json
 //	@Security		BearerAuth
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, start_date, degree, institution, created_at; defaults to the manual order"
+//	@Param			filter[institution]	query		string	false	"Filter by institution"
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
 //	@Success		200	{object}	shared.APIResponse{data=dto.EducationListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}	"Unauthorized"
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/educations [get]
@@ -87,18 +101,25 @@ func (eh *educationHandler) GetEducations(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	educations, err := eh.educationUseCase.GetEducationsByUserID(ctx, userID)
+	query, err := utils.ParseListQuery(r, entities.EducationListSpec)
+	if err != nil {
+		eh.logger.Error("Invalid education list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	educations, err := eh.educationUseCase.GetEducationsByUserID(ctx, userID, query)
 	if err != nil {
 		eh.logger.Error("Failed to get educations for user %d: %v", userID, err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := educationDto.FromEducationsEntityToResponse(educations,
-		&shared.Meta{
+	response := educationDto.FromEducationsEntityToResponse(educations.Items,
+		utils.WithListMeta(&shared.Meta{
 			"timestamp":  time.Now().Format(time.RFC3339),
 			"request_id": utils.GetRequestIDFromContext(ctx),
-		})
+		}, r, query, educations))
 
 	if response == nil {
 		eh.logger.Error("No educations found for user %d", userID)
@@ -118,6 +139,7 @@ func (eh *educationHandler) GetEducations(w http.ResponseWriter, r *http.Request
 //	@Param			id	path	int	true	"Education ID"
 //	@Security		BearerAuth
 //	@Success		200	{object}	shared.APIResponse{data=dto.EducationResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -158,6 +180,7 @@ func (eh *educationHandler) GetEducation(w http.ResponseWriter, r *http.Request)
 			"timestamp":  time.Now().Format(time.RFC3339),
 			"request_id": utils.GetRequestIDFromContext(ctx),
 		})
+	setETag(w, educationEntity.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -221,6 +244,7 @@ func (eh *educationHandler) CreateEducation(w http.ResponseWriter, r *http.Reque
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		dto.CreateBulkEducationsRequest	true	"Bulk educations creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
 //	@Success		201		{object}	shared.APIResponse{data=dto.EducationListResponse}
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -229,6 +253,11 @@ func (eh *educationHandler) CreateEducation(w http.ResponseWriter, r *http.Reque
 //	@Security		BearerAuth
 func (eh *educationHandler) CreateBulkEducations(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	atomic, ok := eh.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
 	var request educationDto.CreateBulkEducationsRequest
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		eh.logger.Error("Failed to decode bulk educations request body: %v", err)
@@ -253,6 +282,22 @@ func (eh *educationHandler) CreateBulkEducations(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if atomic {
+		createdEducations, err := eh.educationUseCase.CreateEducationsAtomically(ctx, educationEntities)
+		if err != nil {
+			eh.logger.Error("Atomic bulk education creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := educationDto.FromEducationsEntityForBulkToResponse(createdEducations, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
 	var createdEducations []*entities.Education
 	var errs []error
 
@@ -299,15 +344,22 @@ func (eh *educationHandler) CreateBulkEducations(w http.ResponseWriter, r *http.
 //	@Produce		json
 //	@Param			id		path	int							true	"Education ID"
 //	@Param			request	body	dto.UpdateEducationRequest	true	"Education update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the education changed since"
 //	@Security		BearerAuth
 //	@Success		200	{object}	shared.APIResponse{data=dto.EducationResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/educations/{id} [put]
 func (eh *educationHandler) UpdateEducation(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := eh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	idStr := r.PathValue("id")
 	if idStr == "" {
@@ -348,7 +400,7 @@ func (eh *educationHandler) UpdateEducation(w http.ResponseWriter, r *http.Reque
 	updatedEducation, err := eh.educationUseCase.UpdateEducation(ctx, id, educationEntity)
 	if err != nil {
 		eh.logger.Error("Failed to update education %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, eh.currentEducation)
 		return
 	}
 
@@ -357,6 +409,7 @@ func (eh *educationHandler) UpdateEducation(w http.ResponseWriter, r *http.Reque
 			"timestamp":  time.Now().Format(time.RFC3339),
 			"request_id": utils.GetRequestIDFromContext(ctx),
 		})
+	setETag(w, updatedEducation.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -369,15 +422,22 @@ func (eh *educationHandler) UpdateEducation(w http.ResponseWriter, r *http.Reque
 //	@Produce		json
 //	@Param			id		path		int								true	"Education ID"
 //	@Param			request	body		dto.PatchEducationRequest	true	"Patch education request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the education changed since"
 //	@Success		200		{object}	shared.APIResponse{data=dto.EducationResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/educations/{id} [patch]
 //	@Security		BearerAuth
 func (eh *educationHandler) PatchEducation(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := eh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	idStr := r.PathValue("id")
 	if idStr == "" {
@@ -422,7 +482,7 @@ func (eh *educationHandler) PatchEducation(w http.ResponseWriter, r *http.Reques
 	updatedEducation, err := eh.educationUseCase.PatchEducation(ctx, id, educationEntity)
 	if err != nil {
 		eh.logger.Error("Failed to patch education %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, eh.currentEducation)
 		return
 	}
 
@@ -431,6 +491,7 @@ func (eh *educationHandler) PatchEducation(w http.ResponseWriter, r *http.Reques
 			"timestamp":  time.Now().Format(time.RFC3339),
 			"request_id": utils.GetRequestIDFromContext(ctx),
 		})
+	setETag(w, updatedEducation.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -442,15 +503,21 @@ func (eh *educationHandler) PatchEducation(w http.ResponseWriter, r *http.Reques
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path	int	true	"Education ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the education changed since"
 //	@Security		BearerAuth
 //	@Success		204	"No Content"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/educations/{id} [delete]
 func (eh *educationHandler) DeleteEducation(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := eh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	idStr := r.PathValue("id")
 	if idStr == "" {
@@ -469,9 +536,53 @@ func (eh *educationHandler) DeleteEducation(w http.ResponseWriter, r *http.Reque
 	err = eh.educationUseCase.DeleteEducation(ctx, id)
 	if err != nil {
 		eh.logger.Error("Failed to delete education %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, eh.currentEducation)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderEducations
+//
+//	@Summary		Reorder educations
+//	@Description	Set the display order of the educations of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Educations
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Education IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/educations/order [put]
+//	@Security		BearerAuth
+func (eh *educationHandler) ReorderEducations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := eh.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := eh.educationUseCase.ReorderEducations(ctx, userID, &request); err != nil {
+		eh.logger.Error("Failed to reorder educations: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (eh *educationHandler) currentEducation(ctx context.Context, id int) (any, int, error) {
+	education, err := eh.educationUseCase.GetEducationByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return educationDto.FromEducationEntityToResponse(education, nil).Education, education.Version, nil
+}
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
 	experienceDto "portfolio/dto/experience"
+	orderDto "portfolio/dto/order"
 	"portfolio/logger"
 	"portfolio/shared"
 	"strconv"
@@ -45,6 +47,11 @@ func NewExperienceHandler(settingUseCase *usecases.SettingUseCase, experienceUse
 			Pattern: "POST /experiences/bulk",
 			Handler: experienceHandler.CreateBulkExperiences,
 		},
+		{
+			Name:    "PutAdminExperiencesOrderHandler",
+			Pattern: "PUT /experiences/order",
+			Handler: experienceHandler.ReorderExperiences,
+		},
 		{
 			Name:    "GetAdminExperienceHandler",
 			Pattern: "GET /experiences/{id}",
@@ -75,7 +82,17 @@ func NewExperienceHandler(settingUseCase *usecases.SettingUseCase, experienceUse
 //	@Tags			Admin Experiences
 //	@Accept			json
 //	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, start_date, job_title, company_name, company, created_at; company orders companies by their latest position; defaults to the manual order"
+//	@Param			filter[company_name]	query		string	false	"Filter by company name"
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
+//	@Param			filter[employment_type]	query		string	false	"Filter by employment type"	Enums(full_time, contract, freelance)
+//	@Param			filter[remote]	query		string	false	"Filter by remote work"	Enums(true, false)
+//	@Param			filter[technology]	query		string	false	"Filter by the name of a linked technology"
 //	@Success		200	{object}	shared.APIResponse{data=dto.ExperienceListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/experiences [get]
@@ -89,18 +106,25 @@ func (eh *experienceHandler) GetExperiences(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	experiences, err := eh.experienceUseCase.GetExperiencesByUserID(ctx, userID)
+	query, err := utils.ParseListQuery(r, entities.ExperienceListSpec)
+	if err != nil {
+		eh.logger.Error("Invalid experience list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	experiences, err := eh.experienceUseCase.GetExperiencesByUserID(ctx, userID, query)
 	if err != nil {
 		eh.logger.Error("Failed to get experiences for user %d: %v", userID, err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := experienceDto.FromExperiencesEntityToResponse(experiences,
-		&shared.Meta{
+	response := experienceDto.FromExperiencesEntityToResponse(experiences.Items,
+		utils.WithListMeta(&shared.Meta{
 			"timestamp":  time.Now().Format(time.RFC3339),
 			"request_id": utils.GetRequestIDFromContext(ctx),
-		})
+		}, r, query, experiences))
 
 	if response == nil {
 		eh.logger.Error("No experiences found for user %d", userID)
@@ -120,6 +144,7 @@ func (eh *experienceHandler) GetExperiences(w http.ResponseWriter, r *http.Reque
 //	@Produce		json
 //	@Param			id	path		int	true	"Experience ID"
 //	@Success		200	{object}	shared.APIResponse{data=dto.ExperienceResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -160,6 +185,7 @@ func (eh *experienceHandler) GetExperience(w http.ResponseWriter, r *http.Reques
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, experienceEntity.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -230,6 +256,7 @@ func (eh *experienceHandler) CreateExperience(w http.ResponseWriter, r *http.Req
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		dto.CreateBulkExperiencesRequest	true	"Bulk experiences creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
 //	@Success		201		{object}	shared.APIResponse{data=dto.ExperienceListResponse}
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -238,6 +265,11 @@ func (eh *experienceHandler) CreateExperience(w http.ResponseWriter, r *http.Req
 //	@Security		BearerAuth
 func (eh *experienceHandler) CreateBulkExperiences(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	atomic, ok := eh.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
 	var request experienceDto.CreateBulkExperiencesRequest
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		eh.logger.Error("Failed to decode bulk experiences request body: %v", err)
@@ -262,6 +294,22 @@ func (eh *experienceHandler) CreateBulkExperiences(w http.ResponseWriter, r *htt
 		return
 	}
 
+	if atomic {
+		createdExperiences, err := eh.experienceUseCase.CreateExperiencesAtomically(ctx, experienceEntities)
+		if err != nil {
+			eh.logger.Error("Atomic bulk experience creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := experienceDto.FromExperiencesEntityForBulkToResponse(createdExperiences, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
 	var createdExperiences []*entities.Experience
 	var errs []error
 
@@ -308,15 +356,22 @@ func (eh *experienceHandler) CreateBulkExperiences(w http.ResponseWriter, r *htt
 //	@Produce		json
 //	@Param			id		path		int							true	"Experience ID"
 //	@Param			request	body		dto.UpdateExperienceRequest	true	"Experience update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the experience changed since"
 //	@Success		200		{object}	shared.APIResponse{data=dto.ExperienceResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/experiences/{id} [put]
 //	@Security		BearerAuth
 func (eh *experienceHandler) UpdateExperience(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := eh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	idStr := r.PathValue("id")
 	if idStr == "" {
@@ -355,7 +410,7 @@ func (eh *experienceHandler) UpdateExperience(w http.ResponseWriter, r *http.Req
 	updatedExperience, err := eh.experienceUseCase.UpdateExperience(ctx, id, experienceEntity)
 	if err != nil {
 		eh.logger.Error("Failed to update experience %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, eh.currentExperience)
 		return
 	}
 
@@ -363,6 +418,7 @@ func (eh *experienceHandler) UpdateExperience(w http.ResponseWriter, r *http.Req
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, updatedExperience.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -375,15 +431,22 @@ func (eh *experienceHandler) UpdateExperience(w http.ResponseWriter, r *http.Req
 //	@Produce		json
 //	@Param			id		path		int								true	"Experience ID"
 //	@Param			request	body		dto.PatchExperienceRequest	true	"Patch experience request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the experience changed since"
 //	@Success		200		{object}	shared.APIResponse{data=dto.ExperienceResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/experiences/{id} [patch]
 //	@Security		BearerAuth
 func (eh *experienceHandler) PatchExperience(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := eh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	idStr := r.PathValue("id")
 	if idStr == "" {
@@ -399,8 +462,7 @@ func (eh *experienceHandler) PatchExperience(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	userID, ok := eh.getUserIDFromContext(w, r)
-	if !ok {
+	if _, ok := eh.getUserIDFromContext(w, r); !ok {
 		eh.logger.Error("Failed to get user ID from context")
 		return
 	}
@@ -417,17 +479,10 @@ func (eh *experienceHandler) PatchExperience(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	experienceEntity, err := request.ToEntity(id, userID)
-	if err != nil {
-		eh.logger.Error("Failed to convert request to entity: %v", err)
-		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid experience data", "experience", &err))
-		return
-	}
-
-	updatedExperience, err := eh.experienceUseCase.PatchExperience(ctx, id, experienceEntity)
+	updatedExperience, err := eh.experienceUseCase.PatchExperience(ctx, id, &request)
 	if err != nil {
 		eh.logger.Error("Failed to patch experience %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, eh.currentExperience)
 		return
 	}
 
@@ -435,6 +490,7 @@ func (eh *experienceHandler) PatchExperience(w http.ResponseWriter, r *http.Requ
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, updatedExperience.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -446,11 +502,17 @@ func (eh *experienceHandler) PatchExperience(w http.ResponseWriter, r *http.Requ
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path	int	true	"Experience ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the experience changed since"
 //	@Success		204	"No Content"
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/experiences/{id} [delete]
 //	@Security		BearerAuth
 func (eh *experienceHandler) DeleteExperience(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := eh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	idStr := r.PathValue("id")
 	if idStr == "" {
@@ -469,9 +531,53 @@ func (eh *experienceHandler) DeleteExperience(w http.ResponseWriter, r *http.Req
 	err = eh.experienceUseCase.DeleteExperience(ctx, id)
 	if err != nil {
 		eh.logger.Error("Failed to delete experience %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, eh.currentExperience)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderExperiences
+//
+//	@Summary		Reorder experiences
+//	@Description	Set the display order of the experiences of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Experiences
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Experience IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/experiences/order [put]
+//	@Security		BearerAuth
+func (eh *experienceHandler) ReorderExperiences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := eh.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := eh.experienceUseCase.ReorderExperiences(ctx, userID, &request); err != nil {
+		eh.logger.Error("Failed to reorder experiences: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (eh *experienceHandler) currentExperience(ctx context.Context, id int) (any, int, error) {
+	experience, err := eh.experienceUseCase.GetExperienceByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return experienceDto.FromExperienceEntityToResponse(experience, nil).Experience, experience.Version, nil
+}
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"portfolio/api/http/routes"
@@ -84,6 +85,7 @@ func (pih *personalInfoHandler) getUserFromContext(w http.ResponseWriter, r *htt
 //	@Tags			Admin Personal Info
 //	@Produce		json
 //	@Success		200	{object}	shared.APIResponse{data=dto.PersonalInfoResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/personal-info [get]
@@ -118,6 +120,7 @@ func (pih *personalInfoHandler) GetPersonalInfo(w http.ResponseWriter, r *http.R
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, personalInfo.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, personalInfoResponse)
 }
 
@@ -179,10 +182,14 @@ func (pih *personalInfoHandler) CreatePersonalInfo(w http.ResponseWriter, r *htt
 //	@Produce		json
 //	@Param			id		path		int								true	"Personal Info ID"
 //	@Param			request	body		dto.UpdatePersonalInfoRequest	true	"Personal info update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the personal information changed since"
 //	@Success		200		{object}	shared.APIResponse{data=dto.PersonalInfoResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/personal-info/{id} [put]
 //	@Security		BearerAuth
@@ -194,7 +201,10 @@ func (pih *personalInfoHandler) UpdatePersonalInfo(w http.ResponseWriter, r *htt
 		utils.WriteErrorResponse(w, domain.NewInvalidFormatError("id", "integer"))
 		return
 	}
-	ctx := r.Context()
+	ctx, ok := pih.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	userID, ok := pih.getUserIDFromContext(w, r)
 	if !ok {
@@ -217,6 +227,10 @@ func (pih *personalInfoHandler) UpdatePersonalInfo(w http.ResponseWriter, r *htt
 	personalInfo, err := pih.personalInfoUseCase.UpdatePersonalInfo(ctx, id, &request)
 	if err != nil {
 		pih.logger.Error("Failed to update personal info: %v", err)
+		if domain.IsPreconditionFailed(err) {
+			writeVersionedError(ctx, w, err, id, pih.currentPersonalInfo)
+			return
+		}
 		utils.WriteErrorResponse(w, domain.NewInternalError("Failed to update personal information", err))
 		return
 	}
@@ -237,7 +251,7 @@ func (pih *personalInfoHandler) UpdatePersonalInfo(w http.ResponseWriter, r *htt
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
-
+	setETag(w, personalInfo.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, personalInfoResponse)
 }
 
@@ -250,10 +264,14 @@ func (pih *personalInfoHandler) UpdatePersonalInfo(w http.ResponseWriter, r *htt
 //	@Produce		json
 //	@Param			id		path		int								true	"Personal Info ID"
 //	@Param			request	body		dto.PatchPersonalInfoRequest	true	"Personal info patch request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the personal information changed since"
 //	@Success		200		{object}	shared.APIResponse{data=dto.PersonalInfoResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/personal-info/{id} [patch]
 //	@Security		BearerAuth
@@ -265,7 +283,10 @@ func (pih *personalInfoHandler) PatchPersonalInfo(w http.ResponseWriter, r *http
 		utils.WriteErrorResponse(w, domain.NewInvalidFormatError("id", "integer"))
 		return
 	}
-	ctx := r.Context()
+	ctx, ok := pih.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	userID, ok := pih.getUserIDFromContext(w, r)
 	if !ok {
@@ -283,6 +304,10 @@ func (pih *personalInfoHandler) PatchPersonalInfo(w http.ResponseWriter, r *http
 	personalInfo, err := pih.personalInfoUseCase.PatchPersonalInfo(ctx, id, &request)
 	if err != nil {
 		pih.logger.Error("Failed to patch personal info: %v", err)
+		if domain.IsPreconditionFailed(err) {
+			writeVersionedError(ctx, w, err, id, pih.currentPersonalInfo)
+			return
+		}
 		utils.WriteErrorResponse(w, domain.NewInternalError("Failed to patch personal information", err))
 		return
 	}
@@ -303,7 +328,7 @@ func (pih *personalInfoHandler) PatchPersonalInfo(w http.ResponseWriter, r *http
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
-
+	setETag(w, personalInfo.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, personalInfoResponse)
 }
 
@@ -314,10 +339,13 @@ func (pih *personalInfoHandler) PatchPersonalInfo(w http.ResponseWriter, r *http
 //	@Tags			Admin Personal Info
 //	@Produce		json
 //	@Param			id	path	int	true	"Personal Info ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the personal information changed since"
 //	@Success		204	"No Content"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/personal-info/{id} [delete]
 //	@Security		BearerAuth
@@ -329,13 +357,32 @@ func (pih *personalInfoHandler) DeletePersonalInfo(w http.ResponseWriter, r *htt
 		utils.WriteErrorResponse(w, domain.NewInvalidFormatError("id", "integer"))
 		return
 	}
-	ctx := r.Context()
+	ctx, ok := pih.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	if err := pih.personalInfoUseCase.DeletePersonalInfo(ctx, id); err != nil {
 		pih.logger.Error("Failed to delete personal info: %v", err)
+		if domain.IsPreconditionFailed(err) {
+			writeVersionedError(ctx, w, err, id, pih.currentPersonalInfo)
+			return
+		}
 		utils.WriteErrorResponse(w, domain.NewInternalError("Failed to delete personal information", err))
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (pih *personalInfoHandler) currentPersonalInfo(ctx context.Context, id int) (any, int, error) {
+	personalInfo, err := pih.personalInfoUseCase.GetPersonalInfoByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	user, err := pih.personalInfoUseCase.GetUserByID(ctx, personalInfo.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return personalInfoDto.FromPersonalInfoEntityToResponse(user, personalInfo, nil).PersonalInfo, personalInfo.Version, nil
+}
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"portfolio/api/http/routes"
@@ -8,6 +9,7 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
 	projectDto "portfolio/dto/project"
 	"portfolio/logger"
 	"portfolio/shared"
@@ -44,6 +46,11 @@ func NewProjectHandler(settingUseCase *usecases.SettingUseCase, projectUseCase *
 			Pattern: "POST /projects/bulk",
 			Handler: projectHandler.CreateBulkProjects,
 		},
+		{
+			Name:    "PutAdminProjectsOrderHandler",
+			Pattern: "PUT /projects/order",
+			Handler: projectHandler.ReorderProjects,
+		},
 		{
 			Name:    "GetAdminProjectHandler",
 			Pattern: "GET /projects/{id}",
@@ -73,7 +80,16 @@ func NewProjectHandler(settingUseCase *usecases.SettingUseCase, projectUseCase *
 //	@Description	Retrieve all projects for the authenticated admin user
 //	@Tags			Admin Projects
 //	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, title, status, created_at, updated_at; defaults to the manual order"
+//	@Param			filter[status]	query		string	false	"Filter by status"
+//	@Param			filter[technology]	query		string	false	"Filter by technology name"
+//	@Param			filter[featured]	query		bool	false	"Filter by featured flag"
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
 //	@Success		200	{object}	shared.APIResponse{data=dto.ProjectListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/projects [get]
@@ -85,18 +101,25 @@ func (ph *projectHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	projectsEntity, err := ph.projectUseCase.GetProjectsByUserID(ctx, userID)
+	query, err := utils.ParseListQuery(r, entities.ProjectListSpec)
+	if err != nil {
+		ph.logger.Error("Invalid project list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	projectsEntity, err := ph.projectUseCase.GetProjectsByUserID(ctx, userID, query)
 	if err != nil {
 		ph.logger.Error("Failed to get projects for user %d: %v", userID, err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := projectDto.FromProjectsEntityToResponse(projectsEntity,
-		&shared.Meta{
+	response := projectDto.FromProjectsEntityToResponse(projectsEntity.Items,
+		utils.WithListMeta(&shared.Meta{
 			"timestamp":  time.Now().Format(time.RFC3339),
 			"request_id": utils.GetRequestIDFromContext(ctx),
-		})
+		}, r, query, projectsEntity))
 
 	if response == nil {
 		ph.logger.Error("No projects found for user %d", userID)
@@ -115,6 +138,7 @@ func (ph *projectHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			id	path		int	true	"Project ID"
 //	@Success		200	{object}	shared.APIResponse{data=dto.ProjectResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -154,6 +178,7 @@ func (ph *projectHandler) GetProject(w http.ResponseWriter, r *http.Request) {
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, projectEntity.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -214,6 +239,7 @@ func (ph *projectHandler) CreateProject(w http.ResponseWriter, r *http.Request)
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		dto.CreateBulkProjectsRequest	true	"Bulk projects creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
 //	@Success		201		{object}	shared.APIResponse{data=dto.ProjectListResponse}
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -222,6 +248,11 @@ func (ph *projectHandler) CreateProject(w http.ResponseWriter, r *http.Request)
 //	@Security		BearerAuth
 func (ph *projectHandler) CreateBulkProjects(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	atomic, ok := ph.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
 	var request projectDto.CreateBulkProjectsRequest
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		ph.logger.Error("Failed to decode bulk projects request body: %v", err)
@@ -239,6 +270,22 @@ func (ph *projectHandler) CreateBulkProjects(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if atomic {
+		createdProjects, err := ph.projectUseCase.CreateProjectsAtomically(ctx, userID, request.Projects)
+		if err != nil {
+			ph.logger.Error("Atomic bulk project creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := projectDto.FromProjectsEntityForBulkToResponse(createdProjects, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
 	var createdProjects []*entities.Project
 	var errs []error
 
@@ -285,15 +332,22 @@ func (ph *projectHandler) CreateBulkProjects(w http.ResponseWriter, r *http.Requ
 //	@Produce		json
 //	@Param			id		path		int								true	"Project ID"
 //	@Param			request	body		dto.UpdateProjectRequest	true	"Project update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the project changed since"
 //	@Success		200		{object}	shared.APIResponse{data=dto.ProjectResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/projects/{id} [put]
 //	@Security		BearerAuth
 func (ph *projectHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := ph.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 	idStr := r.PathValue("id")
 	if idStr == "" {
 		ph.logger.Error("Project ID is required")
@@ -324,7 +378,7 @@ func (ph *projectHandler) UpdateProject(w http.ResponseWriter, r *http.Request)
 	projectEntity, err := ph.projectUseCase.UpdateProject(ctx, id, &request)
 	if err != nil {
 		ph.logger.Error("Failed to update project %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, ph.currentProject)
 		return
 	}
 
@@ -332,6 +386,7 @@ func (ph *projectHandler) UpdateProject(w http.ResponseWriter, r *http.Request)
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, projectEntity.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -344,15 +399,22 @@ func (ph *projectHandler) UpdateProject(w http.ResponseWriter, r *http.Request)
 //	@Produce		json
 //	@Param			id		path		int							true	"Project ID"
 //	@Param			request	body		dto.PatchProjectRequest		true	"Patch project request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the project changed since"
 //	@Success		200		{object}	shared.APIResponse{data=dto.ProjectResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/projects/{id} [patch]
 //	@Security		BearerAuth
 func (ph *projectHandler) PatchProject(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := ph.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 	idStr := r.PathValue("id")
 	if idStr == "" {
 		ph.logger.Error("Project ID is required")
@@ -389,7 +451,7 @@ func (ph *projectHandler) PatchProject(w http.ResponseWriter, r *http.Request) {
 	projectEntity, err := ph.projectUseCase.PatchProject(ctx, id, &req)
 	if err != nil {
 		ph.logger.Error("Failed to patch project %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, ph.currentProject)
 		return
 	}
 
@@ -397,6 +459,7 @@ func (ph *projectHandler) PatchProject(w http.ResponseWriter, r *http.Request) {
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, projectEntity.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -407,15 +470,21 @@ func (ph *projectHandler) PatchProject(w http.ResponseWriter, r *http.Request) {
 //	@Tags			Admin Projects
 //	@Produce		json
 //	@Param			id	path		int	true	"Project ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the project changed since"
 //	@Success		204	"No Content"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/projects/{id} [delete]
 //	@Security		BearerAuth
 func (ph *projectHandler) DeleteProject(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := ph.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 	idStr := r.PathValue("id")
 	if idStr == "" {
 		ph.logger.Error("Project ID is required")
@@ -433,9 +502,53 @@ func (ph *projectHandler) DeleteProject(w http.ResponseWriter, r *http.Request)
 	err = ph.projectUseCase.DeleteProject(ctx, id)
 	if err != nil {
 		ph.logger.Error("Failed to delete project %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ph.currentProject)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderProjects
+//
+//	@Summary		Reorder projects
+//	@Description	Set the display order of the projects of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Project IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/order [put]
+//	@Security		BearerAuth
+func (ph *projectHandler) ReorderProjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ph.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := ph.projectUseCase.ReorderProjects(ctx, userID, &request); err != nil {
+		ph.logger.Error("Failed to reorder projects: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (ph *projectHandler) currentProject(ctx context.Context, id int) (any, int, error) {
+	project, err := ph.projectUseCase.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return projectDto.FromProjectEntityToResponse(project, nil).Project, project.Version, nil
+}
@@ -0,0 +1,268 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
+	projectDto "portfolio/dto/project"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type projectLinkHandler struct {
+	AbstractHandler
+	projectUseCase     *usecases.ProjectUseCase
+	projectLinkUseCase *usecases.ProjectLinkUseCase
+	logger             *logger.Logger
+}
+
+func NewProjectLinkHandler(settingUseCase *usecases.SettingUseCase, projectUseCase *usecases.ProjectUseCase, projectLinkUseCase *usecases.ProjectLinkUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	projectLinkHandler := projectLinkHandler{
+		AbstractHandler:    AbstractHandler{settingUseCase: settingUseCase},
+		projectUseCase:     projectUseCase,
+		projectLinkUseCase: projectLinkUseCase,
+		logger:             logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminProjectLinksHandler",
+			Pattern: "GET /projects/{id}/links",
+			Handler: projectLinkHandler.GetProjectLinks,
+		},
+		{
+			Name:    "PostAdminProjectLinksHandler",
+			Pattern: "POST /projects/{id}/links",
+			Handler: projectLinkHandler.CreateProjectLink,
+		},
+		{
+			Name:    "PutAdminProjectLinkOrderHandler",
+			Pattern: "PUT /projects/{id}/links/order",
+			Handler: projectLinkHandler.ReorderProjectLinks,
+		},
+		{
+			Name:    "PutAdminProjectLinkHandler",
+			Pattern: "PUT /projects/{id}/links/{linkID}",
+			Handler: projectLinkHandler.UpdateProjectLink,
+		},
+		{
+			Name:    "DeleteAdminProjectLinkHandler",
+			Pattern: "DELETE /projects/{id}/links/{linkID}",
+			Handler: projectLinkHandler.DeleteProjectLink,
+		},
+	}
+}
+
+// GetProjectLinks
+//
+//	@Summary		Get a project's links
+//	@Description	Retrieve the links of a project of the authenticated admin user, by position
+//	@Tags			Admin Projects
+//	@Produce		json
+//	@Param			id	path		int	true	"Project ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.ProjectLinkListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/links [get]
+//	@Security		BearerAuth
+func (lh *projectLinkHandler) GetProjectLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := lh.ownedProjectID(w, r, lh.projectUseCase)
+	if !ok {
+		return
+	}
+
+	links, err := lh.projectLinkUseCase.GetProjectLinks(ctx, projectID)
+	if err != nil {
+		lh.logger.Error("Failed to get links of project %d: %v", projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := projectDto.FromProjectLinkEntitiesToResponse(links, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// CreateProjectLink
+//
+//	@Summary		Add a link to a project
+//	@Description	Append a link to a project of the authenticated admin user. A primary link takes the flag from the others.
+//	@Tags			Admin Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Project ID"
+//	@Param			request	body		dto.ProjectLinkRequest	true	"Link"
+//	@Success		201		{object}	shared.APIResponse{data=dto.ProjectLinkResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/links [post]
+//	@Security		BearerAuth
+func (lh *projectLinkHandler) CreateProjectLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := lh.ownedProjectID(w, r, lh.projectUseCase)
+	if !ok {
+		return
+	}
+
+	var request projectDto.ProjectLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	link, err := lh.projectLinkUseCase.CreateProjectLink(ctx, projectID, &request)
+	if err != nil {
+		lh.logger.Error("Failed to add link to project %d: %v", projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := projectDto.FromProjectLinkEntityToResponse(link, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+// UpdateProjectLink
+//
+//	@Summary		Replace a link of a project
+//	@Description	Replace a link of a project of the authenticated admin user; its position is kept. A primary link takes the flag from the others.
+//	@Tags			Admin Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Project ID"
+//	@Param			linkID	path		int							true	"Link ID"
+//	@Param			request	body		dto.ProjectLinkRequest	true	"Link"
+//	@Success		200		{object}	shared.APIResponse{data=dto.ProjectLinkResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/links/{linkID} [put]
+//	@Security		BearerAuth
+func (lh *projectLinkHandler) UpdateProjectLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := lh.ownedProjectID(w, r, lh.projectUseCase)
+	if !ok {
+		return
+	}
+	linkID, ok := pathID(w, r, "linkID", "Invalid link ID")
+	if !ok {
+		return
+	}
+
+	var request projectDto.ProjectLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	link, err := lh.projectLinkUseCase.UpdateProjectLink(ctx, projectID, linkID, &request)
+	if err != nil {
+		lh.logger.Error("Failed to update link %d of project %d: %v", linkID, projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := projectDto.FromProjectLinkEntityToResponse(link, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeleteProjectLink
+//
+//	@Summary		Remove a link from a project
+//	@Description	Delete a link of a project of the authenticated admin user
+//	@Tags			Admin Projects
+//	@Produce		json
+//	@Param			id		path	int	true	"Project ID"
+//	@Param			linkID	path	int	true	"Link ID"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/links/{linkID} [delete]
+//	@Security		BearerAuth
+func (lh *projectLinkHandler) DeleteProjectLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := lh.ownedProjectID(w, r, lh.projectUseCase)
+	if !ok {
+		return
+	}
+	linkID, ok := pathID(w, r, "linkID", "Invalid link ID")
+	if !ok {
+		return
+	}
+
+	if err := lh.projectLinkUseCase.DeleteProjectLink(ctx, projectID, linkID); err != nil {
+		lh.logger.Error("Failed to delete link %d of project %d: %v", linkID, projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderProjectLinks
+//
+//	@Summary		Reorder a project's links
+//	@Description	Move the links of a project of the authenticated admin user into the given order. The IDs must list every link of the project exactly once.
+//	@Tags			Admin Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int					true	"Project ID"
+//	@Param			request	body		dto.OrderRequest	true	"Link IDs in their new order"
+//	@Success		200		{object}	shared.APIResponse{data=dto.ProjectLinkListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/links/order [put]
+//	@Security		BearerAuth
+func (lh *projectLinkHandler) ReorderProjectLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := lh.ownedProjectID(w, r, lh.projectUseCase)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	links, err := lh.projectLinkUseCase.ReorderProjectLinks(ctx, projectID, &request)
+	if err != nil {
+		lh.logger.Error("Failed to reorder links of project %d: %v", projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := projectDto.FromProjectLinkEntitiesToResponse(links, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
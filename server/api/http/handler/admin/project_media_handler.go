@@ -0,0 +1,268 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
+	projectDto "portfolio/dto/project"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type projectMediaHandler struct {
+	AbstractHandler
+	projectUseCase      *usecases.ProjectUseCase
+	projectMediaUseCase *usecases.ProjectMediaUseCase
+	logger              *logger.Logger
+}
+
+func NewProjectMediaHandler(settingUseCase *usecases.SettingUseCase, projectUseCase *usecases.ProjectUseCase, projectMediaUseCase *usecases.ProjectMediaUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	projectMediaHandler := projectMediaHandler{
+		AbstractHandler:     AbstractHandler{settingUseCase: settingUseCase},
+		projectUseCase:      projectUseCase,
+		projectMediaUseCase: projectMediaUseCase,
+		logger:              logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminProjectMediaHandler",
+			Pattern: "GET /projects/{id}/media",
+			Handler: projectMediaHandler.GetProjectMedia,
+		},
+		{
+			Name:    "PostAdminProjectMediaHandler",
+			Pattern: "POST /projects/{id}/media",
+			Handler: projectMediaHandler.CreateProjectMedia,
+		},
+		{
+			Name:    "PutAdminProjectMediaOrderHandler",
+			Pattern: "PUT /projects/{id}/media/order",
+			Handler: projectMediaHandler.ReorderProjectMedia,
+		},
+		{
+			Name:    "PutAdminProjectMediaItemHandler",
+			Pattern: "PUT /projects/{id}/media/{mediaID}",
+			Handler: projectMediaHandler.UpdateProjectMedia,
+		},
+		{
+			Name:    "DeleteAdminProjectMediaItemHandler",
+			Pattern: "DELETE /projects/{id}/media/{mediaID}",
+			Handler: projectMediaHandler.DeleteProjectMedia,
+		},
+	}
+}
+
+// GetProjectMedia
+//
+//	@Summary		Get a project's gallery
+//	@Description	Retrieve the media of a project of the authenticated admin user, by position
+//	@Tags			Admin Projects
+//	@Produce		json
+//	@Param			id	path		int	true	"Project ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.ProjectMediaListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/media [get]
+//	@Security		BearerAuth
+func (mh *projectMediaHandler) GetProjectMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := mh.ownedProjectID(w, r, mh.projectUseCase)
+	if !ok {
+		return
+	}
+
+	media, err := mh.projectMediaUseCase.GetProjectMedia(ctx, projectID)
+	if err != nil {
+		mh.logger.Error("Failed to get media of project %d: %v", projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := projectDto.FromProjectMediaEntitiesToResponse(media, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// CreateProjectMedia
+//
+//	@Summary		Add an item to a project's gallery
+//	@Description	Append an image or video to the gallery of a project of the authenticated admin user. A primary item takes the flag from the others.
+//	@Tags			Admin Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Project ID"
+//	@Param			request	body		dto.ProjectMediaRequest	true	"Media item"
+//	@Success		201		{object}	shared.APIResponse{data=dto.ProjectMediaResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/media [post]
+//	@Security		BearerAuth
+func (mh *projectMediaHandler) CreateProjectMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := mh.ownedProjectID(w, r, mh.projectUseCase)
+	if !ok {
+		return
+	}
+
+	var request projectDto.ProjectMediaRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	media, err := mh.projectMediaUseCase.CreateProjectMedia(ctx, projectID, &request)
+	if err != nil {
+		mh.logger.Error("Failed to add media to project %d: %v", projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := projectDto.FromProjectMediaEntityToResponse(media, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+// UpdateProjectMedia
+//
+//	@Summary		Replace an item of a project's gallery
+//	@Description	Replace an image or video of a project of the authenticated admin user; its position is kept. A primary item takes the flag from the others.
+//	@Tags			Admin Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Project ID"
+//	@Param			mediaID	path		int							true	"Media ID"
+//	@Param			request	body		dto.ProjectMediaRequest	true	"Media item"
+//	@Success		200		{object}	shared.APIResponse{data=dto.ProjectMediaResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/media/{mediaID} [put]
+//	@Security		BearerAuth
+func (mh *projectMediaHandler) UpdateProjectMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := mh.ownedProjectID(w, r, mh.projectUseCase)
+	if !ok {
+		return
+	}
+	mediaID, ok := pathID(w, r, "mediaID", "Invalid media ID")
+	if !ok {
+		return
+	}
+
+	var request projectDto.ProjectMediaRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	media, err := mh.projectMediaUseCase.UpdateProjectMedia(ctx, projectID, mediaID, &request)
+	if err != nil {
+		mh.logger.Error("Failed to update media %d of project %d: %v", mediaID, projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := projectDto.FromProjectMediaEntityToResponse(media, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeleteProjectMedia
+//
+//	@Summary		Remove an item from a project's gallery
+//	@Description	Delete an image or video of a project of the authenticated admin user
+//	@Tags			Admin Projects
+//	@Produce		json
+//	@Param			id		path	int	true	"Project ID"
+//	@Param			mediaID	path	int	true	"Media ID"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/media/{mediaID} [delete]
+//	@Security		BearerAuth
+func (mh *projectMediaHandler) DeleteProjectMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := mh.ownedProjectID(w, r, mh.projectUseCase)
+	if !ok {
+		return
+	}
+	mediaID, ok := pathID(w, r, "mediaID", "Invalid media ID")
+	if !ok {
+		return
+	}
+
+	if err := mh.projectMediaUseCase.DeleteProjectMedia(ctx, projectID, mediaID); err != nil {
+		mh.logger.Error("Failed to delete media %d of project %d: %v", mediaID, projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderProjectMedia
+//
+//	@Summary		Reorder a project's gallery
+//	@Description	Move the media of a project of the authenticated admin user into the given order. The IDs must list every item of the gallery exactly once.
+//	@Tags			Admin Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int					true	"Project ID"
+//	@Param			request	body		dto.OrderRequest	true	"Media IDs in their new order"
+//	@Success		200		{object}	shared.APIResponse{data=dto.ProjectMediaListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		403		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/projects/{id}/media/order [put]
+//	@Security		BearerAuth
+func (mh *projectMediaHandler) ReorderProjectMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, ok := mh.ownedProjectID(w, r, mh.projectUseCase)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	media, err := mh.projectMediaUseCase.ReorderProjectMedia(ctx, projectID, &request)
+	if err != nil {
+		mh.logger.Error("Failed to reorder media of project %d: %v", projectID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := projectDto.FromProjectMediaEntitiesToResponse(media, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
@@ -0,0 +1,539 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
+	publicationDto "portfolio/dto/publication"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type publicationHandler struct {
+	AbstractHandler
+	publicationUseCase *usecases.PublicationUseCase
+	logger             *logger.Logger
+}
+
+func NewPublicationHandler(settingUseCase *usecases.SettingUseCase, publicationUseCase *usecases.PublicationUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	publicationHandler := publicationHandler{
+		AbstractHandler:    AbstractHandler{settingUseCase: settingUseCase},
+		publicationUseCase: publicationUseCase,
+		logger:             logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminPublicationsHandler",
+			Pattern: "GET /publications",
+			Handler: publicationHandler.GetPublications,
+		},
+		{
+			Name:    "PostAdminPublicationHandler",
+			Pattern: "POST /publications",
+			Handler: publicationHandler.CreatePublication,
+		},
+		{
+			Name:    "PostBulkAdminPublicationHandler",
+			Pattern: "POST /publications/bulk",
+			Handler: publicationHandler.CreateBulkPublications,
+		},
+		{
+			Name:    "PutAdminPublicationsOrderHandler",
+			Pattern: "PUT /publications/order",
+			Handler: publicationHandler.ReorderPublications,
+		},
+		{
+			Name:    "GetAdminPublicationHandler",
+			Pattern: "GET /publications/{id}",
+			Handler: publicationHandler.GetPublication,
+		},
+		{
+			Name:    "PutAdminPublicationHandler",
+			Pattern: "PUT /publications/{id}",
+			Handler: publicationHandler.UpdatePublication,
+		},
+		{
+			Name:    "PatchAdminPublicationHandler",
+			Pattern: "PATCH /publications/{id}",
+			Handler: publicationHandler.PatchPublication,
+		},
+		{
+			Name:    "DeleteAdminPublicationHandler",
+			Pattern: "DELETE /publications/{id}",
+			Handler: publicationHandler.DeletePublication,
+		},
+	}
+}
+
+// GetPublications
+//
+//	@Summary		Get all admin publications
+//	@Description	Retrieve all articles, talks, papers, podcasts and open-source contributions of the authenticated admin user
+//	@Tags			Admin Publications
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, date, title, type, created_at; defaults to the manual order"
+//	@Param			filter[type]	query		string	false	"Filter by type"	Enums(article, talk, paper, podcast, open_source)
+//	@Param			filter[venue]	query		string	false	"Filter by venue"
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
+//	@Success		200	{object}	shared.APIResponse{data=dto.PublicationListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}	"Unauthorized"
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publications [get]
+func (ph *publicationHandler) GetPublications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ph.getUserIDFromContext(w, r)
+	if !ok {
+		ph.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.PublicationListSpec)
+	if err != nil {
+		ph.logger.Error("Invalid publication list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	publications, err := ph.publicationUseCase.GetPublicationsByUserID(ctx, userID, query)
+	if err != nil {
+		ph.logger.Error("Failed to get publications for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := publicationDto.FromPublicationsEntityToResponse(publications.Items,
+		utils.WithListMeta(&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		}, r, query, publications))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetPublication
+//
+//	@Summary		Get a specific admin publication
+//	@Description	Retrieve a specific publication by ID for admin management
+//	@Tags			Admin Publications
+//	@Produce		json
+//	@Param			id	path	int	true	"Publication ID"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.PublicationResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publications/{id} [get]
+func (ph *publicationHandler) GetPublication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := pathID(w, r, "id", "Invalid publication ID")
+	if !ok {
+		return
+	}
+
+	publication, err := ph.publicationUseCase.GetPublicationByID(ctx, id)
+	if err != nil {
+		ph.logger.Error("Failed to get publication %d: %v", id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := publicationDto.FromPublicationEntityToResponse(publication,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, publication.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// CreatePublication
+//
+//	@Summary		Create a new publication
+//	@Description	Create a new publication, talk or open-source contribution for the authenticated admin user. The user is its first author; co_authors lists the others
+//	@Tags			Admin Publications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.CreatePublicationRequest	true	"Publication creation request"
+//	@Security		BearerAuth
+//	@Success		201	{object}	shared.APIResponse{data=dto.PublicationResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publications [post]
+func (ph *publicationHandler) CreatePublication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var request publicationDto.CreatePublicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ph.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		ph.logger.Error("Invalid request: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := ph.getUserIDFromContext(w, r)
+	if !ok {
+		ph.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	publicationEntity, err := request.ToEntity(userID)
+	if err != nil {
+		ph.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid publication data", "publication", &err))
+		return
+	}
+
+	createdPublication, err := ph.publicationUseCase.CreatePublication(ctx, publicationEntity)
+	if err != nil {
+		ph.logger.Error("Failed to create publication: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := publicationDto.FromPublicationEntityToResponse(createdPublication,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+// CreateBulkPublications
+//
+//	@Summary		Create multiple publications in bulk
+//	@Description	Create multiple publications for the authenticated admin user
+//	@Tags			Admin Publications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.CreateBulkPublicationsRequest	true	"Bulk publications creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
+//	@Success		201		{object}	shared.APIResponse{data=dto.PublicationListResponse}
+//	@Success		207		{object}	shared.APIResponse{data=dto.PublicationListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publications/bulk [post]
+//	@Security		BearerAuth
+func (ph *publicationHandler) CreateBulkPublications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	atomic, ok := ph.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var request publicationDto.CreateBulkPublicationsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ph.logger.Error("Failed to decode bulk publications request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := ph.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	publicationEntities, err := request.ToEntities(userID)
+	if err != nil {
+		ph.logger.Error("Failed to convert bulk request to entities: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid publications data", "publications", &err))
+		return
+	}
+
+	if atomic {
+		createdPublications, err := ph.publicationUseCase.CreatePublicationsAtomically(ctx, publicationEntities)
+		if err != nil {
+			ph.logger.Error("Atomic bulk publication creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := publicationDto.FromPublicationsEntityForBulkToResponse(createdPublications, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
+	var createdPublications []*entities.Publication
+	var errs []error
+
+	for i, publicationEntity := range publicationEntities {
+		createdPublication, err := ph.publicationUseCase.CreatePublication(ctx, publicationEntity)
+		if err != nil {
+			ph.logger.Error("Failed to create publication at index %d (title: %s): %v", i, publicationEntity.Title, err)
+			errs = append(errs, err)
+		} else {
+			createdPublications = append(createdPublications, createdPublication)
+		}
+	}
+
+	statusCode := http.StatusCreated
+	if len(publicationEntities) == len(errs) {
+		ph.logger.Error("All publications failed to create, returning errors")
+		utils.WriteErrorResponse(w, errs...)
+		return
+	} else if len(errs) > 0 {
+		statusCode = http.StatusMultiStatus
+	}
+
+	response := publicationDto.FromPublicationsEntityForBulkToResponse(createdPublications, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	domainErrors := make([]*shared.APIError, len(errs))
+	for i, err := range errs {
+		if domainErr, ok := domain.AsDomainError(err); ok {
+			domainErrors[i] = utils.DomainErrorToAPIError(domainErr)
+		}
+	}
+	response.Errors = domainErrors
+	utils.WriteSuccessResponse(w, statusCode, response)
+}
+
+// UpdatePublication
+//
+//	@Summary		Update an existing publication
+//	@Description	Replace the fields of a publication by ID for the authenticated admin user; co_authors left out removes the co-authors
+//	@Tags			Admin Publications
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int								true	"Publication ID"
+//	@Param			request	body	dto.UpdatePublicationRequest	true	"Publication update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the publication changed since"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.PublicationResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publications/{id} [put]
+func (ph *publicationHandler) UpdatePublication(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ph.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid publication ID")
+	if !ok {
+		return
+	}
+
+	var request publicationDto.UpdatePublicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ph.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := ph.getUserIDFromContext(w, r)
+	if !ok {
+		ph.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	publicationEntity, err := request.ToEntity(id, userID)
+	if err != nil {
+		ph.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid publication data", "publication", &err))
+		return
+	}
+
+	updatedPublication, err := ph.publicationUseCase.UpdatePublication(ctx, id, publicationEntity)
+	if err != nil {
+		ph.logger.Error("Failed to update publication %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ph.currentPublication)
+		return
+	}
+
+	response := publicationDto.FromPublicationEntityToResponse(updatedPublication,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, updatedPublication.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// PatchPublication
+//
+//	@Summary		Partially update a publication
+//	@Description	Partially update a publication by ID for the authenticated admin user
+//	@Tags			Admin Publications
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Publication ID"
+//	@Param			request	body		dto.PatchPublicationRequest	true	"Patch publication request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the publication changed since"
+//	@Success		200		{object}	shared.APIResponse{data=dto.PublicationResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publications/{id} [patch]
+//	@Security		BearerAuth
+func (ph *publicationHandler) PatchPublication(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ph.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid publication ID")
+	if !ok {
+		return
+	}
+
+	if _, ok := ph.getUserIDFromContext(w, r); !ok {
+		ph.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	var request publicationDto.PatchPublicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ph.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	patchedPublication, err := ph.publicationUseCase.PatchPublication(ctx, id, &request)
+	if err != nil {
+		ph.logger.Error("Failed to patch publication %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ph.currentPublication)
+		return
+	}
+
+	response := publicationDto.FromPublicationEntityToResponse(patchedPublication,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, patchedPublication.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeletePublication
+//
+//	@Summary		Delete a publication
+//	@Description	Move a publication to the trash by ID for the authenticated admin user
+//	@Tags			Admin Publications
+//	@Produce		json
+//	@Param			id	path	int	true	"Publication ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the publication changed since"
+//	@Security		BearerAuth
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publications/{id} [delete]
+func (ph *publicationHandler) DeletePublication(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ph.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid publication ID")
+	if !ok {
+		return
+	}
+
+	if err := ph.publicationUseCase.DeletePublication(ctx, id); err != nil {
+		ph.logger.Error("Failed to delete publication %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, ph.currentPublication)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderPublications
+//
+//	@Summary		Reorder publications
+//	@Description	Set the display order of the publications of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Publications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Publication IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publications/order [put]
+//	@Security		BearerAuth
+func (ph *publicationHandler) ReorderPublications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ph.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := ph.publicationUseCase.ReorderPublications(ctx, userID, &request); err != nil {
+		ph.logger.Error("Failed to reorder publications: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ph *publicationHandler) currentPublication(ctx context.Context, id int) (any, int, error) {
+	publication, err := ph.publicationUseCase.GetPublicationByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return publicationDto.FromPublicationEntityToResponse(publication, nil).Publication, publication.Version, nil
+}
@@ -0,0 +1,193 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/usecases"
+	publishingDto "portfolio/dto/publishing"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type publishingHandler struct {
+	AbstractHandler
+	publishingUseCase *usecases.PublishingUseCase
+	logger            *logger.Logger
+}
+
+func NewPublishingHandler(settingUseCase *usecases.SettingUseCase, publishingUseCase *usecases.PublishingUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	publishingHandler := publishingHandler{
+		AbstractHandler:   AbstractHandler{settingUseCase: settingUseCase},
+		publishingUseCase: publishingUseCase,
+		logger:            logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminPublishingItemsHandler",
+			Pattern: "GET /publishing",
+			Handler: publishingHandler.GetPublishingItems,
+		},
+		{
+			Name:    "GetAdminPublishingHandler",
+			Pattern: "GET /publishing/{type}/{id}",
+			Handler: publishingHandler.GetPublishing,
+		},
+		{
+			Name:    "PutAdminPublishingHandler",
+			Pattern: "PUT /publishing/{type}/{id}",
+			Handler: publishingHandler.SetPublishing,
+		},
+	}
+}
+
+// GetPublishingItems
+//
+//	@Summary		List publishing states
+//	@Description	Retrieve the publishing state of the authenticated admin user's content
+//	@Tags			Admin Publishing
+//	@Produce		json
+//	@Param			type	query		string	false	"Only list one type"	Enums(projects, skills, experiences, educations, certifications, publications, spoken_languages, awards, volunteerings, technologies)
+//	@Param			state	query		string	false	"Only list one state"	Enums(draft, scheduled, published, archived)
+//	@Success		200		{object}	shared.APIResponse{data=dto.PublishingListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publishing [get]
+//	@Security		BearerAuth
+func (ph *publishingHandler) GetPublishingItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ph.getUserIDFromContext(w, r)
+	if !ok {
+		ph.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	items, err := ph.publishingUseCase.GetPublishingItems(ctx, userID, r.URL.Query().Get("type"), r.URL.Query().Get("state"))
+	if err != nil {
+		ph.logger.Error("Failed to get publishing states for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := publishingDto.FromPublishingItemsEntityToResponse(items,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetPublishing
+//
+//	@Summary		Get a publishing
+//	@Description	Retrieve the publishing state of one piece of content
+//	@Tags			Admin Publishing
+//	@Produce		json
+//	@Param			type	path		string	true	"Item type"	Enums(projects, skills, experiences, educations, certifications, publications, spoken_languages, awards, volunteerings, technologies)
+//	@Param			id		path		int		true	"Item ID"
+//	@Success		200		{object}	shared.APIResponse{data=dto.PublishingItemResponse}
+//	@Header		200		{string}	ETag	"Current version of the item, for If-Match"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publishing/{type}/{id} [get]
+//	@Security		BearerAuth
+func (ph *publishingHandler) GetPublishing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ph.getUserIDFromContext(w, r)
+	if !ok {
+		ph.logger.Error("Failed to get user ID from context")
+		return
+	}
+	id, ok := pathID(w, r, "id", "Invalid item ID")
+	if !ok {
+		return
+	}
+	itemType := r.PathValue("type")
+
+	item, err := ph.publishingUseCase.GetPublishing(ctx, userID, itemType, id)
+	if err != nil {
+		ph.logger.Error("Failed to get the publishing state of %s %d: %v", itemType, id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := publishingDto.FromPublishingItemEntityToResponse(item, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	setETag(w, item.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// SetPublishing
+//
+//	@Summary		Set a publishing
+//	@Description	Draft, schedule, publish or archive a piece of content. Scheduled content is published at publish_at; published content is archived at unpublish_at.
+//	@Tags			Admin Publishing
+//	@Accept			json
+//	@Produce		json
+//	@Param			type	path		string	true	"Item type"	Enums(projects, skills, experiences, educations, certifications, publications, spoken_languages, awards, volunteerings, technologies)
+//	@Param			id		path		int		true	"Item ID"
+//	@Param			request	body		dto.PublishingRequest	true	"Publishing request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the item changed since"
+//	@Success		200		{object}	shared.APIResponse{data=dto.PublishingItemResponse}
+//	@Header		200		{string}	ETag	"Current version of the item, for If-Match"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/publishing/{type}/{id} [put]
+//	@Security		BearerAuth
+func (ph *publishingHandler) SetPublishing(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := ph.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+	userID, ok := ph.getUserIDFromContext(w, r)
+	if !ok {
+		ph.logger.Error("Failed to get user ID from context")
+		return
+	}
+	id, ok := pathID(w, r, "id", "Invalid item ID")
+	if !ok {
+		return
+	}
+	itemType := r.PathValue("type")
+
+	var request publishingDto.PublishingRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ph.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	item, err := ph.publishingUseCase.SetPublishing(ctx, userID, itemType, id, &request)
+	if err != nil {
+		ph.logger.Error("Failed to set the publishing state of %s %d: %v", itemType, id, err)
+		writeVersionedError(ctx, w, err, id, func(ctx context.Context, id int) (any, int, error) {
+			current, err := ph.publishingUseCase.GetPublishing(ctx, userID, itemType, id)
+			if err != nil {
+				return nil, 0, err
+			}
+			return publishingDto.FromPublishingItemEntityToResponse(current, nil).Item, current.Version, nil
+		})
+		return
+	}
+
+	response := publishingDto.FromPublishingItemEntityToResponse(item, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	setETag(w, item.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
@@ -0,0 +1,198 @@
+package admin
+
+import (
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/usecases"
+	revisionDto "portfolio/dto/revision"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+type revisionHandler struct {
+	AbstractHandler
+	revisionUseCase *usecases.RevisionUseCase
+	logger          *logger.Logger
+}
+
+func NewRevisionHandler(settingUseCase *usecases.SettingUseCase, revisionUseCase *usecases.RevisionUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	revisionHandler := revisionHandler{
+		AbstractHandler: AbstractHandler{settingUseCase: settingUseCase},
+		revisionUseCase: revisionUseCase,
+		logger:          logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminRevisionsHandler",
+			Pattern: "GET /revisions/{type}/{id}",
+			Handler: revisionHandler.GetRevisions,
+		},
+		{
+			Name:    "GetAdminRevisionDiffHandler",
+			Pattern: "GET /revisions/{type}/{id}/diff",
+			Handler: revisionHandler.GetRevisionDiff,
+		},
+		{
+			Name:    "RollbackAdminRevisionHandler",
+			Pattern: "POST /revisions/{type}/{id}/{revision}/rollback",
+			Handler: revisionHandler.Rollback,
+		},
+	}
+}
+
+// GetRevisions
+//
+//	@Summary		List the revisions of an entity
+//	@Description	Retrieve the snapshots stored for every change of an entity, newest first
+//	@Tags			Admin Revisions
+//	@Produce		json
+//	@Param			type	path		string	true	"Entity type"	Enums(projects, skills, experiences, educations, certifications, publications, spoken_languages, awards, volunteerings, testimonials, technologies, personal_infos)
+//	@Param			id		path		int		true	"Entity ID"
+//	@Success		200		{object}	shared.APIResponse{data=dto.RevisionListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/revisions/{type}/{id} [get]
+//	@Security		BearerAuth
+func (rh *revisionHandler) GetRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, entityType, id, ok := rh.parseEntity(w, r)
+	if !ok {
+		return
+	}
+
+	revisions, err := rh.revisionUseCase.GetRevisions(ctx, userID, entityType, id)
+	if err != nil {
+		rh.logger.Error("Failed to get revisions of %s %d: %v", entityType, id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := revisionDto.FromRevisionsEntityToResponse(revisions,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetRevisionDiff
+//
+//	@Summary		Diff two revisions
+//	@Description	Compare two revisions of an entity field by field
+//	@Tags			Admin Revisions
+//	@Produce		json
+//	@Param			type	path		string	true	"Entity type"	Enums(projects, skills, experiences, educations, certifications, publications, spoken_languages, awards, volunteerings, testimonials, technologies, personal_infos)
+//	@Param			id		path		int		true	"Entity ID"
+//	@Param			from	query		int		true	"Older revision ID"
+//	@Param			to		query		int		true	"Newer revision ID"
+//	@Success		200		{object}	shared.APIResponse{data=dto.RevisionDiffResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/revisions/{type}/{id}/diff [get]
+//	@Security		BearerAuth
+func (rh *revisionHandler) GetRevisionDiff(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, entityType, id, ok := rh.parseEntity(w, r)
+	if !ok {
+		return
+	}
+
+	from, err := strconv.Atoi(r.URL.Query().Get("from"))
+	if err != nil {
+		rh.logger.Error("Invalid from revision ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid from revision ID", "from", &err))
+		return
+	}
+
+	to, err := strconv.Atoi(r.URL.Query().Get("to"))
+	if err != nil {
+		rh.logger.Error("Invalid to revision ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid to revision ID", "to", &err))
+		return
+	}
+
+	changes, err := rh.revisionUseCase.Diff(ctx, userID, entityType, id, from, to)
+	if err != nil {
+		rh.logger.Error("Failed to diff revisions %d and %d of %s %d: %v", from, to, entityType, id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := revisionDto.FromRevisionChangesEntityToResponse(from, to, changes,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// Rollback
+//
+//	@Summary		Roll back to a revision
+//	@Description	Restore an entity to the state of one of its revisions. The change is validated like a regular update and recorded as a new revision
+//	@Tags			Admin Revisions
+//	@Produce		json
+//	@Param			type		path		string	true	"Entity type"	Enums(projects, skills, experiences, educations, certifications, publications, spoken_languages, awards, volunteerings, testimonials, technologies, personal_infos)
+//	@Param			id			path		int		true	"Entity ID"
+//	@Param			revision	path		int		true	"Revision ID"
+//	@Success		200			{object}	shared.APIResponse{data=dto.RevisionResponse}
+//	@Failure		400			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/revisions/{type}/{id}/{revision}/rollback [post]
+//	@Security		BearerAuth
+func (rh *revisionHandler) Rollback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, entityType, id, ok := rh.parseEntity(w, r)
+	if !ok {
+		return
+	}
+
+	revisionID, err := strconv.Atoi(r.PathValue("revision"))
+	if err != nil {
+		rh.logger.Error("Invalid revision ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid revision ID", "revision", &err))
+		return
+	}
+
+	revision, err := rh.revisionUseCase.Rollback(ctx, userID, entityType, id, revisionID)
+	if err != nil {
+		rh.logger.Error("Failed to roll back %s %d to revision %d: %v", entityType, id, revisionID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := revisionDto.FromRevisionEntityToResponse(revision,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (rh *revisionHandler) parseEntity(w http.ResponseWriter, r *http.Request) (int, string, int, bool) {
+	userID, ok := rh.getUserIDFromContext(w, r)
+	if !ok {
+		rh.logger.Error("Failed to get user ID from context")
+		return 0, "", 0, false
+	}
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rh.logger.Error("Invalid entity ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid entity ID", "id", &err))
+		return 0, "", 0, false
+	}
+
+	return userID, r.PathValue("type"), id, true
+}
@@ -0,0 +1,78 @@
+package admin
+
+import (
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain/usecases"
+	searchDto "portfolio/dto/search"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type searchHandler struct {
+	AbstractHandler
+	searchUseCase *usecases.SearchUseCase
+	logger        *logger.Logger
+}
+
+func NewSearchHandler(settingUseCase *usecases.SettingUseCase, searchUseCase *usecases.SearchUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	searchHandler := searchHandler{
+		AbstractHandler: AbstractHandler{settingUseCase: settingUseCase},
+		searchUseCase:   searchUseCase,
+		logger:          logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "SearchAdminHandler",
+			Pattern: "GET /search",
+			Handler: searchHandler.Search,
+		},
+	}
+}
+
+// Search
+//
+//	@Summary		Search your content
+//	@Description	Full-text search over the projects, skills, experiences, educations and personal info of the authenticated admin user, including content that is not published. Trashed items are not searched. Hits are grouped by type, best match first
+//	@Tags			Admin Search
+//	@Produce		json
+//	@Param			q		query		string	true	"Search text, up to 200 characters"
+//	@Param			limit	query		int		false	"Hits per type, up to 50 (default 10)"
+//	@Success		200		{object}	shared.APIResponse{data=dto.SearchResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/search [get]
+//	@Security		BearerAuth
+func (sh *searchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := sh.getUserIDFromContext(w, r)
+	if !ok {
+		sh.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	limit, err := parsePositiveQueryInt(r, "limit", usecases.DefaultSearchResultsPerType)
+	if err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	query := r.URL.Query().Get("q")
+	hits, err := sh.searchUseCase.Search(ctx, userID, query, limit, true)
+	if err != nil {
+		sh.logger.Error("Failed to search for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := searchDto.FromSearchHitsEntityToResponse(query, hits,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
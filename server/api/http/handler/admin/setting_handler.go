@@ -6,6 +6,7 @@ import (
 	"portfolio/api/http/routes"
 	"portfolio/api/http/utils"
 	"portfolio/domain"
+	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
 	settingDto "portfolio/dto/setting"
 	"portfolio/logger"
@@ -14,14 +15,16 @@ import (
 )
 
 type settingHandler struct {
+	AbstractHandler
 	settingUseCase *usecases.SettingUseCase
 	logger         *logger.Logger
 }
 
 func NewSettingHandler(settingUseCase *usecases.SettingUseCase, logger *logger.Logger) []*routes.NamedRoute {
 	settingHandler := settingHandler{
-		settingUseCase: settingUseCase,
-		logger:         logger,
+		AbstractHandler: AbstractHandler{settingUseCase: settingUseCase},
+		settingUseCase:  settingUseCase,
+		logger:          logger,
 	}
 
 	return []*routes.NamedRoute{
@@ -35,6 +38,16 @@ func NewSettingHandler(settingUseCase *usecases.SettingUseCase, logger *logger.L
 			Pattern: "PUT /settings",
 			Handler: settingHandler.UpdateSettings,
 		},
+		{
+			Name:    "GetSettingNamespaceHandler",
+			Pattern: "GET /settings/{namespace}",
+			Handler: settingHandler.GetSettingNamespace,
+		},
+		{
+			Name:    "UpdateSettingNamespaceHandler",
+			Pattern: "PUT /settings/{namespace}",
+			Handler: settingHandler.UpdateSettingNamespace,
+		},
 		{
 			Name:    "ResetSettingsHandler",
 			Pattern: "POST /settings/reset",
@@ -74,7 +87,7 @@ func (sh *settingHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
 // UpdateSettings
 //
 //	@Summary		Update application settings
-//	@Description	Update application settings
+//	@Description	Replace every settings namespace at once. The legacy flat body is still accepted and leaves the SEO settings and social links unchanged
 //	@Tags			Admin Settings
 //	@Accept			json
 //	@Produce		json
@@ -90,19 +103,33 @@ func (sh *settingHandler) UpdateSettings(w http.ResponseWriter, r *http.Request)
 	var req settingDto.UpdateSettingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		sh.logger.Error("Failed to decode request body: %v", err)
-		utils.WriteErrorResponse(w, domain.NewValidationError("body", "Invalid request body", &err))
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
 		return
 	}
 
 	if err := req.Validate(); err != nil {
 		sh.logger.Error("Settings validation error: %v", err)
-		utils.WriteErrorResponse(w, domain.NewValidationError("settings", err.Error(), nil))
+		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	settingEntity := req.ToEntity()
+	settings := req.ToEntity()
+	if req.IsLegacy() {
+		// The flat body predates the SEO namespace and social links, so an
+		// old client must not wipe them.
+		current, err := sh.settingUseCase.GetSettings(ctx)
+		if err != nil {
+			sh.logger.Error("Failed to retrieve settings: %v", err)
+			utils.WriteErrorResponse(w, domain.NewInternalError("Failed to retrieve settings", err))
+			return
+		}
+		settings.SEO = current.SEO
+		contactEmail := settings.Contact.ContactEmail
+		settings.Contact = current.Contact
+		settings.Contact.ContactEmail = contactEmail
+	}
 
-	if err := sh.settingUseCase.Upsert(ctx, settingEntity); err != nil {
+	if err := sh.settingUseCase.UpdateSettings(ctx, settings); err != nil {
 		sh.logger.Error("Failed to update settings: %v", err)
 		utils.WriteErrorResponse(w, domain.NewInternalError("Failed to update settings", err))
 		return
@@ -126,7 +153,7 @@ func (sh *settingHandler) UpdateSettings(w http.ResponseWriter, r *http.Request)
 // ResetSettings
 //
 //	@Summary		Reset settings to default
-//	@Description	Reset application settings to default values
+//	@Description	Reset application settings to default values, owned by the authenticated user
 //	@Tags			Admin Settings
 //	@Produce		json
 //	@Success		200	{object}	shared.APIResponse{data=dto.SettingResponse}
@@ -136,20 +163,12 @@ func (sh *settingHandler) UpdateSettings(w http.ResponseWriter, r *http.Request)
 func (sh *settingHandler) ResetSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	defaultSettings := &settingDto.UpdateSettingRequest{
-		ShowProjects:     true,
-		PortfolioOwnerID: 1,
-		SiteName:         "My Portfolio",
-		SiteDescription:  "Personal portfolio showcasing my projects and skills",
-		ContactEmail:     "",
-		Theme:            "light",
-		Language:         "en",
-		MaintenanceMode:  false,
+	userID, ok := sh.getUserIDFromContext(w, r)
+	if !ok {
+		return
 	}
 
-	settingEntity := defaultSettings.ToEntity()
-
-	if err := sh.settingUseCase.Upsert(ctx, settingEntity); err != nil {
+	if err := sh.settingUseCase.ResetSettings(ctx, userID); err != nil {
 		sh.logger.Error("Failed to reset settings: %v", err)
 		utils.WriteErrorResponse(w, domain.NewInternalError("Failed to reset settings", err))
 		return
@@ -169,3 +188,119 @@ func (sh *settingHandler) ResetSettings(w http.ResponseWriter, r *http.Request)
 		})
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
+
+// GetSettingNamespace
+//
+//	@Summary		Get one settings namespace
+//	@Description	Retrieve a single settings namespace: site, display, seo or contact
+//	@Tags			Admin Settings
+//	@Produce		json
+//	@Param			namespace	path		string	true	"Settings namespace"	Enums(site, display, seo, contact)
+//	@Success		200			{object}	shared.APIResponse{data=dto.SettingResponse}
+//	@Failure		400			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/settings/{namespace} [get]
+//	@Security		BearerAuth
+func (sh *settingHandler) GetSettingNamespace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	namespace, ok := sh.settingNamespace(w, r)
+	if !ok {
+		return
+	}
+
+	settings, err := sh.settingUseCase.GetSettings(ctx)
+	if err != nil {
+		sh.logger.Error("Failed to retrieve %s settings: %v", namespace, err)
+		utils.WriteErrorResponse(w, domain.NewInternalError("Failed to retrieve settings", err))
+		return
+	}
+
+	response := settingDto.FromSettingNamespaceToResponse(settings, namespace,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// UpdateSettingNamespace
+//
+//	@Summary		Update one settings namespace
+//	@Description	Replace a single settings namespace. The body is the matching Update*SettingsRequest
+//	@Tags			Admin Settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			namespace	path		string							true	"Settings namespace"	Enums(site, display, seo, contact)
+//	@Param			request		body		dto.UpdateSiteSettingsRequest	true	"Namespace update request, e.g. for site"
+//	@Success		200			{object}	shared.APIResponse{data=dto.SettingResponse}
+//	@Failure		400			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/settings/{namespace} [put]
+//	@Security		BearerAuth
+func (sh *settingHandler) UpdateSettingNamespace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	namespace, ok := sh.settingNamespace(w, r)
+	if !ok {
+		return
+	}
+
+	var req interface{ Validate() error }
+	var update func() error
+	switch namespace {
+	case entities.SettingNamespaceSite:
+		site := &settingDto.UpdateSiteSettingsRequest{}
+		req, update = site, func() error { return sh.settingUseCase.UpdateSiteSettings(ctx, site.ToEntity()) }
+	case entities.SettingNamespaceDisplay:
+		display := &settingDto.UpdateDisplaySettingsRequest{}
+		req, update = display, func() error { return sh.settingUseCase.UpdateDisplaySettings(ctx, display.ToEntity()) }
+	case entities.SettingNamespaceSEO:
+		seo := &settingDto.UpdateSEOSettingsRequest{}
+		req, update = seo, func() error { return sh.settingUseCase.UpdateSEOSettings(ctx, seo.ToEntity()) }
+	case entities.SettingNamespaceContact:
+		contact := &settingDto.UpdateContactSettingsRequest{}
+		req, update = contact, func() error { return sh.settingUseCase.UpdateContactSettings(ctx, contact.ToEntity()) }
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		sh.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		sh.logger.Error("Settings validation error: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	if err := update(); err != nil {
+		sh.logger.Error("Failed to update %s settings: %v", namespace, err)
+		utils.WriteErrorResponse(w, domain.NewInternalError("Failed to update settings", err))
+		return
+	}
+
+	updatedSettings, err := sh.settingUseCase.GetSettings(ctx)
+	if err != nil {
+		sh.logger.Error("Failed to retrieve updated settings: %v", err)
+		utils.WriteErrorResponse(w, domain.NewInternalError("Failed to retrieve updated settings", err))
+		return
+	}
+
+	response := settingDto.FromSettingNamespaceToResponse(updatedSettings, namespace,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (sh *settingHandler) settingNamespace(w http.ResponseWriter, r *http.Request) (entities.SettingNamespace, bool) {
+	namespace := r.PathValue("namespace")
+	if !entities.IsValidSettingNamespace(namespace) {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Namespace must be one of site, display, seo, contact", "namespace", nil))
+		return "", false
+	}
+	return entities.SettingNamespace(namespace), true
+}
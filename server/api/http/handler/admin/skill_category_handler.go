@@ -0,0 +1,259 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
+	skillDto "portfolio/dto/skill"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type skillCategoryHandler struct {
+	AbstractHandler
+	skillCategoryUseCase *usecases.SkillCategoryUseCase
+	logger               *logger.Logger
+}
+
+func NewSkillCategoryHandler(settingUseCase *usecases.SettingUseCase, skillCategoryUseCase *usecases.SkillCategoryUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	skillCategoryHandler := skillCategoryHandler{
+		AbstractHandler:      AbstractHandler{settingUseCase: settingUseCase},
+		skillCategoryUseCase: skillCategoryUseCase,
+		logger:               logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminSkillCategoriesHandler",
+			Pattern: "GET /skill-categories",
+			Handler: skillCategoryHandler.GetSkillCategories,
+		},
+		{
+			Name:    "PostAdminSkillCategoryHandler",
+			Pattern: "POST /skill-categories",
+			Handler: skillCategoryHandler.CreateSkillCategory,
+		},
+		{
+			Name:    "PutAdminSkillCategoriesOrderHandler",
+			Pattern: "PUT /skill-categories/order",
+			Handler: skillCategoryHandler.ReorderSkillCategories,
+		},
+		{
+			Name:    "PutAdminSkillCategoryHandler",
+			Pattern: "PUT /skill-categories/{id}",
+			Handler: skillCategoryHandler.UpdateSkillCategory,
+		},
+		{
+			Name:    "DeleteAdminSkillCategoryHandler",
+			Pattern: "DELETE /skill-categories/{id}",
+			Handler: skillCategoryHandler.DeleteSkillCategory,
+		},
+	}
+}
+
+// GetSkillCategories
+//
+//	@Summary		Get skill categories
+//	@Description	Retrieve the skill categories of the authenticated admin user, by position
+//	@Tags			Admin Skills
+//	@Produce		json
+//	@Success		200	{object}	shared.APIResponse{data=dto.SkillCategoryListResponse}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/skill-categories [get]
+//	@Security		BearerAuth
+func (ch *skillCategoryHandler) GetSkillCategories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		ch.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	categories, err := ch.skillCategoryUseCase.GetSkillCategories(ctx, userID)
+	if err != nil {
+		ch.logger.Error("Failed to get skill categories for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := skillDto.FromSkillCategoryEntitiesToResponse(categories, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// CreateSkillCategory
+//
+//	@Summary		Create a skill category
+//	@Description	Append a skill category for the authenticated admin user. Names are unique per user.
+//	@Tags			Admin Skills
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.SkillCategoryRequest	true	"Skill category"
+//	@Success		201		{object}	shared.APIResponse{data=dto.SkillCategoryResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		409		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/skill-categories [post]
+//	@Security		BearerAuth
+func (ch *skillCategoryHandler) CreateSkillCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		ch.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	var request skillDto.SkillCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	category, err := ch.skillCategoryUseCase.CreateSkillCategory(ctx, userID, &request)
+	if err != nil {
+		ch.logger.Error("Failed to create skill category for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := skillDto.FromSkillCategoryEntityToResponse(category, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+// UpdateSkillCategory
+//
+//	@Summary		Rename a skill category
+//	@Description	Rename a skill category of the authenticated admin user; its position is kept
+//	@Tags			Admin Skills
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Skill category ID"
+//	@Param			request	body		dto.SkillCategoryRequest	true	"Skill category"
+//	@Success		200		{object}	shared.APIResponse{data=dto.SkillCategoryResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		409		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/skill-categories/{id} [put]
+//	@Security		BearerAuth
+func (ch *skillCategoryHandler) UpdateSkillCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		ch.logger.Error("Failed to get user ID from context")
+		return
+	}
+	categoryID, ok := pathID(w, r, "id", "Invalid skill category ID")
+	if !ok {
+		return
+	}
+
+	var request skillDto.SkillCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	category, err := ch.skillCategoryUseCase.UpdateSkillCategory(ctx, userID, categoryID, &request)
+	if err != nil {
+		ch.logger.Error("Failed to update skill category %d: %v", categoryID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := skillDto.FromSkillCategoryEntityToResponse(category, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeleteSkillCategory
+//
+//	@Summary		Delete a skill category
+//	@Description	Delete a skill category of the authenticated admin user. Its skills are kept without a category.
+//	@Tags			Admin Skills
+//	@Produce		json
+//	@Param			id	path	int	true	"Skill category ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/skill-categories/{id} [delete]
+//	@Security		BearerAuth
+func (ch *skillCategoryHandler) DeleteSkillCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		ch.logger.Error("Failed to get user ID from context")
+		return
+	}
+	categoryID, ok := pathID(w, r, "id", "Invalid skill category ID")
+	if !ok {
+		return
+	}
+
+	if err := ch.skillCategoryUseCase.DeleteSkillCategory(ctx, userID, categoryID); err != nil {
+		ch.logger.Error("Failed to delete skill category %d: %v", categoryID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderSkillCategories
+//
+//	@Summary		Reorder skill categories
+//	@Description	Move the skill categories of the authenticated admin user into the given order. The IDs must list every one of them exactly once; grouped skill lists follow this order.
+//	@Tags			Admin Skills
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.OrderRequest	true	"Skill category IDs in their new order"
+//	@Success		200		{object}	shared.APIResponse{data=dto.SkillCategoryListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/skill-categories/order [put]
+//	@Security		BearerAuth
+func (ch *skillCategoryHandler) ReorderSkillCategories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := ch.getUserIDFromContext(w, r)
+	if !ok {
+		ch.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	categories, err := ch.skillCategoryUseCase.ReorderSkillCategories(ctx, userID, &request)
+	if err != nil {
+		ch.logger.Error("Failed to reorder skill categories: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := skillDto.FromSkillCategoryEntitiesToResponse(categories, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
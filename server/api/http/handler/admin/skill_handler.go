@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"portfolio/api/http/routes"
@@ -8,6 +9,7 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
 	skillDto "portfolio/dto/skill"
 	"portfolio/logger"
 	"portfolio/shared"
@@ -44,6 +46,11 @@ func NewSkillHandler(settingUseCase *usecases.SettingUseCase, skillUseCase *usec
 			Pattern: "POST /skills/bulk",
 			Handler: skillHandler.CreateBulkSkills,
 		},
+		{
+			Name:    "PutAdminSkillsOrderHandler",
+			Pattern: "PUT /skills/order",
+			Handler: skillHandler.ReorderSkills,
+		},
 		{
 			Name:    "GetAdminSkillHandler",
 			Pattern: "GET /skills/{id}",
@@ -73,7 +80,15 @@ func NewSkillHandler(settingUseCase *usecases.SettingUseCase, skillUseCase *usec
 //	@Description	Retrieve all skills for the authenticated admin user
 //	@Tags			Admin Skills
 //	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, name, level, category, years_of_experience, created_at; defaults to the manual order"
+//	@Param			filter[level]	query		int	false	"Filter by level, 1 to 5"
+//	@Param			filter[category]	query		int	false	"Filter by skill category ID"
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
 //	@Success		200	{object}	shared.APIResponse{data=dto.SkillListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -87,18 +102,25 @@ func (sh *skillHandler) GetSkills(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	skills, err := sh.skillUseCase.GetSkillsByUserID(ctx, userID)
+	query, err := utils.ParseListQuery(r, entities.SkillListSpec)
+	if err != nil {
+		sh.logger.Error("Invalid skill list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	skills, err := sh.skillUseCase.GetSkillsByUserID(ctx, userID, query)
 	if err != nil {
 		sh.logger.Error("Failed to get skills for user %d: %v", userID, err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := skillDto.FromSkillsEntityToResponse(skills,
-		&shared.Meta{
+	response := skillDto.FromSkillsEntityToResponse(skills.Items,
+		utils.WithListMeta(&shared.Meta{
 			"timestamp":  time.Now().Format(time.RFC3339),
 			"request_id": utils.GetRequestIDFromContext(ctx),
-		})
+		}, r, query, skills))
 
 	if response == nil {
 		sh.logger.Warn("No skills found for user %d", userID)
@@ -117,6 +139,7 @@ func (sh *skillHandler) GetSkills(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			id	path		int	true	"Skill ID"
 //	@Success		200	{object}	shared.APIResponse{data=dto.SkillResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -163,6 +186,7 @@ func (sh *skillHandler) GetSkill(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setETag(w, skillEntity.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -229,6 +253,7 @@ func (h *skillHandler) CreateSkill(w http.ResponseWriter, r *http.Request) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		dto.CreateBulkSkillsRequest	true	"Bulk skills creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
 //	@Success		201		{object}	shared.APIResponse{data=dto.SkillListResponse}
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -237,6 +262,11 @@ func (h *skillHandler) CreateSkill(w http.ResponseWriter, r *http.Request) {
 //	@Security		BearerAuth
 func (sh *skillHandler) CreateBulkSkills(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	atomic, ok := sh.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
 	var request skillDto.CreateBulkSkillsRequest
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		sh.logger.Error("Failed to decode bulk skills request body: %v", err)
@@ -261,6 +291,22 @@ func (sh *skillHandler) CreateBulkSkills(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if atomic {
+		createdSkills, err := sh.skillUseCase.CreateSkillsAtomically(ctx, skillEntities)
+		if err != nil {
+			sh.logger.Error("Atomic bulk skill creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := skillDto.FromSkillsEntityForBulkToResponse(createdSkills, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
 	var createdSkills []*entities.Skill
 	var errs []error
 
@@ -307,15 +353,22 @@ func (sh *skillHandler) CreateBulkSkills(w http.ResponseWriter, r *http.Request)
 //	@Produce		json
 //	@Param			id		path		int							true	"Skill ID"
 //	@Param			request	body		dto.UpdateSkillRequest	true	"Skill update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the skill changed since"
 //	@Success		200		{object}	shared.APIResponse{data=dto.SkillResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/skills/{id} [put]
 //	@Security		BearerAuth
 func (sh *skillHandler) UpdateSkill(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := sh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 	idStr := r.PathValue("id")
 	if idStr == "" {
 		sh.logger.Error("Skill ID is required")
@@ -353,7 +406,7 @@ func (sh *skillHandler) UpdateSkill(w http.ResponseWriter, r *http.Request) {
 	updatedSkill, err := sh.skillUseCase.UpdateSkill(ctx, id, skillEntity)
 	if err != nil {
 		sh.logger.Error("Failed to update skill %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, sh.currentSkill)
 		return
 	}
 
@@ -361,6 +414,7 @@ func (sh *skillHandler) UpdateSkill(w http.ResponseWriter, r *http.Request) {
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, updatedSkill.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -373,15 +427,22 @@ func (sh *skillHandler) UpdateSkill(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			id		path		int							true	"Skill ID"
 //	@Param			skill	body		dto.PatchSkillRequest		true	"Skill data to update"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the skill changed since"
 //	@Success		200		{object}	dto.SkillResponse
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/skills/{id} [patch]
 //	@Security		BearerAuth
 func (sh *skillHandler) PatchSkill(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := sh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 	idStr := r.PathValue("id")
 	if idStr == "" {
 		sh.logger.Error("Skill ID is required")
@@ -425,7 +486,7 @@ func (sh *skillHandler) PatchSkill(w http.ResponseWriter, r *http.Request) {
 	patchedSkill, err := sh.skillUseCase.PatchSkill(ctx, id, skillEntity)
 	if err != nil {
 		sh.logger.Error("Failed to patch skill %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, sh.currentSkill)
 		return
 	}
 
@@ -433,6 +494,7 @@ func (sh *skillHandler) PatchSkill(w http.ResponseWriter, r *http.Request) {
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, patchedSkill.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -443,15 +505,21 @@ func (sh *skillHandler) PatchSkill(w http.ResponseWriter, r *http.Request) {
 //	@Tags			Admin Skills
 //	@Produce		json
 //	@Param			id	path		int	true	"Skill ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the skill changed since"
 //	@Success		204	"No Content"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/skills/{id} [delete]
 //	@Security		BearerAuth
 func (sh *skillHandler) DeleteSkill(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := sh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 	idStr := r.PathValue("id")
 	if idStr == "" {
 		sh.logger.Error("Skill ID is required")
@@ -469,9 +537,53 @@ func (sh *skillHandler) DeleteSkill(w http.ResponseWriter, r *http.Request) {
 	err = sh.skillUseCase.DeleteSkill(ctx, id)
 	if err != nil {
 		sh.logger.Error("Failed to delete skill %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, sh.currentSkill)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderSkills
+//
+//	@Summary		Reorder skills
+//	@Description	Set the display order of the skills of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Skills
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Skill IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/skills/order [put]
+//	@Security		BearerAuth
+func (sh *skillHandler) ReorderSkills(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := sh.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := sh.skillUseCase.ReorderSkills(ctx, userID, &request); err != nil {
+		sh.logger.Error("Failed to reorder skills: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (sh *skillHandler) currentSkill(ctx context.Context, id int) (any, int, error) {
+	skill, err := sh.skillUseCase.GetSkillByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return skillDto.FromSkillEntityToResponse(skill, nil).Skill, skill.Version, nil
+}
@@ -0,0 +1,541 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
+	spokenLanguageDto "portfolio/dto/spoken_language"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type spokenLanguageHandler struct {
+	AbstractHandler
+	spokenLanguageUseCase *usecases.SpokenLanguageUseCase
+	logger                *logger.Logger
+}
+
+func NewSpokenLanguageHandler(settingUseCase *usecases.SettingUseCase, spokenLanguageUseCase *usecases.SpokenLanguageUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	spokenLanguageHandler := spokenLanguageHandler{
+		AbstractHandler:       AbstractHandler{settingUseCase: settingUseCase},
+		spokenLanguageUseCase: spokenLanguageUseCase,
+		logger:                logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminSpokenLanguagesHandler",
+			Pattern: "GET /spoken-languages",
+			Handler: spokenLanguageHandler.GetSpokenLanguages,
+		},
+		{
+			Name:    "PostAdminSpokenLanguageHandler",
+			Pattern: "POST /spoken-languages",
+			Handler: spokenLanguageHandler.CreateSpokenLanguage,
+		},
+		{
+			Name:    "PostBulkAdminSpokenLanguageHandler",
+			Pattern: "POST /spoken-languages/bulk",
+			Handler: spokenLanguageHandler.CreateBulkSpokenLanguages,
+		},
+		{
+			Name:    "PutAdminSpokenLanguagesOrderHandler",
+			Pattern: "PUT /spoken-languages/order",
+			Handler: spokenLanguageHandler.ReorderSpokenLanguages,
+		},
+		{
+			Name:    "GetAdminSpokenLanguageHandler",
+			Pattern: "GET /spoken-languages/{id}",
+			Handler: spokenLanguageHandler.GetSpokenLanguage,
+		},
+		{
+			Name:    "PutAdminSpokenLanguageHandler",
+			Pattern: "PUT /spoken-languages/{id}",
+			Handler: spokenLanguageHandler.UpdateSpokenLanguage,
+		},
+		{
+			Name:    "PatchAdminSpokenLanguageHandler",
+			Pattern: "PATCH /spoken-languages/{id}",
+			Handler: spokenLanguageHandler.PatchSpokenLanguage,
+		},
+		{
+			Name:    "DeleteAdminSpokenLanguageHandler",
+			Pattern: "DELETE /spoken-languages/{id}",
+			Handler: spokenLanguageHandler.DeleteSpokenLanguage,
+		},
+	}
+}
+
+// GetSpokenLanguages
+//
+//	@Summary		Get all admin spoken languages
+//	@Description	Retrieve all spoken languages of the authenticated admin user
+//	@Tags			Admin Spoken Languages
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, name, level, created_at; level follows A1 to C2, then native; defaults to the manual order, then the most fluent first"
+//	@Param			filter[level]	query		string	false	"Filter by level"	Enums(A1, A2, B1, B2, C1, C2, native)
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
+//	@Success		200	{object}	shared.APIResponse{data=dto.SpokenLanguageListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}	"Unauthorized"
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/spoken-languages [get]
+func (slh *spokenLanguageHandler) GetSpokenLanguages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := slh.getUserIDFromContext(w, r)
+	if !ok {
+		slh.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.SpokenLanguageListSpec)
+	if err != nil {
+		slh.logger.Error("Invalid spoken language list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	spokenLanguages, err := slh.spokenLanguageUseCase.GetSpokenLanguagesByUserID(ctx, userID, query)
+	if err != nil {
+		slh.logger.Error("Failed to get spoken languages for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := spokenLanguageDto.FromSpokenLanguagesEntityToResponse(spokenLanguages.Items,
+		utils.WithListMeta(&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		}, r, query, spokenLanguages))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetSpokenLanguage
+//
+//	@Summary		Get a specific admin spoken language
+//	@Description	Retrieve a specific spoken language by ID for admin management
+//	@Tags			Admin Spoken Languages
+//	@Produce		json
+//	@Param			id	path	int	true	"Spoken language ID"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.SpokenLanguageResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/spoken-languages/{id} [get]
+func (slh *spokenLanguageHandler) GetSpokenLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := pathID(w, r, "id", "Invalid spoken language ID")
+	if !ok {
+		return
+	}
+
+	spokenLanguage, err := slh.spokenLanguageUseCase.GetSpokenLanguageByID(ctx, id)
+	if err != nil {
+		slh.logger.Error("Failed to get spoken language %d: %v", id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := spokenLanguageDto.FromSpokenLanguageEntityToResponse(spokenLanguage,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, spokenLanguage.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// CreateSpokenLanguage
+//
+//	@Summary		Create a new spoken language
+//	@Description	Create a new spoken language for the authenticated admin user. A name can only be used once, ignoring case
+//	@Tags			Admin Spoken Languages
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.CreateSpokenLanguageRequest	true	"Spoken language creation request"
+//	@Security		BearerAuth
+//	@Success		201	{object}	shared.APIResponse{data=dto.SpokenLanguageResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		409	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/spoken-languages [post]
+func (slh *spokenLanguageHandler) CreateSpokenLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var request spokenLanguageDto.CreateSpokenLanguageRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slh.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		slh.logger.Error("Invalid request: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := slh.getUserIDFromContext(w, r)
+	if !ok {
+		slh.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	spokenLanguageEntity, err := request.ToEntity(userID)
+	if err != nil {
+		slh.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid spoken language data", "spoken_language", &err))
+		return
+	}
+
+	createdSpokenLanguage, err := slh.spokenLanguageUseCase.CreateSpokenLanguage(ctx, spokenLanguageEntity)
+	if err != nil {
+		slh.logger.Error("Failed to create spoken language: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := spokenLanguageDto.FromSpokenLanguageEntityToResponse(createdSpokenLanguage,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+// CreateBulkSpokenLanguages
+//
+//	@Summary		Create multiple spoken languages in bulk
+//	@Description	Create multiple spoken languages for the authenticated admin user
+//	@Tags			Admin Spoken Languages
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.CreateBulkSpokenLanguagesRequest	true	"Bulk spoken languages creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
+//	@Success		201		{object}	shared.APIResponse{data=dto.SpokenLanguageListResponse}
+//	@Success		207		{object}	shared.APIResponse{data=dto.SpokenLanguageListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/spoken-languages/bulk [post]
+//	@Security		BearerAuth
+func (slh *spokenLanguageHandler) CreateBulkSpokenLanguages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	atomic, ok := slh.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var request spokenLanguageDto.CreateBulkSpokenLanguagesRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slh.logger.Error("Failed to decode bulk spoken languages request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := slh.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	spokenLanguageEntities, err := request.ToEntities(userID)
+	if err != nil {
+		slh.logger.Error("Failed to convert bulk request to entities: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid spoken languages data", "spoken_languages", &err))
+		return
+	}
+
+	if atomic {
+		createdSpokenLanguages, err := slh.spokenLanguageUseCase.CreateSpokenLanguagesAtomically(ctx, spokenLanguageEntities)
+		if err != nil {
+			slh.logger.Error("Atomic bulk spoken language creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := spokenLanguageDto.FromSpokenLanguagesEntityForBulkToResponse(createdSpokenLanguages, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
+	var createdSpokenLanguages []*entities.SpokenLanguage
+	var errs []error
+
+	for i, spokenLanguageEntity := range spokenLanguageEntities {
+		createdSpokenLanguage, err := slh.spokenLanguageUseCase.CreateSpokenLanguage(ctx, spokenLanguageEntity)
+		if err != nil {
+			slh.logger.Error("Failed to create spoken language at index %d (name: %s): %v", i, spokenLanguageEntity.Name, err)
+			errs = append(errs, err)
+		} else {
+			createdSpokenLanguages = append(createdSpokenLanguages, createdSpokenLanguage)
+		}
+	}
+
+	statusCode := http.StatusCreated
+	if len(spokenLanguageEntities) == len(errs) {
+		slh.logger.Error("All spoken languages failed to create, returning errors")
+		utils.WriteErrorResponse(w, errs...)
+		return
+	} else if len(errs) > 0 {
+		statusCode = http.StatusMultiStatus
+	}
+
+	response := spokenLanguageDto.FromSpokenLanguagesEntityForBulkToResponse(createdSpokenLanguages, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	domainErrors := make([]*shared.APIError, len(errs))
+	for i, err := range errs {
+		if domainErr, ok := domain.AsDomainError(err); ok {
+			domainErrors[i] = utils.DomainErrorToAPIError(domainErr)
+		}
+	}
+	response.Errors = domainErrors
+	utils.WriteSuccessResponse(w, statusCode, response)
+}
+
+// UpdateSpokenLanguage
+//
+//	@Summary		Update an existing spoken language
+//	@Description	Replace the fields of a spoken language by ID for the authenticated admin user
+//	@Tags			Admin Spoken Languages
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int								true	"Spoken language ID"
+//	@Param			request	body	dto.UpdateSpokenLanguageRequest	true	"Spoken language update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the spoken language changed since"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.SpokenLanguageResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		409	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/spoken-languages/{id} [put]
+func (slh *spokenLanguageHandler) UpdateSpokenLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := slh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid spoken language ID")
+	if !ok {
+		return
+	}
+
+	var request spokenLanguageDto.UpdateSpokenLanguageRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slh.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := slh.getUserIDFromContext(w, r)
+	if !ok {
+		slh.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	spokenLanguageEntity, err := request.ToEntity(id, userID)
+	if err != nil {
+		slh.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid spoken language data", "spoken_language", &err))
+		return
+	}
+
+	updatedSpokenLanguage, err := slh.spokenLanguageUseCase.UpdateSpokenLanguage(ctx, id, spokenLanguageEntity)
+	if err != nil {
+		slh.logger.Error("Failed to update spoken language %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, slh.currentSpokenLanguage)
+		return
+	}
+
+	response := spokenLanguageDto.FromSpokenLanguageEntityToResponse(updatedSpokenLanguage,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, updatedSpokenLanguage.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// PatchSpokenLanguage
+//
+//	@Summary		Partially update a spoken language
+//	@Description	Partially update a spoken language by ID for the authenticated admin user
+//	@Tags			Admin Spoken Languages
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Spoken language ID"
+//	@Param			request	body		dto.PatchSpokenLanguageRequest	true	"Patch spoken language request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the spoken language changed since"
+//	@Success		200		{object}	shared.APIResponse{data=dto.SpokenLanguageResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		409		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/spoken-languages/{id} [patch]
+//	@Security		BearerAuth
+func (slh *spokenLanguageHandler) PatchSpokenLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := slh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid spoken language ID")
+	if !ok {
+		return
+	}
+
+	if _, ok := slh.getUserIDFromContext(w, r); !ok {
+		slh.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	var request spokenLanguageDto.PatchSpokenLanguageRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slh.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	patchedSpokenLanguage, err := slh.spokenLanguageUseCase.PatchSpokenLanguage(ctx, id, &request)
+	if err != nil {
+		slh.logger.Error("Failed to patch spoken language %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, slh.currentSpokenLanguage)
+		return
+	}
+
+	response := spokenLanguageDto.FromSpokenLanguageEntityToResponse(patchedSpokenLanguage,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, patchedSpokenLanguage.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeleteSpokenLanguage
+//
+//	@Summary		Delete a spoken language
+//	@Description	Move a spoken language to the trash by ID for the authenticated admin user
+//	@Tags			Admin Spoken Languages
+//	@Produce		json
+//	@Param			id	path	int	true	"Spoken language ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the spoken language changed since"
+//	@Security		BearerAuth
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/spoken-languages/{id} [delete]
+func (slh *spokenLanguageHandler) DeleteSpokenLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := slh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid spoken language ID")
+	if !ok {
+		return
+	}
+
+	if err := slh.spokenLanguageUseCase.DeleteSpokenLanguage(ctx, id); err != nil {
+		slh.logger.Error("Failed to delete spoken language %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, slh.currentSpokenLanguage)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderSpokenLanguages
+//
+//	@Summary		Reorder spoken languages
+//	@Description	Set the display order of the spoken languages of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Spoken Languages
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Spoken language IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/spoken-languages/order [put]
+//	@Security		BearerAuth
+func (slh *spokenLanguageHandler) ReorderSpokenLanguages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := slh.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := slh.spokenLanguageUseCase.ReorderSpokenLanguages(ctx, userID, &request); err != nil {
+		slh.logger.Error("Failed to reorder spoken languages: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (slh *spokenLanguageHandler) currentSpokenLanguage(ctx context.Context, id int) (any, int, error) {
+	spokenLanguage, err := slh.spokenLanguageUseCase.GetSpokenLanguageByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return spokenLanguageDto.FromSpokenLanguageEntityToResponse(spokenLanguage, nil).SpokenLanguage, spokenLanguage.Version, nil
+}
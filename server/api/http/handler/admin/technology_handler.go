@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"portfolio/api/http/routes"
@@ -8,6 +9,7 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
 	technologyDto "portfolio/dto/technology"
 	"portfolio/logger"
 	"portfolio/shared"
@@ -44,6 +46,11 @@ func NewTechnologyHandler(settingUseCase *usecases.SettingUseCase, technologyUse
 			Pattern: "POST /technologies/bulk",
 			Handler: technologyHandler.CreateBulkTechnologies,
 		},
+		{
+			Name:    "PutAdminTechnologiesOrderHandler",
+			Pattern: "PUT /technologies/order",
+			Handler: technologyHandler.ReorderTechnologies,
+		},
 		{
 			Name:    "GetAdminTechnologyHandler",
 			Pattern: "GET /technologies/{id}",
@@ -74,7 +81,14 @@ func NewTechnologyHandler(settingUseCase *usecases.SettingUseCase, technologyUse
 //	@Tags			Admin Technologies
 //	@Accept			json
 //	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, name, created_at; defaults to the manual order"
+//	@Param			filter[name]	query		string	false	"Filter by name"
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
 //	@Success		200	{object}	shared.APIResponse{data=dto.TechnologyListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/technologies [get]
@@ -88,17 +102,24 @@ func (th *technologyHandler) GetTechnologies(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	technologies, err := th.technologyUseCase.GetTechnologiesByUserID(ctx, userID)
+	query, err := utils.ParseListQuery(r, entities.TechnologyListSpec)
+	if err != nil {
+		th.logger.Error("Invalid technology list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	technologies, err := th.technologyUseCase.GetTechnologiesByUserID(ctx, userID, query)
 	if err != nil {
 		th.logger.Error("Failed to get technologies for user %d: %v", userID, err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := technologyDto.FromTechnologiesEntityToResponse(technologies, &shared.Meta{
+	response := technologyDto.FromTechnologiesEntityToResponse(technologies.Items, utils.WithListMeta(&shared.Meta{
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
-	})
+	}, r, query, technologies))
 
 	if response == nil {
 		th.logger.Error("No technologies found for user %d", userID)
@@ -118,6 +139,7 @@ func (th *technologyHandler) GetTechnologies(w http.ResponseWriter, r *http.Requ
 //	@Produce		json
 //	@Param			id	path		int	true	"Technology ID"
 //	@Success		200	{object}	shared.APIResponse{data=dto.TechnologyResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -158,6 +180,7 @@ func (th *technologyHandler) GetTechnology(w http.ResponseWriter, r *http.Reques
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, technologyEntity.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -220,6 +243,7 @@ func (th *technologyHandler) CreateTechnology(w http.ResponseWriter, r *http.Req
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		dto.CreateBulkTechnologiesRequest	true	"Bulk technology creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
 //	@Success		201		{object}	shared.APIResponse{data=dto.TechnologyListResponse}
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -228,6 +252,11 @@ func (th *technologyHandler) CreateTechnology(w http.ResponseWriter, r *http.Req
 //	@Security		BearerAuth
 func (th *technologyHandler) CreateBulkTechnologies(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	atomic, ok := th.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
 	var request technologyDto.CreateBulkTechnologiesRequest
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		th.logger.Error("Failed to decode bulk technologies request body: %v", err)
@@ -254,6 +283,22 @@ func (th *technologyHandler) CreateBulkTechnologies(w http.ResponseWriter, r *ht
 		return
 	}
 
+	if atomic {
+		createdTechnologies, err := th.technologyUseCase.CreateTechnologiesAtomically(ctx, technologyEntities)
+		if err != nil {
+			th.logger.Error("Atomic bulk technology creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := technologyDto.FromTechnologiesEntityForBulkToResponse(createdTechnologies, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
 	var createdTechnologies []*entities.Technology
 	var errs []error
 
@@ -300,15 +345,22 @@ func (th *technologyHandler) CreateBulkTechnologies(w http.ResponseWriter, r *ht
 //	@Produce		json
 //	@Param			id		path		int										true	"Technology ID"
 //	@Param			request	body		dto.UpdateTechnologyRequest	true	"Technology update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the technology changed since"
 //	@Success		200		{object}	shared.APIResponse{data=dto.TechnologyResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
 //	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/technologies/{id} [put]
 //	@Security		BearerAuth
 func (th *technologyHandler) UpdateTechnology(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := th.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	idStr := r.PathValue("id")
 	if idStr == "" {
@@ -349,7 +401,7 @@ func (th *technologyHandler) UpdateTechnology(w http.ResponseWriter, r *http.Req
 	updatedTechnology, err := th.technologyUseCase.UpdateTechnology(ctx, id, technologyEntity)
 	if err != nil {
 		th.logger.Error("Failed to update technology %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, th.currentTechnology)
 		return
 	}
 
@@ -357,6 +409,7 @@ func (th *technologyHandler) UpdateTechnology(w http.ResponseWriter, r *http.Req
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, updatedTechnology.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -369,15 +422,22 @@ func (th *technologyHandler) UpdateTechnology(w http.ResponseWriter, r *http.Req
 //	@Produce		json
 //	@Param			id			path		int								true	"Technology ID"
 //	@Param			technology	body		dto.PatchTechnologyRequest	true	"Technology data to update"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the technology changed since"
 //	@Success		200			{object}	dto.TechnologyResponse
+//	@Header		200			{string}	ETag	"Current version, for If-Match"
 //	@Failure		400			{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401			{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412			{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428			{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500			{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/technologies/{id} [patch]
 //	@Security		BearerAuth
 func (th *technologyHandler) PatchTechnology(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := th.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	idStr := r.PathValue("id")
 	if idStr == "" {
@@ -422,7 +482,7 @@ func (th *technologyHandler) PatchTechnology(w http.ResponseWriter, r *http.Requ
 	patchedTechnologyEntity, err := th.technologyUseCase.PatchTechnology(ctx, id, technologyEntity)
 	if err != nil {
 		th.logger.Error("Failed to patch technology %d: %v", id, err)
-		utils.WriteErrorResponse(w, err)
+		writeVersionedError(ctx, w, err, id, th.currentTechnology)
 		return
 	}
 
@@ -430,6 +490,7 @@ func (th *technologyHandler) PatchTechnology(w http.ResponseWriter, r *http.Requ
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
+	setETag(w, patchedTechnologyEntity.Version)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -441,15 +502,21 @@ func (th *technologyHandler) PatchTechnology(w http.ResponseWriter, r *http.Requ
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		int	true	"Technology ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the technology changed since"
 //	@Success		204	"No Content"
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/admin/technologies/{id} [delete]
 //	@Security		BearerAuth
 func (th *technologyHandler) DeleteTechnology(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	ctx, ok := th.withIfMatch(w, r)
+	if !ok {
+		return
+	}
 
 	idStr := r.PathValue("id")
 	if idStr == "" {
@@ -468,9 +535,53 @@ func (th *technologyHandler) DeleteTechnology(w http.ResponseWriter, r *http.Req
 	err = th.technologyUseCase.DeleteTechnology(ctx, id)
 	if err != nil {
 		th.logger.Error("Failed to delete technology %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, th.currentTechnology)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderTechnologies
+//
+//	@Summary		Reorder technologies
+//	@Description	Set the display order of the technologies of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Technologies
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Technology IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/technologies/order [put]
+//	@Security		BearerAuth
+func (th *technologyHandler) ReorderTechnologies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := th.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := th.technologyUseCase.ReorderTechnologies(ctx, userID, &request); err != nil {
+		th.logger.Error("Failed to reorder technologies: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (th *technologyHandler) currentTechnology(ctx context.Context, id int) (any, int, error) {
+	technology, err := th.technologyUseCase.GetTechnologyByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return technologyDto.FromTechnologyEntityToResponse(technology, nil).Technology, technology.Version, nil
+}
@@ -0,0 +1,94 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	"portfolio/infrastructure/memory"
+	"portfolio/logger"
+	"portfolio/service"
+	"portfolio/shared"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPatchTechnologyWithStaleIfMatch(t *testing.T) {
+	logger := logger.NewWriterLogger(io.Discard)
+	store := memory.NewStore()
+	users := memory.NewUserRepository(store, logger)
+	technologies := memory.NewTechnologyRepository(store, logger)
+	technologyUseCase := usecases.NewTechnologyUseCase(technologies, users, memory.NewRevisionRepository(store, logger),
+		memory.NewUnitOfWork(store, logger), service.NewCacheService(true, 100, time.Minute), logger)
+
+	now := time.Now()
+	user, err := users.CreateUser(t.Context(), &entities.User{
+		Username: "admin", Email: "admin@example.com", Password: "hashed",
+		Role: entities.RoleAdmin, IsActive: true, CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+	technology, err := technologyUseCase.CreateTechnology(t.Context(), &entities.Technology{
+		UserID: user.ID, Name: "Go", IconURL: "https://example.com/go.svg",
+	})
+	if err != nil {
+		t.Fatalf("CreateTechnology failed: %v", err)
+	}
+
+	handler := &technologyHandler{technologyUseCase: technologyUseCase, logger: logger}
+	mux := http.NewServeMux()
+	mux.HandleFunc("PATCH /technologies/{id}", handler.PatchTechnology)
+	patch := func(ifMatch, name string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		r := httptest.NewRequest(http.MethodPatch, "/technologies/"+strconv.Itoa(technology.TechnologyID),
+			strings.NewReader(`{"name":"`+name+`"}`))
+		r.Header.Set("If-Match", ifMatch)
+		r = r.WithContext(context.WithValue(r.Context(), shared.USER_ID_KEY, user.ID))
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	version := `"` + strconv.Itoa(technology.Version) + `"`
+	w := patch(version, "Golang")
+	if w.Code != http.StatusOK {
+		t.Fatalf("PATCH with the current version: status = %d, want %d (body %s)", w.Code, http.StatusOK, w.Body)
+	}
+	newVersion := w.Header().Get("ETag")
+	if newVersion == "" || newVersion == version {
+		t.Fatalf("ETag after the update = %q, want a new version", newVersion)
+	}
+
+	// The same If-Match is now stale: the write is refused and the answer
+	// carries the current version to merge with.
+	w = patch(version, "Rust")
+	if w.Code != http.StatusPreconditionFailed {
+		t.Fatalf("PATCH with a stale version: status = %d, want %d (body %s)", w.Code, http.StatusPreconditionFailed, w.Body)
+	}
+	if etag := w.Header().Get("ETag"); etag != newVersion {
+		t.Errorf("ETag of the 412 = %q, want %q", etag, newVersion)
+	}
+	var body struct {
+		Errors []struct {
+			Meta map[string]any `json:"meta"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil || len(body.Errors) == 0 || body.Errors[0].Meta["current"] == nil {
+		t.Errorf("412 body lacks the current representation (decode error %v)", err)
+	}
+
+	current, err := technologyUseCase.GetTechnologyByID(t.Context(), technology.TechnologyID)
+	if err != nil {
+		t.Fatalf("GetTechnologyByID failed: %v", err)
+	}
+	if current.Name != "Golang" {
+		t.Errorf("name after the refused write = %q, want %q", current.Name, "Golang")
+	}
+}
@@ -0,0 +1,487 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
+	testimonialDto "portfolio/dto/testimonial"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type testimonialHandler struct {
+	AbstractHandler
+	testimonialUseCase *usecases.TestimonialUseCase
+	logger             *logger.Logger
+}
+
+func NewTestimonialHandler(settingUseCase *usecases.SettingUseCase, testimonialUseCase *usecases.TestimonialUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	testimonialHandler := testimonialHandler{
+		AbstractHandler:    AbstractHandler{settingUseCase: settingUseCase},
+		testimonialUseCase: testimonialUseCase,
+		logger:             logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminTestimonialsHandler",
+			Pattern: "GET /testimonials",
+			Handler: testimonialHandler.GetTestimonials,
+		},
+		{
+			Name:    "PutAdminTestimonialsOrderHandler",
+			Pattern: "PUT /testimonials/order",
+			Handler: testimonialHandler.ReorderTestimonials,
+		},
+		{
+			Name:    "GetAdminTestimonialHandler",
+			Pattern: "GET /testimonials/{id}",
+			Handler: testimonialHandler.GetTestimonial,
+		},
+		{
+			Name:    "PutAdminTestimonialHandler",
+			Pattern: "PUT /testimonials/{id}",
+			Handler: testimonialHandler.UpdateTestimonial,
+		},
+		{
+			Name:    "PatchAdminTestimonialHandler",
+			Pattern: "PATCH /testimonials/{id}",
+			Handler: testimonialHandler.PatchTestimonial,
+		},
+		{
+			Name:    "PostAdminTestimonialApproveHandler",
+			Pattern: "POST /testimonials/{id}/approve",
+			Handler: testimonialHandler.ApproveTestimonial,
+		},
+		{
+			Name:    "PostAdminTestimonialRejectHandler",
+			Pattern: "POST /testimonials/{id}/reject",
+			Handler: testimonialHandler.RejectTestimonial,
+		},
+		{
+			Name:    "DeleteAdminTestimonialHandler",
+			Pattern: "DELETE /testimonials/{id}",
+			Handler: testimonialHandler.DeleteTestimonial,
+		},
+	}
+}
+
+// GetTestimonials
+//
+//	@Summary		Get all admin testimonials
+//	@Description	Retrieve all testimonials of the authenticated admin user, whatever their status; filter by status pending for the moderation queue
+//	@Tags			Admin Testimonials
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort				query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, created_at, author_name, status; defaults to the manual order"
+//	@Param			filter[status]		query		string	false	"Filter by moderation status"	Enums(pending, approved, rejected)
+//	@Param			filter[project]		query		int		false	"Filter by project ID"
+//	@Param			filter[experience]	query		int		false	"Filter by experience ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.TestimonialListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}	"Unauthorized"
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/testimonials [get]
+func (th *testimonialHandler) GetTestimonials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := th.getUserIDFromContext(w, r)
+	if !ok {
+		th.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.TestimonialListSpec)
+	if err != nil {
+		th.logger.Error("Invalid testimonial list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	testimonials, err := th.testimonialUseCase.GetTestimonialsByUserID(ctx, userID, query)
+	if err != nil {
+		th.logger.Error("Failed to get testimonials for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := testimonialDto.FromTestimonialsEntityToResponse(testimonials.Items,
+		utils.WithListMeta(&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		}, r, query, testimonials))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetTestimonial
+//
+//	@Summary		Get a specific admin testimonial
+//	@Description	Retrieve a specific testimonial by ID for admin management
+//	@Tags			Admin Testimonials
+//	@Produce		json
+//	@Param			id	path	int	true	"Testimonial ID"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.TestimonialResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/testimonials/{id} [get]
+func (th *testimonialHandler) GetTestimonial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := pathID(w, r, "id", "Invalid testimonial ID")
+	if !ok {
+		return
+	}
+
+	testimonial, err := th.testimonialUseCase.GetTestimonialByID(ctx, id)
+	if err != nil {
+		th.logger.Error("Failed to get testimonial %d: %v", id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := testimonialDto.FromTestimonialEntityToResponse(testimonial,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, testimonial.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// UpdateTestimonial
+//
+//	@Summary		Update an existing testimonial
+//	@Description	Replace the fields of a testimonial by ID for the authenticated admin user; project_id and experience_id left out remove the links. The status is set by approve and reject
+//	@Tags			Admin Testimonials
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int								true	"Testimonial ID"
+//	@Param			request	body	dto.UpdateTestimonialRequest	true	"Testimonial update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the testimonial changed since"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.TestimonialResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/testimonials/{id} [put]
+func (th *testimonialHandler) UpdateTestimonial(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := th.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid testimonial ID")
+	if !ok {
+		return
+	}
+
+	var request testimonialDto.UpdateTestimonialRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		th.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := th.getUserIDFromContext(w, r)
+	if !ok {
+		th.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	testimonialEntity, err := request.ToEntity(id, userID)
+	if err != nil {
+		th.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid testimonial data", "testimonial", &err))
+		return
+	}
+
+	updatedTestimonial, err := th.testimonialUseCase.UpdateTestimonial(ctx, id, testimonialEntity)
+	if err != nil {
+		th.logger.Error("Failed to update testimonial %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, th.currentTestimonial)
+		return
+	}
+
+	response := testimonialDto.FromTestimonialEntityToResponse(updatedTestimonial,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, updatedTestimonial.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// PatchTestimonial
+//
+//	@Summary		Partially update a testimonial
+//	@Description	Partially update a testimonial by ID for the authenticated admin user
+//	@Tags			Admin Testimonials
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Testimonial ID"
+//	@Param			request	body		dto.PatchTestimonialRequest	true	"Patch testimonial request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the testimonial changed since"
+//	@Success		200		{object}	shared.APIResponse{data=dto.TestimonialResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/testimonials/{id} [patch]
+//	@Security		BearerAuth
+func (th *testimonialHandler) PatchTestimonial(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := th.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid testimonial ID")
+	if !ok {
+		return
+	}
+
+	if _, ok := th.getUserIDFromContext(w, r); !ok {
+		th.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	var request testimonialDto.PatchTestimonialRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		th.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	patchedTestimonial, err := th.testimonialUseCase.PatchTestimonial(ctx, id, &request)
+	if err != nil {
+		th.logger.Error("Failed to patch testimonial %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, th.currentTestimonial)
+		return
+	}
+
+	response := testimonialDto.FromTestimonialEntityToResponse(patchedTestimonial,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, patchedTestimonial.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// ApproveTestimonial
+//
+//	@Summary		Approve a testimonial
+//	@Description	Approve a pending or rejected testimonial by ID, which lists it on the public API
+//	@Tags			Admin Testimonials
+//	@Produce		json
+//	@Param			id	path	int	true	"Testimonial ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the testimonial changed since"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.TestimonialResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/testimonials/{id}/approve [post]
+func (th *testimonialHandler) ApproveTestimonial(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := th.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid testimonial ID")
+	if !ok {
+		return
+	}
+
+	approvedTestimonial, err := th.testimonialUseCase.ApproveTestimonial(ctx, id)
+	if err != nil {
+		th.logger.Error("Failed to approve testimonial %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, th.currentTestimonial)
+		return
+	}
+
+	response := testimonialDto.FromTestimonialEntityToResponse(approvedTestimonial,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, approvedTestimonial.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// RejectTestimonial
+//
+//	@Summary		Reject a testimonial
+//	@Description	Reject a pending or approved testimonial by ID, which hides it from the public API. The body and its reason are optional; the reason is only shown to the admin
+//	@Tags			Admin Testimonials
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int								true	"Testimonial ID"
+//	@Param			request	body	dto.RejectTestimonialRequest	false	"Rejection reason"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the testimonial changed since"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.TestimonialResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/testimonials/{id}/reject [post]
+func (th *testimonialHandler) RejectTestimonial(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := th.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid testimonial ID")
+	if !ok {
+		return
+	}
+
+	var request testimonialDto.RejectTestimonialRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		th.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	rejectedTestimonial, err := th.testimonialUseCase.RejectTestimonial(ctx, id, request.Reason)
+	if err != nil {
+		th.logger.Error("Failed to reject testimonial %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, th.currentTestimonial)
+		return
+	}
+
+	response := testimonialDto.FromTestimonialEntityToResponse(rejectedTestimonial,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, rejectedTestimonial.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeleteTestimonial
+//
+//	@Summary		Delete a testimonial
+//	@Description	Move a testimonial to the trash by ID for the authenticated admin user
+//	@Tags			Admin Testimonials
+//	@Produce		json
+//	@Param			id	path	int	true	"Testimonial ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the testimonial changed since"
+//	@Security		BearerAuth
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/testimonials/{id} [delete]
+func (th *testimonialHandler) DeleteTestimonial(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := th.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid testimonial ID")
+	if !ok {
+		return
+	}
+
+	if err := th.testimonialUseCase.DeleteTestimonial(ctx, id); err != nil {
+		th.logger.Error("Failed to delete testimonial %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, th.currentTestimonial)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderTestimonials
+//
+//	@Summary		Reorder testimonials
+//	@Description	Set the display order of the testimonials of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Testimonials
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Testimonial IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/testimonials/order [put]
+//	@Security		BearerAuth
+func (th *testimonialHandler) ReorderTestimonials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := th.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := th.testimonialUseCase.ReorderTestimonials(ctx, userID, &request); err != nil {
+		th.logger.Error("Failed to reorder testimonials: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (th *testimonialHandler) currentTestimonial(ctx context.Context, id int) (any, int, error) {
+	testimonial, err := th.testimonialUseCase.GetTestimonialByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return testimonialDto.FromTestimonialEntityToResponse(testimonial, nil).Testimonial, testimonial.Version, nil
+}
@@ -0,0 +1,159 @@
+package admin
+
+import (
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/usecases"
+	trashDto "portfolio/dto/trash"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+type trashHandler struct {
+	AbstractHandler
+	trashUseCase *usecases.TrashUseCase
+	logger       *logger.Logger
+}
+
+func NewTrashHandler(settingUseCase *usecases.SettingUseCase, trashUseCase *usecases.TrashUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	trashHandler := trashHandler{
+		AbstractHandler: AbstractHandler{settingUseCase: settingUseCase},
+		trashUseCase:    trashUseCase,
+		logger:          logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminTrashHandler",
+			Pattern: "GET /trash",
+			Handler: trashHandler.GetTrash,
+		},
+		{
+			Name:    "RestoreAdminTrashItemHandler",
+			Pattern: "POST /trash/{type}/{id}/restore",
+			Handler: trashHandler.RestoreItem,
+		},
+		{
+			Name:    "PurgeAdminTrashItemHandler",
+			Pattern: "DELETE /trash/{type}/{id}",
+			Handler: trashHandler.PurgeItem,
+		},
+	}
+}
+
+// GetTrash
+//
+//	@Summary		List the trash
+//	@Description	Retrieve soft-deleted items of the authenticated admin user, most recently deleted first
+//	@Tags			Admin Trash
+//	@Produce		json
+//	@Param			type	query		string	false	"Only list one type"	Enums(projects, skills, experiences, educations, certifications, publications, spoken_languages, awards, volunteerings, testimonials, technologies, personal_infos)
+//	@Success		200		{object}	shared.APIResponse{data=dto.TrashListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/trash [get]
+//	@Security		BearerAuth
+func (th *trashHandler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := th.getUserIDFromContext(w, r)
+	if !ok {
+		th.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	items, err := th.trashUseCase.GetTrash(ctx, userID, r.URL.Query().Get("type"))
+	if err != nil {
+		th.logger.Error("Failed to get trash for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := trashDto.FromTrashItemsEntityToResponse(items,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// RestoreItem
+//
+//	@Summary		Restore a trashed item
+//	@Description	Move a soft-deleted item back to the portfolio
+//	@Tags			Admin Trash
+//	@Param			type	path	string	true	"Item type"	Enums(projects, skills, experiences, educations, certifications, publications, spoken_languages, awards, volunteerings, testimonials, technologies, personal_infos)
+//	@Param			id		path	int		true	"Item ID"
+//	@Success		204
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/trash/{type}/{id}/restore [post]
+//	@Security		BearerAuth
+func (th *trashHandler) RestoreItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, itemType, id, ok := th.parseItem(w, r)
+	if !ok {
+		return
+	}
+
+	if err := th.trashUseCase.Restore(ctx, userID, itemType, id); err != nil {
+		th.logger.Error("Failed to restore %s %d: %v", itemType, id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PurgeItem
+//
+//	@Summary		Purge a trashed item
+//	@Description	Permanently delete a soft-deleted item
+//	@Tags			Admin Trash
+//	@Param			type	path	string	true	"Item type"	Enums(projects, skills, experiences, educations, certifications, publications, spoken_languages, awards, volunteerings, testimonials, technologies, personal_infos)
+//	@Param			id		path	int		true	"Item ID"
+//	@Success		204
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/trash/{type}/{id} [delete]
+//	@Security		BearerAuth
+func (th *trashHandler) PurgeItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, itemType, id, ok := th.parseItem(w, r)
+	if !ok {
+		return
+	}
+
+	if err := th.trashUseCase.Purge(ctx, userID, itemType, id); err != nil {
+		th.logger.Error("Failed to purge %s %d: %v", itemType, id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (th *trashHandler) parseItem(w http.ResponseWriter, r *http.Request) (int, string, int, bool) {
+	userID, ok := th.getUserIDFromContext(w, r)
+	if !ok {
+		th.logger.Error("Failed to get user ID from context")
+		return 0, "", 0, false
+	}
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		th.logger.Error("Invalid trash item ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid item ID", "id", &err))
+		return 0, "", 0, false
+	}
+
+	return userID, r.PathValue("type"), id, true
+}
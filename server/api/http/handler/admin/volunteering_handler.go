@@ -0,0 +1,540 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
+	volunteeringDto "portfolio/dto/volunteering"
+	"portfolio/logger"
+	"portfolio/shared"
+	"time"
+)
+
+type volunteeringHandler struct {
+	AbstractHandler
+	volunteeringUseCase *usecases.VolunteeringUseCase
+	logger              *logger.Logger
+}
+
+func NewVolunteeringHandler(settingUseCase *usecases.SettingUseCase, volunteeringUseCase *usecases.VolunteeringUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	volunteeringHandler := volunteeringHandler{
+		AbstractHandler:     AbstractHandler{settingUseCase: settingUseCase},
+		volunteeringUseCase: volunteeringUseCase,
+		logger:              logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAdminVolunteeringsHandler",
+			Pattern: "GET /volunteerings",
+			Handler: volunteeringHandler.GetVolunteerings,
+		},
+		{
+			Name:    "PostAdminVolunteeringHandler",
+			Pattern: "POST /volunteerings",
+			Handler: volunteeringHandler.CreateVolunteering,
+		},
+		{
+			Name:    "PostBulkAdminVolunteeringHandler",
+			Pattern: "POST /volunteerings/bulk",
+			Handler: volunteeringHandler.CreateBulkVolunteerings,
+		},
+		{
+			Name:    "PutAdminVolunteeringsOrderHandler",
+			Pattern: "PUT /volunteerings/order",
+			Handler: volunteeringHandler.ReorderVolunteerings,
+		},
+		{
+			Name:    "GetAdminVolunteeringHandler",
+			Pattern: "GET /volunteerings/{id}",
+			Handler: volunteeringHandler.GetVolunteering,
+		},
+		{
+			Name:    "PutAdminVolunteeringHandler",
+			Pattern: "PUT /volunteerings/{id}",
+			Handler: volunteeringHandler.UpdateVolunteering,
+		},
+		{
+			Name:    "PatchAdminVolunteeringHandler",
+			Pattern: "PATCH /volunteerings/{id}",
+			Handler: volunteeringHandler.PatchVolunteering,
+		},
+		{
+			Name:    "DeleteAdminVolunteeringHandler",
+			Pattern: "DELETE /volunteerings/{id}",
+			Handler: volunteeringHandler.DeleteVolunteering,
+		},
+	}
+}
+
+// GetVolunteerings
+//
+//	@Summary		Get all admin volunteerings
+//	@Description	Retrieve all volunteer work of the authenticated admin user
+//	@Tags			Admin Volunteering
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, start_date, role, organization, created_at; defaults to the manual order"
+//	@Param			filter[organization]	query	string	false	"Filter by organization"
+//	@Param			filter[cause]		query	string	false	"Filter by cause"
+//	@Param			filter[current]		query	bool	false	"Only the ongoing volunteerings, or only the ended ones"
+//	@Param			filter[state]	query		string	false	"Filter by publishing state"	Enums(draft, scheduled, published, archived)
+//	@Success		200	{object}	shared.APIResponse{data=dto.VolunteeringListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}	"Unauthorized"
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/volunteerings [get]
+func (vh *volunteeringHandler) GetVolunteerings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := vh.getUserIDFromContext(w, r)
+	if !ok {
+		vh.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.VolunteeringListSpec)
+	if err != nil {
+		vh.logger.Error("Invalid volunteering list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	volunteerings, err := vh.volunteeringUseCase.GetVolunteeringsByUserID(ctx, userID, query)
+	if err != nil {
+		vh.logger.Error("Failed to get volunteerings for user %d: %v", userID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := volunteeringDto.FromVolunteeringsEntityToResponse(volunteerings.Items,
+		utils.WithListMeta(&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		}, r, query, volunteerings))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetVolunteering
+//
+//	@Summary		Get a specific admin volunteering
+//	@Description	Retrieve a specific volunteering by ID for admin management
+//	@Tags			Admin Volunteering
+//	@Produce		json
+//	@Param			id	path	int	true	"Volunteering ID"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.VolunteeringResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/volunteerings/{id} [get]
+func (vh *volunteeringHandler) GetVolunteering(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := pathID(w, r, "id", "Invalid volunteering ID")
+	if !ok {
+		return
+	}
+
+	volunteering, err := vh.volunteeringUseCase.GetVolunteeringByID(ctx, id)
+	if err != nil {
+		vh.logger.Error("Failed to get volunteering %d: %v", id, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := volunteeringDto.FromVolunteeringEntityToResponse(volunteering,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, volunteering.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// CreateVolunteering
+//
+//	@Summary		Create a new volunteering
+//	@Description	Create a new volunteering for the authenticated admin user; leave out the end_date while it is ongoing
+//	@Tags			Admin Volunteering
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.CreateVolunteeringRequest	true	"Volunteering creation request"
+//	@Security		BearerAuth
+//	@Success		201	{object}	shared.APIResponse{data=dto.VolunteeringResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/volunteerings [post]
+func (vh *volunteeringHandler) CreateVolunteering(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var request volunteeringDto.CreateVolunteeringRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		vh.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		vh.logger.Error("Invalid request: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := vh.getUserIDFromContext(w, r)
+	if !ok {
+		vh.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	volunteeringEntity, err := request.ToEntity(userID)
+	if err != nil {
+		vh.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid volunteering data", "volunteering", &err))
+		return
+	}
+
+	createdVolunteering, err := vh.volunteeringUseCase.CreateVolunteering(ctx, volunteeringEntity)
+	if err != nil {
+		vh.logger.Error("Failed to create volunteering: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := volunteeringDto.FromVolunteeringEntityToResponse(createdVolunteering,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+// CreateBulkVolunteerings
+//
+//	@Summary		Create multiple volunteerings in bulk
+//	@Description	Create multiple volunteerings for the authenticated admin user
+//	@Tags			Admin Volunteering
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.CreateBulkVolunteeringsRequest	true	"Bulk volunteerings creation request"
+//	@Param			atomic	query		bool	false	"Create every item or none; any failure rolls back the batch instead of answering 207"
+//	@Success		201		{object}	shared.APIResponse{data=dto.VolunteeringListResponse}
+//	@Success		207		{object}	shared.APIResponse{data=dto.VolunteeringListResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/volunteerings/bulk [post]
+//	@Security		BearerAuth
+func (vh *volunteeringHandler) CreateBulkVolunteerings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	atomic, ok := vh.isAtomicRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var request volunteeringDto.CreateBulkVolunteeringsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		vh.logger.Error("Failed to decode bulk volunteerings request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := vh.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	volunteeringEntities, err := request.ToEntities(userID)
+	if err != nil {
+		vh.logger.Error("Failed to convert bulk request to entities: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid volunteerings data", "volunteerings", &err))
+		return
+	}
+
+	if atomic {
+		createdVolunteerings, err := vh.volunteeringUseCase.CreateVolunteeringsAtomically(ctx, volunteeringEntities)
+		if err != nil {
+			vh.logger.Error("Atomic bulk volunteering creation rolled back: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+
+		response := volunteeringDto.FromVolunteeringsEntityForBulkToResponse(createdVolunteerings, &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+		utils.WriteSuccessResponse(w, http.StatusCreated, response)
+		return
+	}
+
+	var createdVolunteerings []*entities.Volunteering
+	var errs []error
+
+	for i, volunteeringEntity := range volunteeringEntities {
+		createdVolunteering, err := vh.volunteeringUseCase.CreateVolunteering(ctx, volunteeringEntity)
+		if err != nil {
+			vh.logger.Error("Failed to create volunteering at index %d (role: %s): %v", i, volunteeringEntity.Role, err)
+			errs = append(errs, err)
+		} else {
+			createdVolunteerings = append(createdVolunteerings, createdVolunteering)
+		}
+	}
+
+	statusCode := http.StatusCreated
+	if len(volunteeringEntities) == len(errs) {
+		vh.logger.Error("All volunteerings failed to create, returning errors")
+		utils.WriteErrorResponse(w, errs...)
+		return
+	} else if len(errs) > 0 {
+		statusCode = http.StatusMultiStatus
+	}
+
+	response := volunteeringDto.FromVolunteeringsEntityForBulkToResponse(createdVolunteerings, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	domainErrors := make([]*shared.APIError, len(errs))
+	for i, err := range errs {
+		if domainErr, ok := domain.AsDomainError(err); ok {
+			domainErrors[i] = utils.DomainErrorToAPIError(domainErr)
+		}
+	}
+	response.Errors = domainErrors
+	utils.WriteSuccessResponse(w, statusCode, response)
+}
+
+// UpdateVolunteering
+//
+//	@Summary		Update an existing volunteering
+//	@Description	Replace the fields of a volunteering by ID for the authenticated admin user; an end_date left out means it is ongoing
+//	@Tags			Admin Volunteering
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int								true	"Volunteering ID"
+//	@Param			request	body	dto.UpdateVolunteeringRequest	true	"Volunteering update request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the volunteering changed since"
+//	@Security		BearerAuth
+//	@Success		200	{object}	shared.APIResponse{data=dto.VolunteeringResponse}
+//	@Header		200	{string}	ETag	"Current version, for If-Match"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/volunteerings/{id} [put]
+func (vh *volunteeringHandler) UpdateVolunteering(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := vh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid volunteering ID")
+	if !ok {
+		return
+	}
+
+	var request volunteeringDto.UpdateVolunteeringRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		vh.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	userID, ok := vh.getUserIDFromContext(w, r)
+	if !ok {
+		vh.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	volunteeringEntity, err := request.ToEntity(id, userID)
+	if err != nil {
+		vh.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid volunteering data", "volunteering", &err))
+		return
+	}
+
+	updatedVolunteering, err := vh.volunteeringUseCase.UpdateVolunteering(ctx, id, volunteeringEntity)
+	if err != nil {
+		vh.logger.Error("Failed to update volunteering %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, vh.currentVolunteering)
+		return
+	}
+
+	response := volunteeringDto.FromVolunteeringEntityToResponse(updatedVolunteering,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, updatedVolunteering.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// PatchVolunteering
+//
+//	@Summary		Partially update a volunteering
+//	@Description	Partially update a volunteering by ID for the authenticated admin user
+//	@Tags			Admin Volunteering
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Volunteering ID"
+//	@Param			request	body		dto.PatchVolunteeringRequest	true	"Patch volunteering request"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the volunteering changed since"
+//	@Success		200		{object}	shared.APIResponse{data=dto.VolunteeringResponse}
+//	@Header		200		{string}	ETag	"Current version, for If-Match"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/volunteerings/{id} [patch]
+//	@Security		BearerAuth
+func (vh *volunteeringHandler) PatchVolunteering(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := vh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid volunteering ID")
+	if !ok {
+		return
+	}
+
+	if _, ok := vh.getUserIDFromContext(w, r); !ok {
+		vh.logger.Error("Failed to get user ID from context")
+		return
+	}
+
+	var request volunteeringDto.PatchVolunteeringRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		vh.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	patchedVolunteering, err := vh.volunteeringUseCase.PatchVolunteering(ctx, id, &request)
+	if err != nil {
+		vh.logger.Error("Failed to patch volunteering %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, vh.currentVolunteering)
+		return
+	}
+
+	response := volunteeringDto.FromVolunteeringEntityToResponse(patchedVolunteering,
+		&shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		})
+	setETag(w, patchedVolunteering.Version)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeleteVolunteering
+//
+//	@Summary		Delete a volunteering
+//	@Description	Move a volunteering to the trash by ID for the authenticated admin user
+//	@Tags			Admin Volunteering
+//	@Produce		json
+//	@Param			id	path	int	true	"Volunteering ID"
+//	@Param			If-Match	header		string	false	"ETag from an earlier read; the write answers 412 if the volunteering changed since"
+//	@Security		BearerAuth
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		412	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		428	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/volunteerings/{id} [delete]
+func (vh *volunteeringHandler) DeleteVolunteering(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := vh.withIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "id", "Invalid volunteering ID")
+	if !ok {
+		return
+	}
+
+	if err := vh.volunteeringUseCase.DeleteVolunteering(ctx, id); err != nil {
+		vh.logger.Error("Failed to delete volunteering %d: %v", id, err)
+		writeVersionedError(ctx, w, err, id, vh.currentVolunteering)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderVolunteerings
+//
+//	@Summary		Reorder volunteerings
+//	@Description	Set the display order of the volunteerings of the authenticated admin user. The IDs must list every one of them exactly once; lists follow this order unless another sort is requested.
+//	@Tags			Admin Volunteering
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body	dto.OrderRequest	true	"Volunteering IDs in their new order"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		401		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/admin/volunteerings/order [put]
+//	@Security		BearerAuth
+func (vh *volunteeringHandler) ReorderVolunteerings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := vh.getUserIDFromContext(w, r)
+	if !ok {
+		return
+	}
+
+	var request orderDto.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	if err := vh.volunteeringUseCase.ReorderVolunteerings(ctx, userID, &request); err != nil {
+		vh.logger.Error("Failed to reorder volunteerings: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (vh *volunteeringHandler) currentVolunteering(ctx context.Context, id int) (any, int, error) {
+	volunteering, err := vh.volunteeringUseCase.GetVolunteeringByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return volunteeringDto.FromVolunteeringEntityToResponse(volunteering, nil).Volunteering, volunteering.Version, nil
+}
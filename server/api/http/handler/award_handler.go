@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	awardDto "portfolio/dto/award"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+type awardHandler struct {
+	AbstractHandler
+	awardUseCase *usecases.AwardUseCase
+	logger       *logger.Logger
+}
+
+func NewAwardHandler(settingUseCase *usecases.SettingUseCase, awardUseCase *usecases.AwardUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	awardHandler := awardHandler{
+		AbstractHandler: AbstractHandler{
+			settingUseCase: settingUseCase,
+		},
+		awardUseCase: awardUseCase,
+		logger:       logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetAwardsHandler",
+			Pattern: "GET /awards",
+			Handler: awardHandler.GetAwards,
+		},
+		{
+			Name:    "GetAwardHandler",
+			Pattern: "GET /awards/{id}",
+			Handler: awardHandler.GetAward,
+		},
+	}
+}
+
+// GetAwards
+//
+//	@Summary		Get all awards
+//	@Description	Retrieve all published awards and honors of the portfolio owner
+//	@Tags			Awards
+//	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, date, title, issuer, created_at; defaults to the manual order"
+//	@Param			filter[issuer]	query		string	false	"Filter by issuer"
+//	@Success		200	{object}	shared.APIResponse{data=dto.AwardListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/awards [get]
+func (ah *awardHandler) GetAwards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(ah.settingUseCase, ctx, w)
+	if err != nil {
+		ah.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.AwardListSpec)
+	if err != nil {
+		ah.logger.Error("Invalid award list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	awards, err := ah.awardUseCase.GetAwardsByUserID(ctx, portfolioOwnerID, query)
+	if err != nil {
+		ah.logger.Error("Failed to get awards: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := awardDto.FromAwardsEntityToResponse(awards.Items, utils.WithListMeta(&shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	}, r, query, awards))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetAward
+//
+//	@Summary		Get a specific award
+//	@Description	Retrieve a specific published award by ID
+//	@Tags			Awards
+//	@Produce		json
+//	@Param			id	path		int	true	"Award ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.AwardResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/awards/{id} [get]
+func (ah *awardHandler) GetAward(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	awardID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || awardID <= 0 {
+		ah.logger.Error("Invalid award ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid award ID", "id", &err))
+		return
+	}
+
+	award, err := ah.awardUseCase.GetAwardByID(ctx, awardID)
+	if err != nil {
+		ah.logger.Error("Failed to get award: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(ah.settingUseCase, ctx, w)
+	if err != nil {
+		ah.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	if !award.IsPublished() || award.UserID != portfolioOwnerID {
+		ah.logger.Error("Unauthorized access to award %d", awardID)
+		utils.WriteErrorResponse(w, domain.NewNotFoundError("Award", strconv.Itoa(awardID)))
+		return
+	}
+
+	response := awardDto.FromAwardEntityToResponse(award, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
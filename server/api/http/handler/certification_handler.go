@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	certificationDto "portfolio/dto/certification"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+type certificationHandler struct {
+	AbstractHandler
+	certificationUseCase *usecases.CertificationUseCase
+	logger               *logger.Logger
+}
+
+func NewCertificationHandler(settingUseCase *usecases.SettingUseCase, certificationUseCase *usecases.CertificationUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	certificationHandler := certificationHandler{
+		AbstractHandler: AbstractHandler{
+			settingUseCase: settingUseCase,
+		},
+		certificationUseCase: certificationUseCase,
+		logger:               logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetCertificationsHandler",
+			Pattern: "GET /certifications",
+			Handler: certificationHandler.GetCertifications,
+		},
+		{
+			Name:    "GetCertificationHandler",
+			Pattern: "GET /certifications/{id}",
+			Handler: certificationHandler.GetCertification,
+		},
+	}
+}
+
+// GetCertifications
+//
+//	@Summary		Get all certifications
+//	@Description	Retrieve all published certifications and licenses for the portfolio. Each one carries an expiry_status: expired, expiring_soon within 90 days of its expiry date, or valid
+//	@Tags			Certifications
+//	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, issue_date, expiry_date, name, issuer, created_at; certifications that never expire sort last by expiry_date; defaults to the manual order"
+//	@Param			filter[issuer]	query		string	false	"Filter by issuer"
+//	@Param			filter[expiry]	query		string	false	"Filter by expiry status on the current date"	Enums(valid, expiring_soon, expired)
+//	@Success		200	{object}	shared.APIResponse{data=dto.CertificationListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/certifications [get]
+func (ch *certificationHandler) GetCertifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(ch.settingUseCase, ctx, w)
+	if err != nil {
+		ch.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.CertificationListSpec)
+	if err != nil {
+		ch.logger.Error("Invalid certification list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	certifications, err := ch.certificationUseCase.GetCertificationsByUserID(ctx, portfolioOwnerID, query)
+	if err != nil {
+		ch.logger.Error("Failed to get certifications: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := certificationDto.FromCertificationsEntityToResponse(certifications.Items, utils.WithListMeta(&shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	}, r, query, certifications))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetCertification
+//
+//	@Summary		Get a specific certification
+//	@Description	Retrieve a specific published certification by ID
+//	@Tags			Certifications
+//	@Produce		json
+//	@Param			id	path		int	true	"Certification ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.CertificationResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/certifications/{id} [get]
+func (ch *certificationHandler) GetCertification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	certificationID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || certificationID <= 0 {
+		ch.logger.Error("Invalid certification ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid certification ID", "id", &err))
+		return
+	}
+
+	certification, err := ch.certificationUseCase.GetCertificationByID(ctx, certificationID)
+	if err != nil {
+		ch.logger.Error("Failed to get certification: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(ch.settingUseCase, ctx, w)
+	if err != nil {
+		ch.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	if !certification.IsPublished() || certification.UserID != portfolioOwnerID {
+		ch.logger.Error("Unauthorized access to certification %d", certificationID)
+		utils.WriteErrorResponse(w, domain.NewNotFoundError("Certification", strconv.Itoa(certificationID)))
+		return
+	}
+
+	response := certificationDto.FromCertificationEntityToResponse(certification, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
@@ -5,6 +5,7 @@ import (
 	"portfolio/api/http/routes"
 	"portfolio/api/http/utils"
 	"portfolio/domain"
+	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
 	educationDto "portfolio/dto/education"
 	"portfolio/logger"
@@ -45,10 +46,16 @@ func NewEducationHandler(settingUseCase *usecases.SettingUseCase, educationUseCa
 // GetEducations
 //
 //	@Summary		Get all educations
-//	@Description	Retrieve all educations for the portfolio
+//	@Description	Retrieve all published educations for the portfolio
 //	@Tags			Educations
 //	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, start_date, degree, institution, created_at; defaults to the manual order"
+//	@Param			filter[institution]	query		string	false	"Filter by institution"
 //	@Success		200	{object}	shared.APIResponse{data=dto.EducationListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/v1/educations [get]
 func (eh *educationHandler) GetEducations(w http.ResponseWriter, r *http.Request) {
@@ -61,17 +68,28 @@ func (eh *educationHandler) GetEducations(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	educationsEntity, err := eh.educationUseCase.GetEducationsByUserID(ctx, portfolioOwnerID)
+	query, err := utils.ParseListQuery(r, entities.EducationListSpec)
+	if err != nil {
+		eh.logger.Error("Invalid education list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	educationsEntity, err := eh.educationUseCase.GetEducationsByUserID(ctx, portfolioOwnerID, query)
 	if err != nil {
 		eh.logger.Error("Failed to get educations: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := educationDto.FromEducationsEntityToResponse(educationsEntity, &shared.Meta{
+	response := educationDto.FromEducationsEntityToResponse(educationsEntity.Items, utils.WithListMeta(&shared.Meta{
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
-	})
+	}, r, query, educationsEntity))
 	if response == nil {
 		eh.logger.Error("No educations found for user ID %d", portfolioOwnerID)
 		utils.WriteErrorResponse(w, domain.NewNotFoundError("Educations", ""))
@@ -117,7 +135,7 @@ func (eh *educationHandler) GetEducation(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if educationEntity.UserID != portfolioOwnerID {
+	if !educationEntity.IsPublished() || educationEntity.UserID != portfolioOwnerID {
 		eh.logger.Error("Unauthorized access to education %d", educationID)
 		utils.WriteErrorResponse(w, domain.NewNotFoundError("Education", strconv.Itoa(educationID)))
 		return
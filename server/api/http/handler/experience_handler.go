@@ -5,6 +5,7 @@ import (
 	"portfolio/api/http/routes"
 	"portfolio/api/http/utils"
 	"portfolio/domain"
+	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
 	experienceDto "portfolio/dto/experience"
 	"portfolio/logger"
@@ -45,10 +46,20 @@ func NewExperienceHandler(settingUseCase *usecases.SettingUseCase, experienceUse
 // GetExperiences
 //
 //	@Summary		Get all experiences
-//	@Description	Retrieve all experiences for the portfolio
+//	@Description	Retrieve all published experiences for the portfolio. With group=company the page is sorted by company first, most recent company first, and returned as the positions of each company; see dto.ExperienceGroupListResponse.
 //	@Tags			Experiences
 //	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, start_date, job_title, company_name, company, created_at; defaults to the manual order"
+//	@Param			group			query		string	false	"Group the experiences"	Enums(company)
+//	@Param			filter[company_name]	query		string	false	"Filter by company name"
+//	@Param			filter[employment_type]	query		string	false	"Filter by employment type"	Enums(full_time, contract, freelance)
+//	@Param			filter[remote]	query		string	false	"Filter by remote work"	Enums(true, false)
+//	@Param			filter[technology]	query		string	false	"Filter by the name of a linked technology"
 //	@Success		200	{object}	shared.APIResponse{data=dto.ExperienceListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/v1/experiences [get]
 func (eh *experienceHandler) GetExperiences(w http.ResponseWriter, r *http.Request) {
@@ -61,17 +72,46 @@ func (eh *experienceHandler) GetExperiences(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	experiences, err := eh.experienceUseCase.GetExperiencesByUserID(ctx, portfolioOwnerID)
+	query, err := utils.ParseListQuery(r, entities.ExperienceListSpec)
+	if err != nil {
+		eh.logger.Error("Invalid experience list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	grouped := false
+	switch group := r.URL.Query().Get("group"); group {
+	case "":
+	case "company":
+		grouped = true
+		groupByCompany(query)
+	default:
+		utils.WriteErrorResponse(w, domain.NewValidationError("Cannot group by "+strconv.Quote(group)+", use company", "group", nil))
+		return
+	}
+
+	experiences, err := eh.experienceUseCase.GetExperiencesByUserID(ctx, portfolioOwnerID, query)
 	if err != nil {
 		eh.logger.Error("Failed to get experiences: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := experienceDto.FromExperiencesEntityToResponse(experiences, &shared.Meta{
+	meta := utils.WithListMeta(&shared.Meta{
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
-	})
+	}, r, query, experiences)
+
+	if grouped {
+		utils.WriteSuccessResponse(w, http.StatusOK, experienceDto.FromExperienceGroupsToResponse(experiences.Items, meta))
+		return
+	}
+
+	response := experienceDto.FromExperiencesEntityToResponse(experiences.Items, meta)
 
 	if response == nil {
 		eh.logger.Error("No experiences found for user ID %d", portfolioOwnerID)
@@ -118,7 +158,7 @@ func (eh *experienceHandler) GetExperience(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if experienceEntity.UserID != portfolioOwnerID {
+	if !experienceEntity.IsPublished() || experienceEntity.UserID != portfolioOwnerID {
 		eh.logger.Error("Unauthorized access to experience %d", experienceID)
 		utils.WriteErrorResponse(w, domain.NewNotFoundError("Experience", strconv.Itoa(experienceID)))
 		return
@@ -131,3 +171,20 @@ func (eh *experienceHandler) GetExperience(w http.ResponseWriter, r *http.Reques
 
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
+
+// groupByCompany sorts the list by company first, most recent company
+// first, so that each page holds whole runs of a company's positions. A
+// company sort the client asked for first is kept as is; later ones are
+// redundant and dropped.
+func groupByCompany(query *entities.ListQuery) {
+	if len(query.Sort) > 0 && query.Sort[0].Name == "company" {
+		return
+	}
+	sort := []entities.SortField{{Name: "company", Descending: true}}
+	for _, field := range query.Sort {
+		if field.Name != "company" {
+			sort = append(sort, field)
+		}
+	}
+	query.Sort = sort
+}
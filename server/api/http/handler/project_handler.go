@@ -2,14 +2,18 @@ package handler
 
 import (
 	"net/http"
+	"net/url"
+	"path"
 	"portfolio/api/http/routes"
 	"portfolio/api/http/utils"
 	"portfolio/domain"
+	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
 	projectDto "portfolio/dto/project"
 	"portfolio/logger"
 	"portfolio/shared"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -34,6 +38,11 @@ func NewProjectHandler(settingUseCase *usecases.SettingUseCase, projectUseCase *
 			Pattern: "GET /projects",
 			Handler: projectHandler.GetProjects,
 		},
+		{
+			Name:    "GetFeaturedProjectsHandler",
+			Pattern: "GET /projects/featured",
+			Handler: projectHandler.GetFeaturedProjects,
+		},
 		{
 			Name:    "GetProjectHandler",
 			Pattern: "GET /projects/{id}",
@@ -44,14 +53,46 @@ func NewProjectHandler(settingUseCase *usecases.SettingUseCase, projectUseCase *
 
 // GetProjects
 //
-//	@Summary		Get all active projects
-//	@Description	Retrieve all active projects for the portfolio
+//	@Summary		Get all published active projects
+//	@Description	Retrieve all published, active projects for the portfolio
 //	@Tags			Projects
 //	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, title, status, created_at, updated_at; defaults to the manual order"
+//	@Param			filter[technology]	query		string	false	"Filter by technology name"
+//	@Param			filter[featured]	query		bool	false	"Filter by featured flag"
 //	@Success		200	{object}	shared.APIResponse{data=dto.ProjectListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/v1/projects [get]
 func (ph *projectHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
+	ph.listProjects(w, r, false)
+}
+
+// GetFeaturedProjects
+//
+//	@Summary		Get featured projects
+//	@Description	Retrieve the published, active projects marked as featured
+//	@Tags			Projects
+//	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, title, status, created_at, updated_at; defaults to the manual order"
+//	@Param			filter[technology]	query		string	false	"Filter by technology name"
+//	@Success		200	{object}	shared.APIResponse{data=dto.ProjectListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/projects/featured [get]
+func (ph *projectHandler) GetFeaturedProjects(w http.ResponseWriter, r *http.Request) {
+	ph.listProjects(w, r, true)
+}
+
+// listProjects writes a page of the portfolio owner's published, active
+// projects, only the featured ones when featured is set.
+func (ph *projectHandler) listProjects(w http.ResponseWriter, r *http.Request, featured bool) {
 	ctx := r.Context()
 
 	portfolioOwnerID, err := utils.GetPortfolioOwnerID(ph.settingUseCase, ctx, w)
@@ -61,17 +102,39 @@ func (ph *projectHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	projects, err := ph.projectUseCase.GetProjectsByUserID(ctx, portfolioOwnerID)
+	query, err := utils.ParseListQuery(r, entities.ProjectListSpec)
+	if err != nil {
+		ph.logger.Error("Invalid project list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyActiveProjects(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if featured {
+		if value, ok := query.Filters["featured"]; ok && value != "true" {
+			utils.WriteErrorResponse(w, domain.NewValidationError("Only featured projects are listed", "filter[featured]", nil))
+			return
+		}
+		query.Filters["featured"] = "true"
+	}
+
+	projects, err := ph.projectUseCase.GetProjectsByUserID(ctx, portfolioOwnerID, query)
 	if err != nil {
 		ph.logger.Error("Failed to get projects: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := projectDto.FromProjectsEntityToResponse(projects, &shared.Meta{
+	response := projectDto.FromProjectsEntityToResponse(projects.Items, utils.WithListMeta(&shared.Meta{
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
-	})
+	}, r, query, projects))
 
 	if response == nil {
 		ph.logger.Error("No projects found for user ID %d", portfolioOwnerID)
@@ -85,11 +148,12 @@ func (ph *projectHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
 // GetProject
 //
 //	@Summary		Get a specific project
-//	@Description	Retrieve a specific project by ID
+//	@Description	Retrieve a specific project by ID or slug. A former slug redirects to the current one.
 //	@Tags			Projects
 //	@Produce		json
-//	@Param			id	path		int	true	"Project ID"
+//	@Param			id	path		string	true	"Project ID or slug"
 //	@Success		200	{object}	shared.APIResponse{data=dto.ProjectResponse}
+//	@Success		301	{object}	shared.APIResponse
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -98,6 +162,11 @@ func (ph *projectHandler) GetProject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	projectIDStr := r.PathValue("id")
+	if strings.Trim(projectIDStr, "0123456789") != "" {
+		ph.getProjectBySlug(w, r, projectIDStr)
+		return
+	}
+
 	projectID, err := strconv.Atoi(projectIDStr)
 	if err != nil || projectID <= 0 {
 		ph.logger.Error("Invalid project ID format: %v", err)
@@ -119,7 +188,7 @@ func (ph *projectHandler) GetProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if project.Status != "active" || project.UserID != portfolioOwnerID {
+	if !project.IsPublished() || !project.IsActive() || project.UserID != portfolioOwnerID {
 		ph.logger.Error("Unauthorized access to project %d", projectID)
 		utils.WriteErrorResponse(w, domain.NewNotFoundError("Project", strconv.Itoa(projectID)))
 		return
@@ -132,3 +201,68 @@ func (ph *projectHandler) GetProject(w http.ResponseWriter, r *http.Request) {
 
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
+
+// getProjectBySlug writes the published, active project of the portfolio
+// owner named by slug. A former slug is answered with a permanent redirect to
+// the current one, so that shared links survive a rename.
+func (ph *projectHandler) getProjectBySlug(w http.ResponseWriter, r *http.Request, slug string) {
+	ctx := r.Context()
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(ph.settingUseCase, ctx, w)
+
+	if err != nil {
+		ph.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	project, err := ph.projectUseCase.GetProjectBySlug(ctx, portfolioOwnerID, slug)
+	if err != nil {
+		ph.logger.Error("Failed to get project by slug %q: %v", slug, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	if !project.IsPublished() || !project.IsActive() {
+		ph.logger.Error("Unauthorized access to project %q", slug)
+		utils.WriteErrorResponse(w, domain.NewNotFoundError("Project", slug))
+		return
+	}
+
+	if project.Slug != slug {
+		location := projectLocation(r, project.Slug)
+		w.Header().Set("Location", location)
+		utils.WriteSuccessResponse(w, http.StatusMovedPermanently, shared.APIResponse{
+			Message: "Project moved to " + location,
+			Meta: shared.Meta{
+				"location":   location,
+				"slug":       project.Slug,
+				"timestamp":  time.Now().Format(time.RFC3339),
+				"request_id": utils.GetRequestIDFromContext(ctx),
+			},
+		})
+		return
+	}
+
+	response := projectDto.FromProjectEntityToResponse(project, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// projectLocation is the request URL with its last path segment replaced by
+// slug. The path is read from the request URI, as the mux strips its prefix
+// from r.URL.
+func projectLocation(r *http.Request, slug string) string {
+	requestPath := r.URL.Path
+	if requestURI, err := url.ParseRequestURI(r.RequestURI); err == nil {
+		requestPath = requestURI.Path
+	}
+
+	location := path.Join(path.Dir(requestPath), slug)
+	if r.URL.RawQuery != "" {
+		location += "?" + r.URL.RawQuery
+	}
+	return location
+}
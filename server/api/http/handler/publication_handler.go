@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	publicationDto "portfolio/dto/publication"
+	"portfolio/logger"
+	"portfolio/shared"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type publicationHandler struct {
+	AbstractHandler
+	publicationUseCase *usecases.PublicationUseCase
+	logger             *logger.Logger
+}
+
+func NewPublicationHandler(settingUseCase *usecases.SettingUseCase, publicationUseCase *usecases.PublicationUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	publicationHandler := publicationHandler{
+		AbstractHandler: AbstractHandler{
+			settingUseCase: settingUseCase,
+		},
+		publicationUseCase: publicationUseCase,
+		logger:             logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetPublicationsHandler",
+			Pattern: "GET /publications",
+			Handler: publicationHandler.GetPublications,
+		},
+		{
+			Name:    "GetPublicationHandler",
+			Pattern: "GET /publications/{id}",
+			Handler: publicationHandler.GetPublication,
+		},
+	}
+}
+
+// NewPublicationExportHandler serves the citation exports, which are files
+// rather than API responses; mount it outside the response middleware.
+func NewPublicationExportHandler(settingUseCase *usecases.SettingUseCase, publicationUseCase *usecases.PublicationUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	publicationHandler := publicationHandler{
+		AbstractHandler: AbstractHandler{
+			settingUseCase: settingUseCase,
+		},
+		publicationUseCase: publicationUseCase,
+		logger:             logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "ExportPublicationsHandler",
+			Pattern: "GET /publications/export",
+			Handler: publicationHandler.ExportPublications,
+		},
+	}
+}
+
+// GetPublications
+//
+//	@Summary		Get all publications
+//	@Description	Retrieve all published articles, talks, papers, podcasts and open-source contributions for the portfolio
+//	@Tags			Publications
+//	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, date, title, type, created_at; defaults to the manual order"
+//	@Param			filter[type]	query		string	false	"Filter by type"	Enums(article, talk, paper, podcast, open_source)
+//	@Param			filter[venue]	query		string	false	"Filter by venue"
+//	@Success		200	{object}	shared.APIResponse{data=dto.PublicationListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/publications [get]
+func (ph *publicationHandler) GetPublications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(ph.settingUseCase, ctx, w)
+	if err != nil {
+		ph.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.PublicationListSpec)
+	if err != nil {
+		ph.logger.Error("Invalid publication list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	publications, err := ph.publicationUseCase.GetPublicationsByUserID(ctx, portfolioOwnerID, query)
+	if err != nil {
+		ph.logger.Error("Failed to get publications: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := publicationDto.FromPublicationsEntityToResponse(publications.Items, utils.WithListMeta(&shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	}, r, query, publications))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetPublication
+//
+//	@Summary		Get a specific publication
+//	@Description	Retrieve a specific published publication by ID
+//	@Tags			Publications
+//	@Produce		json
+//	@Param			id	path		int	true	"Publication ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.PublicationResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/publications/{id} [get]
+func (ph *publicationHandler) GetPublication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	publicationID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || publicationID <= 0 {
+		ph.logger.Error("Invalid publication ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid publication ID", "id", &err))
+		return
+	}
+
+	publication, err := ph.publicationUseCase.GetPublicationByID(ctx, publicationID)
+	if err != nil {
+		ph.logger.Error("Failed to get publication: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(ph.settingUseCase, ctx, w)
+	if err != nil {
+		ph.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	if !publication.IsPublished() || publication.UserID != portfolioOwnerID {
+		ph.logger.Error("Unauthorized access to publication %d", publicationID)
+		utils.WriteErrorResponse(w, domain.NewNotFoundError("Publication", strconv.Itoa(publicationID)))
+		return
+	}
+
+	response := publicationDto.FromPublicationEntityToResponse(publication, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// ExportPublications
+//
+//	@Summary		Export the publications as citations
+//	@Description	Download every published publication as BibTeX or as CSL-JSON, for reference managers. The portfolio owner is the first author of each. Page parameters are ignored; sort and filters apply as in the list
+//	@Tags			Publications
+//	@Produce		application/x-bibtex
+//	@Produce		application/vnd.citationstyles.csl+json
+//	@Param			format			query		string	false	"Export format"	Enums(bibtex, csl-json)	default(bibtex)
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, date, title, type, created_at; defaults to the manual order"
+//	@Param			filter[type]	query		string	false	"Filter by type"	Enums(article, talk, paper, podcast, open_source)
+//	@Param			filter[venue]	query		string	false	"Filter by venue"
+//	@Success		200				{array}		dto.CSLItem
+//	@Failure		400				{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500				{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/publications/export [get]
+func (ph *publicationHandler) ExportPublications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = publicationDto.ExportFormatBibTeX
+	}
+	if !slices.Contains(publicationDto.ExportFormats, format) {
+		ph.logger.Error("Invalid publication export format: %s", format)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Format must be one of: "+strings.Join(publicationDto.ExportFormats, ", "), "format", nil))
+		return
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(ph.settingUseCase, ctx, w)
+	if err != nil {
+		ph.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.PublicationListSpec)
+	if err != nil {
+		ph.logger.Error("Invalid publication export query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	publications, author, err := ph.publicationUseCase.ExportPublications(ctx, portfolioOwnerID, query)
+	if err != nil {
+		ph.logger.Error("Failed to export publications: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	if format == publicationDto.ExportFormatCSLJSON {
+		w.Header().Set("Content-Disposition", `attachment; filename="publications.json"`)
+		w.Header().Set("Content-Type", "application/vnd.citationstyles.csl+json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(publicationDto.ToCSLJSON(publications, author)); err != nil {
+			ph.logger.Error("Failed to write publication CSL-JSON export: %v", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Disposition", `attachment; filename="publications.bib"`)
+	w.Header().Set("Content-Type", "application/x-bibtex; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(publicationDto.ToBibTeX(publications, author))); err != nil {
+		ph.logger.Error("Failed to write publication BibTeX export: %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/usecases"
+	searchDto "portfolio/dto/search"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+type searchHandler struct {
+	AbstractHandler
+	searchUseCase *usecases.SearchUseCase
+	logger        *logger.Logger
+}
+
+func NewSearchHandler(settingUseCase *usecases.SettingUseCase, searchUseCase *usecases.SearchUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	searchHandler := searchHandler{
+		AbstractHandler: AbstractHandler{
+			settingUseCase: settingUseCase,
+		},
+		searchUseCase: searchUseCase,
+		logger:        logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "SearchHandler",
+			Pattern: "GET /search",
+			Handler: searchHandler.Search,
+		},
+	}
+}
+
+// Search
+//
+//	@Summary		Search the portfolio
+//	@Description	Full-text search over projects, skills, experiences, educations and personal info of the portfolio owner. Every word must match, as a word prefix. Only published content is searched. Hits are grouped by type, best match first
+//	@Tags			Search
+//	@Produce		json
+//	@Param			q		query		string	true	"Search text, up to 200 characters"
+//	@Param			limit	query		int		false	"Hits per type, up to 50 (default 10)"
+//	@Success		200		{object}	shared.APIResponse{data=dto.SearchResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/search [get]
+func (sh *searchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query().Get("q")
+
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			sh.logger.Error("Invalid search limit: %s", value)
+			utils.WriteErrorResponse(w, domain.NewValidationError("limit must be a positive integer", "limit", nil))
+			return
+		}
+		limit = parsed
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(sh.settingUseCase, ctx, w)
+	if err != nil {
+		sh.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	hits, err := sh.searchUseCase.Search(ctx, portfolioOwnerID, query, limit, false)
+	if err != nil {
+		sh.logger.Error("Failed to search: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := searchDto.FromSearchHitsEntityToResponse(query, hits, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
@@ -6,6 +6,7 @@ import (
 	"portfolio/api/http/routes"
 	"portfolio/api/http/utils"
 	"portfolio/domain"
+	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
 	dto "portfolio/dto/skill"
 	"portfolio/logger"
@@ -16,17 +17,19 @@ import (
 
 type skillHandler struct {
 	AbstractHandler
-	skillUseCase *usecases.SkillUseCase
-	logger       *logger.Logger
+	skillUseCase         *usecases.SkillUseCase
+	skillCategoryUseCase *usecases.SkillCategoryUseCase
+	logger               *logger.Logger
 }
 
-func NewSkillHandler(settingUseCase *usecases.SettingUseCase, skillUseCase *usecases.SkillUseCase, logger *logger.Logger) []*routes.NamedRoute {
+func NewSkillHandler(settingUseCase *usecases.SettingUseCase, skillUseCase *usecases.SkillUseCase, skillCategoryUseCase *usecases.SkillCategoryUseCase, logger *logger.Logger) []*routes.NamedRoute {
 	skillHandler := skillHandler{
 		AbstractHandler: AbstractHandler{
 			settingUseCase: settingUseCase,
 		},
-		skillUseCase: skillUseCase,
-		logger:       logger,
+		skillUseCase:         skillUseCase,
+		skillCategoryUseCase: skillCategoryUseCase,
+		logger:               logger,
 	}
 
 	return []*routes.NamedRoute{
@@ -46,9 +49,16 @@ func NewSkillHandler(settingUseCase *usecases.SettingUseCase, skillUseCase *usec
 // GetSkills
 //
 //	@Summary		Get all skills
-//	@Description	Retrieve all skills for the portfolio
+//	@Description	Retrieve all published skills for the portfolio, with the published projects and experiences they were used in. With group=category the page is sorted by category first and returned as groups in category order, uncategorized skills last; see dto.SkillGroupListResponse.
 //	@Tags			Skills
 //	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, name, level, category, years_of_experience, created_at; defaults to the manual order"
+//	@Param			filter[level]	query		int	false	"Filter by level, 1 to 5"
+//	@Param			filter[category]	query		int	false	"Filter by skill category ID"
+//	@Param			group			query		string	false	"Group the skills"	Enums(category)
 //	@Success		200	{object}	shared.APIResponse{data=dto.SkillListResponse}
 //	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
@@ -64,17 +74,57 @@ func (sh *skillHandler) GetSkills(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	skills, err := sh.skillUseCase.GetSkillsByUserID(ctx, portfolioOwnerID)
+	query, err := utils.ParseListQuery(r, entities.SkillListSpec)
+	if err != nil {
+		sh.logger.Error("Invalid skill list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	grouped := false
+	switch group := r.URL.Query().Get("group"); group {
+	case "":
+	case "category":
+		grouped = true
+		groupByCategory(query)
+	default:
+		utils.WriteErrorResponse(w, domain.NewValidationError("Cannot group by "+strconv.Quote(group)+", use category", "group", nil))
+		return
+	}
+
+	skills, err := sh.skillUseCase.GetSkillsByUserID(ctx, portfolioOwnerID, query)
 	if err != nil {
 		sh.logger.Error("Failed to get skills: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := dto.FromSkillsEntityToResponse(skills, &shared.Meta{
+	items := make([]*entities.Skill, 0, len(skills.Items))
+	for _, skill := range skills.Items {
+		items = append(items, skill.WithPublishedEvidence())
+	}
+
+	meta := utils.WithListMeta(&shared.Meta{
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
-	})
+	}, r, query, skills)
+
+	if grouped {
+		categories, err := sh.skillCategoryUseCase.GetSkillCategories(ctx, portfolioOwnerID)
+		if err != nil {
+			sh.logger.Error("Failed to get skill categories: %v", err)
+			utils.WriteErrorResponse(w, err)
+			return
+		}
+		utils.WriteSuccessResponse(w, http.StatusOK, dto.FromSkillGroupsToResponse(items, categories, meta))
+		return
+	}
+
+	response := dto.FromSkillsEntityToResponse(items, meta)
 
 	if response == nil {
 		sh.logger.Error("Skills response is nil for user ID %d", portfolioOwnerID)
@@ -88,7 +138,7 @@ func (sh *skillHandler) GetSkills(w http.ResponseWriter, r *http.Request) {
 // GetSkill
 //
 //	@Summary		Get a specific skill
-//	@Description	Retrieve a specific skill by ID
+//	@Description	Retrieve a specific skill by ID, with the published projects and experiences it was used in
 //	@Tags			Skills
 //	@Produce		json
 //	@Param			id	path		int	true	"Skill ID"
@@ -122,13 +172,13 @@ func (sh *skillHandler) GetSkill(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if skill.UserID != portfolioOwnerID {
+	if !skill.IsPublished() || skill.UserID != portfolioOwnerID {
 		sh.logger.Error("Unauthorized access to skill %d", skillID)
 		utils.WriteErrorResponse(w, domain.NewNotFoundError("Skill", fmt.Sprint(skillID)))
 		return
 	}
 
-	response := dto.FromSkillEntityToResponse(skill, &shared.Meta{
+	response := dto.FromSkillEntityToResponse(skill.WithPublishedEvidence(), &shared.Meta{
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
 	})
@@ -141,3 +191,19 @@ func (sh *skillHandler) GetSkill(w http.ResponseWriter, r *http.Request) {
 
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
+
+// groupByCategory sorts the list by category first, so that each page
+// holds whole runs of a category. A category sort the client asked for
+// first is kept as is; later ones are redundant and dropped.
+func groupByCategory(query *entities.ListQuery) {
+	if len(query.Sort) > 0 && query.Sort[0].Name == "category" {
+		return
+	}
+	sort := []entities.SortField{{Name: "category"}}
+	for _, field := range query.Sort {
+		if field.Name != "category" {
+			sort = append(sort, field)
+		}
+	}
+	query.Sort = sort
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	spokenLanguageDto "portfolio/dto/spoken_language"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+type spokenLanguageHandler struct {
+	AbstractHandler
+	spokenLanguageUseCase *usecases.SpokenLanguageUseCase
+	logger                *logger.Logger
+}
+
+func NewSpokenLanguageHandler(settingUseCase *usecases.SettingUseCase, spokenLanguageUseCase *usecases.SpokenLanguageUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	spokenLanguageHandler := spokenLanguageHandler{
+		AbstractHandler: AbstractHandler{
+			settingUseCase: settingUseCase,
+		},
+		spokenLanguageUseCase: spokenLanguageUseCase,
+		logger:                logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetSpokenLanguagesHandler",
+			Pattern: "GET /spoken-languages",
+			Handler: spokenLanguageHandler.GetSpokenLanguages,
+		},
+		{
+			Name:    "GetSpokenLanguageHandler",
+			Pattern: "GET /spoken-languages/{id}",
+			Handler: spokenLanguageHandler.GetSpokenLanguage,
+		},
+	}
+}
+
+// GetSpokenLanguages
+//
+//	@Summary		Get all spoken languages
+//	@Description	Retrieve all published spoken languages of the portfolio owner, each with its CEFR level or native
+//	@Tags			Spoken Languages
+//	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, name, level, created_at; level follows A1 to C2, then native; defaults to the manual order, then the most fluent first"
+//	@Param			filter[level]	query		string	false	"Filter by level"	Enums(A1, A2, B1, B2, C1, C2, native)
+//	@Success		200	{object}	shared.APIResponse{data=dto.SpokenLanguageListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/spoken-languages [get]
+func (slh *spokenLanguageHandler) GetSpokenLanguages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(slh.settingUseCase, ctx, w)
+	if err != nil {
+		slh.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.SpokenLanguageListSpec)
+	if err != nil {
+		slh.logger.Error("Invalid spoken language list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	spokenLanguages, err := slh.spokenLanguageUseCase.GetSpokenLanguagesByUserID(ctx, portfolioOwnerID, query)
+	if err != nil {
+		slh.logger.Error("Failed to get spoken languages: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := spokenLanguageDto.FromSpokenLanguagesEntityToResponse(spokenLanguages.Items, utils.WithListMeta(&shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	}, r, query, spokenLanguages))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetSpokenLanguage
+//
+//	@Summary		Get a specific spoken language
+//	@Description	Retrieve a specific published spoken language by ID
+//	@Tags			Spoken Languages
+//	@Produce		json
+//	@Param			id	path		int	true	"Spoken language ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.SpokenLanguageResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/spoken-languages/{id} [get]
+func (slh *spokenLanguageHandler) GetSpokenLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	spokenLanguageID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || spokenLanguageID <= 0 {
+		slh.logger.Error("Invalid spoken language ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid spoken language ID", "id", &err))
+		return
+	}
+
+	spokenLanguage, err := slh.spokenLanguageUseCase.GetSpokenLanguageByID(ctx, spokenLanguageID)
+	if err != nil {
+		slh.logger.Error("Failed to get spoken language: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(slh.settingUseCase, ctx, w)
+	if err != nil {
+		slh.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	if !spokenLanguage.IsPublished() || spokenLanguage.UserID != portfolioOwnerID {
+		slh.logger.Error("Unauthorized access to spoken language %d", spokenLanguageID)
+		utils.WriteErrorResponse(w, domain.NewNotFoundError("SpokenLanguage", strconv.Itoa(spokenLanguageID)))
+		return
+	}
+
+	response := spokenLanguageDto.FromSpokenLanguageEntityToResponse(spokenLanguage, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
@@ -5,7 +5,9 @@ import (
 	"portfolio/api/http/routes"
 	"portfolio/api/http/utils"
 	"portfolio/domain"
+	"portfolio/domain/entities"
 	"portfolio/domain/usecases"
+	projectDto "portfolio/dto/project"
 	dto "portfolio/dto/technology"
 	"portfolio/logger"
 	"portfolio/shared"
@@ -16,15 +18,17 @@ import (
 type technologyHandler struct {
 	AbstractHandler
 	technologyUseCase *usecases.TechnologyUseCase
+	projectUseCase    *usecases.ProjectUseCase
 	logger            *logger.Logger
 }
 
-func NewTechnologyHandler(settingUseCase *usecases.SettingUseCase, technologyUseCase *usecases.TechnologyUseCase, logger *logger.Logger) []*routes.NamedRoute {
+func NewTechnologyHandler(settingUseCase *usecases.SettingUseCase, technologyUseCase *usecases.TechnologyUseCase, projectUseCase *usecases.ProjectUseCase, logger *logger.Logger) []*routes.NamedRoute {
 	technologyHandler := technologyHandler{
 		AbstractHandler: AbstractHandler{
 			settingUseCase: settingUseCase,
 		},
 		technologyUseCase: technologyUseCase,
+		projectUseCase:    projectUseCase,
 		logger:            logger,
 	}
 
@@ -39,16 +43,27 @@ func NewTechnologyHandler(settingUseCase *usecases.SettingUseCase, technologyUse
 			Pattern: "GET /technologies/{id}",
 			Handler: technologyHandler.GetTechnology,
 		},
+		{
+			Name:    "GetTechnologyProjectsHandler",
+			Pattern: "GET /technologies/{id}/projects",
+			Handler: technologyHandler.GetTechnologyProjects,
+		},
 	}
 }
 
 // GetTechnologies
 //
 //	@Summary		Get all technologies
-//	@Description	Retrieve all technologies for the portfolio
+//	@Description	Retrieve all published technologies for the portfolio
 //	@Tags			Technologies
 //	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, name, created_at; defaults to the manual order"
+//	@Param			filter[name]	query		string	false	"Filter by name"
 //	@Success		200	{object}	shared.APIResponse{data=dto.TechnologyListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
 //	@Router			/v1/technologies [get]
 func (th *technologyHandler) GetTechnologies(w http.ResponseWriter, r *http.Request) {
@@ -61,17 +76,28 @@ func (th *technologyHandler) GetTechnologies(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	technologies, err := th.technologyUseCase.GetTechnologiesByUserID(ctx, portfolioOwnerID)
+	query, err := utils.ParseListQuery(r, entities.TechnologyListSpec)
+	if err != nil {
+		th.logger.Error("Invalid technology list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	technologies, err := th.technologyUseCase.GetTechnologiesByUserID(ctx, portfolioOwnerID, query)
 	if err != nil {
 		th.logger.Error("Failed to get technologies: %v", err)
 		utils.WriteErrorResponse(w, err)
 		return
 	}
 
-	response := dto.FromTechnologiesEntityToResponse(technologies, &shared.Meta{
+	response := dto.FromTechnologiesEntityToResponse(technologies.Items, utils.WithListMeta(&shared.Meta{
 		"timestamp":  time.Now().Format(time.RFC3339),
 		"request_id": utils.GetRequestIDFromContext(ctx),
-	})
+	}, r, query, technologies))
 
 	if response == nil {
 		th.logger.Error("Technologies response is nil for user ID %d", portfolioOwnerID)
@@ -119,7 +145,7 @@ func (th *technologyHandler) GetTechnology(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if technology.UserID != portfolioOwnerID {
+	if !technology.IsPublished() || technology.UserID != portfolioOwnerID {
 		th.logger.Error("Unauthorized access to technology %d", technologyID)
 		utils.WriteErrorResponse(w, domain.NewNotFoundError("Technology", strconv.Itoa(technologyID)))
 		return
@@ -132,3 +158,81 @@ func (th *technologyHandler) GetTechnology(w http.ResponseWriter, r *http.Reques
 
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
+
+// GetTechnologyProjects
+//
+//	@Summary		Get the projects of a technology
+//	@Description	Retrieve the published, active projects linked to a technology
+//	@Tags			Technologies
+//	@Produce		json
+//	@Param			id				path		int		true	"Technology ID"
+//	@Param			page[size]		query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]		query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, title, status, created_at, updated_at; defaults to the manual order"
+//	@Param			filter[technology]	query		string	false	"Filter by another technology name"
+//	@Success		200	{object}	shared.APIResponse{data=dto.ProjectListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/technologies/{id}/projects [get]
+func (th *technologyHandler) GetTechnologyProjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	technologyIDStr := r.PathValue("id")
+	technologyID, err := strconv.Atoi(technologyIDStr)
+	if err != nil || technologyID <= 0 {
+		th.logger.Error("Invalid technology ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid technology ID", "id", &err))
+		return
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(th.settingUseCase, ctx, w)
+
+	if err != nil {
+		th.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	technology, err := th.technologyUseCase.GetTechnologyByID(ctx, technologyID)
+	if err != nil {
+		th.logger.Error("Failed to get technology by ID %d: %v", technologyID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	if !technology.IsPublished() || technology.UserID != portfolioOwnerID {
+		th.logger.Error("Unauthorized access to technology %d", technologyID)
+		utils.WriteErrorResponse(w, domain.NewNotFoundError("Technology", strconv.Itoa(technologyID)))
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.ProjectListSpec)
+	if err != nil {
+		th.logger.Error("Invalid project list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyActiveProjects(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	projects, err := th.projectUseCase.GetProjectsByTechnologyID(ctx, portfolioOwnerID, technologyID, query)
+	if err != nil {
+		th.logger.Error("Failed to get projects of technology %d: %v", technologyID, err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := projectDto.FromProjectsEntityToResponse(projects.Items, utils.WithListMeta(&shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	}, r, query, projects))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
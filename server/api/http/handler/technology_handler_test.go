@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	"portfolio/infrastructure/memory"
+	"portfolio/logger"
+	"portfolio/service"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPublicTechnologiesHideUnpublished(t *testing.T) {
+	logger := logger.NewWriterLogger(io.Discard)
+	store := memory.NewStore()
+	users := memory.NewUserRepository(store, logger)
+	unitOfWork := memory.NewUnitOfWork(store, logger)
+	cache := service.NewCacheService(true, 100, time.Minute)
+	settingUseCase := usecases.NewSettingUseCase(memory.NewSettingRepository(store, logger, "portfolio"), unitOfWork, cache, logger)
+	technologyUseCase := usecases.NewTechnologyUseCase(memory.NewTechnologyRepository(store, logger), users,
+		memory.NewRevisionRepository(store, logger), unitOfWork, cache, logger)
+
+	now := time.Now()
+	user, err := users.CreateUser(t.Context(), &entities.User{
+		Username: "admin", Email: "admin@example.com", Password: "hashed",
+		Role: entities.RoleAdmin, IsActive: true, CreatedAt: now, UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+	if err := settingUseCase.ResetSettings(t.Context(), user.ID); err != nil {
+		t.Fatalf("ResetSettings failed: %v", err)
+	}
+
+	ids := map[string]int{}
+	for name, state := range map[string]string{
+		"Go":   entities.PublishingStatePublished,
+		"Rust": entities.PublishingStateDraft,
+		"Zig":  entities.PublishingStateArchived,
+	} {
+		technology, err := technologyUseCase.CreateTechnology(t.Context(), &entities.Technology{
+			UserID: user.ID, Name: name, IconURL: "https://example.com/" + name + ".svg",
+			Publishing: entities.Publishing{State: state},
+		})
+		if err != nil {
+			t.Fatalf("CreateTechnology(%q) failed: %v", name, err)
+		}
+		ids[name] = technology.TechnologyID
+	}
+
+	handler := &technologyHandler{
+		AbstractHandler:   AbstractHandler{settingUseCase: settingUseCase},
+		technologyUseCase: technologyUseCase,
+		logger:            logger,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /technologies", handler.GetTechnologies)
+	mux.HandleFunc("GET /technologies/{id}", handler.GetTechnology)
+	get := func(target string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+		return w
+	}
+
+	w := get("/technologies")
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /technologies: status = %d, want %d (body %s)", w.Code, http.StatusOK, w.Body)
+	}
+	var list struct {
+		Technologies []struct {
+			Name string `json:"name"`
+		} `json:"technologies"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&list); err != nil {
+		t.Fatalf("decoding the list failed: %v", err)
+	}
+	var names []string
+	for _, technology := range list.Technologies {
+		names = append(names, technology.Name)
+	}
+	if !slices.Equal(names, []string{"Go"}) {
+		t.Errorf("public technologies = %v, want [Go]", names)
+	}
+
+	if w := get("/technologies?filter%5Bstate%5D=draft"); w.Code != http.StatusBadRequest {
+		t.Errorf("GET /technologies filtered on drafts: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	for name, want := range map[string]int{
+		"Go":   http.StatusOK,
+		"Rust": http.StatusNotFound,
+		"Zig":  http.StatusNotFound,
+	} {
+		if w := get("/technologies/" + strconv.Itoa(ids[name])); w.Code != want {
+			t.Errorf("GET of the %s technology: status = %d, want %d", name, w.Code, want)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	testimonialDto "portfolio/dto/testimonial"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+// testimonialSubmissionMessage answers every accepted submission, including
+// those dropped as spam, so that bots cannot tell them apart.
+const testimonialSubmissionMessage = "Thank you! Your testimonial will be shown once it is approved."
+
+type testimonialHandler struct {
+	AbstractHandler
+	testimonialUseCase *usecases.TestimonialUseCase
+	logger             *logger.Logger
+}
+
+func NewTestimonialHandler(settingUseCase *usecases.SettingUseCase, testimonialUseCase *usecases.TestimonialUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	testimonialHandler := testimonialHandler{
+		AbstractHandler: AbstractHandler{
+			settingUseCase: settingUseCase,
+		},
+		testimonialUseCase: testimonialUseCase,
+		logger:             logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetTestimonialsHandler",
+			Pattern: "GET /testimonials",
+			Handler: testimonialHandler.GetTestimonials,
+		},
+		{
+			Name:    "GetTestimonialHandler",
+			Pattern: "GET /testimonials/{id}",
+			Handler: testimonialHandler.GetTestimonial,
+		},
+	}
+}
+
+// NewTestimonialSubmissionHandler serves the submissions of visitors; mount
+// it behind the submission rate limiter.
+func NewTestimonialSubmissionHandler(settingUseCase *usecases.SettingUseCase, testimonialUseCase *usecases.TestimonialUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	testimonialHandler := testimonialHandler{
+		AbstractHandler: AbstractHandler{
+			settingUseCase: settingUseCase,
+		},
+		testimonialUseCase: testimonialUseCase,
+		logger:             logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "PostTestimonialHandler",
+			Pattern: "POST /testimonials",
+			Handler: testimonialHandler.SubmitTestimonial,
+		},
+	}
+}
+
+// GetTestimonials
+//
+//	@Summary		Get all testimonials
+//	@Description	Retrieve all approved testimonials for the portfolio
+//	@Tags			Testimonials
+//	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort				query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, created_at, author_name; defaults to the manual order"
+//	@Param			filter[project]		query		int		false	"Filter by project ID"
+//	@Param			filter[experience]	query		int		false	"Filter by experience ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.TestimonialListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/testimonials [get]
+func (th *testimonialHandler) GetTestimonials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(th.settingUseCase, ctx, w)
+	if err != nil {
+		th.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.TestimonialListSpec)
+	if err != nil {
+		th.logger.Error("Invalid testimonial list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyApproved(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	testimonials, err := th.testimonialUseCase.GetTestimonialsByUserID(ctx, portfolioOwnerID, query)
+	if err != nil {
+		th.logger.Error("Failed to get testimonials: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := testimonialDto.FromPublicTestimonialsEntityToResponse(testimonials.Items, utils.WithListMeta(&shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	}, r, query, testimonials))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetTestimonial
+//
+//	@Summary		Get a specific testimonial
+//	@Description	Retrieve a specific approved testimonial by ID
+//	@Tags			Testimonials
+//	@Produce		json
+//	@Param			id	path		int	true	"Testimonial ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.TestimonialResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/testimonials/{id} [get]
+func (th *testimonialHandler) GetTestimonial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	testimonialID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || testimonialID <= 0 {
+		th.logger.Error("Invalid testimonial ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid testimonial ID", "id", &err))
+		return
+	}
+
+	testimonial, err := th.testimonialUseCase.GetTestimonialByID(ctx, testimonialID)
+	if err != nil {
+		th.logger.Error("Failed to get testimonial: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(th.settingUseCase, ctx, w)
+	if err != nil {
+		th.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	if !testimonial.IsApproved() || testimonial.UserID != portfolioOwnerID {
+		th.logger.Error("Unauthorized access to testimonial %d", testimonialID)
+		utils.WriteErrorResponse(w, domain.NewNotFoundError("Testimonial", strconv.Itoa(testimonialID)))
+		return
+	}
+
+	response := testimonialDto.FromPublicTestimonialEntityToResponse(testimonial, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// SubmitTestimonial
+//
+//	@Summary		Submit a testimonial
+//	@Description	Send a testimonial about the portfolio owner, optionally about one of the published projects or experiences. It waits for moderation and is only listed once approved. Submissions are rate limited per client and content with more than 2 links is refused. The same content sent twice and more than 3 pending submissions from one email are dropped, with the same answer as an accepted submission.
+//	@Tags			Testimonials
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.SubmitTestimonialRequest	true	"Testimonial submission"
+//	@Success		202		{object}	shared.APIResponse{data=dto.TestimonialSubmissionResponse}
+//	@Failure		400		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		429		{object}	shared.APIResponse{errors=[]shared.APIError}	"Too many submissions"
+//	@Failure		500		{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/testimonials [post]
+func (th *testimonialHandler) SubmitTestimonial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var request testimonialDto.SubmitTestimonialRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		th.logger.Error("Failed to decode request body: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid request body", "body", &err))
+		return
+	}
+
+	response := &testimonialDto.TestimonialSubmissionResponse{
+		Status:  entities.TestimonialStatusPending,
+		Message: testimonialSubmissionMessage,
+		Meta: &shared.Meta{
+			"timestamp":  time.Now().Format(time.RFC3339),
+			"request_id": utils.GetRequestIDFromContext(ctx),
+		},
+	}
+
+	if request.IsBot() {
+		th.logger.Warn("Dropped testimonial from %s: honeypot filled in", request.AuthorEmail)
+		utils.WriteSuccessResponse(w, http.StatusAccepted, response)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(th.settingUseCase, ctx, w)
+	if err != nil {
+		th.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	testimonialEntity, err := request.ToEntity(portfolioOwnerID)
+	if err != nil {
+		th.logger.Error("Failed to convert request to entity: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid testimonial data", "testimonial", &err))
+		return
+	}
+
+	if _, err := th.testimonialUseCase.SubmitTestimonial(ctx, testimonialEntity); err != nil {
+		th.logger.Error("Failed to submit testimonial: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, response)
+}
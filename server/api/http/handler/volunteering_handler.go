@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"net/http"
+	"portfolio/api/http/routes"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	volunteeringDto "portfolio/dto/volunteering"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+type volunteeringHandler struct {
+	AbstractHandler
+	volunteeringUseCase *usecases.VolunteeringUseCase
+	logger              *logger.Logger
+}
+
+func NewVolunteeringHandler(settingUseCase *usecases.SettingUseCase, volunteeringUseCase *usecases.VolunteeringUseCase, logger *logger.Logger) []*routes.NamedRoute {
+	volunteeringHandler := volunteeringHandler{
+		AbstractHandler: AbstractHandler{
+			settingUseCase: settingUseCase,
+		},
+		volunteeringUseCase: volunteeringUseCase,
+		logger:              logger,
+	}
+
+	return []*routes.NamedRoute{
+		{
+			Name:    "GetVolunteeringsHandler",
+			Pattern: "GET /volunteerings",
+			Handler: volunteeringHandler.GetVolunteerings,
+		},
+		{
+			Name:    "GetVolunteeringHandler",
+			Pattern: "GET /volunteerings/{id}",
+			Handler: volunteeringHandler.GetVolunteering,
+		},
+	}
+}
+
+// GetVolunteerings
+//
+//	@Summary		Get all volunteerings
+//	@Description	Retrieve all published volunteer work of the portfolio owner. Ongoing volunteerings have no end_date and are flagged as current
+//	@Tags			Volunteering
+//	@Produce		json
+//	@Param			page[size]	query		int		false	"Items per page, 1 to 100"	default(20)
+//	@Param			page[after]	query		string	false	"Cursor of the next page, from meta.links.next"
+//	@Param			page[before]	query		string	false	"Cursor of the previous page, from meta.links.prev"
+//	@Param			sort			query		string	false	"Comma-separated sort fields, descending when prefixed with -: position, start_date, role, organization, created_at; defaults to the manual order"
+//	@Param			filter[organization]	query	string	false	"Filter by organization"
+//	@Param			filter[cause]		query	string	false	"Filter by cause"
+//	@Param			filter[current]		query	bool	false	"Only the ongoing volunteerings, or only the ended ones"
+//	@Success		200	{object}	shared.APIResponse{data=dto.VolunteeringListResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/volunteerings [get]
+func (vh *volunteeringHandler) GetVolunteerings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(vh.settingUseCase, ctx, w)
+	if err != nil {
+		vh.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	query, err := utils.ParseListQuery(r, entities.VolunteeringListSpec)
+	if err != nil {
+		vh.logger.Error("Invalid volunteering list query: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+	if err := onlyPublished(query); err != nil {
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	volunteerings, err := vh.volunteeringUseCase.GetVolunteeringsByUserID(ctx, portfolioOwnerID, query)
+	if err != nil {
+		vh.logger.Error("Failed to get volunteerings: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	response := volunteeringDto.FromVolunteeringsEntityToResponse(volunteerings.Items, utils.WithListMeta(&shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	}, r, query, volunteerings))
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetVolunteering
+//
+//	@Summary		Get a specific volunteering
+//	@Description	Retrieve a specific published volunteering by ID
+//	@Tags			Volunteering
+//	@Produce		json
+//	@Param			id	path		int	true	"Volunteering ID"
+//	@Success		200	{object}	shared.APIResponse{data=dto.VolunteeringResponse}
+//	@Failure		400	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		404	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Failure		500	{object}	shared.APIResponse{errors=[]shared.APIError}
+//	@Router			/v1/volunteerings/{id} [get]
+func (vh *volunteeringHandler) GetVolunteering(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	volunteeringID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || volunteeringID <= 0 {
+		vh.logger.Error("Invalid volunteering ID format: %v", err)
+		utils.WriteErrorResponse(w, domain.NewValidationError("Invalid volunteering ID", "id", &err))
+		return
+	}
+
+	volunteering, err := vh.volunteeringUseCase.GetVolunteeringByID(ctx, volunteeringID)
+	if err != nil {
+		vh.logger.Error("Failed to get volunteering: %v", err)
+		utils.WriteErrorResponse(w, err)
+		return
+	}
+
+	portfolioOwnerID, err := utils.GetPortfolioOwnerID(vh.settingUseCase, ctx, w)
+	if err != nil {
+		vh.logger.Error("Failed to get portfolio owner ID: %v", err)
+		return
+	}
+
+	if !volunteering.IsPublished() || volunteering.UserID != portfolioOwnerID {
+		vh.logger.Error("Unauthorized access to volunteering %d", volunteeringID)
+		utils.WriteErrorResponse(w, domain.NewNotFoundError("Volunteering", strconv.Itoa(volunteeringID)))
+		return
+	}
+
+	response := volunteeringDto.FromVolunteeringEntityToResponse(volunteering, &shared.Meta{
+		"timestamp":  time.Now().Format(time.RFC3339),
+		"request_id": utils.GetRequestIDFromContext(ctx),
+	})
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
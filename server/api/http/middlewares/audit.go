@@ -0,0 +1,106 @@
+package middlewares
+
+import (
+	"net"
+	"net/http"
+	"portfolio/api/http/utils"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+	"strings"
+)
+
+// AuditMiddleware appends an audit record for every successful admin write.
+// It must run after the auth middleware so the actor is known. Requests whose
+// path contains one of skipPaths are not audited.
+func AuditMiddleware(auditUseCase *usecases.AuditUseCase, logger *logger.Logger, skipPaths []string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			for _, path := range skipPaths {
+				if strings.Contains(r.URL.Path, path) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			ctx, frame := usecases.WithAuditFrame(r.Context())
+			recorder := &statusRecorder{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+
+			next.ServeHTTP(recorder, r.WithContext(ctx))
+
+			if recorder.statusCode >= 400 {
+				return
+			}
+
+			resourceType, resourceID, action := auditTarget(r.Method, r.URL.Path)
+			auditLog := &entities.AuditLog{
+				Action:       action,
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				IP:           clientIP(r),
+				RequestID:    utils.GetRequestIDFromContext(ctx),
+			}
+			if userID, ok := ctx.Value(shared.USER_ID_KEY).(int); ok {
+				auditLog.UserID = &userID
+			}
+
+			if err := auditUseCase.Record(ctx, frame, auditLog); err != nil {
+				logger.Error("Failed to audit %s %s: %v", r.Method, r.URL.Path, err)
+			}
+		})
+	}
+}
+
+// statusRecorder remembers the status code of a response and passes the body
+// straight through, so large responses such as exports are not buffered.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *statusRecorder) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// auditTarget derives the resource and action from an admin path:
+// /admin/projects/4 is projects 4, /admin/trash/skills/2/restore is a restore
+// of trash skills/2. A trailing non-numeric segment is the action, otherwise
+// the action is named after the method.
+func auditTarget(method, path string) (string, string, string) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(path, "/admin"), "/"), "/")
+	resourceType, rest := segments[0], segments[1:]
+
+	action := map[string]string{
+		http.MethodPost:   entities.RevisionActionCreate,
+		http.MethodPut:    entities.RevisionActionUpdate,
+		http.MethodPatch:  entities.RevisionActionPatch,
+		http.MethodDelete: entities.RevisionActionDelete,
+	}[method]
+
+	if n := len(rest); n > 0 {
+		if _, err := strconv.Atoi(rest[n-1]); err != nil {
+			action, rest = rest[n-1], rest[:n-1]
+		}
+	}
+
+	return resourceType, strings.Join(rest, "/"), action
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -178,7 +178,7 @@ func (am *AuthMiddleware) MiddlewareBearerToken(next http.Handler) http.Handler
 			return
 		}
 
-		ctx := context.WithValue(r.Context(), userCtxKey{}, int(userID))
+		ctx := context.WithValue(r.Context(), shared.USER_ID_KEY, int(userID))
 		r = r.WithContext(ctx)
 		next.ServeHTTP(w, r)
 	})
@@ -219,7 +219,7 @@ func (am *AuthMiddleware) writeUnauthorizedBearerToken(w http.ResponseWriter, er
 }
 
 func GetUserIDFromContext(r *http.Request) any {
-	return r.Context().Value(userCtxKey{})
+	return r.Context().Value(shared.USER_ID_KEY)
 }
 
 func GetUserFromContext(r *http.Request) (*entities.User, bool) {
@@ -0,0 +1,35 @@
+package middlewares
+
+import (
+	"net/http"
+	"portfolio/api/http/utils"
+	"portfolio/domain"
+	"portfolio/logger"
+	"portfolio/shared"
+)
+
+// ClientCertMiddleware rejects requests that did not present a client
+// certificate verified against the configured client CA pool.
+func ClientCertMiddleware(logger *logger.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+				logger.Error("Client certificate required for %s from %s", r.URL.Path, r.RemoteAddr)
+
+				domainErr := domain.NewForbiddenError("A valid client certificate is required")
+				apiError := utils.DomainErrorToAPIError(domainErr)
+
+				response := struct {
+					Errors []*shared.APIError `json:"errors"`
+				}{
+					Errors: []*shared.APIError{apiError},
+				}
+
+				utils.JSONResponse(w, domainErr.HTTPStatus(), response)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+)
+
+type ifMatchRequiredKey struct{}
+
+// RequireIfMatchMiddleware makes If-Match mandatory for the versioned PUT,
+// PATCH and DELETE requests it serves: they answer 428 Precondition Required
+// without it, so that a client cannot overwrite a change it never saw.
+func RequireIfMatchMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ifMatchRequiredKey{}, true)))
+	})
+}
+
+// IfMatchRequired reports whether r went through RequireIfMatchMiddleware.
+func IfMatchRequired(r *http.Request) bool {
+	required, _ := r.Context().Value(ifMatchRequiredKey{}).(bool)
+	return required
+}
@@ -16,7 +16,9 @@ type RateLimiter struct {
 	rate         time.Duration
 	limit        int
 	cleanupTimer *time.Timer
+	stopped      bool
 	logger       *logger.Logger
+	key          func(r *http.Request) string
 }
 
 type visitor struct {
@@ -30,6 +32,7 @@ func NewRateLimiter(rate time.Duration, limit int) *RateLimiter {
 		rate:     rate,
 		limit:    limit,
 		logger:   nil,
+		key:      func(r *http.Request) string { return r.RemoteAddr },
 	}
 
 	return rl
@@ -41,10 +44,32 @@ func (rl *RateLimiter) SetLogger(logger *logger.Logger) {
 	rl.logger = logger
 }
 
+// KeyByHost makes the connections of one client share their limit whatever
+// their port, for limits over a window long enough to reconnect in.
+func (rl *RateLimiter) KeyByHost() {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.key = clientIP
+}
+
+func (rl *RateLimiter) Stop() {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.stopped = true
+	if rl.cleanupTimer != nil {
+		rl.cleanupTimer.Stop()
+		rl.cleanupTimer = nil
+	}
+}
+
 func (rl *RateLimiter) scheduleCleanup() {
 	if rl.cleanupTimer != nil {
 		rl.cleanupTimer.Stop()
 	}
+	if rl.stopped {
+		return
+	}
 	rl.cleanupTimer = time.AfterFunc(5*time.Minute, func() {
 		rl.cleanup()
 	})
@@ -54,9 +79,12 @@ func (rl *RateLimiter) cleanup() {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
+	// A visitor is only forgotten once its tokens are released, so that
+	// limits over a long rate cannot be reset by waiting for the cleanup.
+	idle := max(3*time.Minute, rl.rate)
 	before := len(rl.visitors)
 	for ip, v := range rl.visitors {
-		if time.Since(v.lastSeen) > 3*time.Minute {
+		if time.Since(v.lastSeen) > idle {
 			close(v.limiter)
 			delete(rl.visitors, ip)
 		}
@@ -73,9 +101,8 @@ func (rl *RateLimiter) cleanup() {
 
 func (rl *RateLimiter) Middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ip := r.RemoteAddr
-
 		rl.mu.RLock()
+		ip := rl.key(r)
 		v, exists := rl.visitors[ip]
 		rl.mu.RUnlock()
 
@@ -0,0 +1,172 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxFilterValueLength = 100
+
+type listCursor struct {
+	ID int `json:"id"`
+}
+
+// ParseListQuery reads the query parameters shared by every list endpoint:
+//
+//	page[size]=20           items per page, up to 100
+//	page[after]=<cursor>    the page after the one that returned the cursor
+//	page[before]=<cursor>   the page before it
+//	sort=-start_date,title  sort fields, descending when prefixed with -
+//	filter[status]=active   filters, all of which must match
+//
+// Sort and filter names must be declared by spec. Other parameters are left
+// to the handler.
+func ParseListQuery(r *http.Request, spec entities.ListSpec) (*entities.ListQuery, error) {
+	query := &entities.ListQuery{
+		Size:    entities.DefaultPageSize,
+		Filters: map[string]string{},
+	}
+
+	for key, values := range r.URL.Query() {
+		value := values[len(values)-1]
+
+		switch {
+		case key == "page[size]":
+			size, err := strconv.Atoi(value)
+			if err != nil || size < 1 || size > entities.MaxPageSize {
+				return nil, domain.NewValidationError("Page size must be between 1 and "+strconv.Itoa(entities.MaxPageSize), key, nil)
+			}
+			query.Size = size
+		case key == "page[after]" || key == "page[before]":
+			id, err := decodeCursor(value)
+			if err != nil {
+				return nil, domain.NewInvalidFormatError(key, "cursor from a previous page")
+			}
+			if key == "page[after]" {
+				query.After = id
+			} else {
+				query.Before = id
+			}
+		case key == "sort":
+			sort, err := parseSort(value, spec)
+			if err != nil {
+				return nil, err
+			}
+			query.Sort = sort
+		case strings.HasPrefix(key, "filter[") && strings.HasSuffix(key, "]"):
+			name := strings.TrimSuffix(strings.TrimPrefix(key, "filter["), "]")
+			allowed, ok := spec.Filters[name]
+			if !ok {
+				return nil, domain.NewValidationError("Unknown filter "+name, key, nil)
+			}
+			value = strings.TrimSpace(value)
+			if value == "" || utf8.RuneCountInString(value) > maxFilterValueLength {
+				return nil, domain.NewValidationError("Filter value must be between 1 and 100 characters", key, nil)
+			}
+			if allowed != nil && !slices.Contains(allowed, value) {
+				return nil, domain.NewValidationError(name+" must be one of "+strings.Join(allowed, ", "), key, nil)
+			}
+			query.Filters[name] = value
+		case strings.HasPrefix(key, "page[") || strings.HasPrefix(key, "filter["):
+			return nil, domain.NewValidationError("Unknown query parameter "+key, key, nil)
+		}
+	}
+
+	if query.After != 0 && query.Before != 0 {
+		return nil, domain.NewValidationError("page[after] and page[before] cannot be combined", "page", nil)
+	}
+	if len(query.Sort) == 0 {
+		query.Sort = spec.DefaultSort
+	}
+
+	return query, nil
+}
+
+func parseSort(value string, spec entities.ListSpec) ([]entities.SortField, error) {
+	var sort []entities.SortField
+	seen := map[string]bool{}
+
+	for _, name := range strings.Split(value, ",") {
+		field := entities.SortField{Name: strings.TrimSpace(name)}
+		if strings.HasPrefix(field.Name, "-") {
+			field.Name, field.Descending = field.Name[1:], true
+		}
+		if !slices.Contains(spec.Sorts, field.Name) {
+			return nil, domain.NewValidationError("Cannot sort by "+strconv.Quote(field.Name)+", use one of "+strings.Join(spec.Sorts, ", "), "sort", nil)
+		}
+		if seen[field.Name] {
+			return nil, domain.NewValidationError("Cannot sort by "+field.Name+" twice", "sort", nil)
+		}
+		seen[field.Name] = true
+		sort = append(sort, field)
+	}
+	return sort, nil
+}
+
+func encodeCursor(id int) string {
+	encoded, _ := json.Marshal(listCursor{ID: id})
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+func decodeCursor(value string) (int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return 0, err
+	}
+	var cursor listCursor
+	if err := json.Unmarshal(decoded, &cursor); err != nil {
+		return 0, err
+	}
+	if cursor.ID <= 0 {
+		return 0, domain.NewValidationError("Cursor ID must be positive", "page", nil)
+	}
+	return cursor.ID, nil
+}
+
+// WithListMeta adds the paging of page to meta: the page size, the total
+// number of matching items and links to this page and its neighbours.
+func WithListMeta[T any](meta *shared.Meta, r *http.Request, query *entities.ListQuery, page *entities.ListPage[T]) *shared.Meta {
+	links := map[string]string{"self": listLink(r, "", 0)}
+	if page.NextAfter != 0 {
+		links["next"] = listLink(r, "page[after]", page.NextAfter)
+	}
+	if page.PrevBefore != 0 {
+		links["prev"] = listLink(r, "page[before]", page.PrevBefore)
+	}
+
+	(*meta)["page_size"] = query.Size
+	(*meta)["total"] = page.Total
+	(*meta)["links"] = links
+	return meta
+}
+
+var linkBrackets = strings.NewReplacer("%5B", "[", "%5D", "]")
+
+// listLink is the request URL with its page cursor replaced. The path is
+// read from the request URI, as the mux strips its prefix from r.URL.
+func listLink(r *http.Request, cursorKey string, id int) string {
+	path := r.URL.Path
+	if requestURI, err := url.ParseRequestURI(r.RequestURI); err == nil {
+		path = requestURI.Path
+	}
+
+	values := r.URL.Query()
+	if cursorKey != "" {
+		values.Del("page[after]")
+		values.Del("page[before]")
+		values.Set(cursorKey, encodeCursor(id))
+	}
+	if len(values) == 0 {
+		return path
+	}
+	return path + "?" + linkBrackets.Replace(values.Encode())
+}
@@ -35,15 +35,10 @@ func GetPortfolioOwnerID(settingUseCase *usecases.SettingUseCase, ctx context.Co
 		return 0, err
 	}
 
-	if settings == nil {
-		WriteErrorResponse(w, domain.NewInternalError("Settings not initialized", nil))
-		return 0, domain.NewInternalError("Settings not initialized", nil)
-	}
-
-	if settings.PortfolioOwnerID <= 0 {
+	if settings.Site.PortfolioOwnerID <= 0 {
 		WriteErrorResponse(w, domain.NewInternalError("PortfolioOwnerID not configured in settings", nil))
 		return 0, domain.NewInternalError("PortfolioOwnerID not configured in settings", nil)
 	}
 
-	return settings.PortfolioOwnerID, nil
+	return settings.Site.PortfolioOwnerID, nil
 }
@@ -21,7 +21,10 @@ package main
 import (
 	"context"
 	"database/sql"
+	"flag"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -32,9 +35,14 @@ import (
 	"portfolio/api/http/routes"
 	"portfolio/api/http/utils"
 	"portfolio/config"
+	"portfolio/domain"
+	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
 	"portfolio/domain/usecases"
+	"portfolio/infrastructure/memory"
+	"portfolio/infrastructure/postgres"
 	"portfolio/infrastructure/sqlite"
+	"portfolio/infrastructure/transaction"
 	"portfolio/logger"
 	"portfolio/service"
 	"syscall"
@@ -44,26 +52,56 @@ import (
 )
 
 type RepositoryBundle struct {
-	Setting      interfaces.SettingRepository
-	PersonalInfo interfaces.PersonalInfoRepository
-	RevokeToken  interfaces.RevokedTokenRepository
-	User         interfaces.UserRepository
-	Project      interfaces.ProjectRepository
-	Skill        interfaces.SkillRepository
-	Experience   interfaces.ExperienceRepository
-	Education    interfaces.EducationRepository
-	Technology   interfaces.TechnologyRepository
+	Setting        interfaces.SettingRepository
+	PersonalInfo   interfaces.PersonalInfoRepository
+	RevokeToken    interfaces.RevokedTokenRepository
+	User           interfaces.UserRepository
+	Project        interfaces.ProjectRepository
+	ProjectMedia   interfaces.ProjectMediaRepository
+	ProjectLink    interfaces.ProjectLinkRepository
+	Skill          interfaces.SkillRepository
+	SkillCategory  interfaces.SkillCategoryRepository
+	Experience     interfaces.ExperienceRepository
+	Education      interfaces.EducationRepository
+	Certification  interfaces.CertificationRepository
+	Publication    interfaces.PublicationRepository
+	SpokenLanguage interfaces.SpokenLanguageRepository
+	Award          interfaces.AwardRepository
+	Volunteering   interfaces.VolunteeringRepository
+	Testimonial    interfaces.TestimonialRepository
+	Technology     interfaces.TechnologyRepository
+	Trash          interfaces.TrashRepository
+	Publishing     interfaces.PublishingRepository
+	Search         interfaces.SearchRepository
+	Revision       interfaces.RevisionRepository
+	AuditLog       interfaces.AuditLogRepository
+	UnitOfWork     interfaces.UnitOfWork
 }
 
 type UseCaseBundle struct {
-	Setting      *usecases.SettingUseCase
-	PersonalInfo *usecases.PersonalInfoUseCase
-	Auth         *usecases.AuthUseCase
-	Project      *usecases.ProjectUseCase
-	Skill        *usecases.SkillUseCase
-	Experience   *usecases.ExperienceUseCase
-	Education    *usecases.EducationUseCase
-	Technology   *usecases.TechnologyUseCase
+	Setting        *usecases.SettingUseCase
+	PersonalInfo   *usecases.PersonalInfoUseCase
+	Auth           *usecases.AuthUseCase
+	Project        *usecases.ProjectUseCase
+	ProjectMedia   *usecases.ProjectMediaUseCase
+	ProjectLink    *usecases.ProjectLinkUseCase
+	Skill          *usecases.SkillUseCase
+	SkillCategory  *usecases.SkillCategoryUseCase
+	Experience     *usecases.ExperienceUseCase
+	Education      *usecases.EducationUseCase
+	Certification  *usecases.CertificationUseCase
+	Publication    *usecases.PublicationUseCase
+	SpokenLanguage *usecases.SpokenLanguageUseCase
+	Award          *usecases.AwardUseCase
+	Volunteering   *usecases.VolunteeringUseCase
+	Testimonial    *usecases.TestimonialUseCase
+	Technology     *usecases.TechnologyUseCase
+	Trash          *usecases.TrashUseCase
+	Publishing     *usecases.PublishingUseCase
+	Search         *usecases.SearchUseCase
+	Revision       *usecases.RevisionUseCase
+	Audit          *usecases.AuditUseCase
+	Cache          *service.CacheService
 }
 
 func initializeConfig() (*config.Config, *logger.Logger, error) {
@@ -79,50 +117,300 @@ func initializeConfig() (*config.Config, *logger.Logger, error) {
 func initializeDatabase(cfg *config.Config, logger *logger.Logger) (*sql.DB, error) {
 	logger.Info("Initializing database connection...")
 
-	if err := os.MkdirAll("data", 0755); err != nil {
-		return nil, err
+	switch cfg.Database.Driver {
+	case config.DriverSQLite:
+		if err := os.MkdirAll("data", 0755); err != nil {
+			return nil, err
+		}
+		return sqlite.NewConnection(&cfg.Database, logger)
+	case config.DriverPostgres:
+		return postgres.NewConnection(&cfg.Database, logger)
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", cfg.Database.Driver)
 	}
+}
 
-	db, err := sqlite.NewConnection(&cfg.Database, logger)
-	if err != nil {
-		return nil, err
+func initializeCertificates(cfg *config.Config, logger *logger.Logger) (*service.CertificateService, error) {
+	if !cfg.Server.TLS.Enabled {
+		return nil, nil
 	}
 
-	return db, nil
+	logger.Info("Loading TLS certificates...")
+
+	return service.NewCertificateService(&cfg.Server.TLS, logger)
+}
+
+func initializeCache(cfg *config.Config, logger *logger.Logger) *service.CacheService {
+	cache := service.NewCacheService(cfg.Cache.Enabled, cfg.Cache.MaxEntries, time.Duration(cfg.Cache.TTL)*time.Second)
+	if stats := cache.Stats(); stats.Enabled {
+		logger.Info("Response cache enabled (max %d entries, ttl %ds)", stats.MaxEntries, stats.TTLSeconds)
+	}
+	return cache
 }
 
 func initializeRepositories(db *sql.DB, cfg *config.Config, logger *logger.Logger) *RepositoryBundle {
 	logger.Info("Initializing repositories...")
 
+	if cfg.Database.Driver == config.DriverPostgres {
+		return &RepositoryBundle{
+			Setting:        postgres.NewSettingRepository(db, logger, cfg.SettingKey),
+			PersonalInfo:   postgres.NewPersonalInfoRepository(db, logger),
+			RevokeToken:    postgres.NewRevokedTokenRepository(db, logger),
+			User:           postgres.NewUserRepository(db, logger),
+			Project:        postgres.NewProjectRepository(db, logger),
+			ProjectMedia:   postgres.NewProjectMediaRepository(db, logger),
+			ProjectLink:    postgres.NewProjectLinkRepository(db, logger),
+			Skill:          postgres.NewSkillRepository(db, logger),
+			SkillCategory:  postgres.NewSkillCategoryRepository(db, logger),
+			Experience:     postgres.NewExperienceRepository(db, logger),
+			Education:      postgres.NewEducationRepository(db, logger),
+			Certification:  postgres.NewCertificationRepository(db, logger),
+			Publication:    postgres.NewPublicationRepository(db, logger),
+			SpokenLanguage: postgres.NewSpokenLanguageRepository(db, logger),
+			Award:          postgres.NewAwardRepository(db, logger),
+			Volunteering:   postgres.NewVolunteeringRepository(db, logger),
+			Testimonial:    postgres.NewTestimonialRepository(db, logger),
+			Technology:     postgres.NewTechnologyRepository(db, logger),
+			Trash:          postgres.NewTrashRepository(db, logger),
+			Publishing:     postgres.NewPublishingRepository(db, logger),
+			Search:         postgres.NewSearchRepository(db, logger),
+			Revision:       postgres.NewRevisionRepository(db, logger),
+			AuditLog:       postgres.NewAuditLogRepository(db, logger),
+			UnitOfWork:     transaction.NewUnitOfWork(db, logger),
+		}
+	}
+
 	return &RepositoryBundle{
-		Setting:      sqlite.NewSettingRepository(db, logger, cfg.SettingKey),
-		PersonalInfo: sqlite.NewPersonalInfoRepository(db, logger),
-		RevokeToken:  sqlite.NewRevokedTokenRepository(db, logger),
-		User:         sqlite.NewUserRepository(db, logger),
-		Project:      sqlite.NewProjectRepository(db, logger),
-		Skill:        sqlite.NewSkillRepository(db, logger),
-		Experience:   sqlite.NewExperienceRepository(db, logger),
-		Education:    sqlite.NewEducationRepository(db, logger),
-		Technology:   sqlite.NewTechnologyRepository(db, logger),
+		Setting:        sqlite.NewSettingRepository(db, logger, cfg.SettingKey),
+		PersonalInfo:   sqlite.NewPersonalInfoRepository(db, logger),
+		RevokeToken:    sqlite.NewRevokedTokenRepository(db, logger),
+		User:           sqlite.NewUserRepository(db, logger),
+		Project:        sqlite.NewProjectRepository(db, logger),
+		ProjectMedia:   sqlite.NewProjectMediaRepository(db, logger),
+		ProjectLink:    sqlite.NewProjectLinkRepository(db, logger),
+		Skill:          sqlite.NewSkillRepository(db, logger),
+		SkillCategory:  sqlite.NewSkillCategoryRepository(db, logger),
+		Experience:     sqlite.NewExperienceRepository(db, logger),
+		Education:      sqlite.NewEducationRepository(db, logger),
+		Certification:  sqlite.NewCertificationRepository(db, logger),
+		Publication:    sqlite.NewPublicationRepository(db, logger),
+		SpokenLanguage: sqlite.NewSpokenLanguageRepository(db, logger),
+		Award:          sqlite.NewAwardRepository(db, logger),
+		Volunteering:   sqlite.NewVolunteeringRepository(db, logger),
+		Testimonial:    sqlite.NewTestimonialRepository(db, logger),
+		Technology:     sqlite.NewTechnologyRepository(db, logger),
+		Trash:          sqlite.NewTrashRepository(db, logger),
+		Publishing:     sqlite.NewPublishingRepository(db, logger),
+		Search:         sqlite.NewSearchRepository(db, logger),
+		Revision:       sqlite.NewRevisionRepository(db, logger),
+		AuditLog:       sqlite.NewAuditLogRepository(db, logger),
+		UnitOfWork:     transaction.NewUnitOfWork(db, logger),
+	}
+}
+
+// initializeDemoRepositories backs every repository with a seeded in-memory
+// store that is wiped and re-seeded every demo.reset_interval minutes.
+func initializeDemoRepositories(lifecycle *service.LifecycleService, cache *service.CacheService, cfg *config.Config, logger *logger.Logger) (*RepositoryBundle, error) {
+	password := cfg.Demo.AdminPassword
+	if password == "" {
+		password = config.DefaultDemoAdminPassword
+	}
+	hashedPassword, err := service.NewAuthService(&cfg.JWT).HashPassword(password, cfg.Admin.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	store := memory.NewStore()
+	store.SeedDemo(cfg.SettingKey, cfg.Admin.Username, hashedPassword)
+
+	interval := time.Duration(cfg.Demo.ResetInterval) * time.Minute
+	if interval <= 0 {
+		interval = time.Hour
 	}
+	logger.Warn("Demo mode enabled: data is kept in memory and reset every %s (admin user: %s)", interval, cfg.Admin.Username)
+
+	lifecycle.Go("demo-reset", func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				store.SeedDemo(cfg.SettingKey, cfg.Admin.Username, hashedPassword)
+				cache.Flush()
+				logger.Info("Demo data reset")
+			}
+		}
+	})
+
+	return &RepositoryBundle{
+		Setting:        memory.NewSettingRepository(store, logger, cfg.SettingKey),
+		PersonalInfo:   memory.NewPersonalInfoRepository(store, logger),
+		RevokeToken:    memory.NewRevokedTokenRepository(store, logger),
+		User:           memory.NewUserRepository(store, logger),
+		Project:        memory.NewProjectRepository(store, logger),
+		ProjectMedia:   memory.NewProjectMediaRepository(store, logger),
+		ProjectLink:    memory.NewProjectLinkRepository(store, logger),
+		Skill:          memory.NewSkillRepository(store, logger),
+		SkillCategory:  memory.NewSkillCategoryRepository(store, logger),
+		Experience:     memory.NewExperienceRepository(store, logger),
+		Education:      memory.NewEducationRepository(store, logger),
+		Certification:  memory.NewCertificationRepository(store, logger),
+		Publication:    memory.NewPublicationRepository(store, logger),
+		SpokenLanguage: memory.NewSpokenLanguageRepository(store, logger),
+		Award:          memory.NewAwardRepository(store, logger),
+		Volunteering:   memory.NewVolunteeringRepository(store, logger),
+		Testimonial:    memory.NewTestimonialRepository(store, logger),
+		Technology:     memory.NewTechnologyRepository(store, logger),
+		Trash:          memory.NewTrashRepository(store, logger),
+		Publishing:     memory.NewPublishingRepository(store, logger),
+		Search:         memory.NewSearchRepository(store, logger),
+		Revision:       memory.NewRevisionRepository(store, logger),
+		AuditLog:       memory.NewAuditLogRepository(store, logger),
+		UnitOfWork:     memory.NewUnitOfWork(store, logger),
+	}, nil
 }
 
-func initializeUseCases(repos *RepositoryBundle, cfg *config.Config, logger *logger.Logger) *UseCaseBundle {
+func initializeUseCases(repos *RepositoryBundle, cache *service.CacheService, cfg *config.Config, logger *logger.Logger) *UseCaseBundle {
 	logger.Info("Initializing use cases...")
 
 	authService := service.NewAuthService(&cfg.JWT)
-	settingUseCase := usecases.NewSettingUseCase(repos.Setting, logger)
+	settingUseCase := usecases.NewSettingUseCase(repos.Setting, repos.UnitOfWork, cache, logger)
+	personalInfoUseCase := usecases.NewPersonalInfoUseCase(repos.PersonalInfo, repos.Revision, cache, logger)
+	projectUseCase := usecases.NewProjectUseCase(repos.Project, repos.Technology, repos.ProjectMedia, repos.ProjectLink, repos.User, repos.Setting, repos.Revision, repos.UnitOfWork, cache, logger)
+	skillUseCase := usecases.NewSkillUseCase(repos.Skill, repos.SkillCategory, repos.Project, repos.Experience, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	experienceUseCase := usecases.NewExperienceUseCase(repos.Experience, repos.Technology, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	educationUseCase := usecases.NewEducationUseCase(repos.Education, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	certificationUseCase := usecases.NewCertificationUseCase(repos.Certification, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	publicationUseCase := usecases.NewPublicationUseCase(repos.Publication, repos.PersonalInfo, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	spokenLanguageUseCase := usecases.NewSpokenLanguageUseCase(repos.SpokenLanguage, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	awardUseCase := usecases.NewAwardUseCase(repos.Award, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	volunteeringUseCase := usecases.NewVolunteeringUseCase(repos.Volunteering, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	testimonialUseCase := usecases.NewTestimonialUseCase(repos.Testimonial, repos.Project, repos.Experience, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	technologyUseCase := usecases.NewTechnologyUseCase(repos.Technology, repos.User, repos.Revision, repos.UnitOfWork, cache, logger)
+	revisionUseCase := usecases.NewRevisionUseCase(repos.Revision, projectUseCase, skillUseCase, experienceUseCase,
+		educationUseCase, certificationUseCase, publicationUseCase, spokenLanguageUseCase, awardUseCase, volunteeringUseCase,
+		testimonialUseCase, technologyUseCase, personalInfoUseCase, logger)
+	auditUseCase := usecases.NewAuditUseCase(repos.AuditLog, logger)
+
+	events := service.NewEventService()
+	events.Subscribe(func(ctx context.Context, event *entities.PublishingEvent) {
+		logger.Info("Publishing scheduler moved %s %d from %s to %s", event.Type, event.ID, event.From, event.To)
+	})
+	events.Subscribe(func(ctx context.Context, event *entities.PublishingEvent) {
+		_ = auditUseCase.RecordPublishingEvent(ctx, event)
+	})
 
 	return &UseCaseBundle{
-		Setting:      settingUseCase,
-		PersonalInfo: usecases.NewPersonalInfoUseCase(repos.PersonalInfo, logger),
-		Auth:         usecases.NewAuthUseCase(repos.User, repos.RevokeToken, settingUseCase, authService, logger, cfg.Admin.Salt),
-		Project:      usecases.NewProjectUseCase(repos.Project, repos.User, repos.Setting, logger),
-		Skill:        usecases.NewSkillUseCase(repos.Skill, repos.User, logger),
-		Experience:   usecases.NewExperienceUseCase(repos.Experience, repos.User, logger),
-		Education:    usecases.NewEducationUseCase(repos.Education, repos.User, logger),
-		Technology:   usecases.NewTechnologyUseCase(repos.Technology, repos.User, logger),
+		Setting:        settingUseCase,
+		PersonalInfo:   personalInfoUseCase,
+		Auth:           usecases.NewAuthUseCase(repos.User, repos.RevokeToken, settingUseCase, authService, logger, cfg.Admin.Salt),
+		Project:        projectUseCase,
+		ProjectMedia:   usecases.NewProjectMediaUseCase(repos.ProjectMedia, repos.Project, repos.UnitOfWork, cache, logger),
+		ProjectLink:    usecases.NewProjectLinkUseCase(repos.ProjectLink, repos.Project, repos.UnitOfWork, cache, logger),
+		Skill:          skillUseCase,
+		SkillCategory:  usecases.NewSkillCategoryUseCase(repos.SkillCategory, repos.UnitOfWork, cache, logger),
+		Experience:     experienceUseCase,
+		Education:      educationUseCase,
+		Certification:  certificationUseCase,
+		Publication:    publicationUseCase,
+		SpokenLanguage: spokenLanguageUseCase,
+		Award:          awardUseCase,
+		Volunteering:   volunteeringUseCase,
+		Testimonial:    testimonialUseCase,
+		Technology:     technologyUseCase,
+		Trash:          usecases.NewTrashUseCase(repos.Trash, cache, logger),
+		Publishing:     usecases.NewPublishingUseCase(repos.Publishing, repos.UnitOfWork, events, cache, logger),
+		Search:         usecases.NewSearchUseCase(repos.Search, logger),
+		Revision:       revisionUseCase,
+		Audit:          auditUseCase,
+		Cache:          cache,
+	}
+}
+
+// scheduleTrashRetention purges items that have been in the trash for longer
+// than trash.retention_days, once at startup and then hourly. A negative
+// value turns it off and 0 means the default.
+func scheduleTrashRetention(lifecycle *service.LifecycleService, trashUseCase *usecases.TrashUseCase, cfg *config.Config, logger *logger.Logger) {
+	retentionDays := cfg.Trash.RetentionDays
+	if retentionDays < 0 {
+		logger.Info("Trash retention disabled, deleted items are kept until purged")
+		return
+	}
+	if retentionDays == 0 {
+		retentionDays = config.DefaultTrashRetentionDays
+	}
+
+	retention := time.Duration(retentionDays) * 24 * time.Hour
+	purge := func(ctx context.Context) {
+		purged, err := trashUseCase.PurgeExpired(ctx, retention)
+		if err != nil {
+			logger.Error("Trash retention run failed: %v", err)
+			return
+		}
+		if purged > 0 {
+			logger.Info("Trash retention purged %d item(s) older than %d days", purged, retentionDays)
+		}
 	}
+
+	lifecycle.Go("trash-retention", func(ctx context.Context) {
+		purge(ctx)
+
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				purge(ctx)
+			}
+		}
+	})
+}
+
+// schedulePublishing publishes scheduled content and archives expired
+// content every publishing.interval_seconds, once at startup and then on
+// every tick. A negative interval turns it off and 0 means the default.
+func schedulePublishing(lifecycle *service.LifecycleService, publishingUseCase *usecases.PublishingUseCase, cfg *config.Config, logger *logger.Logger) {
+	intervalSeconds := cfg.Publishing.IntervalSeconds
+	if intervalSeconds < 0 {
+		logger.Warn("Publishing scheduler disabled, scheduled content is only published by hand")
+		return
+	}
+	if intervalSeconds == 0 {
+		intervalSeconds = config.DefaultPublishingIntervalSeconds
+	}
+
+	run := func(ctx context.Context) {
+		changed, err := publishingUseCase.PublishDue(ctx)
+		if err != nil {
+			logger.Error("Publishing scheduler run failed: %v", err)
+			return
+		}
+		if changed > 0 {
+			logger.Info("Publishing scheduler changed the state of %d item(s)", changed)
+		}
+	}
+
+	lifecycle.Go("publishing-scheduler", func(ctx context.Context) {
+		run(ctx)
+
+		ticker := time.NewTicker(time.Duration(intervalSeconds) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				run(ctx)
+			}
+		}
+	})
 }
 
 func setupMiddlewares(authUseCase *usecases.AuthUseCase, jwtConfig *config.JWTConfig, cfg *config.Config, logger *logger.Logger) (
@@ -150,40 +438,92 @@ func setupHandlers(
 	personalInfoUseCase *usecases.PersonalInfoUseCase,
 	authUseCase *usecases.AuthUseCase,
 	projectUseCase *usecases.ProjectUseCase,
+	projectMediaUseCase *usecases.ProjectMediaUseCase,
+	projectLinkUseCase *usecases.ProjectLinkUseCase,
 	skillUseCase *usecases.SkillUseCase,
+	skillCategoryUseCase *usecases.SkillCategoryUseCase,
 	experienceUseCase *usecases.ExperienceUseCase,
 	educationUseCase *usecases.EducationUseCase,
+	certificationUseCase *usecases.CertificationUseCase,
+	publicationUseCase *usecases.PublicationUseCase,
+	spokenLanguageUseCase *usecases.SpokenLanguageUseCase,
+	awardUseCase *usecases.AwardUseCase,
+	volunteeringUseCase *usecases.VolunteeringUseCase,
+	testimonialUseCase *usecases.TestimonialUseCase,
 	technologyUseCase *usecases.TechnologyUseCase,
+	trashUseCase *usecases.TrashUseCase,
+	publishingUseCase *usecases.PublishingUseCase,
+	searchUseCase *usecases.SearchUseCase,
+	revisionUseCase *usecases.RevisionUseCase,
+	auditUseCase *usecases.AuditUseCase,
+	cache *service.CacheService,
 	jwtConfig *config.JWTConfig,
 	logger *logger.Logger,
 ) ([]*routes.NamedRoute, []*routes.NamedRoute) {
 
 	personalInfoHandler := handler.NewPersonalInfoHandler(settingUseCase, personalInfoUseCase, logger)
 	projectHandler := handler.NewProjectHandler(settingUseCase, projectUseCase, logger)
-	skillHandler := handler.NewSkillHandler(settingUseCase, skillUseCase, logger)
+	skillHandler := handler.NewSkillHandler(settingUseCase, skillUseCase, skillCategoryUseCase, logger)
 	experienceHandler := handler.NewExperienceHandler(settingUseCase, experienceUseCase, logger)
 	educationHandler := handler.NewEducationHandler(settingUseCase, educationUseCase, logger)
-	technologyHandler := handler.NewTechnologyHandler(settingUseCase, technologyUseCase, logger)
+	certificationHandler := handler.NewCertificationHandler(settingUseCase, certificationUseCase, logger)
+	publicationHandler := handler.NewPublicationHandler(settingUseCase, publicationUseCase, logger)
+	spokenLanguageHandler := handler.NewSpokenLanguageHandler(settingUseCase, spokenLanguageUseCase, logger)
+	awardHandler := handler.NewAwardHandler(settingUseCase, awardUseCase, logger)
+	volunteeringHandler := handler.NewVolunteeringHandler(settingUseCase, volunteeringUseCase, logger)
+	testimonialHandler := handler.NewTestimonialHandler(settingUseCase, testimonialUseCase, logger)
+	technologyHandler := handler.NewTechnologyHandler(settingUseCase, technologyUseCase, projectUseCase, logger)
 	settingHandler := handler.NewSettingHandler(settingUseCase, logger)
+	searchHandler := handler.NewSearchHandler(settingUseCase, searchUseCase, logger)
 
 	adminAuthHandler := admin.NewAuthHandler(authUseCase, jwtConfig, logger)
 	adminPersonalInfoHandler := admin.NewPersonalInfoHandler(settingUseCase, personalInfoUseCase, logger)
 	adminProjectHandler := admin.NewProjectHandler(settingUseCase, projectUseCase, logger)
+	adminProjectMediaHandler := admin.NewProjectMediaHandler(settingUseCase, projectUseCase, projectMediaUseCase, logger)
+	adminProjectLinkHandler := admin.NewProjectLinkHandler(settingUseCase, projectUseCase, projectLinkUseCase, logger)
 	adminSkillHandler := admin.NewSkillHandler(settingUseCase, skillUseCase, logger)
+	adminSkillCategoryHandler := admin.NewSkillCategoryHandler(settingUseCase, skillCategoryUseCase, logger)
 	adminExperienceHandler := admin.NewExperienceHandler(settingUseCase, experienceUseCase, logger)
 	adminEducationHandler := admin.NewEducationHandler(settingUseCase, educationUseCase, logger)
+	adminCertificationHandler := admin.NewCertificationHandler(settingUseCase, certificationUseCase, logger)
+	adminPublicationHandler := admin.NewPublicationHandler(settingUseCase, publicationUseCase, logger)
+	adminSpokenLanguageHandler := admin.NewSpokenLanguageHandler(settingUseCase, spokenLanguageUseCase, logger)
+	adminAwardHandler := admin.NewAwardHandler(settingUseCase, awardUseCase, logger)
+	adminVolunteeringHandler := admin.NewVolunteeringHandler(settingUseCase, volunteeringUseCase, logger)
+	adminTestimonialHandler := admin.NewTestimonialHandler(settingUseCase, testimonialUseCase, logger)
 	adminTechnologyHandler := admin.NewTechnologyHandler(settingUseCase, technologyUseCase, logger)
 	adminSettingHandler := admin.NewSettingHandler(settingUseCase, logger)
+	adminTrashHandler := admin.NewTrashHandler(settingUseCase, trashUseCase, logger)
+	adminPublishingHandler := admin.NewPublishingHandler(settingUseCase, publishingUseCase, logger)
+	adminSearchHandler := admin.NewSearchHandler(settingUseCase, searchUseCase, logger)
+	adminRevisionHandler := admin.NewRevisionHandler(settingUseCase, revisionUseCase, logger)
+	adminAuditHandler := admin.NewAuditHandler(settingUseCase, auditUseCase, logger)
+	adminCacheHandler := admin.NewCacheHandler(cache, logger)
 
 	var allAdminRoutes []*routes.NamedRoute
 	allAdminRoutes = append(allAdminRoutes, adminAuthHandler...)
 	allAdminRoutes = append(allAdminRoutes, adminPersonalInfoHandler...)
 	allAdminRoutes = append(allAdminRoutes, adminProjectHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminProjectMediaHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminProjectLinkHandler...)
 	allAdminRoutes = append(allAdminRoutes, adminSkillHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminSkillCategoryHandler...)
 	allAdminRoutes = append(allAdminRoutes, adminExperienceHandler...)
 	allAdminRoutes = append(allAdminRoutes, adminEducationHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminCertificationHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminPublicationHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminSpokenLanguageHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminAwardHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminVolunteeringHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminTestimonialHandler...)
 	allAdminRoutes = append(allAdminRoutes, adminTechnologyHandler...)
 	allAdminRoutes = append(allAdminRoutes, adminSettingHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminTrashHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminPublishingHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminSearchHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminRevisionHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminAuditHandler...)
+	allAdminRoutes = append(allAdminRoutes, adminCacheHandler...)
 
 	var allRoutes []*routes.NamedRoute
 	allRoutes = append(allRoutes, personalInfoHandler...)
@@ -191,21 +531,33 @@ func setupHandlers(
 	allRoutes = append(allRoutes, skillHandler...)
 	allRoutes = append(allRoutes, experienceHandler...)
 	allRoutes = append(allRoutes, educationHandler...)
+	allRoutes = append(allRoutes, certificationHandler...)
+	allRoutes = append(allRoutes, publicationHandler...)
+	allRoutes = append(allRoutes, spokenLanguageHandler...)
+	allRoutes = append(allRoutes, awardHandler...)
+	allRoutes = append(allRoutes, volunteeringHandler...)
+	allRoutes = append(allRoutes, testimonialHandler...)
 	allRoutes = append(allRoutes, technologyHandler...)
 	allRoutes = append(allRoutes, settingHandler...)
+	allRoutes = append(allRoutes, searchHandler...)
 
 	return allRoutes, allAdminRoutes
 }
 
-func setupHTTPServer(useCases *UseCaseBundle, cfg *config.Config, logger *logger.Logger) *http.Server {
+func setupHTTPServer(db *sql.DB, useCases *UseCaseBundle, certificateService *service.CertificateService, lifecycle *service.LifecycleService, cfg *config.Config, logger *logger.Logger) *http.Server {
 	logger.Info("Setting up HTTP server...")
 
 	authMiddleware, rateLimiter, loggingMW, corsMW, recoveryMW, responseMW := setupMiddlewares(useCases.Auth, &cfg.JWT, cfg, logger)
+	lifecycle.Go("rate-limiter", func(ctx context.Context) {
+		<-ctx.Done()
+		rateLimiter.Stop()
+	})
 
 	allRoutes, allAdminRoutes := setupHandlers(
 		useCases.Setting,
-		useCases.PersonalInfo, useCases.Auth, useCases.Project, useCases.Skill,
-		useCases.Experience, useCases.Education, useCases.Technology, &cfg.JWT, logger,
+		useCases.PersonalInfo, useCases.Auth, useCases.Project, useCases.ProjectMedia, useCases.ProjectLink, useCases.Skill, useCases.SkillCategory,
+		useCases.Experience, useCases.Education, useCases.Certification, useCases.Publication,
+		useCases.SpokenLanguage, useCases.Award, useCases.Volunteering, useCases.Testimonial, useCases.Technology, useCases.Trash, useCases.Publishing, useCases.Search, useCases.Revision, useCases.Audit, useCases.Cache, &cfg.JWT, logger,
 	)
 	docs := doc.NewDocsHandler(logger)
 
@@ -215,7 +567,10 @@ func setupHTTPServer(useCases *UseCaseBundle, cfg *config.Config, logger *logger
 
 	mux := http.NewServeMux()
 
+	hstsMW := middlewares.HSTSMiddleware(&cfg.Server.TLS)
+
 	baseChain := middlewares.ChainMiddleware(
+		hstsMW,
 		responseMW,
 		recoveryMW,
 		corsMW,
@@ -223,19 +578,87 @@ func setupHTTPServer(useCases *UseCaseBundle, cfg *config.Config, logger *logger
 		loggingMW,
 	)
 
-	adminChain := middlewares.ChainMiddleware(
-		baseChain,
-		authMiddleware.MiddlewareBearerToken,
+	var adminAuthMiddlewares []middlewares.Middleware
+	if certificateService != nil && cfg.Server.TLS.RequireAdminClientCert {
+		if !certificateService.HasClientCAs() {
+			logger.Fatal("tls.require_admin_client_cert is enabled but no tls.client_ca_file is configured")
+		}
+		adminAuthMiddlewares = append(adminAuthMiddlewares, middlewares.ClientCertMiddleware(logger))
+	}
+	adminAuthMiddlewares = append(adminAuthMiddlewares, authMiddleware.MiddlewareBearerToken)
+
+	adminMiddlewares := append(append([]middlewares.Middleware{baseChain}, adminAuthMiddlewares...),
+		middlewares.AuditMiddleware(useCases.Audit, logger, []string{"/auth/"}))
+	if cfg.Admin.RequireIfMatch {
+		adminMiddlewares = append(adminMiddlewares, middlewares.RequireIfMatchMiddleware)
+	}
+	adminChain := middlewares.ChainMiddleware(adminMiddlewares...)
+
+	// Audit exports are file downloads, so like the debug area they skip
+	// responseMW and its JSON envelope.
+	exportChain := middlewares.ChainMiddleware(append([]middlewares.Middleware{
+		hstsMW,
+		recoveryMW,
+		corsMW,
+		rateLimiter.Middleware,
+		loggingMW,
+	}, adminAuthMiddlewares...)...)
+	exportMux := routes.SetupRoutes(admin.NewAuditExportHandler(useCases.Setting, useCases.Audit, logger)...)
+
+	// Citation exports are public downloads, outside responseMW as well.
+	publicExportChain := middlewares.ChainMiddleware(
+		hstsMW,
+		recoveryMW,
+		corsMW,
+		rateLimiter.Middleware,
+		loggingMW,
 	)
+	publicExportMux := routes.SetupRoutes(handler.NewPublicationExportHandler(useCases.Setting, useCases.Publication, logger)...)
+
+	// Testimonial submissions are public writes, so each client gets a few
+	// per window on top of the global limit.
+	submissionLimit := cfg.Testimonials.SubmissionLimit
+	if submissionLimit <= 0 {
+		submissionLimit = config.DefaultTestimonialSubmissionLimit
+	}
+	submissionWindow := cfg.Testimonials.SubmissionWindowMinutes
+	if submissionWindow <= 0 {
+		submissionWindow = config.DefaultTestimonialSubmissionWindowMinutes
+	}
+	submissionLimiter := middlewares.NewRateLimiter(time.Duration(submissionWindow)*time.Minute, submissionLimit)
+	submissionLimiter.KeyByHost()
+	lifecycle.Go("testimonial-rate-limiter", func(ctx context.Context) {
+		<-ctx.Done()
+		submissionLimiter.Stop()
+	})
+	submissionChain := middlewares.ChainMiddleware(baseChain, submissionLimiter.Middleware)
+	submissionMux := routes.SetupRoutes(handler.NewTestimonialSubmissionHandler(useCases.Setting, useCases.Testimonial, logger)...)
 
 	docsChain := middlewares.ChainMiddleware(
+		hstsMW,
 		authMiddleware.MiddlewareBasicAuth,
 	)
 
 	mux.Handle("/v1/", baseChain(http.StripPrefix("/v1", baseMux)))
 	mux.Handle("/admin/", adminChain(http.StripPrefix("/admin", adminMux)))
+	mux.Handle("GET /admin/audit/export", exportChain(http.StripPrefix("/admin", exportMux)))
+	mux.Handle("GET /v1/publications/export", publicExportChain(http.StripPrefix("/v1", publicExportMux)))
+	mux.Handle("POST /v1/testimonials", submissionChain(http.StripPrefix("/v1", submissionMux)))
 	mux.Handle("/doc/", docsChain(http.StripPrefix("/doc", docsMux)))
 
+	if cfg.Debug.Enabled {
+		// The debug area skips responseMW: pprof writes binary and text
+		// payloads that must reach the client untouched.
+		debugChain := middlewares.ChainMiddleware(append([]middlewares.Middleware{
+			hstsMW,
+			recoveryMW,
+			loggingMW,
+		}, adminAuthMiddlewares...)...)
+		debugMux := routes.SetupRoutes(admin.NewDebugHandler(db, cfg.Database.Driver, logger)...)
+		mux.Handle("/admin/debug/", debugChain(http.StripPrefix("/admin", debugMux)))
+		logger.Warn("Debug endpoints are enabled under /admin/debug/")
+	}
+
 	mux.Handle("GET /health", baseChain(
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			utils.JSONResponse(w, http.StatusOK, map[string]any{
@@ -245,86 +668,229 @@ func setupHTTPServer(useCases *UseCaseBundle, cfg *config.Config, logger *logger
 		}),
 	))
 
+	mux.Handle("GET /ready", baseChain(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !lifecycle.IsReady() {
+				utils.WriteErrorResponse(w, domain.NewServiceUnavailableError("Server is not ready to accept traffic"))
+				return
+			}
+			utils.JSONResponse(w, http.StatusOK, map[string]any{
+				"message":   "READY",
+				"timestamp": time.Now().Format(time.RFC3339),
+			})
+		}),
+	))
+
 	server := &http.Server{
 		Addr:    ":" + cfg.Server.Port,
 		Handler: mux,
+		BaseContext: func(net.Listener) context.Context {
+			return lifecycle.Context()
+		},
+	}
+
+	if certificateService != nil {
+		server.TLSConfig = certificateService.TLSConfig()
 	}
 
 	return server
 }
 
-func startServer(server *http.Server, useCases *UseCaseBundle, cfg *config.Config, logger *logger.Logger) error {
+func setupRedirectServer(cfg *config.Config, logger *logger.Logger) *http.Server {
+	if !cfg.Server.TLS.Enabled || !cfg.Server.TLS.RedirectHTTP || cfg.Server.TLS.HTTPPort == "" {
+		return nil
+	}
+
+	logger.Info("Setting up HTTP to HTTPS redirect server...")
+
+	return &http.Server{
+		Addr:              ":" + cfg.Server.TLS.HTTPPort,
+		Handler:           middlewares.HTTPSRedirectHandler(cfg.Server.Port),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+func startServer(server *http.Server, redirectServer *http.Server, certificateService *service.CertificateService, lifecycle *service.LifecycleService, useCases *UseCaseBundle, cfg *config.Config, logger *logger.Logger) error {
 	logger.Info("Starting portfolio backend server...")
 
-	ctx := context.Background()
-	err := useCases.Auth.CreateDefaultAdmin(ctx, cfg.Admin.Username)
+	err := useCases.Auth.CreateDefaultAdmin(lifecycle.Context(), cfg.Admin.Username)
 	if err != nil {
 		logger.Error("Failed to create default admin: %v", err)
 	}
 
+	scheme := "http"
+	if server.TLSConfig != nil {
+		scheme = "https"
+	}
+
+	if certificateService != nil {
+		lifecycle.Go("certificate-watcher", certificateService.Watch)
+	}
+
+	if redirectServer != nil {
+		go func() {
+			logger.Info("↪️  Redirect: http://localhost:%s -> https://localhost:%s", cfg.Server.TLS.HTTPPort, cfg.Server.Port)
+			if err := redirectServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("Redirect server failed: %v", err)
+			}
+		}()
+	}
+
 	go func() {
 		logger.Info("=== Portfolio Backend Server ===")
 		logger.Info("Port: %s", cfg.Server.Port)
 		logger.Info("Environment: %s", cfg.Server.Environment)
 		logger.Info("Mode: %s", cfg.Server.Mode)
 		logger.Info("Database: %s", cfg.Database.Path)
+		logger.Info("TLS: %t", server.TLSConfig != nil)
 		logger.Info("===============================")
-		logger.Info("🚀 API: http://localhost:%s/v1/", cfg.Server.Port)
-		logger.Info("👑 Admin: http://localhost:%s/admin/", cfg.Server.Port)
-		logger.Info("📚 Documentation: http://localhost:%s/doc/", cfg.Server.Port)
-		logger.Info("💖 Health: http://localhost:%s/health", cfg.Server.Port)
-
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Info("🚀 API: %s://localhost:%s/v1/", scheme, cfg.Server.Port)
+		logger.Info("👑 Admin: %s://localhost:%s/admin/", scheme, cfg.Server.Port)
+		logger.Info("📚 Documentation: %s://localhost:%s/doc/", scheme, cfg.Server.Port)
+		logger.Info("💖 Health: %s://localhost:%s/health", scheme, cfg.Server.Port)
+		logger.Info("🚦 Readiness: %s://localhost:%s/ready", scheme, cfg.Server.Port)
+
+		var err error
+		if server.TLSConfig != nil {
+			// Certificates are served through TLSConfig, so no file paths here.
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logger.Fatal("Server failed to start: %v", err)
 		}
 	}()
 
+	lifecycle.SetReady(true)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
+	return shutdownServer(server, redirectServer, lifecycle, cfg, logger)
+}
+
+// shutdownServer drains the HTTP servers: readiness fails first so load
+// balancers stop routing, then in-flight requests get the configured window
+// to finish before background jobs are cancelled through the root context.
+func shutdownServer(server *http.Server, redirectServer *http.Server, lifecycle *service.LifecycleService, cfg *config.Config, logger *logger.Logger) error {
 	logger.Info("Shutting down server...")
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	lifecycle.SetReady(false)
+
+	if drainDelay := time.Duration(cfg.Server.ReadinessDrainDelay) * time.Second; drainDelay > 0 {
+		logger.Info("Readiness set to failing, waiting %s before draining connections", drainDelay)
+		time.Sleep(drainDelay)
+	}
+
+	shutdownTimeout := time.Duration(cfg.Server.ShutdownTimeout) * time.Second
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = 30 * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
 
-	if err := server.Shutdown(ctx); err != nil {
-		logger.Error("Server forced to shutdown: %v", err)
-		return err
+	if redirectServer != nil {
+		if err := redirectServer.Shutdown(ctx); err != nil {
+			logger.Error("Redirect server forced to shutdown: %v", err)
+		}
+	}
+
+	shutdownErr := server.Shutdown(ctx)
+	if shutdownErr != nil {
+		logger.Error("Server forced to shutdown: %v", shutdownErr)
 	} else {
 		logger.Info("Server exited gracefully")
 	}
 
-	return nil
+	jobsTimeout := time.Second
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) > jobsTimeout {
+		jobsTimeout = time.Until(deadline)
+	}
+	if !lifecycle.Stop(jobsTimeout) {
+		logger.Warn("Background jobs did not stop before the shutdown deadline")
+	}
+
+	return shutdownErr
+}
+
+func closeResources(db *sql.DB, cfg *config.Config, logger *logger.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if db != nil {
+		if cfg.Database.Driver == config.DriverSQLite {
+			if err := sqlite.Checkpoint(ctx, db, logger); err != nil {
+				logger.Error("Failed to checkpoint database: %v", err)
+			}
+		}
+
+		if err := db.Close(); err != nil {
+			logger.Error("Failed to close database: %v", err)
+		}
+	}
+
+	logger.Info("Shutdown complete")
+
+	if err := logger.Close(); err != nil {
+		log.Printf("logger close: %v", err)
+	}
 }
 
 func main() {
+	demo := flag.Bool("demo", false, "run against seeded in-memory repositories that reset periodically")
+	flag.Parse()
+
 	_ = godotenv.Load()
 
 	cfg, logger, err := initializeConfig()
 	if err != nil {
 		log.Fatalf("Failed to initialize config: %v", err)
 	}
+	if *demo {
+		cfg.Demo.Enabled = true
+	}
 
 	logger.Info("Starting portfolio backend server...")
 
-	db, err := initializeDatabase(cfg, logger)
+	certificateService, err := initializeCertificates(cfg, logger)
 	if err != nil {
-		logger.Fatal("Failed to initialize database: %v", err)
+		logger.Fatal("Failed to initialize TLS certificates: %v", err)
 	}
-	defer func() {
-		if err := db.Close(); err != nil {
-			logger.Printf("db close: %v", err)
+
+	lifecycle := service.NewLifecycleService(logger)
+
+	cache := initializeCache(cfg, logger)
+
+	var db *sql.DB
+	var repos *RepositoryBundle
+	if cfg.Demo.Enabled {
+		repos, err = initializeDemoRepositories(lifecycle, cache, cfg, logger)
+		if err != nil {
+			logger.Fatal("Failed to initialize demo mode: %v", err)
 		}
-	}()
+	} else {
+		db, err = initializeDatabase(cfg, logger)
+		if err != nil {
+			logger.Fatal("Failed to initialize database: %v", err)
+		}
+		repos = initializeRepositories(db, cfg, logger)
+	}
+
+	useCases := initializeUseCases(repos, cache, cfg, logger)
+	scheduleTrashRetention(lifecycle, useCases.Trash, cfg, logger)
+	schedulePublishing(lifecycle, useCases.Publishing, cfg, logger)
 
-	repos := initializeRepositories(db, cfg, logger)
+	server := setupHTTPServer(db, useCases, certificateService, lifecycle, cfg, logger)
+	redirectServer := setupRedirectServer(cfg, logger)
 
-	useCases := initializeUseCases(repos, cfg, logger)
+	serverErr := startServer(server, redirectServer, certificateService, lifecycle, useCases, cfg, logger)
 
-	server := setupHTTPServer(useCases, cfg, logger)
+	closeResources(db, cfg, logger)
 
-	if err := startServer(server, useCases, cfg, logger); err != nil {
-		logger.Fatal("Server failed: %v", err)
+	if serverErr != nil {
+		log.Fatalf("Server failed: %v", serverErr)
 	}
 }
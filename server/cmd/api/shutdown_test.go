@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"portfolio/config"
+	"portfolio/logger"
+	"portfolio/service"
+	"testing"
+	"time"
+)
+
+func TestShutdownServerDrainsInFlightRequests(t *testing.T) {
+	logger := logger.NewWriterLogger(io.Discard)
+	lifecycle := service.NewLifecycleService(logger)
+	lifecycle.SetReady(true)
+
+	jobStopped := make(chan struct{})
+	lifecycle.Go("test-job", func(ctx context.Context) {
+		<-ctx.Done()
+		close(jobStopped)
+	})
+
+	started := make(chan struct{})
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(300 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	})}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	go func() { _ = server.Serve(listener) }()
+
+	response := make(chan int, 1)
+	go func() {
+		res, err := http.Get("http://" + listener.Addr().String())
+		if err != nil {
+			response <- 0
+			return
+		}
+		_ = res.Body.Close()
+		response <- res.StatusCode
+	}()
+	<-started
+
+	cfg := &config.Config{Server: config.ServerConfig{ShutdownTimeout: 5}}
+	if err := shutdownServer(server, nil, lifecycle, cfg, logger); err != nil {
+		t.Fatalf("shutdownServer failed: %v", err)
+	}
+
+	if lifecycle.IsReady() {
+		t.Error("still ready after shutdown")
+	}
+	if status := <-response; status != http.StatusNoContent {
+		t.Errorf("in-flight request ended with status %d, want %d", status, http.StatusNoContent)
+	}
+	select {
+	case <-jobStopped:
+	default:
+		t.Error("background job was not stopped")
+	}
+	if _, err := http.Get("http://" + listener.Addr().String()); err == nil {
+		t.Error("server still accepts connections after shutdown")
+	}
+}
+
+func TestShutdownServerFailsReadinessBeforeDraining(t *testing.T) {
+	logger := logger.NewWriterLogger(io.Discard)
+	lifecycle := service.NewLifecycleService(logger)
+	lifecycle.SetReady(true)
+
+	server := &http.Server{Handler: http.NotFoundHandler()}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	go func() { _ = server.Serve(listener) }()
+
+	done := make(chan error, 1)
+	cfg := &config.Config{Server: config.ServerConfig{ReadinessDrainDelay: 1, ShutdownTimeout: 5}}
+	go func() { done <- shutdownServer(server, nil, lifecycle, cfg, logger) }()
+
+	// During the drain delay readiness fails while requests are still served.
+	time.Sleep(200 * time.Millisecond)
+	if lifecycle.IsReady() {
+		t.Error("still ready during the drain delay")
+	}
+	res, err := http.Get("http://" + listener.Addr().String())
+	if err != nil {
+		t.Fatalf("request during the drain delay failed: %v", err)
+	}
+	_ = res.Body.Close()
+
+	if err := <-done; err != nil {
+		t.Fatalf("shutdownServer failed: %v", err)
+	}
+}
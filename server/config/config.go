@@ -6,30 +6,81 @@ import (
 	"os"
 	"path/filepath"
 	"portfolio/helpers"
+	"strconv"
 
 	"github.com/joho/godotenv"
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	JWT        JWTConfig      `yaml:"jwt"`
-	Database   DatabaseConfig `yaml:"database"`
-	CORS       CORSConfig     `yaml:"cors"`
-	Logging    LoggingConfig  `yaml:"logging"`
-	Server     ServerConfig   `yaml:"server"`
-	Admin      AdminConfig    `yaml:"admin"`
-	SettingKey string         `yaml:"setting_key"`
+	JWT          JWTConfig          `yaml:"jwt"`
+	Database     DatabaseConfig     `yaml:"database"`
+	CORS         CORSConfig         `yaml:"cors"`
+	Logging      LoggingConfig      `yaml:"logging"`
+	Server       ServerConfig       `yaml:"server"`
+	Admin        AdminConfig        `yaml:"admin"`
+	Debug        DebugConfig        `yaml:"debug"`
+	Demo         DemoConfig         `yaml:"demo"`
+	Cache        CacheConfig        `yaml:"cache"`
+	Trash        TrashConfig        `yaml:"trash"`
+	Publishing   PublishingConfig   `yaml:"publishing"`
+	Testimonials TestimonialsConfig `yaml:"testimonials"`
+	SettingKey   string             `yaml:"setting_key"`
+}
+
+type DebugConfig struct {
+	Enabled bool `yaml:"enabled"`
 }
 
 type ServerConfig struct {
-	Port        string `yaml:"port"`
-	Environment string `yaml:"environment"`
-	Mode        string `yaml:"mode"`
+	Port                string    `yaml:"port"`
+	Environment         string    `yaml:"environment"`
+	Mode                string    `yaml:"mode"`
+	ShutdownTimeout     int       `yaml:"shutdown_timeout"`
+	ReadinessDrainDelay int       `yaml:"readiness_drain_delay"`
+	TLS                 TLSConfig `yaml:"tls"`
+}
+
+type TLSConfig struct {
+	Enabled                bool     `yaml:"enabled"`
+	CertFile               string   `yaml:"cert_file"`
+	KeyFile                string   `yaml:"key_file"`
+	MinVersion             string   `yaml:"min_version"`
+	CipherSuites           []string `yaml:"cipher_suites"`
+	ClientCAFile           string   `yaml:"client_ca_file"`
+	RequireAdminClientCert bool     `yaml:"require_admin_client_cert"`
+	ReloadInterval         int      `yaml:"reload_interval"`
+	RedirectHTTP           bool     `yaml:"redirect_http"`
+	HTTPPort               string   `yaml:"http_port"`
+	HSTSMaxAge             int      `yaml:"hsts_max_age"`
+	HSTSIncludeSubdomains  bool     `yaml:"hsts_include_subdomains"`
+	HSTSPreload            bool     `yaml:"hsts_preload"`
 }
 
+const (
+	DriverSQLite   = "sqlite3"
+	DriverPostgres = "postgres"
+)
+
+const DefaultDemoAdminPassword = "demodemo"
+
+// Trash retention and the publishing scheduler fall back to these when their
+// setting is 0, as left by config files written before the setting existed.
+// A negative value turns them off.
+const (
+	DefaultTrashRetentionDays        = 30
+	DefaultPublishingIntervalSeconds = 60
+)
+
+const (
+	DefaultTestimonialSubmissionLimit         = 3
+	DefaultTestimonialSubmissionWindowMinutes = 60
+)
+
 type DatabaseConfig struct {
 	Driver             string            `yaml:"driver"`
 	Path               string            `yaml:"path"`
+	DSN                string            `yaml:"dsn"`
 	MaxOpenConnections int               `yaml:"max_open_connections"`
 	MaxIdleConnections int               `yaml:"max_idle_connections"`
 	ConnMaxLifetime    int               `yaml:"conn_max_lifetime"`
@@ -53,9 +104,42 @@ type LoggingConfig struct {
 	RotateDaily *bool   `yaml:"rotate_daily"`
 }
 
+type DemoConfig struct {
+	Enabled       bool   `yaml:"enabled"`
+	ResetInterval int    `yaml:"reset_interval"`
+	AdminPassword string `yaml:"admin_password"`
+}
+
+type CacheConfig struct {
+	Enabled    bool `yaml:"enabled"`
+	MaxEntries int  `yaml:"max_entries"`
+	TTL        int  `yaml:"ttl"`
+}
+
+type TrashConfig struct {
+	RetentionDays int `yaml:"retention_days"`
+}
+
+type PublishingConfig struct {
+	IntervalSeconds int `yaml:"interval_seconds"`
+}
+
+// TestimonialsConfig limits the public testimonial submissions of each
+// client to SubmissionLimit per SubmissionWindowMinutes. Values of 0 or
+// less, as left by config files written before the section existed, fall
+// back to the defaults.
+type TestimonialsConfig struct {
+	SubmissionLimit         int `yaml:"submission_limit"`
+	SubmissionWindowMinutes int `yaml:"submission_window_minutes"`
+}
+
+// AdminConfig: with RequireIfMatch, the versioned admin writes answer 428
+// without an If-Match header. It is off by default, and a write without the
+// header then overwrites whatever version is stored.
 type AdminConfig struct {
-	Username string `yaml:"username"`
-	Salt     string `yaml:"salt"`
+	Username       string `yaml:"username"`
+	Salt           string `yaml:"salt"`
+	RequireIfMatch bool   `yaml:"require_if_match"`
 }
 
 type JWTConfig struct {
@@ -96,11 +180,21 @@ func getDefaultConfig() *Config {
 	}
 	return &Config{
 		Server: ServerConfig{
-			Port: "3000",
-			Mode: "development",
+			Port:                "3000",
+			Mode:                "development",
+			ShutdownTimeout:     30,
+			ReadinessDrainDelay: 5,
+			TLS: TLSConfig{
+				Enabled:        false,
+				MinVersion:     "1.2",
+				ReloadInterval: 30,
+				RedirectHTTP:   true,
+				HTTPPort:       "8080",
+				HSTSMaxAge:     31536000, // 1 year
+			},
 		},
 		Database: DatabaseConfig{
-			Driver:             "sqlite3",
+			Driver:             DriverSQLite,
 			Path:               filepath.Join(baseDir, "data", "portfolio.sqlite3"),
 			MaxOpenConnections: 25,
 			MaxIdleConnections: 5,
@@ -126,7 +220,7 @@ func getDefaultConfig() *Config {
 				"http://localhost:4200",
 			},
 			AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders: []string{"Content-Type", "Authorization"},
+			AllowedHeaders: []string{"Content-Type", "Authorization", "If-Match"},
 		},
 		Logging: LoggingConfig{
 			File:        "logs/portfolio.log",
@@ -140,6 +234,29 @@ func getDefaultConfig() *Config {
 		Admin: AdminConfig{
 			Username: "admin",
 		},
+		Debug: DebugConfig{
+			Enabled: false,
+		},
+		Demo: DemoConfig{
+			Enabled:       false,
+			ResetInterval: 60, // minutes
+			AdminPassword: DefaultDemoAdminPassword,
+		},
+		Cache: CacheConfig{
+			Enabled:    true,
+			MaxEntries: 1000,
+			TTL:        300, // seconds
+		},
+		Trash: TrashConfig{
+			RetentionDays: DefaultTrashRetentionDays,
+		},
+		Publishing: PublishingConfig{
+			IntervalSeconds: DefaultPublishingIntervalSeconds,
+		},
+		Testimonials: TestimonialsConfig{
+			SubmissionLimit:         DefaultTestimonialSubmissionLimit,
+			SubmissionWindowMinutes: DefaultTestimonialSubmissionWindowMinutes,
+		},
 		JWT: JWTConfig{
 			Secret:        "your_jwt_secret_key",
 			Expiration:    "24h",
@@ -182,6 +299,40 @@ func overrideWithEnv(config *Config) {
 	if mode := os.Getenv("PORTFOLIO_MODE"); mode != "" {
 		config.Server.Mode = mode
 	}
+	if shutdownTimeout := os.Getenv("PORTFOLIO_SHUTDOWN_TIMEOUT"); shutdownTimeout != "" {
+		if value, err := strconv.Atoi(shutdownTimeout); err == nil {
+			config.Server.ShutdownTimeout = value
+		}
+	}
+	if drainDelay := os.Getenv("PORTFOLIO_READINESS_DRAIN_DELAY"); drainDelay != "" {
+		if value, err := strconv.Atoi(drainDelay); err == nil {
+			config.Server.ReadinessDrainDelay = value
+		}
+	}
+	if tlsEnabled := os.Getenv("PORTFOLIO_TLS_ENABLED"); tlsEnabled != "" {
+		config.Server.TLS.Enabled = tlsEnabled == "true" || tlsEnabled == "1"
+	}
+	if tlsCertFile := os.Getenv("PORTFOLIO_TLS_CERT_FILE"); tlsCertFile != "" {
+		config.Server.TLS.CertFile = tlsCertFile
+	}
+	if tlsKeyFile := os.Getenv("PORTFOLIO_TLS_KEY_FILE"); tlsKeyFile != "" {
+		config.Server.TLS.KeyFile = tlsKeyFile
+	}
+	if tlsClientCAFile := os.Getenv("PORTFOLIO_TLS_CLIENT_CA_FILE"); tlsClientCAFile != "" {
+		config.Server.TLS.ClientCAFile = tlsClientCAFile
+	}
+	if tlsHTTPPort := os.Getenv("PORTFOLIO_TLS_HTTP_PORT"); tlsHTTPPort != "" {
+		config.Server.TLS.HTTPPort = tlsHTTPPort
+	}
+	if databaseDriver := os.Getenv("PORTFOLIO_DATABASE_DRIVER"); databaseDriver != "" {
+		config.Database.Driver = databaseDriver
+	}
+	if databasePath := os.Getenv("PORTFOLIO_DATABASE_PATH"); databasePath != "" {
+		config.Database.Path = databasePath
+	}
+	if databaseDSN := os.Getenv("PORTFOLIO_DATABASE_DSN"); databaseDSN != "" {
+		config.Database.DSN = databaseDSN
+	}
 	if logFile := os.Getenv("PORTFOLIO_LOG_FILE"); logFile != "" {
 		config.Logging.File = logFile
 	}
@@ -207,6 +358,51 @@ func overrideWithEnv(config *Config) {
 	if salt := os.Getenv("PORTFOLIO_ADMIN_SALT"); salt != "" {
 		config.Admin.Salt = salt
 	}
+	if requireIfMatch := os.Getenv("PORTFOLIO_ADMIN_REQUIRE_IF_MATCH"); requireIfMatch != "" {
+		config.Admin.RequireIfMatch = requireIfMatch == "true" || requireIfMatch == "1"
+	}
+	if debugEnabled := os.Getenv("PORTFOLIO_DEBUG_ENABLED"); debugEnabled != "" {
+		config.Debug.Enabled = debugEnabled == "true" || debugEnabled == "1"
+	}
+	if demoEnabled := os.Getenv("PORTFOLIO_DEMO_ENABLED"); demoEnabled != "" {
+		config.Demo.Enabled = demoEnabled == "true" || demoEnabled == "1"
+	}
+	if demoAdminPassword := os.Getenv("PORTFOLIO_DEMO_ADMIN_PASSWORD"); demoAdminPassword != "" {
+		config.Demo.AdminPassword = demoAdminPassword
+	}
+	if cacheEnabled := os.Getenv("PORTFOLIO_CACHE_ENABLED"); cacheEnabled != "" {
+		config.Cache.Enabled = cacheEnabled == "true" || cacheEnabled == "1"
+	}
+	if cacheMaxEntries := os.Getenv("PORTFOLIO_CACHE_MAX_ENTRIES"); cacheMaxEntries != "" {
+		if value, err := strconv.Atoi(cacheMaxEntries); err == nil {
+			config.Cache.MaxEntries = value
+		}
+	}
+	if cacheTTL := os.Getenv("PORTFOLIO_CACHE_TTL"); cacheTTL != "" {
+		if value, err := strconv.Atoi(cacheTTL); err == nil {
+			config.Cache.TTL = value
+		}
+	}
+	if trashRetentionDays := os.Getenv("PORTFOLIO_TRASH_RETENTION_DAYS"); trashRetentionDays != "" {
+		if value, err := strconv.Atoi(trashRetentionDays); err == nil {
+			config.Trash.RetentionDays = value
+		}
+	}
+	if publishingInterval := os.Getenv("PORTFOLIO_PUBLISHING_INTERVAL_SECONDS"); publishingInterval != "" {
+		if value, err := strconv.Atoi(publishingInterval); err == nil {
+			config.Publishing.IntervalSeconds = value
+		}
+	}
+	if submissionLimit := os.Getenv("PORTFOLIO_TESTIMONIALS_SUBMISSION_LIMIT"); submissionLimit != "" {
+		if value, err := strconv.Atoi(submissionLimit); err == nil {
+			config.Testimonials.SubmissionLimit = value
+		}
+	}
+	if submissionWindow := os.Getenv("PORTFOLIO_TESTIMONIALS_SUBMISSION_WINDOW_MINUTES"); submissionWindow != "" {
+		if value, err := strconv.Atoi(submissionWindow); err == nil {
+			config.Testimonials.SubmissionWindowMinutes = value
+		}
+	}
 	if settingKey := os.Getenv("PORTFOLIO_SETTING_KEY"); settingKey != "" {
 		config.SettingKey = settingKey
 	}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"slices"
+)
+
+type expectedVersionKey struct{}
+
+// WithExpectedVersion returns a context under which repositories only write a
+// row that is still at one of versions, as listed by the client in If-Match.
+// Without any version, no row matches and every write fails its precondition.
+func WithExpectedVersion(ctx context.Context, versions ...int) context.Context {
+	return context.WithValue(ctx, expectedVersionKey{}, versions)
+}
+
+// ExpectedVersions returns the versions set by WithExpectedVersion, if any.
+func ExpectedVersions(ctx context.Context) ([]int, bool) {
+	versions, ok := ctx.Value(expectedVersionKey{}).([]int)
+	return versions, ok
+}
+
+// MatchesExpectedVersion reports whether a row at version may be written
+// under ctx: either no version is expected, or version is one of them.
+func MatchesExpectedVersion(ctx context.Context, version int) bool {
+	versions, ok := ExpectedVersions(ctx)
+	return !ok || slices.Contains(versions, version)
+}
@@ -0,0 +1,31 @@
+package entities
+
+import "time"
+
+// AuditLog records one successful admin write. UserID is the actor, Before
+// and After are short JSON summaries of the resource around the change.
+type AuditLog struct {
+	AuditLogID   int
+	UserID       *int
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Before       string
+	After        string
+	IP           string
+	RequestID    string
+	CreatedAt    time.Time
+}
+
+// AuditLogFilter selects audit records; zero values match everything and a
+// Limit of 0 returns every match.
+type AuditLogFilter struct {
+	UserID       int
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Since        *time.Time
+	Until        *time.Time
+	Limit        int
+	Offset       int
+}
@@ -0,0 +1,35 @@
+package entities
+
+import "time"
+
+// Award is an award or an honor the portfolio owner received.
+type Award struct {
+	AwardID     int
+	UserID      int
+	Title       string
+	Issuer      string
+	Date        time.Time
+	URL         string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Version     int
+	Position    int
+	Publishing
+}
+
+func (a *Award) HasRequiredFields() bool {
+	return a.Title != "" && a.Issuer != "" && !a.Date.IsZero() && a.UserID > 0
+}
+
+func (a *Award) BelongsToUser(userID int) bool {
+	return a.UserID == userID
+}
+
+func (a *Award) MarkAsUpdated() {
+	a.UpdatedAt = time.Now()
+}
+
+func (a *Award) GetFullDescription() string {
+	return a.Title + " by " + a.Issuer
+}
@@ -0,0 +1,92 @@
+package entities
+
+import "time"
+
+// Expiry statuses of a certification. A certification without an expiry date
+// never expires, so it stays valid.
+const (
+	CertificationExpiryValid        = "valid"
+	CertificationExpiryExpiringSoon = "expiring_soon"
+	CertificationExpiryExpired      = "expired"
+)
+
+var CertificationExpiryStatuses = []string{
+	CertificationExpiryValid,
+	CertificationExpiryExpiringSoon,
+	CertificationExpiryExpired,
+}
+
+// CertificationExpiryWarningDays is how many days ahead of its expiry date a
+// certification is flagged as expiring soon.
+const CertificationExpiryWarningDays = 90
+
+// Certification is a certification or a license: a credential an issuer
+// grants, as opposed to an Education, which is a course of study.
+type Certification struct {
+	CertificationID int
+	UserID          int
+	Name            string
+	Issuer          string
+	CredentialID    string
+	VerificationURL string
+	IssueDate       time.Time
+	ExpiryDate      *time.Time
+	Description     string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Version         int
+	Position        int
+	Publishing
+}
+
+func (c *Certification) HasRequiredFields() bool {
+	return c.Name != "" && c.Issuer != "" && !c.IssueDate.IsZero() && c.UserID > 0
+}
+
+func (c *Certification) BelongsToUser(userID int) bool {
+	return c.UserID == userID
+}
+
+func (c *Certification) MarkAsUpdated() {
+	c.UpdatedAt = time.Now()
+}
+
+func (c *Certification) HasExpiryDate() bool {
+	return c.ExpiryDate != nil && !c.ExpiryDate.IsZero()
+}
+
+// DaysUntilExpiry returns the number of days from now to the expiry date,
+// negative once it has passed, or nil when the certification never expires.
+// A certification expires at the end of its expiry date.
+func (c *Certification) DaysUntilExpiry(now time.Time) *int {
+	if !c.HasExpiryDate() {
+		return nil
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	expiry := time.Date(c.ExpiryDate.Year(), c.ExpiryDate.Month(), c.ExpiryDate.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(expiry.Sub(today).Hours() / 24)
+	return &days
+}
+
+// ExpiryStatus flags the certification as expired, expiring within
+// CertificationExpiryWarningDays of now, or valid.
+func (c *Certification) ExpiryStatus(now time.Time) string {
+	days := c.DaysUntilExpiry(now)
+	switch {
+	case days == nil:
+		return CertificationExpiryValid
+	case *days < 0:
+		return CertificationExpiryExpired
+	case *days < CertificationExpiryWarningDays:
+		return CertificationExpiryExpiringSoon
+	}
+	return CertificationExpiryValid
+}
+
+func (c *Certification) IsExpired(now time.Time) bool {
+	return c.ExpiryStatus(now) == CertificationExpiryExpired
+}
+
+func (c *Certification) GetFullDescription() string {
+	return c.Name + " by " + c.Issuer
+}
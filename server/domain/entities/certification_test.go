@@ -0,0 +1,56 @@
+package entities_test
+
+import (
+	"portfolio/domain/entities"
+	"testing"
+	"time"
+)
+
+func TestCertificationExpiryStatus(t *testing.T) {
+	// Late in the day, so that expiry dates compare by day rather than by
+	// the time left.
+	now := time.Date(2025, time.March, 10, 23, 30, 0, 0, time.UTC)
+	days := func(n int) *time.Time {
+		expiry := time.Date(2025, time.March, 10+n, 0, 0, 0, 0, time.UTC)
+		return &expiry
+	}
+
+	for name, c := range map[string]struct {
+		expiry *time.Time
+		status string
+		days   *int
+	}{
+		"no expiry date":   {nil, entities.CertificationExpiryValid, nil},
+		"zero expiry date": {&time.Time{}, entities.CertificationExpiryValid, nil},
+		"expired":          {days(-1), entities.CertificationExpiryExpired, ptr(-1)},
+		"expiring today":   {days(0), entities.CertificationExpiryExpiringSoon, ptr(0)},
+		"expiring soon":    {days(entities.CertificationExpiryWarningDays - 1), entities.CertificationExpiryExpiringSoon, ptr(entities.CertificationExpiryWarningDays - 1)},
+		"valid":            {days(entities.CertificationExpiryWarningDays), entities.CertificationExpiryValid, ptr(entities.CertificationExpiryWarningDays)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			certification := &entities.Certification{ExpiryDate: c.expiry}
+
+			if status := certification.ExpiryStatus(now); status != c.status {
+				t.Errorf("ExpiryStatus = %q, want %q", status, c.status)
+			}
+			got := certification.DaysUntilExpiry(now)
+			if (got == nil) != (c.days == nil) || (got != nil && *got != *c.days) {
+				t.Errorf("DaysUntilExpiry = %v, want %v", deref(got), deref(c.days))
+			}
+			if expired := certification.IsExpired(now); expired != (c.status == entities.CertificationExpiryExpired) {
+				t.Errorf("IsExpired = %v for status %q", expired, c.status)
+			}
+		})
+	}
+}
+
+func ptr(n int) *int {
+	return &n
+}
+
+func deref(n *int) any {
+	if n == nil {
+		return nil
+	}
+	return *n
+}
@@ -15,6 +15,9 @@ type Education struct {
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Version     int
+	Position    int
+	Publishing
 }
 
 func (e *Education) HasRequiredFields() bool {
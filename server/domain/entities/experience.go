@@ -2,25 +2,69 @@ package entities
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 )
 
+// Employment types of an experience.
+const (
+	EmploymentTypeFullTime  = "full_time"
+	EmploymentTypeContract  = "contract"
+	EmploymentTypeFreelance = "freelance"
+)
+
+var EmploymentTypes = []string{EmploymentTypeFullTime, EmploymentTypeContract, EmploymentTypeFreelance}
+
+const (
+	// MaxExperienceAchievements bounds the achievement bullets of one
+	// experience.
+	MaxExperienceAchievements      = 20
+	MaxExperienceAchievementLength = 300
+)
+
+// Experience is one position at a company. The positions of a company share
+// its name, so a promotion or a return to a former title is another
+// experience with its own dates.
 type Experience struct {
-	ExperienceID int
-	UserID       int
-	JobTitle     string
-	CompanyName  string
-	StartDate    time.Time
-	EndDate      time.Time
-	Description  string
+	ExperienceID   int
+	UserID         int
+	JobTitle       string
+	CompanyName    string
+	StartDate      time.Time
+	EndDate        time.Time
+	Description    string
+	EmploymentType string
+	Location       string
+	Remote         bool
+	// Achievements are the bullet points of the position, in order.
+	Achievements []string
+	// Technologies are the live technologies linked to the position, in
+	// order. On writes, nil Achievements or Technologies keep the stored
+	// ones.
+	Technologies []*Technology
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	Version      int
+	Position     int
+	Publishing
 }
 
 func (e *Experience) HasRequiredFields() bool {
 	return e.JobTitle != "" && e.CompanyName != "" && e.UserID > 0
 }
 
+func (e *Experience) HasValidEmploymentType() bool {
+	return slices.Contains(EmploymentTypes, e.EmploymentType)
+}
+
+// CompanyKey identifies the company of the position: positions whose
+// company names only differ in case or surrounding spaces are grouped
+// together.
+func (e *Experience) CompanyKey() string {
+	return strings.ToLower(strings.TrimSpace(e.CompanyName))
+}
+
 func (e *Experience) BelongsToUser(userID int) bool {
 	return e.UserID == userID
 }
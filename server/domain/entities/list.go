@@ -0,0 +1,194 @@
+package entities
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Page size bounds shared by every list endpoint.
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+type SortField struct {
+	Name       string
+	Descending bool
+}
+
+// ListQuery selects one page of a list. Sort and filter names are the API
+// names declared by the list's ListSpec; the repositories map them to
+// columns. After and Before are item IDs: the page starts right after the
+// first or ends right before the second. At most one of them is set.
+type ListQuery struct {
+	Size    int
+	After   int
+	Before  int
+	Sort    []SortField
+	Filters map[string]string
+}
+
+// String is a canonical form of the query, equal for equal queries.
+func (q *ListQuery) String() string {
+	var builder strings.Builder
+	builder.WriteString("size=" + strconv.Itoa(q.Size))
+	builder.WriteString(";after=" + strconv.Itoa(q.After))
+	builder.WriteString(";before=" + strconv.Itoa(q.Before))
+
+	builder.WriteString(";sort=")
+	for i, field := range q.Sort {
+		if i > 0 {
+			builder.WriteString(",")
+		}
+		if field.Descending {
+			builder.WriteString("-")
+		}
+		builder.WriteString(field.Name)
+	}
+
+	names := make([]string, 0, len(q.Filters))
+	for name := range q.Filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		builder.WriteString(";filter[" + name + "]=" + strconv.Quote(q.Filters[name]))
+	}
+	return builder.String()
+}
+
+// ListSpec declares what a list can be sorted and filtered by. Lists of the
+// reorderable collections sort by position first by default, so they follow
+// the owner's manual order and fall back to their natural order on ties.
+type ListSpec struct {
+	Sorts []string
+	// Filters maps each filter to its allowed values; nil allows any value.
+	Filters     map[string][]string
+	DefaultSort []SortField
+}
+
+// ListPage is one page of a list. Total counts every item that matches the
+// filters, on any page. NextAfter and PrevBefore are the cursors of the
+// neighbouring pages, 0 when there is none.
+type ListPage[T any] struct {
+	Items      []T
+	Total      int
+	NextAfter  int
+	PrevBefore int
+}
+
+var (
+	ProjectListSpec = ListSpec{
+		Sorts: []string{"position", "title", "status", "created_at", "updated_at"},
+		Filters: map[string][]string{
+			"status":     {"active", "inactive", "archived"},
+			"state":      PublishingStates,
+			"featured":   {"true", "false"},
+			"technology": nil,
+		},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "created_at", Descending: true}},
+	}
+
+	// Skills sorted by category follow the order of their categories, with
+	// the uncategorized ones last.
+	SkillListSpec = ListSpec{
+		Sorts: []string{"position", "name", "level", "category", "years_of_experience", "created_at"},
+		Filters: map[string][]string{
+			"level":    {"1", "2", "3", "4", "5"},
+			"state":    PublishingStates,
+			"category": nil,
+		},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "name"}},
+	}
+
+	// Experiences sorted by company follow the latest start date of each
+	// company's positions, then its name, so that a company's positions
+	// stay together.
+	ExperienceListSpec = ListSpec{
+		Sorts: []string{"position", "start_date", "job_title", "company_name", "company", "created_at"},
+		Filters: map[string][]string{
+			"company_name":    nil,
+			"state":           PublishingStates,
+			"employment_type": EmploymentTypes,
+			"remote":          {"true", "false"},
+			"technology":      nil,
+		},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "start_date", Descending: true}},
+	}
+
+	EducationListSpec = ListSpec{
+		Sorts:       []string{"position", "start_date", "degree", "institution", "created_at"},
+		Filters:     map[string][]string{"institution": nil, "state": PublishingStates},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "start_date", Descending: true}},
+	}
+
+	// Certifications filtered by expiry match their ExpiryStatus on the
+	// current date.
+	CertificationListSpec = ListSpec{
+		Sorts: []string{"position", "issue_date", "expiry_date", "name", "issuer", "created_at"},
+		Filters: map[string][]string{
+			"issuer": nil,
+			"state":  PublishingStates,
+			"expiry": CertificationExpiryStatuses,
+		},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "issue_date", Descending: true}},
+	}
+
+	PublicationListSpec = ListSpec{
+		Sorts: []string{"position", "date", "title", "type", "created_at"},
+		Filters: map[string][]string{
+			"type":  PublicationTypes,
+			"venue": nil,
+			"state": PublishingStates,
+		},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "date", Descending: true}},
+	}
+
+	// Testimonials filtered by project or experience match the ID of the
+	// linked one.
+	TestimonialListSpec = ListSpec{
+		Sorts: []string{"position", "created_at", "author_name", "status"},
+		Filters: map[string][]string{
+			"status":     TestimonialStatuses,
+			"project":    nil,
+			"experience": nil,
+		},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "created_at", Descending: true}},
+	}
+
+	// Spoken languages sorted by level follow SpokenLanguageLevels, so the
+	// most fluent come first when descending.
+	SpokenLanguageListSpec = ListSpec{
+		Sorts: []string{"position", "name", "level", "created_at"},
+		Filters: map[string][]string{
+			"level": SpokenLanguageLevels,
+			"state": PublishingStates,
+		},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "level", Descending: true}},
+	}
+
+	AwardListSpec = ListSpec{
+		Sorts:       []string{"position", "date", "title", "issuer", "created_at"},
+		Filters:     map[string][]string{"issuer": nil, "state": PublishingStates},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "date", Descending: true}},
+	}
+
+	// Volunteerings filtered by current match the ones without an end date.
+	VolunteeringListSpec = ListSpec{
+		Sorts: []string{"position", "start_date", "role", "organization", "created_at"},
+		Filters: map[string][]string{
+			"organization": nil,
+			"cause":        nil,
+			"current":      {"true", "false"},
+			"state":        PublishingStates,
+		},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "start_date", Descending: true}},
+	}
+
+	TechnologyListSpec = ListSpec{
+		Sorts:       []string{"position", "name", "created_at"},
+		Filters:     map[string][]string{"name": nil, "state": PublishingStates},
+		DefaultSort: []SortField{{Name: "position"}, {Name: "name"}},
+	}
+)
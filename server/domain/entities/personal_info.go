@@ -9,6 +9,7 @@ type PersonalInfo struct {
 	DateOfBirth       *utils.Date
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
+	Version           int
 	FirstName         string
 	LastName          string
 	ProfessionalTitle string
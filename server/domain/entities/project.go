@@ -1,19 +1,46 @@
 package entities
 
-import "time"
+import (
+	"slices"
+	"strings"
+	"time"
+)
 
 type Project struct {
-	ProjectID        int
-	UserID           int
-	Title            string
+	ProjectID int
+	UserID    int
+	Title     string
+	// Slug names the project in public URLs. It is derived from the title
+	// when the project is created and only changes when set explicitly.
+	Slug             string
 	Description      string
 	ShortDescription string
-	Technologies     string
-	GithubURL        string
-	ImageURL         string
-	Status           string
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	// Technologies holds the names of the linked technologies as of the last
+	// save, comma-separated, for search; projects created before technologies
+	// were linked may also name technologies that do not exist.
+	Technologies string
+	// GithubURL and ImageURL are derived from Links and Media by SetLinks
+	// and SetMedia; they are not stored.
+	GithubURL string
+	ImageURL  string
+	// Status describes the project itself; whether visitors see it is up
+	// to its Publishing.
+	Status string
+	// Featured projects are also listed by GET /v1/projects/featured.
+	Featured  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Version   int
+	// Position places the project in its owner's manual display order,
+	// which lists use unless another sort is requested.
+	Position int
+	Publishing
+	// LinkedTechnologies are the live technologies linked to the project, in
+	// the order they were given.
+	LinkedTechnologies []*Technology
+	// Media and Links are the project's gallery and links, by position.
+	Media []*ProjectMedia
+	Links []*ProjectLink
 }
 
 func (p *Project) IsActive() bool {
@@ -61,3 +88,117 @@ func (p *Project) HasRequiredFields() bool {
 func (p *Project) MarkAsUpdated() {
 	p.UpdatedAt = time.Now()
 }
+
+// SetTechnologies links the project to technologies and names them in
+// Technologies.
+func (p *Project) SetTechnologies(technologies []*Technology) {
+	p.LinkedTechnologies = technologies
+	p.Technologies = JoinTechnologyNames(technologies)
+}
+
+// JoinTechnologyNames is the technologies text of a project linked to
+// technologies.
+func JoinTechnologyNames(technologies []*Technology) string {
+	names := make([]string, 0, len(technologies))
+	for _, technology := range technologies {
+		names = append(names, technology.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// SetMedia sets the project's gallery and derives ImageURL from it: the
+// primary image, or else the first one.
+func (p *Project) SetMedia(media []*ProjectMedia) {
+	p.Media = media
+	p.ImageURL = ""
+	for _, item := range media {
+		if !item.IsImage() {
+			continue
+		}
+		if item.IsPrimary {
+			p.ImageURL = item.URL
+			return
+		}
+		if p.ImageURL == "" {
+			p.ImageURL = item.URL
+		}
+	}
+}
+
+// SetLinks sets the project's links and derives GithubURL from them: the
+// primary GitHub link, or else the first one.
+func (p *Project) SetLinks(links []*ProjectLink) {
+	p.Links = links
+	p.GithubURL = ""
+	for _, link := range links {
+		if link.Type != ProjectLinkTypeGithub {
+			continue
+		}
+		if link.IsPrimary {
+			p.GithubURL = link.URL
+			return
+		}
+		if p.GithubURL == "" {
+			p.GithubURL = link.URL
+		}
+	}
+}
+
+// MaxProjectSlugLength bounds project slugs.
+const MaxProjectSlugLength = 100
+
+// reservedProjectSlugs are the public project routes that a slug would
+// shadow.
+var reservedProjectSlugs = []string{"featured"}
+
+// NewProjectSlug derives the slug of a project from its title: the title in
+// lowercase with every run of other characters than a-z and 0-9 replaced by
+// one hyphen. Titles that leave no usable slug fall back to "project".
+func NewProjectSlug(title string) string {
+	var builder strings.Builder
+	separate := false
+	for _, r := range strings.ToLower(title) {
+		if r < '0' || r > '9' && r < 'a' || r > 'z' {
+			separate = true
+			continue
+		}
+		if separate && builder.Len() > 0 {
+			builder.WriteByte('-')
+		}
+		separate = false
+		builder.WriteRune(r)
+	}
+
+	slug := builder.String()
+	if len(slug) > MaxProjectSlugLength {
+		slug = strings.TrimRight(slug[:MaxProjectSlugLength], "-")
+	}
+	switch {
+	case slug == "":
+		return "project"
+	case IsReservedProjectSlug(slug):
+		return strings.TrimRight(slug[:min(len(slug), MaxProjectSlugLength-len("project-"))], "-") + "-project"
+	}
+	return slug
+}
+
+// IsProjectSlug reports whether slug is made of lowercase letters and digits
+// in runs joined by single hyphens, within MaxProjectSlugLength.
+func IsProjectSlug(slug string) bool {
+	if slug == "" || len(slug) > MaxProjectSlugLength || strings.HasPrefix(slug, "-") || strings.HasSuffix(slug, "-") || strings.Contains(slug, "--") {
+		return false
+	}
+	for _, r := range slug {
+		if r != '-' && (r < '0' || r > '9' && r < 'a' || r > 'z') {
+			return false
+		}
+	}
+	return true
+}
+
+// IsReservedProjectSlug reports whether slug cannot name a project: public
+// URLs take a slug made only of digits as an ID, and a reserved slug as
+// another route.
+func IsReservedProjectSlug(slug string) bool {
+	return strings.Trim(slug, "0123456789") == "" || slices.Contains(reservedProjectSlugs, slug)
+}
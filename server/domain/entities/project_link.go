@@ -0,0 +1,41 @@
+package entities
+
+import "time"
+
+const (
+	ProjectLinkTypeGithub  = "github"
+	ProjectLinkTypeDemo    = "demo"
+	ProjectLinkTypeDocs    = "docs"
+	ProjectLinkTypeVideo   = "video"
+	ProjectLinkTypeWebsite = "website"
+	ProjectLinkTypeOther   = "other"
+)
+
+// ProjectLinkTypes are the kinds of links a project lists.
+var ProjectLinkTypes = []string{
+	ProjectLinkTypeGithub,
+	ProjectLinkTypeDemo,
+	ProjectLinkTypeDocs,
+	ProjectLinkTypeVideo,
+	ProjectLinkTypeWebsite,
+	ProjectLinkTypeOther,
+}
+
+// ProjectLink is one of a project's links, such as its repository or a demo.
+// At most one link of a project is primary; the primary GitHub link is the
+// project's GithubURL.
+type ProjectLink struct {
+	ProjectLinkID int
+	ProjectID     int
+	Type          string
+	URL           string
+	Label         string
+	Position      int
+	IsPrimary     bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (l *ProjectLink) BelongsToProject(projectID int) bool {
+	return l.ProjectID == projectID
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+const (
+	ProjectMediaTypeImage = "image"
+	ProjectMediaTypeVideo = "video"
+)
+
+// ProjectMediaTypes are the kinds of media a project gallery holds.
+var ProjectMediaTypes = []string{ProjectMediaTypeImage, ProjectMediaTypeVideo}
+
+// ProjectMedia is an image or video of a project's gallery. At most one item
+// of a project is primary; the primary image is the project's ImageURL.
+type ProjectMedia struct {
+	ProjectMediaID int
+	ProjectID      int
+	Type           string
+	URL            string
+	Caption        string
+	AltText        string
+	Position       int
+	IsPrimary      bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (m *ProjectMedia) BelongsToProject(projectID int) bool {
+	return m.ProjectID == projectID
+}
+
+func (m *ProjectMedia) IsImage() bool {
+	return m.Type == ProjectMediaTypeImage
+}
@@ -0,0 +1,75 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+// Publication types. Open-source contributions are listed alongside the
+// writing and speaking, with the repository as their venue.
+const (
+	PublicationTypeArticle    = "article"
+	PublicationTypeTalk       = "talk"
+	PublicationTypePaper      = "paper"
+	PublicationTypePodcast    = "podcast"
+	PublicationTypeOpenSource = "open_source"
+)
+
+var PublicationTypes = []string{
+	PublicationTypeArticle,
+	PublicationTypeTalk,
+	PublicationTypePaper,
+	PublicationTypePodcast,
+	PublicationTypeOpenSource,
+}
+
+const (
+	// MaxPublicationCoAuthors bounds the co-authors of one publication.
+	MaxPublicationCoAuthors      = 20
+	MaxPublicationCoAuthorLength = 150
+)
+
+// Publication is an article, a talk, a paper, a podcast episode or an
+// open-source contribution. The portfolio owner is its first author; the
+// co-authors are the others, in order.
+type Publication struct {
+	PublicationID int
+	UserID        int
+	Title         string
+	Type          string
+	Venue         string
+	Date          time.Time
+	URL           string
+	SlidesURL     string
+	VideoURL      string
+	Description   string
+	CoAuthors     []string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Version       int
+	Position      int
+	Publishing
+}
+
+func (p *Publication) HasRequiredFields() bool {
+	return p.Title != "" && p.Type != "" && !p.Date.IsZero() && p.UserID > 0
+}
+
+func (p *Publication) HasValidType() bool {
+	return slices.Contains(PublicationTypes, p.Type)
+}
+
+func (p *Publication) BelongsToUser(userID int) bool {
+	return p.UserID == userID
+}
+
+func (p *Publication) MarkAsUpdated() {
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Publication) GetFullDescription() string {
+	if p.Venue == "" {
+		return p.Title
+	}
+	return p.Title + " at " + p.Venue
+}
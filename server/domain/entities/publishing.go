@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+// Publishing states. Only published content is shown on the public API;
+// the scheduler moves scheduled content to published once its PublishAt has
+// passed, and published content to archived once its UnpublishAt has.
+const (
+	PublishingStateDraft     = "draft"
+	PublishingStateScheduled = "scheduled"
+	PublishingStatePublished = "published"
+	PublishingStateArchived  = "archived"
+)
+
+var PublishingStates = []string{
+	PublishingStateDraft,
+	PublishingStateScheduled,
+	PublishingStatePublished,
+	PublishingStateArchived,
+}
+
+// Publishing types are the content tables with a publishing state, named
+// like the trash item types.
+var PublishingTypes = []string{
+	TrashTypeProject,
+	TrashTypeSkill,
+	TrashTypeExperience,
+	TrashTypeEducation,
+	TrashTypeCertification,
+	TrashTypePublication,
+	TrashTypeSpokenLanguage,
+	TrashTypeAward,
+	TrashTypeVolunteering,
+	TrashTypeTechnology,
+}
+
+// Publishing is the visibility of a piece of content.
+type Publishing struct {
+	State       string
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+}
+
+// IsPublished reports whether the content is shown on the public API.
+func (p Publishing) IsPublished() bool {
+	return p.State == PublishingStatePublished
+}
+
+// SetDefaultState gives content created without a state one: scheduled when
+// it has a PublishAt after now, published otherwise.
+func (p *Publishing) SetDefaultState(now time.Time) {
+	if p.State != "" {
+		return
+	}
+	if p.PublishAt != nil && p.PublishAt.After(now) {
+		p.State = PublishingStateScheduled
+		return
+	}
+	p.State = PublishingStatePublished
+}
+
+// Due returns the state the scheduler moves the content to at now, or ""
+// when it stays as is.
+func (p Publishing) Due(now time.Time) string {
+	switch {
+	case (p.State == PublishingStateScheduled || p.State == PublishingStatePublished) &&
+		p.UnpublishAt != nil && !p.UnpublishAt.After(now):
+		return PublishingStateArchived
+	case p.State == PublishingStateScheduled && p.PublishAt != nil && !p.PublishAt.After(now):
+		return PublishingStatePublished
+	}
+	return ""
+}
+
+// PublishingItem is the publishing state of one piece of content, whatever its
+// type.
+type PublishingItem struct {
+	Type    string
+	ID      int
+	UserID  int
+	Label   string
+	Version int
+	Publishing
+}
+
+// PublishingEvent reports a state change made by the scheduler.
+type PublishingEvent struct {
+	Type       string
+	ID         int
+	UserID     int
+	From       string
+	To         string
+	OccurredAt time.Time
+}
+
+func IsPublishingState(state string) bool {
+	return slices.Contains(PublishingStates, state)
+}
+
+func IsPublishingType(itemType string) bool {
+	return slices.Contains(PublishingTypes, itemType)
+}
@@ -0,0 +1,30 @@
+package entities
+
+import "time"
+
+const (
+	RevisionActionCreate = "create"
+	RevisionActionUpdate = "update"
+	RevisionActionPatch  = "patch"
+	RevisionActionDelete = "delete"
+)
+
+// Revision is a snapshot of a portfolio entity taken after a change, or just
+// before it for deletes. EntityType uses the same table names as the trash.
+type Revision struct {
+	RevisionID int
+	EntityType string
+	EntityID   int
+	Action     string
+	Snapshot   []byte
+	UserID     *int
+	RequestID  string
+	CreatedAt  time.Time
+}
+
+// RevisionChange is one field that differs between two revisions.
+type RevisionChange struct {
+	Field string
+	From  any
+	To    any
+}
@@ -0,0 +1,45 @@
+package entities
+
+// Search result types reuse the table names, like the trash item types.
+const (
+	SearchTypeProject      = TrashTypeProject
+	SearchTypeSkill        = TrashTypeSkill
+	SearchTypeExperience   = TrashTypeExperience
+	SearchTypeEducation    = TrashTypeEducation
+	SearchTypePersonalInfo = TrashTypePersonalInfo
+)
+
+var SearchTypes = []string{
+	SearchTypeProject,
+	SearchTypeSkill,
+	SearchTypeExperience,
+	SearchTypeEducation,
+	SearchTypePersonalInfo,
+}
+
+// Snippets mark matched terms with these control characters rather than
+// HTML, so that the stored text can be escaped before the marks are rendered.
+const (
+	SearchHighlightStart = "\x02"
+	SearchHighlightEnd   = "\x03"
+)
+
+type SearchQuery struct {
+	Terms  []string
+	UserID int
+	// IncludeHidden also matches content that is not published, and
+	// projects that are not active.
+	IncludeHidden bool
+	// Limit caps the number of hits per type.
+	Limit int
+}
+
+type SearchHit struct {
+	Type    string
+	ID      int
+	Title   string
+	Snippet string
+	// Score is higher for better matches. Scores are only comparable
+	// between hits of the same search.
+	Score float64
+}
@@ -9,14 +9,88 @@ type Setting struct {
 	SettingUpdatedAt string
 }
 
-type SettingJson struct {
-	ShowProjects     bool
-	PortfolioOwnerID int
-	SiteName         string
-	SiteDescription  string
-	ContactEmail     string
-	Theme            string
-	Language         string
-	MaintenanceMode  bool
-	UpdatedAt        time.Time
+// SettingNamespace names one typed group of settings. Each namespace is
+// stored as its own document and carries its own schema version.
+type SettingNamespace string
+
+const (
+	SettingNamespaceSite    SettingNamespace = "site"
+	SettingNamespaceDisplay SettingNamespace = "display"
+	SettingNamespaceSEO     SettingNamespace = "seo"
+	SettingNamespaceContact SettingNamespace = "contact"
+)
+
+var SettingNamespaces = []SettingNamespace{
+	SettingNamespaceSite,
+	SettingNamespaceDisplay,
+	SettingNamespaceSEO,
+	SettingNamespaceContact,
+}
+
+func IsValidSettingNamespace(namespace string) bool {
+	for _, known := range SettingNamespaces {
+		if string(known) == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// Current schema version of each namespace. Bump one together with a new
+// upgrade function in the setting use case whenever its fields change.
+const (
+	SiteSettingsSchemaVersion    = 1
+	DisplaySettingsSchemaVersion = 1
+	SEOSettingsSchemaVersion     = 1
+	ContactSettingsSchemaVersion = 1
+)
+
+type SiteSettings struct {
+	SchemaVersion    int       `json:"schema_version"`
+	PortfolioOwnerID int       `json:"portfolio_owner_id"`
+	SiteName         string    `json:"site_name"`
+	SiteDescription  string    `json:"site_description"`
+	Language         string    `json:"language"`
+	MaintenanceMode  bool      `json:"maintenance_mode"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type DisplaySettings struct {
+	SchemaVersion int       `json:"schema_version"`
+	ShowProjects  bool      `json:"show_projects"`
+	Theme         string    `json:"theme"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Longest meta title and description search engines display in full.
+const (
+	MaxMetaTitleLength       = 70
+	MaxMetaDescriptionLength = 160
+)
+
+type SEOSettings struct {
+	SchemaVersion   int       `json:"schema_version"`
+	MetaTitle       string    `json:"meta_title"`
+	MetaDescription string    `json:"meta_description"`
+	Keywords        []string  `json:"keywords"`
+	OGImageURL      string    `json:"og_image_url"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type ContactSettings struct {
+	SchemaVersion int       `json:"schema_version"`
+	ContactEmail  string    `json:"contact_email"`
+	GitHub        string    `json:"github"`
+	LinkedIn      string    `json:"linkedin"`
+	Twitter       string    `json:"twitter"`
+	Website       string    `json:"website"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Settings gathers every namespace for callers that need the whole picture.
+type Settings struct {
+	Site    SiteSettings
+	Display DisplaySettings
+	SEO     SEOSettings
+	Contact ContactSettings
 }
@@ -4,13 +4,27 @@ import (
 	"time"
 )
 
+// MaxSkillYearsOfExperience bounds the years of experience of a skill.
+const MaxSkillYearsOfExperience = 80
+
 type Skill struct {
-	SkillID   int
-	UserID    int
-	Name      string
-	Level     int // 1-5
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	SkillID           int
+	UserID            int
+	Name              string
+	Level             int // 1-5
+	CategoryID        *int
+	YearsOfExperience *int
+	LastUsedAt        *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Version           int
+	Position          int
+	Publishing
+	// Projects and Experiences are the live projects and experiences linked
+	// to the skill as evidence, in order. On writes, nil leaves the links as
+	// they are.
+	Projects    []*SkillEvidence
+	Experiences []*SkillEvidence
 }
 
 func (s *Skill) HasRequiredFields() bool {
@@ -25,6 +39,12 @@ func (s *Skill) MarkAsUpdated() {
 	s.UpdatedAt = time.Now()
 }
 
+// HasValidYearsOfExperience reports whether the years of experience, when
+// set, are within the bounds of the skill_years_of_experience CHECK.
+func (s *Skill) HasValidYearsOfExperience() bool {
+	return s.YearsOfExperience == nil || (*s.YearsOfExperience >= 0 && *s.YearsOfExperience <= MaxSkillYearsOfExperience)
+}
+
 func (s *Skill) IsValidLevel() bool {
 	return s.Level >= 1 && s.Level <= 5
 }
@@ -54,3 +74,22 @@ func (s *Skill) SetLevel(level int) bool {
 	}
 	return false
 }
+
+// WithPublishedEvidence returns a copy of the skill that only links the
+// published projects and experiences, as visitors see them.
+func (s *Skill) WithPublishedEvidence() *Skill {
+	published := *s
+	published.Projects = publishedEvidence(s.Projects)
+	published.Experiences = publishedEvidence(s.Experiences)
+	return &published
+}
+
+func publishedEvidence(evidence []*SkillEvidence) []*SkillEvidence {
+	published := make([]*SkillEvidence, 0, len(evidence))
+	for _, item := range evidence {
+		if item.IsPublished() {
+			published = append(published, item)
+		}
+	}
+	return published
+}
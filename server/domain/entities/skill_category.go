@@ -0,0 +1,38 @@
+package entities
+
+import "time"
+
+// SkillCategory groups a user's skills, such as "Languages" or "Cloud".
+// Categories are listed by position; skills outside of any category come
+// after them.
+type SkillCategory struct {
+	SkillCategoryID int
+	UserID          int
+	Name            string
+	Position        int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (c *SkillCategory) BelongsToUser(userID int) bool {
+	return c.UserID == userID
+}
+
+// SkillEvidence is a project or an experience linked to a skill to show
+// where it was used. The title of an experience is its job title and its
+// organization the company.
+type SkillEvidence struct {
+	ID           int
+	Title        string
+	Organization string
+	Publishing
+}
+
+// SkillEvidenceIDs returns the IDs of evidence, in order.
+func SkillEvidenceIDs(evidence []*SkillEvidence) []int {
+	ids := make([]int, 0, len(evidence))
+	for _, item := range evidence {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
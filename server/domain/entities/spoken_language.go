@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+// Spoken language levels are the CEFR levels, from beginner to proficient,
+// followed by native.
+const (
+	SpokenLanguageLevelA1     = "A1"
+	SpokenLanguageLevelA2     = "A2"
+	SpokenLanguageLevelB1     = "B1"
+	SpokenLanguageLevelB2     = "B2"
+	SpokenLanguageLevelC1     = "C1"
+	SpokenLanguageLevelC2     = "C2"
+	SpokenLanguageLevelNative = "native"
+)
+
+// SpokenLanguageLevels lists the levels in ascending order.
+var SpokenLanguageLevels = []string{
+	SpokenLanguageLevelA1,
+	SpokenLanguageLevelA2,
+	SpokenLanguageLevelB1,
+	SpokenLanguageLevelB2,
+	SpokenLanguageLevelC1,
+	SpokenLanguageLevelC2,
+	SpokenLanguageLevelNative,
+}
+
+var spokenLanguageLevelLabels = map[string]string{
+	SpokenLanguageLevelA1:     "Beginner",
+	SpokenLanguageLevelA2:     "Elementary",
+	SpokenLanguageLevelB1:     "Intermediate",
+	SpokenLanguageLevelB2:     "Upper intermediate",
+	SpokenLanguageLevelC1:     "Advanced",
+	SpokenLanguageLevelC2:     "Proficient",
+	SpokenLanguageLevelNative: "Native",
+}
+
+// SpokenLanguage is a human language the portfolio owner speaks, as opposed
+// to a Technology. Its name is unique per user, ignoring case.
+type SpokenLanguage struct {
+	SpokenLanguageID int
+	UserID           int
+	Name             string
+	Level            string
+	Description      string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Version          int
+	Position         int
+	Publishing
+}
+
+func (l *SpokenLanguage) HasRequiredFields() bool {
+	return l.Name != "" && l.Level != "" && l.UserID > 0
+}
+
+func (l *SpokenLanguage) HasValidLevel() bool {
+	return slices.Contains(SpokenLanguageLevels, l.Level)
+}
+
+func (l *SpokenLanguage) BelongsToUser(userID int) bool {
+	return l.UserID == userID
+}
+
+func (l *SpokenLanguage) MarkAsUpdated() {
+	l.UpdatedAt = time.Now()
+}
+
+func (l *SpokenLanguage) IsNative() bool {
+	return l.Level == SpokenLanguageLevelNative
+}
+
+// LevelRank orders the levels from 0 for A1 up to native; an unknown level
+// ranks -1.
+func (l *SpokenLanguage) LevelRank() int {
+	return slices.Index(SpokenLanguageLevels, l.Level)
+}
+
+// LevelLabel names the level, like "Upper intermediate" for B2.
+func (l *SpokenLanguage) LevelLabel() string {
+	return spokenLanguageLevelLabels[l.Level]
+}
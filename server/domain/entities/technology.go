@@ -12,6 +12,9 @@ type Technology struct {
 	IconURL      string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	Version      int
+	Position     int
+	Publishing
 }
 
 func (t *Technology) HasRequiredFields() bool {
@@ -27,7 +30,13 @@ func (t *Technology) MarkAsUpdated() {
 }
 
 func (t *Technology) GetNormalizedName() string {
-	return strings.ToLower(strings.TrimSpace(t.Name))
+	return NormalizeTechnologyName(t.Name)
+}
+
+// NormalizeTechnologyName is the form under which technology names are
+// matched, e.g. when a project names its technologies.
+func NormalizeTechnologyName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
 }
 
 func (t *Technology) HasValidIconURL() bool {
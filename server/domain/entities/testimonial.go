@@ -0,0 +1,131 @@
+package entities
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// Testimonial statuses. Submissions wait as pending until an admin approves
+// or rejects them; only approved testimonials are shown to visitors.
+const (
+	TestimonialStatusPending  = "pending"
+	TestimonialStatusApproved = "approved"
+	TestimonialStatusRejected = "rejected"
+)
+
+var TestimonialStatuses = []string{
+	TestimonialStatusPending,
+	TestimonialStatusApproved,
+	TestimonialStatusRejected,
+}
+
+const (
+	MinTestimonialContentLength = 20
+	MaxTestimonialContentLength = 2000
+	// MaxTestimonialLinks bounds the links in the content of a submission;
+	// more are taken for spam.
+	MaxTestimonialLinks = 2
+	// MaxPendingTestimonialsPerEmail bounds the submissions from one email
+	// address that can wait for moderation at the same time.
+	MaxPendingTestimonialsPerEmail = 3
+)
+
+// TestimonialSubject is the live project or experience that a testimonial is
+// about, with its title and, for an experience, its company.
+type TestimonialSubject struct {
+	ID           int
+	Title        string
+	Organization string
+	Publishing
+}
+
+// Testimonial is a recommendation from a colleague or a client. The author's
+// email is only shown to the admin.
+type Testimonial struct {
+	TestimonialID   int
+	UserID          int
+	AuthorName      string
+	AuthorTitle     string
+	AuthorCompany   string
+	AuthorEmail     string
+	AuthorURL       string
+	Content         string
+	Status          string
+	RejectionReason string
+	ModeratedAt     *time.Time
+	ProjectID       *int
+	ExperienceID    *int
+	// Project and Experience are filled on reads with the linked project and
+	// experience, while they are live.
+	Project    *TestimonialSubject
+	Experience *TestimonialSubject
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Version    int
+	Position   int
+}
+
+func (t *Testimonial) HasRequiredFields() bool {
+	return t.AuthorName != "" && t.AuthorEmail != "" && t.Content != "" && t.UserID > 0
+}
+
+func (t *Testimonial) HasValidStatus() bool {
+	return slices.Contains(TestimonialStatuses, t.Status)
+}
+
+func (t *Testimonial) IsApproved() bool {
+	return t.Status == TestimonialStatusApproved
+}
+
+func (t *Testimonial) BelongsToUser(userID int) bool {
+	return t.UserID == userID
+}
+
+func (t *Testimonial) MarkAsUpdated() {
+	t.UpdatedAt = time.Now()
+}
+
+// Moderate sets the status of the testimonial, with the reason of a
+// rejection, and the time of the decision.
+func (t *Testimonial) Moderate(status, reason string, now time.Time) {
+	t.Status = status
+	t.RejectionReason = ""
+	if status == TestimonialStatusRejected {
+		t.RejectionReason = reason
+	}
+	t.ModeratedAt = &now
+}
+
+// WithPublishedSubjects returns a copy of the testimonial that only links a
+// published project or experience, as visitors see it.
+func (t *Testimonial) WithPublishedSubjects() *Testimonial {
+	published := *t
+	if t.Project != nil && !t.Project.IsPublished() {
+		published.Project = nil
+	}
+	if t.Experience != nil && !t.Experience.IsPublished() {
+		published.Experience = nil
+	}
+	return &published
+}
+
+// LinkCount counts the links written in the content.
+func (t *Testimonial) LinkCount() int {
+	content := strings.ToLower(t.Content)
+	return strings.Count(content, "http://") + strings.Count(content, "https://") +
+		strings.Count(content, "www.") - strings.Count(content, "://www.")
+}
+
+func (t *Testimonial) GetAuthorDescription() string {
+	switch {
+	case t.AuthorTitle != "" && t.AuthorCompany != "":
+		return t.AuthorName + ", " + t.AuthorTitle + " at " + t.AuthorCompany
+	case t.AuthorTitle != "":
+		return t.AuthorName + ", " + t.AuthorTitle
+	case t.AuthorCompany != "":
+		return t.AuthorName + ", " + t.AuthorCompany
+	default:
+		return t.AuthorName
+	}
+}
@@ -0,0 +1,51 @@
+package entities
+
+import "time"
+
+// Trash item types are the names of the soft-deletable tables.
+const (
+	TrashTypeProject        = "projects"
+	TrashTypeSkill          = "skills"
+	TrashTypeExperience     = "experiences"
+	TrashTypeEducation      = "educations"
+	TrashTypeCertification  = "certifications"
+	TrashTypePublication    = "publications"
+	TrashTypeSpokenLanguage = "spoken_languages"
+	TrashTypeAward          = "awards"
+	TrashTypeVolunteering   = "volunteerings"
+	TrashTypeTestimonial    = "testimonials"
+	TrashTypeTechnology     = "technologies"
+	TrashTypePersonalInfo   = "personal_infos"
+)
+
+var TrashTypes = []string{
+	TrashTypeProject,
+	TrashTypeSkill,
+	TrashTypeExperience,
+	TrashTypeEducation,
+	TrashTypeCertification,
+	TrashTypePublication,
+	TrashTypeSpokenLanguage,
+	TrashTypeAward,
+	TrashTypeVolunteering,
+	TrashTypeTestimonial,
+	TrashTypeTechnology,
+	TrashTypePersonalInfo,
+}
+
+type TrashItem struct {
+	Type      string
+	ID        int
+	UserID    int
+	Label     string
+	DeletedAt time.Time
+}
+
+func IsValidTrashType(itemType string) bool {
+	for _, validType := range TrashTypes {
+		if itemType == validType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package entities
+
+import "time"
+
+// Volunteering is volunteer work for an organization. Without an end date
+// it is ongoing.
+type Volunteering struct {
+	VolunteeringID int
+	UserID         int
+	Role           string
+	Organization   string
+	Cause          string
+	StartDate      time.Time
+	EndDate        *time.Time
+	URL            string
+	Description    string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Version        int
+	Position       int
+	Publishing
+}
+
+func (v *Volunteering) HasRequiredFields() bool {
+	return v.Role != "" && v.Organization != "" && !v.StartDate.IsZero() && v.UserID > 0
+}
+
+func (v *Volunteering) BelongsToUser(userID int) bool {
+	return v.UserID == userID
+}
+
+func (v *Volunteering) MarkAsUpdated() {
+	v.UpdatedAt = time.Now()
+}
+
+func (v *Volunteering) HasEndDate() bool {
+	return v.EndDate != nil && !v.EndDate.IsZero()
+}
+
+func (v *Volunteering) IsCurrent() bool {
+	return !v.HasEndDate()
+}
+
+func (v *Volunteering) GetFullDescription() string {
+	return v.Role + " at " + v.Organization
+}
@@ -18,11 +18,15 @@ const (
 	ErrCodeUnauthorized  ErrorCode = "UNAUTHORIZED"
 	ErrCodeForbidden     ErrorCode = "FORBIDDEN"
 
+	ErrCodePreconditionFailed   ErrorCode = "PRECONDITION_FAILED"
+	ErrCodePreconditionRequired ErrorCode = "PRECONDITION_REQUIRED"
+
 	ErrCodeDatabase     ErrorCode = "DATABASE_ERROR"
 	ErrCodeInternal     ErrorCode = "INTERNAL_ERROR"
 	ErrCodeTimeout      ErrorCode = "TIMEOUT_ERROR"
 	ErrCodeRateLimit    ErrorCode = "RATE_LIMIT_ERROR"
 	ErrCodeTokenExpired ErrorCode = "TOKEN_EXPIRED"
+	ErrCodeUnavailable  ErrorCode = "SERVICE_UNAVAILABLE"
 )
 
 type DomainError struct {
@@ -56,10 +60,16 @@ func (e *DomainError) HTTPStatus() int {
 		return http.StatusUnauthorized
 	case ErrCodeForbidden:
 		return http.StatusForbidden
+	case ErrCodePreconditionFailed:
+		return http.StatusPreconditionFailed
+	case ErrCodePreconditionRequired:
+		return http.StatusPreconditionRequired
 	case ErrCodeTimeout:
 		return http.StatusRequestTimeout
 	case ErrCodeRateLimit:
 		return http.StatusTooManyRequests
+	case ErrCodeUnavailable:
+		return http.StatusServiceUnavailable
 	case ErrCodeDatabase, ErrCodeInternal:
 		return http.StatusInternalServerError
 	default:
@@ -85,6 +95,10 @@ func (e *DomainError) Title() string {
 		return "Unauthorized"
 	case ErrCodeForbidden:
 		return "Forbidden"
+	case ErrCodePreconditionFailed:
+		return "Precondition Failed"
+	case ErrCodePreconditionRequired:
+		return "Precondition Required"
 	case ErrCodeDatabase:
 		return "Database Error"
 	case ErrCodeInternal:
@@ -95,6 +109,8 @@ func (e *DomainError) Title() string {
 		return "Rate Limit Exceeded"
 	case ErrCodeTokenExpired:
 		return "Token Expired"
+	case ErrCodeUnavailable:
+		return "Service Unavailable"
 	default:
 		return "Unknown Error"
 	}
@@ -160,6 +176,25 @@ func NewAlreadyExistsError(resource string, identifier string) *DomainError {
 	}
 }
 
+func NewPreconditionFailedError(resource string, identifier string) *DomainError {
+	return &DomainError{
+		Code:    ErrCodePreconditionFailed,
+		Message: fmt.Sprintf("%s with identifier '%s' has been modified since it was read", resource, identifier),
+		Details: map[string]interface{}{
+			"resource":   resource,
+			"identifier": identifier,
+		},
+	}
+}
+
+func NewPreconditionRequiredError(header string) *DomainError {
+	return &DomainError{
+		Code:    ErrCodePreconditionRequired,
+		Message: fmt.Sprintf("This request must be conditional: send the %s header", header),
+		Field:   header,
+	}
+}
+
 func NewDatabaseError(operation string, cause error) *DomainError {
 	return &DomainError{
 		Code:    ErrCodeDatabase,
@@ -207,11 +242,43 @@ func NewTokenExpiredError(message string) *DomainError {
 	}
 }
 
+func NewServiceUnavailableError(message string) *DomainError {
+	return &DomainError{
+		Code:    ErrCodeUnavailable,
+		Message: message,
+	}
+}
+
 func IsDomainError(err error) bool {
 	_, ok := err.(*DomainError)
 	return ok
 }
 
+func IsPreconditionFailed(err error) bool {
+	domainErr, ok := AsDomainError(err)
+	return ok && domainErr.Code == ErrCodePreconditionFailed
+}
+
+func IsNotFound(err error) bool {
+	domainErr, ok := AsDomainError(err)
+	return ok && domainErr.Code == ErrCodeNotFound
+}
+
+// IsValidationError reports whether err is one of the errors about a bad
+// request field.
+func IsValidationError(err error) bool {
+	domainErr, ok := AsDomainError(err)
+	if !ok {
+		return false
+	}
+	switch domainErr.Code {
+	case ErrCodeValidation, ErrCodeRequiredField, ErrCodeInvalidFormat, ErrCodeInvalidLength:
+		return true
+	default:
+		return false
+	}
+}
+
 func AsDomainError(err error) (*DomainError, bool) {
 	domainErr, ok := err.(*DomainError)
 	return domainErr, ok
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, auditLog *entities.AuditLog) (*entities.AuditLog, error)
+	// List returns one page of matching records, newest first, and the total
+	// number of matches.
+	List(ctx context.Context, filter *entities.AuditLogFilter) ([]*entities.AuditLog, int, error)
+}
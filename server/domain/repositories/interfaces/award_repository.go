@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type AwardRepository interface {
+	Create(ctx context.Context, award *entities.Award) (*entities.Award, error)
+	// Update writes every field of the award but its publishing state, which
+	// is set through the PublishingRepository.
+	Update(ctx context.Context, awardID int, award *entities.Award) (*entities.Award, error)
+	Delete(ctx context.Context, awardID int) error
+
+	// GetByUserID returns one page of the user's live awards.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Award], error)
+	// Reorder gives the user's live awards the positions of their IDs in
+	// awardIDs, which must list each of them exactly once. Create appends
+	// new awards after the last one.
+	Reorder(ctx context.Context, userID int, awardIDs []int) error
+	GetByID(ctx context.Context, awardID int) (*entities.Award, error)
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type CertificationRepository interface {
+	Create(ctx context.Context, certification *entities.Certification) (*entities.Certification, error)
+	// Update writes every field of the certification but its publishing
+	// state, which is set through the PublishingRepository.
+	Update(ctx context.Context, certificationID int, certification *entities.Certification) (*entities.Certification, error)
+	Delete(ctx context.Context, certificationID int) error
+
+	// GetByUserID returns one page of the user's live certifications.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Certification], error)
+	// Reorder gives the user's live certifications the positions of their
+	// IDs in certificationIDs, which must list each of them exactly once.
+	// Create appends new certifications after the last one.
+	Reorder(ctx context.Context, userID int, certificationIDs []int) error
+	GetByID(ctx context.Context, certificationID int) (*entities.Certification, error)
+	// ExistsByCredential reports whether another certification of the user,
+	// live or trashed, has the credential ID from the same issuer.
+	ExistsByCredential(ctx context.Context, userID int, issuer, credentialID string, exceptCertificationID int) (bool, error)
+}
@@ -11,7 +11,12 @@ type EducationRepository interface {
 	Patch(ctx context.Context, educationID int, education *entities.Education) (*entities.Education, error)
 	Delete(ctx context.Context, educationID int) error
 
-	GetByUserID(ctx context.Context, userID int) ([]*entities.Education, error)
+	// GetByUserID returns one page of the user's live educations.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Education], error)
+	// Reorder gives the user's live educations the positions of their IDs in
+	// educationIDs, which must list each of them exactly once. Create appends
+	// new educations after the last one.
+	Reorder(ctx context.Context, userID int, educationIDs []int) error
 	GetByID(ctx context.Context, educationID int) (*entities.Education, error)
 	ExistsByID(ctx context.Context, educationID int) (bool, error)
 	ExistsByDegreeInstitutionAndUserID(ctx context.Context, degree, institution string, userID int) (bool, error)
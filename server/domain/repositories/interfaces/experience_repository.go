@@ -12,9 +12,20 @@ type ExperienceRepository interface {
 	Delete(ctx context.Context, experienceID int) error
 
 	GetByID(ctx context.Context, experienceID int) (*entities.Experience, error)
-	GetByUserID(ctx context.Context, userID int) ([]*entities.Experience, error)
+	// GetByUserID returns one page of the user's live experiences.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Experience], error)
+	// Reorder gives the user's live experiences the positions of their IDs in
+	// experienceIDs, which must list each of them exactly once. Create appends
+	// new experiences after the last one.
+	Reorder(ctx context.Context, userID int, experienceIDs []int) error
 	ExistsByID(ctx context.Context, experienceID int) (bool, error)
-	ExistsByJobTitleCompanyAndUserID(ctx context.Context, jobTitle, companyName string, userID int) (bool, error)
 	GetAll(ctx context.Context) ([]*entities.Experience, error)
 	GetCurrentExperiences(ctx context.Context, userID int) ([]*entities.Experience, error)
+
+	// SetAchievements replaces the achievement bullets of the experience, in
+	// the order given.
+	SetAchievements(ctx context.Context, experienceID int, achievements []string) error
+	// SetTechnologies replaces the technologies linked to the experience, in
+	// the order given.
+	SetTechnologies(ctx context.Context, experienceID int, technologies []*entities.Technology) error
 }
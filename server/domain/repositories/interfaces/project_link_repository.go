@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type ProjectLinkRepository interface {
+	// Create appends the link to the project's links. A primary link takes
+	// the flag from the project's other links.
+	Create(ctx context.Context, link *entities.ProjectLink) (*entities.ProjectLink, error)
+	// Update rewrites the link but not its position. A primary link takes
+	// the flag from the project's other links.
+	Update(ctx context.Context, linkID int, link *entities.ProjectLink) (*entities.ProjectLink, error)
+	Delete(ctx context.Context, linkID int) error
+
+	GetByID(ctx context.Context, linkID int) (*entities.ProjectLink, error)
+	// GetByProjectID returns the project's links by position.
+	GetByProjectID(ctx context.Context, projectID int) ([]*entities.ProjectLink, error)
+	// Reorder gives the project's links the positions of their IDs in
+	// linkIDs.
+	Reorder(ctx context.Context, projectID int, linkIDs []int) error
+}
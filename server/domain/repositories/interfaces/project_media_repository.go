@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type ProjectMediaRepository interface {
+	// Create appends the item to the project's gallery. A primary item
+	// takes the flag from the project's other items.
+	Create(ctx context.Context, media *entities.ProjectMedia) (*entities.ProjectMedia, error)
+	// Update rewrites the item but not its position. A primary item takes
+	// the flag from the project's other items.
+	Update(ctx context.Context, mediaID int, media *entities.ProjectMedia) (*entities.ProjectMedia, error)
+	Delete(ctx context.Context, mediaID int) error
+
+	GetByID(ctx context.Context, mediaID int) (*entities.ProjectMedia, error)
+	// GetByProjectID returns the project's gallery by position.
+	GetByProjectID(ctx context.Context, projectID int) ([]*entities.ProjectMedia, error)
+	// Reorder gives the project's items the positions of their IDs in
+	// mediaIDs.
+	Reorder(ctx context.Context, projectID int, mediaIDs []int) error
+}
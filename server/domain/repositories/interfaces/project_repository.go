@@ -12,5 +12,26 @@ type ProjectRepository interface {
 	Delete(ctx context.Context, projectID int) error
 
 	GetByID(ctx context.Context, projectID int) (*entities.Project, error)
-	GetAll(ctx context.Context, userID int) ([]*entities.Project, error)
+	// GetBySlug returns the user's live project named by slug or, failing
+	// that, the one formerly named by it.
+	GetBySlug(ctx context.Context, userID int, slug string) (*entities.Project, error)
+	// SlugExists reports whether slug names, or formerly named, a project of
+	// the user other than exceptProjectID, including trashed projects.
+	SlugExists(ctx context.Context, userID int, slug string, exceptProjectID int) (bool, error)
+	// RetireSlug keeps oldSlug as a former slug of the project, which is
+	// now named by its Slug, and forgets Slug as a former one.
+	RetireSlug(ctx context.Context, project *entities.Project, oldSlug string) error
+	// GetAll returns one page of the user's live projects.
+	GetAll(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Project], error)
+	// GetByTechnologyID returns one page of the user's live projects linked
+	// to the technology.
+	GetByTechnologyID(ctx context.Context, userID, technologyID int, query *entities.ListQuery) (*entities.ListPage[*entities.Project], error)
+	// Reorder gives the user's live projects the positions of their IDs in
+	// projectIDs, which must list each of them exactly once. Create appends new
+	// projects after the last one.
+	Reorder(ctx context.Context, userID int, projectIDs []int) error
+
+	// SetTechnologies replaces the technologies linked to the project, in
+	// order, and stores their names as the project's technologies text.
+	SetTechnologies(ctx context.Context, projectID int, technologies []*entities.Technology) error
 }
@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type PublicationRepository interface {
+	// Create and Update leave the co-authors alone; they are written by
+	// SetCoAuthors.
+	Create(ctx context.Context, publication *entities.Publication) (*entities.Publication, error)
+	// Update writes every field of the publication but its publishing
+	// state, which is set through the PublishingRepository.
+	Update(ctx context.Context, publicationID int, publication *entities.Publication) (*entities.Publication, error)
+	Delete(ctx context.Context, publicationID int) error
+
+	// GetByUserID returns one page of the user's live publications.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Publication], error)
+	// Reorder gives the user's live publications the positions of their IDs
+	// in publicationIDs, which must list each of them exactly once. Create
+	// appends new publications after the last one.
+	Reorder(ctx context.Context, userID int, publicationIDs []int) error
+	GetByID(ctx context.Context, publicationID int) (*entities.Publication, error)
+
+	// SetCoAuthors replaces the co-authors of the publication with
+	// coAuthors, in order.
+	SetCoAuthors(ctx context.Context, publicationID int, coAuthors []string) error
+}
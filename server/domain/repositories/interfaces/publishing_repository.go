@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+	"time"
+)
+
+type PublishingRepository interface {
+	// GetAll returns the publishing state of every live item, for every user.
+	GetAll(ctx context.Context) ([]*entities.PublishingItem, error)
+	Get(ctx context.Context, itemType string, id int) (*entities.PublishingItem, error)
+	// GetDue returns the live items whose publishing state the scheduler has to
+	// move at now; see entities.Publishing.Due.
+	GetDue(ctx context.Context, now time.Time) ([]*entities.PublishingItem, error)
+	// Set replaces the publishing state of a live item and bumps its version.
+	Set(ctx context.Context, itemType string, id int, publishing entities.Publishing) error
+}
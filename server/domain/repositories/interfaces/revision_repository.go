@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type RevisionRepository interface {
+	Create(ctx context.Context, revision *entities.Revision) (*entities.Revision, error)
+	GetByID(ctx context.Context, revisionID int) (*entities.Revision, error)
+	GetByEntity(ctx context.Context, entityType string, entityID int) ([]*entities.Revision, error)
+}
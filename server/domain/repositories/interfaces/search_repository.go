@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type SearchRepository interface {
+	// Search returns up to query.Limit hits of each type, in the order of
+	// entities.SearchTypes and best match first within a type. Soft-deleted
+	// rows never match.
+	Search(ctx context.Context, query entities.SearchQuery) ([]*entities.SearchHit, error)
+}
@@ -5,8 +5,14 @@ import (
 	"portfolio/domain/entities"
 )
 
+// SettingRepository stores settings as raw JSON documents, one per namespace.
+// Decoding and schema upgrades belong to the setting use case.
 type SettingRepository interface {
-	GetSettings(ctx context.Context) (*entities.SettingJson, error)
-	Upsert(ctx context.Context, settingJson *entities.SettingJson) error
+	// GetNamespace returns the document stored for namespace, or nil if none is.
+	GetNamespace(ctx context.Context, namespace entities.SettingNamespace) ([]byte, error)
+	UpsertNamespace(ctx context.Context, namespace entities.SettingNamespace, document []byte) error
+	// GetLegacy returns the flat document written before settings had
+	// namespaces, or nil if there is none.
+	GetLegacy(ctx context.Context) ([]byte, error)
 	Delete(ctx context.Context) error
 }
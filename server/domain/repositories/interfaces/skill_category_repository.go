@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type SkillCategoryRepository interface {
+	// Create appends the category after the user's last one.
+	Create(ctx context.Context, category *entities.SkillCategory) (*entities.SkillCategory, error)
+	// Update renames the category but keeps its position.
+	Update(ctx context.Context, categoryID int, category *entities.SkillCategory) (*entities.SkillCategory, error)
+	// Delete removes the category; its skills become uncategorized.
+	Delete(ctx context.Context, categoryID int) error
+
+	GetByID(ctx context.Context, categoryID int) (*entities.SkillCategory, error)
+	// GetByUserID returns the user's categories by position.
+	GetByUserID(ctx context.Context, userID int) ([]*entities.SkillCategory, error)
+	ExistsByNameAndUserID(ctx context.Context, name string, userID int, exceptCategoryID int) (bool, error)
+	// Reorder gives the user's categories the positions of their IDs in
+	// categoryIDs, which must list each of them exactly once.
+	Reorder(ctx context.Context, userID int, categoryIDs []int) error
+}
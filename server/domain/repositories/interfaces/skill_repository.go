@@ -11,9 +11,21 @@ type SkillRepository interface {
 	Patch(ctx context.Context, skillID int, skill *entities.Skill) (*entities.Skill, error)
 	Delete(ctx context.Context, skillID int) error
 
-	GetByUserID(ctx context.Context, userID int) ([]*entities.Skill, error)
+	// GetByUserID returns one page of the user's live skills.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Skill], error)
+	// Reorder gives the user's live skills the positions of their IDs in
+	// skillIDs, which must list each of them exactly once. Create appends new
+	// skills after the last one.
+	Reorder(ctx context.Context, userID int, skillIDs []int) error
 	GetByID(ctx context.Context, skillID int) (*entities.Skill, error)
 	ExistsByID(ctx context.Context, skillID int) (bool, error)
 	ExistsByNameAndUserID(ctx context.Context, name string, userID int) (bool, error)
 	GetAll(ctx context.Context) ([]*entities.Skill, error)
+
+	// SetProjects links the skill to the projects as evidence, in order,
+	// replacing its earlier project links.
+	SetProjects(ctx context.Context, skillID int, projectIDs []int) error
+	// SetExperiences links the skill to the experiences as evidence, in
+	// order, replacing its earlier experience links.
+	SetExperiences(ctx context.Context, skillID int, experienceIDs []int) error
 }
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type SpokenLanguageRepository interface {
+	Create(ctx context.Context, spokenLanguage *entities.SpokenLanguage) (*entities.SpokenLanguage, error)
+	// Update writes every field of the spoken language but its publishing
+	// state, which is set through the PublishingRepository.
+	Update(ctx context.Context, spokenLanguageID int, spokenLanguage *entities.SpokenLanguage) (*entities.SpokenLanguage, error)
+	Delete(ctx context.Context, spokenLanguageID int) error
+
+	// GetByUserID returns one page of the user's live spoken languages.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.SpokenLanguage], error)
+	// Reorder gives the user's live spoken languages the positions of their
+	// IDs in spokenLanguageIDs, which must list each of them exactly once.
+	// Create appends new spoken languages after the last one.
+	Reorder(ctx context.Context, userID int, spokenLanguageIDs []int) error
+	GetByID(ctx context.Context, spokenLanguageID int) (*entities.SpokenLanguage, error)
+	// ExistsByName reports whether another spoken language of the user,
+	// live or trashed, has the name, ignoring case.
+	ExistsByName(ctx context.Context, userID int, name string, exceptSpokenLanguageID int) (bool, error)
+}
@@ -12,9 +12,16 @@ type TechnologyRepository interface {
 	Delete(ctx context.Context, technologyID int) error
 
 	GetByID(ctx context.Context, technologyID int) (*entities.Technology, error)
-	GetByUserID(ctx context.Context, userID int) ([]*entities.Technology, error)
+	// GetByUserID returns one page of the user's live technologies.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Technology], error)
+	// Reorder gives the user's live technologies the positions of their IDs in
+	// technologyIDs, which must list each of them exactly once. Create appends
+	// new technologies after the last one.
+	Reorder(ctx context.Context, userID int, technologyIDs []int) error
 	ExistsByID(ctx context.Context, technologyID int) (bool, error)
 	ExistsByNameAndUserID(ctx context.Context, name string, userID int) (bool, error)
 	GetAll(ctx context.Context) ([]*entities.Technology, error)
+	// GetByNames returns the user's live technologies whose name matches one
+	// of names once both are normalized (see entities.NormalizeTechnologyName).
 	GetByNames(ctx context.Context, names []string, userID int) ([]*entities.Technology, error)
 }
@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type TestimonialRepository interface {
+	Create(ctx context.Context, testimonial *entities.Testimonial) (*entities.Testimonial, error)
+	// Update writes the author, the content and the links of the
+	// testimonial but not its status, which is set by SetStatus.
+	Update(ctx context.Context, testimonialID int, testimonial *entities.Testimonial) (*entities.Testimonial, error)
+	// SetStatus writes the status, the rejection reason and the moderation
+	// time of the testimonial.
+	SetStatus(ctx context.Context, testimonialID int, testimonial *entities.Testimonial) (*entities.Testimonial, error)
+	Delete(ctx context.Context, testimonialID int) error
+
+	// GetByUserID returns one page of the user's live testimonials.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Testimonial], error)
+	// GetByAuthorEmail returns the user's live testimonials from an author
+	// email, compared without case.
+	GetByAuthorEmail(ctx context.Context, userID int, email string) ([]*entities.Testimonial, error)
+	// Reorder gives the user's live testimonials the positions of their IDs
+	// in testimonialIDs, which must list each of them exactly once. Create
+	// appends new testimonials after the last one.
+	Reorder(ctx context.Context, userID int, testimonialIDs []int) error
+	GetByID(ctx context.Context, testimonialID int) (*entities.Testimonial, error)
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+	"time"
+)
+
+type TrashRepository interface {
+	GetAll(ctx context.Context) ([]*entities.TrashItem, error)
+	Restore(ctx context.Context, itemType string, id int) error
+	Purge(ctx context.Context, itemType string, id int) error
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
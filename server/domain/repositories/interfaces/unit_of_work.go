@@ -0,0 +1,10 @@
+package interfaces
+
+import "context"
+
+// UnitOfWork runs several repository calls as one transaction. Repositories
+// called with the context handed to fn take part in it; fn returning an error
+// rolls everything back. Nested calls join the outer unit of work.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
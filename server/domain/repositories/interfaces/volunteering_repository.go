@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+	"portfolio/domain/entities"
+)
+
+type VolunteeringRepository interface {
+	Create(ctx context.Context, volunteering *entities.Volunteering) (*entities.Volunteering, error)
+	// Update writes every field of the volunteering but its publishing
+	// state, which is set through the PublishingRepository.
+	Update(ctx context.Context, volunteeringID int, volunteering *entities.Volunteering) (*entities.Volunteering, error)
+	Delete(ctx context.Context, volunteeringID int) error
+
+	// GetByUserID returns one page of the user's live volunteerings.
+	GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Volunteering], error)
+	// Reorder gives the user's live volunteerings the positions of their IDs
+	// in volunteeringIDs, which must list each of them exactly once. Create
+	// appends new volunteerings after the last one.
+	Reorder(ctx context.Context, userID int, volunteeringIDs []int) error
+	GetByID(ctx context.Context, volunteeringID int) (*entities.Volunteering, error)
+}
@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxAuditPageSize caps one page of GET /admin/audit; exports are not paged.
+	MaxAuditPageSize = 200
+	// maxAuditSummaryLength keeps before/after summaries readable in a CSV.
+	maxAuditSummaryLength = 1000
+)
+
+type auditFrameKey struct{}
+
+// AuditFrame collects what the use cases learn about an admin write while it
+// runs: the resources it touched and their state before and after. The audit
+// middleware opens one per request and records it once the write succeeded.
+type AuditFrame struct {
+	action       string
+	resourceType string
+	resourceIDs  []string
+	before       []string
+	after        []string
+}
+
+// WithAuditFrame returns a context carrying a new, empty frame.
+func WithAuditFrame(ctx context.Context) (context.Context, *AuditFrame) {
+	frame := &AuditFrame{}
+	return context.WithValue(ctx, auditFrameKey{}, frame), frame
+}
+
+func auditFrameFrom(ctx context.Context) *AuditFrame {
+	frame, _ := ctx.Value(auditFrameKey{}).(*AuditFrame)
+	return frame
+}
+
+// auditResource notes a resource touched by the current admin write. Bulk
+// writes note one ID per item.
+func auditResource(ctx context.Context, resourceType, resourceID string) {
+	if frame := auditFrameFrom(ctx); frame != nil {
+		frame.resourceType = resourceType
+		frame.resourceIDs = append(frame.resourceIDs, resourceID)
+	}
+}
+
+// auditAction names the write when the request path alone is ambiguous.
+func auditAction(ctx context.Context, action string) {
+	if frame := auditFrameFrom(ctx); frame != nil {
+		frame.action = action
+	}
+}
+
+// auditBefore notes the state a write is about to replace.
+func auditBefore(ctx context.Context, state any) {
+	if frame := auditFrameFrom(ctx); frame != nil {
+		frame.before = append(frame.before, auditSummary(state))
+	}
+}
+
+// auditAfter notes the state a write produced.
+func auditAfter(ctx context.Context, state any) {
+	if frame := auditFrameFrom(ctx); frame != nil {
+		frame.after = append(frame.after, auditSummary(state))
+	}
+}
+
+func auditSummary(state any) string {
+	summary, err := json.Marshal(state)
+	if err != nil {
+		return ""
+	}
+	return string(summary)
+}
+
+// joinAuditSummaries renders one summary as is and several as a JSON array,
+// truncated to maxAuditSummaryLength.
+func joinAuditSummaries(summaries []string) string {
+	joined := ""
+	switch len(summaries) {
+	case 0:
+	case 1:
+		joined = summaries[0]
+	default:
+		joined = "[" + strings.Join(summaries, ",") + "]"
+	}
+
+	if len(joined) > maxAuditSummaryLength {
+		joined = joined[:maxAuditSummaryLength] + "..."
+	}
+	return joined
+}
+
+type AuditUseCase struct {
+	auditLogRepo interfaces.AuditLogRepository
+	logger       *logger.Logger
+}
+
+func NewAuditUseCase(auditLogRepo interfaces.AuditLogRepository, logger *logger.Logger) *AuditUseCase {
+	return &AuditUseCase{
+		auditLogRepo: auditLogRepo,
+		logger:       logger,
+	}
+}
+
+// Record appends an audit record. What the use cases noted in frame takes
+// precedence over the resource the caller derived from the request.
+func (uc *AuditUseCase) Record(ctx context.Context, frame *AuditFrame, auditLog *entities.AuditLog) error {
+	if frame != nil {
+		if frame.action != "" {
+			auditLog.Action = frame.action
+		}
+		if frame.resourceType != "" {
+			auditLog.ResourceType = frame.resourceType
+			auditLog.ResourceID = strings.Join(frame.resourceIDs, ",")
+		}
+		auditLog.Before = joinAuditSummaries(frame.before)
+		auditLog.After = joinAuditSummaries(frame.after)
+	}
+
+	if _, err := uc.auditLogRepo.Create(ctx, auditLog); err != nil {
+		uc.logger.Error("Failed to record audit log for %s %s: %v", auditLog.Action, auditLog.ResourceType, err)
+		return err
+	}
+	return nil
+}
+
+// RecordPublishingEvent records a state change made by the publishing
+// scheduler, which has no actor, IP or request.
+func (uc *AuditUseCase) RecordPublishingEvent(ctx context.Context, event *entities.PublishingEvent) error {
+	action := "unpublish"
+	if event.To == entities.PublishingStatePublished {
+		action = "publish"
+	}
+
+	return uc.Record(ctx, nil, &entities.AuditLog{
+		Action:       action,
+		ResourceType: event.Type,
+		ResourceID:   strconv.Itoa(event.ID),
+		Before:       auditSummary(map[string]string{"state": event.From}),
+		After:        auditSummary(map[string]string{"state": event.To}),
+	})
+}
+
+// GetAuditLogs returns one page of audit records, newest first, and the total
+// number of matches. A zero Limit returns every match, for exports.
+func (uc *AuditUseCase) GetAuditLogs(ctx context.Context, filter *entities.AuditLogFilter) ([]*entities.AuditLog, int, error) {
+	if filter.Limit < 0 || filter.Limit > MaxAuditPageSize {
+		uc.logger.Error("Invalid audit page size: %d", filter.Limit)
+		return nil, 0, domain.NewValidationError("Page size must be between 1 and 200", "per_page", nil)
+	}
+
+	if filter.Offset < 0 {
+		uc.logger.Error("Invalid audit offset: %d", filter.Offset)
+		return nil, 0, domain.NewValidationError("Page must be a positive integer", "page", nil)
+	}
+
+	if filter.Since != nil && filter.Until != nil && !filter.Since.Before(*filter.Until) {
+		uc.logger.Error("Invalid audit time range: %v - %v", filter.Since, filter.Until)
+		return nil, 0, domain.NewValidationError("since must be before until", "since", nil)
+	}
+
+	auditLogs, total, err := uc.auditLogRepo.List(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to list audit logs: %v", err)
+		return nil, 0, err
+	}
+
+	return auditLogs, total, nil
+}
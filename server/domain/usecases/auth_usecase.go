@@ -140,7 +140,7 @@ func (uc *AuthUseCase) CreateDefaultAdmin(ctx context.Context, username string)
 		return err
 	}
 
-	err = uc.settingUseCase.Upsert(ctx, &entities.SettingJson{
+	err = uc.settingUseCase.UpdateDisplaySettings(ctx, &entities.DisplaySettings{
 		ShowProjects: true,
 	})
 
@@ -0,0 +1,260 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	awardDto "portfolio/dto/award"
+	orderDto "portfolio/dto/order"
+	"portfolio/logger"
+	"portfolio/service"
+	"time"
+)
+
+type AwardUseCase struct {
+	awardRepo    interfaces.AwardRepository
+	userRepo     interfaces.UserRepository
+	revisionRepo interfaces.RevisionRepository
+	unitOfWork   interfaces.UnitOfWork
+	cache        *service.CacheService
+	logger       *logger.Logger
+}
+
+func NewAwardUseCase(awardRepo interfaces.AwardRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *AwardUseCase {
+	return &AwardUseCase{
+		awardRepo:    awardRepo,
+		userRepo:     userRepo,
+		revisionRepo: revisionRepo,
+		unitOfWork:   unitOfWork,
+		cache:        cache,
+		logger:       logger,
+	}
+}
+
+func (uc *AwardUseCase) CreateAward(ctx context.Context, award *entities.Award) (*entities.Award, error) {
+	if err := uc.checkFields(award); err != nil {
+		return nil, err
+	}
+
+	userExists, err := uc.userRepo.ExistsByID(ctx, award.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to check if user exists: %v", err)
+		return nil, domain.NewInternalError("failed to validate user", err)
+	}
+	if !userExists {
+		uc.logger.Error("User not found for ID %d", award.UserID)
+		return nil, domain.NewNotFoundError("User", fmt.Sprint(award.UserID))
+	}
+
+	award.SetDefaultState(time.Now())
+	createdAward, err := uc.awardRepo.Create(ctx, award)
+	if err != nil {
+		uc.logger.Error("Failed to create award: %v", err)
+		return nil, domain.NewInternalError("failed to create award", err)
+	}
+
+	uc.snapshotRevision(ctx, createdAward.AwardID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespaceAwards, createdAward.UserID)
+	return createdAward, nil
+}
+
+// CreateAwardsAtomically creates every award or none: the first failure
+// rolls back the awards created before it.
+func (uc *AwardUseCase) CreateAwardsAtomically(ctx context.Context, awards []*entities.Award) ([]*entities.Award, error) {
+	defer invalidate(uc.cache, cacheNamespaceAwards, batchOwners(awards, awardOwner)...)
+
+	var createdAwards []*entities.Award
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, award := range awards {
+			createdAward, err := uc.CreateAward(ctx, award)
+			if err != nil {
+				uc.logger.Error("Failed to create award at index %d (title: %s), rolling back: %v", i, award.Title, err)
+				return err
+			}
+			createdAwards = append(createdAwards, createdAward)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdAwards, nil
+}
+
+func (uc *AwardUseCase) GetAwardByID(ctx context.Context, awardID int) (*entities.Award, error) {
+	if awardID <= 0 {
+		uc.logger.Error("Invalid award ID: %d", awardID)
+		return nil, domain.NewValidationError("Award ID must be positive", "awardID", nil)
+	}
+
+	award, err := readItem(uc.cache, itemCacheKey(cacheNamespaceAwards, awardID), awardOwner, func() (*entities.Award, error) {
+		return uc.awardRepo.GetByID(ctx, awardID)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get award by ID %d: %v", awardID, err)
+		return nil, domain.NewInternalError("failed to retrieve award", err)
+	}
+
+	if award == nil {
+		uc.logger.Error("Award not found for ID %d", awardID)
+		return nil, domain.NewNotFoundError("Award", fmt.Sprint(awardID))
+	}
+
+	return award, nil
+}
+
+func (uc *AwardUseCase) GetAwardsByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Award], error) {
+	if userID <= 0 {
+		uc.logger.Error("Invalid user ID: %d", userID)
+		return nil, domain.NewValidationError("User ID must be positive", "userID", nil)
+	}
+
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespaceAwards, userID, query), userID, func() (*entities.ListPage[*entities.Award], error) {
+		return uc.awardRepo.GetByUserID(ctx, userID, query)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get awards for user %d: %v", userID, err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve awards", err))
+	}
+
+	return page, nil
+}
+
+func (uc *AwardUseCase) UpdateAward(ctx context.Context, awardID int, award *entities.Award) (*entities.Award, error) {
+	if err := uc.checkFields(award); err != nil {
+		return nil, err
+	}
+
+	existingAward, err := uc.awardRepo.GetByID(ctx, awardID)
+	if err != nil {
+		uc.logger.Error("Failed to check if award exists: %v", err)
+		return nil, domain.NewInternalError("failed to check award existence", err)
+	}
+	if existingAward == nil {
+		uc.logger.Error("Award not found for ID %d", awardID)
+		return nil, domain.NewNotFoundError("Award", fmt.Sprint(awardID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Award", awardID, existingAward.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingAward)
+
+	return uc.save(ctx, awardID, award, entities.RevisionActionUpdate)
+}
+
+func (uc *AwardUseCase) PatchAward(ctx context.Context, awardID int, req *awardDto.PatchAwardRequest) (*entities.Award, error) {
+	if awardID <= 0 {
+		uc.logger.Error("Award ID is required")
+		return nil, domain.NewValidationError("Award ID must be positive", "awardID", nil)
+	}
+
+	existingAward, err := uc.awardRepo.GetByID(ctx, awardID)
+	if err != nil {
+		uc.logger.Error("Failed to get award by ID %d: %v", awardID, err)
+		return nil, domain.NewInternalError("failed to get award", err)
+	}
+	if existingAward == nil {
+		uc.logger.Error("Award not found: %d", awardID)
+		return nil, domain.NewNotFoundError("Award", fmt.Sprint(awardID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Award", awardID, existingAward.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingAward)
+
+	patched := *existingAward
+	req.ApplyTo(&patched)
+
+	return uc.save(ctx, awardID, &patched, entities.RevisionActionPatch)
+}
+
+// save writes the fields of award over the stored ones, for both updates
+// and patches.
+func (uc *AwardUseCase) save(ctx context.Context, awardID int, award *entities.Award, action string) (*entities.Award, error) {
+	award.MarkAsUpdated()
+	savedAward, err := uc.awardRepo.Update(ctx, awardID, award)
+	if err != nil {
+		uc.logger.Error("Failed to update award: %v", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update award", err))
+	}
+
+	uc.snapshotRevision(ctx, awardID, action)
+	invalidate(uc.cache, cacheNamespaceAwards, savedAward.UserID)
+	return savedAward, nil
+}
+
+func (uc *AwardUseCase) DeleteAward(ctx context.Context, awardID int) error {
+	if awardID <= 0 {
+		uc.logger.Error("Invalid award ID: %d", awardID)
+		return domain.NewValidationError("Award ID must be positive", "awardID", nil)
+	}
+
+	existingAward, err := uc.awardRepo.GetByID(ctx, awardID)
+	if err != nil {
+		uc.logger.Error("Failed to check if award exists: %v", err)
+		return domain.NewInternalError("failed to check award existence", err)
+	}
+	if existingAward == nil {
+		uc.logger.Error("Award not found for ID %d", awardID)
+		return domain.NewNotFoundError("Award", fmt.Sprint(awardID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Award", awardID, existingAward.Version); err != nil {
+		return err
+	}
+
+	if err := uc.awardRepo.Delete(ctx, awardID); err != nil {
+		uc.logger.Error("Failed to delete award: %v", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete award", err))
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeAward, awardID, entities.RevisionActionDelete, existingAward)
+	invalidate(uc.cache, cacheNamespaceAwards, existingAward.UserID)
+	return nil
+}
+
+// ReorderAwards moves the user's awards into the order of req, which must
+// list every live one of them exactly once. Lists follow that order unless
+// another sort is requested.
+func (uc *AwardUseCase) ReorderAwards(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.awardRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder awards of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceAwards, userID)
+	return nil
+}
+
+func (uc *AwardUseCase) checkFields(award *entities.Award) error {
+	if !award.HasRequiredFields() {
+		uc.logger.Error("Invalid award fields: %v", award)
+		return domain.NewValidationError("Title, issuer, date and user ID are required", "award", nil)
+	}
+	return nil
+}
+
+// snapshotRevision records the stored state of the award as a revision.
+func (uc *AwardUseCase) snapshotRevision(ctx context.Context, awardID int, action string) {
+	award, err := uc.awardRepo.GetByID(ctx, awardID)
+	if err != nil || award == nil {
+		uc.logger.Error("Failed to load award %d for revision: %v", awardID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeAward, awardID, action, award)
+}
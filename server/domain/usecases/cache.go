@@ -0,0 +1,124 @@
+package usecases
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/service"
+	"slices"
+	"strconv"
+)
+
+// Cache namespaces, one per table read by the public handlers. Every write
+// through a use case invalidates its own namespace for the owner of the rows
+// it wrote, plus the namespaces that embed its rows (see invalidate). They match the table names, and therefore
+// the trash item types.
+const (
+	cacheNamespaceSettings        = "settings"
+	cacheNamespacePersonalInfo    = "personal_infos"
+	cacheNamespaceProjects        = "projects"
+	cacheNamespaceSkills          = "skills"
+	cacheNamespaceExperiences     = "experiences"
+	cacheNamespaceEducations      = "educations"
+	cacheNamespaceCertifications  = "certifications"
+	cacheNamespacePublications    = "publications"
+	cacheNamespaceSpokenLanguages = "spoken_languages"
+	cacheNamespaceAwards          = "awards"
+	cacheNamespaceVolunteerings   = "volunteerings"
+	cacheNamespaceTestimonials    = "testimonials"
+	cacheNamespaceTechnologies    = "technologies"
+)
+
+// sharedOwner is the owner the settings are cached and invalidated for, as
+// they are not owned by a user.
+const sharedOwner = 0
+
+// readThrough returns the cached value for key or calls load and caches its
+// result on behalf of ownerID, the user whose rows it holds. Errors are never
+// cached, and neither are values loaded while their namespace was invalidated.
+func readThrough[T any](cache *service.CacheService, key string, ownerID int, load func() (T, error)) (T, error) {
+	if value, ok := cache.Get(key); ok {
+		if typed, ok := value.(T); ok {
+			return typed, nil
+		}
+	}
+
+	generation := cache.Generation(key)
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	cache.SetIfCurrent(key, ownerID, generation, value)
+	return value, nil
+}
+
+// readItem is readThrough for a row looked up by ID, whose owner is only known
+// once it is loaded. A missing row is not cached.
+func readItem[E any](cache *service.CacheService, key string, ownerID func(*E) int, load func() (*E, error)) (*E, error) {
+	if value, ok := cache.Get(key); ok {
+		if typed, ok := value.(*E); ok {
+			return typed, nil
+		}
+	}
+
+	generation := cache.Generation(key)
+	value, err := load()
+	if err != nil || value == nil {
+		return value, err
+	}
+
+	cache.SetIfCurrent(key, ownerID(value), generation, value)
+	return value, nil
+}
+
+// embeddingNamespaces lists, per namespace, the namespaces whose cached
+// values embed its rows: projects and experiences embed their technologies,
+// skills the projects and experiences linked to them as evidence, and
+// testimonials the project and the experience they are about.
+var embeddingNamespaces = map[string][]string{
+	cacheNamespaceTechnologies: {cacheNamespaceProjects, cacheNamespaceExperiences},
+	cacheNamespaceProjects:     {cacheNamespaceSkills, cacheNamespaceTestimonials},
+	cacheNamespaceExperiences:  {cacheNamespaceSkills, cacheNamespaceTestimonials},
+}
+
+// invalidate drops the values of namespace cached on behalf of ownerIDs, and
+// their values of the namespaces that embed its rows. Rows only ever embed
+// rows of the same owner.
+func invalidate(cache *service.CacheService, namespace string, ownerIDs ...int) {
+	cache.InvalidateOwner(namespace, ownerIDs...)
+	for _, embedding := range embeddingNamespaces[namespace] {
+		cache.InvalidateOwner(embedding, ownerIDs...)
+	}
+}
+
+// batchOwners returns the owners of the rows of a batch, to invalidate once
+// the batch has committed.
+func batchOwners[E any](rows []*E, ownerID func(*E) int) []int {
+	owners := make([]int, 0, 1)
+	for _, row := range rows {
+		if owner := ownerID(row); !slices.Contains(owners, owner) {
+			owners = append(owners, owner)
+		}
+	}
+	return owners
+}
+
+// Owners of the rows cached by ID, for readItem and batchOwners.
+func awardOwner(award *entities.Award) int                            { return award.UserID }
+func certificationOwner(certification *entities.Certification) int    { return certification.UserID }
+func educationOwner(education *entities.Education) int                { return education.UserID }
+func experienceOwner(experience *entities.Experience) int             { return experience.UserID }
+func projectOwner(project *entities.Project) int                      { return project.UserID }
+func publicationOwner(publication *entities.Publication) int          { return publication.UserID }
+func skillOwner(skill *entities.Skill) int                            { return skill.UserID }
+func spokenLanguageOwner(spokenLanguage *entities.SpokenLanguage) int { return spokenLanguage.UserID }
+func technologyOwner(technology *entities.Technology) int             { return technology.UserID }
+func testimonialOwner(testimonial *entities.Testimonial) int          { return testimonial.UserID }
+func volunteeringOwner(volunteering *entities.Volunteering) int       { return volunteering.UserID }
+
+func listCacheKey(namespace string, userID int, query *entities.ListQuery) string {
+	return service.CacheKey(namespace, "list", strconv.Itoa(userID), query.String())
+}
+
+func itemCacheKey(namespace string, id int) string {
+	return service.CacheKey(namespace, "item", strconv.Itoa(id))
+}
@@ -0,0 +1,287 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	certificationDto "portfolio/dto/certification"
+	orderDto "portfolio/dto/order"
+	"portfolio/logger"
+	"portfolio/service"
+	"time"
+)
+
+type CertificationUseCase struct {
+	certificationRepo interfaces.CertificationRepository
+	userRepo          interfaces.UserRepository
+	revisionRepo      interfaces.RevisionRepository
+	unitOfWork        interfaces.UnitOfWork
+	cache             *service.CacheService
+	logger            *logger.Logger
+}
+
+func NewCertificationUseCase(certificationRepo interfaces.CertificationRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *CertificationUseCase {
+	return &CertificationUseCase{
+		certificationRepo: certificationRepo,
+		userRepo:          userRepo,
+		revisionRepo:      revisionRepo,
+		unitOfWork:        unitOfWork,
+		cache:             cache,
+		logger:            logger,
+	}
+}
+
+func (uc *CertificationUseCase) CreateCertification(ctx context.Context, certification *entities.Certification) (*entities.Certification, error) {
+	if !certification.HasRequiredFields() {
+		uc.logger.Error("Invalid certification fields: %v", certification)
+		return nil, domain.NewValidationError("Name, issuer, issue date and user ID are required", "certification", nil)
+	}
+
+	userExists, err := uc.userRepo.ExistsByID(ctx, certification.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to check if user exists: %v", err)
+		return nil, domain.NewInternalError("failed to validate user", err)
+	}
+	if !userExists {
+		uc.logger.Error("User not found for ID %d", certification.UserID)
+		return nil, domain.NewNotFoundError("User", fmt.Sprint(certification.UserID))
+	}
+
+	if err := uc.checkCredentialAvailable(ctx, certification, 0); err != nil {
+		return nil, err
+	}
+
+	certification.SetDefaultState(time.Now())
+	createdCertification, err := uc.certificationRepo.Create(ctx, certification)
+	if err != nil {
+		uc.logger.Error("Failed to create certification: %v", err)
+		return nil, domain.NewInternalError("failed to create certification", err)
+	}
+
+	uc.snapshotRevision(ctx, createdCertification.CertificationID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespaceCertifications, createdCertification.UserID)
+	return createdCertification, nil
+}
+
+// CreateCertificationsAtomically creates every certification or none: the
+// first failure rolls back the certifications created before it.
+func (uc *CertificationUseCase) CreateCertificationsAtomically(ctx context.Context, certifications []*entities.Certification) ([]*entities.Certification, error) {
+	defer invalidate(uc.cache, cacheNamespaceCertifications, batchOwners(certifications, certificationOwner)...)
+
+	var createdCertifications []*entities.Certification
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, certification := range certifications {
+			createdCertification, err := uc.CreateCertification(ctx, certification)
+			if err != nil {
+				uc.logger.Error("Failed to create certification at index %d (name: %s), rolling back: %v", i, certification.Name, err)
+				return err
+			}
+			createdCertifications = append(createdCertifications, createdCertification)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdCertifications, nil
+}
+
+func (uc *CertificationUseCase) GetCertificationByID(ctx context.Context, certificationID int) (*entities.Certification, error) {
+	if certificationID <= 0 {
+		uc.logger.Error("Invalid certification ID: %d", certificationID)
+		return nil, domain.NewValidationError("Certification ID must be positive", "certificationID", nil)
+	}
+
+	certification, err := readItem(uc.cache, itemCacheKey(cacheNamespaceCertifications, certificationID), certificationOwner, func() (*entities.Certification, error) {
+		return uc.certificationRepo.GetByID(ctx, certificationID)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get certification by ID %d: %v", certificationID, err)
+		return nil, domain.NewInternalError("failed to retrieve certification", err)
+	}
+
+	if certification == nil {
+		uc.logger.Error("Certification not found for ID %d", certificationID)
+		return nil, domain.NewNotFoundError("Certification", fmt.Sprint(certificationID))
+	}
+
+	return certification, nil
+}
+
+// GetCertificationsByUserID lists the user's certifications. The expiry
+// filter is evaluated on the current date, so cached pages of it can lag
+// behind by up to the cache TTL.
+func (uc *CertificationUseCase) GetCertificationsByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Certification], error) {
+	if userID <= 0 {
+		uc.logger.Error("Invalid user ID: %d", userID)
+		return nil, domain.NewValidationError("User ID must be positive", "userID", nil)
+	}
+
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespaceCertifications, userID, query), userID, func() (*entities.ListPage[*entities.Certification], error) {
+		return uc.certificationRepo.GetByUserID(ctx, userID, query)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get certifications for user %d: %v", userID, err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve certifications", err))
+	}
+
+	return page, nil
+}
+
+func (uc *CertificationUseCase) UpdateCertification(ctx context.Context, certificationID int, certification *entities.Certification) (*entities.Certification, error) {
+	if !certification.HasRequiredFields() {
+		uc.logger.Error("Invalid certification fields: %v", certification)
+		return nil, domain.NewValidationError("Name, issuer, issue date and user ID are required", "certification", nil)
+	}
+
+	existingCertification, err := uc.certificationRepo.GetByID(ctx, certificationID)
+	if err != nil {
+		uc.logger.Error("Failed to check if certification exists: %v", err)
+		return nil, domain.NewInternalError("failed to check certification existence", err)
+	}
+	if existingCertification == nil {
+		uc.logger.Error("Certification not found for ID %d", certificationID)
+		return nil, domain.NewNotFoundError("Certification", fmt.Sprint(certificationID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Certification", certificationID, existingCertification.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingCertification)
+
+	return uc.save(ctx, certificationID, certification, entities.RevisionActionUpdate)
+}
+
+func (uc *CertificationUseCase) PatchCertification(ctx context.Context, certificationID int, req *certificationDto.PatchCertificationRequest) (*entities.Certification, error) {
+	if certificationID <= 0 {
+		uc.logger.Error("Certification ID is required")
+		return nil, domain.NewValidationError("Certification ID must be positive", "certificationID", nil)
+	}
+
+	existingCertification, err := uc.certificationRepo.GetByID(ctx, certificationID)
+	if err != nil {
+		uc.logger.Error("Failed to get certification by ID %d: %v", certificationID, err)
+		return nil, domain.NewInternalError("failed to get certification", err)
+	}
+	if existingCertification == nil {
+		uc.logger.Error("Certification not found: %d", certificationID)
+		return nil, domain.NewNotFoundError("Certification", fmt.Sprint(certificationID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Certification", certificationID, existingCertification.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingCertification)
+
+	patched := *existingCertification
+	if err := req.ApplyTo(&patched); err != nil {
+		return nil, err
+	}
+
+	return uc.save(ctx, certificationID, &patched, entities.RevisionActionPatch)
+}
+
+// save writes the fields of certification over the stored ones, for both
+// updates and patches.
+func (uc *CertificationUseCase) save(ctx context.Context, certificationID int, certification *entities.Certification, action string) (*entities.Certification, error) {
+	if err := uc.checkCredentialAvailable(ctx, certification, certificationID); err != nil {
+		return nil, err
+	}
+
+	certification.MarkAsUpdated()
+	savedCertification, err := uc.certificationRepo.Update(ctx, certificationID, certification)
+	if err != nil {
+		uc.logger.Error("Failed to update certification: %v", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update certification", err))
+	}
+
+	uc.snapshotRevision(ctx, certificationID, action)
+	invalidate(uc.cache, cacheNamespaceCertifications, savedCertification.UserID)
+	return savedCertification, nil
+}
+
+func (uc *CertificationUseCase) DeleteCertification(ctx context.Context, certificationID int) error {
+	if certificationID <= 0 {
+		uc.logger.Error("Invalid certification ID: %d", certificationID)
+		return domain.NewValidationError("Certification ID must be positive", "certificationID", nil)
+	}
+
+	existingCertification, err := uc.certificationRepo.GetByID(ctx, certificationID)
+	if err != nil {
+		uc.logger.Error("Failed to check if certification exists: %v", err)
+		return domain.NewInternalError("failed to check certification existence", err)
+	}
+	if existingCertification == nil {
+		uc.logger.Error("Certification not found for ID %d", certificationID)
+		return domain.NewNotFoundError("Certification", fmt.Sprint(certificationID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Certification", certificationID, existingCertification.Version); err != nil {
+		return err
+	}
+
+	if err := uc.certificationRepo.Delete(ctx, certificationID); err != nil {
+		uc.logger.Error("Failed to delete certification: %v", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete certification", err))
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeCertification, certificationID, entities.RevisionActionDelete, existingCertification)
+	invalidate(uc.cache, cacheNamespaceCertifications, existingCertification.UserID)
+	return nil
+}
+
+// ReorderCertifications moves the user's certifications into the order of
+// req, which must list every live one of them exactly once. Lists follow
+// that order unless another sort is requested.
+func (uc *CertificationUseCase) ReorderCertifications(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.certificationRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder certifications of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceCertifications, userID)
+	return nil
+}
+
+// checkCredentialAvailable rejects a credential ID that another
+// certification of the user already has from the same issuer.
+func (uc *CertificationUseCase) checkCredentialAvailable(ctx context.Context, certification *entities.Certification, certificationID int) error {
+	if certification.CredentialID == "" {
+		return nil
+	}
+
+	exists, err := uc.certificationRepo.ExistsByCredential(ctx, certification.UserID, certification.Issuer, certification.CredentialID, certificationID)
+	if err != nil {
+		uc.logger.Error("Failed to check certification credential: %v", err)
+		return domain.NewInternalError("failed to check certification existence", err)
+	}
+	if exists {
+		uc.logger.Error("Certification already exists: %s from %s", certification.CredentialID, certification.Issuer)
+		return domain.NewAlreadyExistsError("Certification", certification.CredentialID+" from "+certification.Issuer)
+	}
+	return nil
+}
+
+// snapshotRevision records the stored state of the certification as a
+// revision.
+func (uc *CertificationUseCase) snapshotRevision(ctx context.Context, certificationID int, action string) {
+	certification, err := uc.certificationRepo.GetByID(ctx, certificationID)
+	if err != nil || certification == nil {
+		uc.logger.Error("Failed to load certification %d for revision: %v", certificationID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeCertification, certificationID, action, certification)
+}
@@ -6,19 +6,28 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
 	"portfolio/logger"
+	"portfolio/service"
+	"time"
 )
 
 type EducationUseCase struct {
 	educationRepo interfaces.EducationRepository
 	userRepo      interfaces.UserRepository
+	revisionRepo  interfaces.RevisionRepository
+	unitOfWork    interfaces.UnitOfWork
+	cache         *service.CacheService
 	logger        *logger.Logger
 }
 
-func NewEducationUseCase(educationRepo interfaces.EducationRepository, userRepo interfaces.UserRepository, logger *logger.Logger) *EducationUseCase {
+func NewEducationUseCase(educationRepo interfaces.EducationRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *EducationUseCase {
 	return &EducationUseCase{
 		educationRepo: educationRepo,
 		userRepo:      userRepo,
+		revisionRepo:  revisionRepo,
+		unitOfWork:    unitOfWork,
+		cache:         cache,
 		logger:        logger,
 	}
 }
@@ -49,22 +58,51 @@ func (uc *EducationUseCase) CreateEducation(ctx context.Context, education *enti
 		return nil, domain.NewAlreadyExistsError("Education", education.Degree+" at "+education.Institution)
 	}
 
+	education.SetDefaultState(time.Now())
 	createdEducation, err := uc.educationRepo.Create(ctx, education)
 	if err != nil {
 		uc.logger.Error("Failed to create education: %v", err)
 		return nil, domain.NewInternalError("failed to create education", err)
 	}
 
+	uc.snapshotRevision(ctx, createdEducation.EducationID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespaceEducations, createdEducation.UserID)
 	return createdEducation, nil
 }
 
+// CreateEducationsAtomically creates every education or none: the first failure rolls
+// back the educations created before it.
+func (uc *EducationUseCase) CreateEducationsAtomically(ctx context.Context, educations []*entities.Education) ([]*entities.Education, error) {
+	defer invalidate(uc.cache, cacheNamespaceEducations, batchOwners(educations, educationOwner)...)
+
+	var createdEducations []*entities.Education
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, education := range educations {
+			createdEducation, err := uc.CreateEducation(ctx, education)
+			if err != nil {
+				uc.logger.Error("Failed to create education at index %d (degree: %s), rolling back: %v", i, education.Degree, err)
+				return err
+			}
+			createdEducations = append(createdEducations, createdEducation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdEducations, nil
+}
+
 func (uc *EducationUseCase) GetEducationByID(ctx context.Context, educationID int) (*entities.Education, error) {
 	if educationID <= 0 {
 		uc.logger.Error("Invalid education ID: %d", educationID)
 		return nil, domain.NewValidationError("educationID", "education ID must be positive", nil)
 	}
 
-	education, err := uc.educationRepo.GetByID(ctx, educationID)
+	education, err := readItem(uc.cache, itemCacheKey(cacheNamespaceEducations, educationID), educationOwner, func() (*entities.Education, error) {
+		return uc.educationRepo.GetByID(ctx, educationID)
+	})
 	if err != nil {
 		uc.logger.Error("Failed to get education by ID %d: %v", educationID, err)
 		return nil, domain.NewInternalError("failed to retrieve education", err)
@@ -78,19 +116,21 @@ func (uc *EducationUseCase) GetEducationByID(ctx context.Context, educationID in
 	return education, nil
 }
 
-func (uc *EducationUseCase) GetEducationsByUserID(ctx context.Context, userID int) ([]*entities.Education, error) {
+func (uc *EducationUseCase) GetEducationsByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Education], error) {
 	if userID <= 0 {
 		uc.logger.Error("Invalid user ID: %d", userID)
 		return nil, domain.NewValidationError("userID", "user ID must be positive", nil)
 	}
 
-	educations, err := uc.educationRepo.GetByUserID(ctx, userID)
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespaceEducations, userID, query), userID, func() (*entities.ListPage[*entities.Education], error) {
+		return uc.educationRepo.GetByUserID(ctx, userID, query)
+	})
 	if err != nil {
 		uc.logger.Error("Failed to get educations for user %d: %v", userID, err)
-		return nil, domain.NewInternalError("failed to retrieve educations", err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve educations", err))
 	}
 
-	return educations, nil
+	return page, nil
 }
 
 func (uc *EducationUseCase) UpdateEducation(ctx context.Context, educationID int, education *entities.Education) (*entities.Education, error) {
@@ -99,23 +139,31 @@ func (uc *EducationUseCase) UpdateEducation(ctx context.Context, educationID int
 		return nil, domain.NewValidationError("education", "degree, institution, and user ID are required", nil)
 	}
 
-	exists, err := uc.educationRepo.ExistsByID(ctx, education.EducationID)
+	existingEducation, err := uc.educationRepo.GetByID(ctx, education.EducationID)
 	if err != nil {
 		uc.logger.Error("Failed to check if education exists: %v", err)
 		return nil, domain.NewInternalError("failed to check education existence", err)
 	}
-	if !exists {
+	if existingEducation == nil {
 		uc.logger.Error("Education not found for ID %d", education.EducationID)
 		return nil, domain.NewNotFoundError("Education", fmt.Sprint(education.EducationID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Education", educationID, existingEducation.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingEducation)
+
 	education.MarkAsUpdated()
 	updatedEducation, err := uc.educationRepo.Update(ctx, educationID, education)
 	if err != nil {
 		uc.logger.Error("Failed to update education: %v", err)
-		return nil, domain.NewInternalError("failed to update education", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update education", err))
 	}
 
+	uc.snapshotRevision(ctx, educationID, entities.RevisionActionUpdate)
+	invalidate(uc.cache, cacheNamespaceEducations, existingEducation.UserID)
 	return updatedEducation, nil
 }
 
@@ -136,6 +184,12 @@ func (uc *EducationUseCase) PatchEducation(ctx context.Context, educationID int,
 		return nil, domain.NewNotFoundError("Education", fmt.Sprint(educationID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Education", educationID, existingEducation.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingEducation)
+
 	if updates.Degree != "" {
 		existingEducation.Degree = updates.Degree
 	}
@@ -157,9 +211,11 @@ func (uc *EducationUseCase) PatchEducation(ctx context.Context, educationID int,
 	patchedEducation, err := uc.educationRepo.Patch(ctx, educationID, existingEducation)
 	if err != nil {
 		uc.logger.Error("Failed to update education: %v", err)
-		return nil, domain.NewInternalError("failed to update education", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update education", err))
 	}
 
+	uc.snapshotRevision(ctx, educationID, entities.RevisionActionPatch)
+	invalidate(uc.cache, cacheNamespaceEducations, existingEducation.UserID)
 	return patchedEducation, nil
 }
 
@@ -169,22 +225,49 @@ func (uc *EducationUseCase) DeleteEducation(ctx context.Context, educationID int
 		return domain.NewValidationError("educationID", "education ID must be positive", nil)
 	}
 
-	exists, err := uc.educationRepo.ExistsByID(ctx, educationID)
+	existingEducation, err := uc.educationRepo.GetByID(ctx, educationID)
 	if err != nil {
 		uc.logger.Error("Failed to check if education exists: %v", err)
 		return domain.NewInternalError("failed to check education existence", err)
 	}
-	if !exists {
+	if existingEducation == nil {
 		uc.logger.Error("Education not found for ID %d", educationID)
 		return domain.NewNotFoundError("Education", fmt.Sprint(educationID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Education", educationID, existingEducation.Version); err != nil {
+		return err
+	}
+
 	err = uc.educationRepo.Delete(ctx, educationID)
 	if err != nil {
 		uc.logger.Error("Failed to delete education: %v", err)
-		return domain.NewInternalError("failed to delete education", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete education", err))
 	}
 
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeEducation, educationID, entities.RevisionActionDelete, existingEducation)
+	invalidate(uc.cache, cacheNamespaceEducations, existingEducation.UserID)
+	return nil
+}
+
+// ReorderEducations moves the user's educations into the order of req, which must list
+// every live one of them exactly once. Lists follow that order unless
+// another sort is requested.
+func (uc *EducationUseCase) ReorderEducations(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.educationRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder educations of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceEducations, userID)
 	return nil
 }
 
@@ -212,3 +295,13 @@ func (uc *EducationUseCase) GetCurrentEducations(ctx context.Context, userID int
 
 	return educations, nil
 }
+
+// snapshotRevision records the stored state of the education as a revision.
+func (uc *EducationUseCase) snapshotRevision(ctx context.Context, educationID int, action string) {
+	education, err := uc.educationRepo.GetByID(ctx, educationID)
+	if err != nil || education == nil {
+		uc.logger.Error("Failed to load education %d for revision: %v", educationID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeEducation, educationID, action, education)
+}
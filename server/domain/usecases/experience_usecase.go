@@ -6,19 +6,31 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	experienceDto "portfolio/dto/experience"
+	orderDto "portfolio/dto/order"
 	"portfolio/logger"
+	"portfolio/service"
+	"time"
 )
 
 type ExperienceUseCase struct {
 	experienceRepo interfaces.ExperienceRepository
+	technologyRepo interfaces.TechnologyRepository
 	userRepo       interfaces.UserRepository
+	revisionRepo   interfaces.RevisionRepository
+	unitOfWork     interfaces.UnitOfWork
+	cache          *service.CacheService
 	logger         *logger.Logger
 }
 
-func NewExperienceUseCase(experienceRepo interfaces.ExperienceRepository, userRepo interfaces.UserRepository, logger *logger.Logger) *ExperienceUseCase {
+func NewExperienceUseCase(experienceRepo interfaces.ExperienceRepository, technologyRepo interfaces.TechnologyRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *ExperienceUseCase {
 	return &ExperienceUseCase{
 		experienceRepo: experienceRepo,
+		technologyRepo: technologyRepo,
 		userRepo:       userRepo,
+		revisionRepo:   revisionRepo,
+		unitOfWork:     unitOfWork,
+		cache:          cache,
 		logger:         logger,
 	}
 }
@@ -39,32 +51,63 @@ func (uc *ExperienceUseCase) CreateExperience(ctx context.Context, experience *e
 		return nil, domain.NewNotFoundError("User", fmt.Sprint(experience.UserID))
 	}
 
-	exists, err := uc.experienceRepo.ExistsByJobTitleCompanyAndUserID(ctx, experience.JobTitle, experience.CompanyName, experience.UserID)
-	if err != nil {
-		uc.logger.Error("Failed to check if experience exists: %v", err)
-		return nil, domain.NewInternalError("failed to check experience existence", err)
-	}
-	if exists {
-		uc.logger.Error("Experience already exists: %s at %s", experience.JobTitle, experience.CompanyName)
-		return nil, domain.NewAlreadyExistsError("Experience", experience.JobTitle+" at "+experience.CompanyName)
+	if err := uc.checkDetails(ctx, experience.UserID, experience); err != nil {
+		return nil, err
 	}
 
-	createdExperience, err := uc.experienceRepo.Create(ctx, experience)
+	experience.SetDefaultState(time.Now())
+	var createdExperience *entities.Experience
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		created, err := uc.experienceRepo.Create(ctx, experience)
+		if err != nil {
+			return err
+		}
+		createdExperience, err = uc.saveDetails(ctx, created.ExperienceID, experience)
+		return err
+	})
 	if err != nil {
 		uc.logger.Error("Failed to create experience: %v", err)
-		return nil, domain.NewInternalError("failed to create experience", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to create experience", err))
 	}
 
+	uc.snapshotRevision(ctx, createdExperience.ExperienceID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespaceExperiences, createdExperience.UserID)
 	return createdExperience, nil
 }
 
+// CreateExperiencesAtomically creates every experience or none: the first failure rolls
+// back the experiences created before it.
+func (uc *ExperienceUseCase) CreateExperiencesAtomically(ctx context.Context, experiences []*entities.Experience) ([]*entities.Experience, error) {
+	defer invalidate(uc.cache, cacheNamespaceExperiences, batchOwners(experiences, experienceOwner)...)
+
+	var createdExperiences []*entities.Experience
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, experience := range experiences {
+			createdExperience, err := uc.CreateExperience(ctx, experience)
+			if err != nil {
+				uc.logger.Error("Failed to create experience at index %d (job title: %s), rolling back: %v", i, experience.JobTitle, err)
+				return err
+			}
+			createdExperiences = append(createdExperiences, createdExperience)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdExperiences, nil
+}
+
 func (uc *ExperienceUseCase) GetExperienceByID(ctx context.Context, experienceID int) (*entities.Experience, error) {
 	if experienceID <= 0 {
 		uc.logger.Error("Invalid experience ID: %d", experienceID)
 		return nil, domain.NewValidationError("experienceID", "experience ID must be positive", nil)
 	}
 
-	experience, err := uc.experienceRepo.GetByID(ctx, experienceID)
+	experience, err := readItem(uc.cache, itemCacheKey(cacheNamespaceExperiences, experienceID), experienceOwner, func() (*entities.Experience, error) {
+		return uc.experienceRepo.GetByID(ctx, experienceID)
+	})
 	if err != nil {
 		uc.logger.Error("Failed to get experience by ID %d: %v", experienceID, err)
 		return nil, domain.NewInternalError("failed to retrieve experience", err)
@@ -78,19 +121,21 @@ func (uc *ExperienceUseCase) GetExperienceByID(ctx context.Context, experienceID
 	return experience, nil
 }
 
-func (uc *ExperienceUseCase) GetExperiencesByUserID(ctx context.Context, userID int) ([]*entities.Experience, error) {
+func (uc *ExperienceUseCase) GetExperiencesByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Experience], error) {
 	if userID <= 0 {
 		uc.logger.Error("Invalid user ID: %d", userID)
 		return nil, domain.NewValidationError("userID", "user ID must be positive", nil)
 	}
 
-	experiences, err := uc.experienceRepo.GetByUserID(ctx, userID)
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespaceExperiences, userID, query), userID, func() (*entities.ListPage[*entities.Experience], error) {
+		return uc.experienceRepo.GetByUserID(ctx, userID, query)
+	})
 	if err != nil {
 		uc.logger.Error("Failed to get experiences for user %d: %v", userID, err)
-		return nil, domain.NewInternalError("failed to retrieve experiences", err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve experiences", err))
 	}
 
-	return experiences, nil
+	return page, nil
 }
 
 func (uc *ExperienceUseCase) UpdateExperience(ctx context.Context, experienceID int, experience *entities.Experience) (*entities.Experience, error) {
@@ -99,27 +144,47 @@ func (uc *ExperienceUseCase) UpdateExperience(ctx context.Context, experienceID
 		return nil, domain.NewValidationError("experience", "job title, company name, and user ID are required", nil)
 	}
 
-	exists, err := uc.experienceRepo.ExistsByID(ctx, experience.ExperienceID)
+	existingExperience, err := uc.experienceRepo.GetByID(ctx, experience.ExperienceID)
 	if err != nil {
 		uc.logger.Error("Failed to check if experience exists: %v", err)
 		return nil, domain.NewInternalError("failed to check experience existence", err)
 	}
-	if !exists {
+	if existingExperience == nil {
 		uc.logger.Error("Experience not found for ID %d", experience.ExperienceID)
 		return nil, domain.NewNotFoundError("Experience", fmt.Sprint(experience.ExperienceID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Experience", experienceID, existingExperience.Version); err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkDetails(ctx, existingExperience.UserID, experience); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingExperience)
+
 	experience.MarkAsUpdated()
-	updatedExperience, err := uc.experienceRepo.Update(ctx, experienceID, experience)
+	var updatedExperience *entities.Experience
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, err := uc.experienceRepo.Update(ctx, experienceID, experience); err != nil {
+			return err
+		}
+		updatedExperience, err = uc.saveDetails(ctx, experienceID, experience)
+		return err
+	})
 	if err != nil {
 		uc.logger.Error("Failed to update experience: %v", err)
-		return nil, domain.NewInternalError("failed to update experience", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update experience", err))
 	}
 
+	uc.snapshotRevision(ctx, experienceID, entities.RevisionActionUpdate)
+	invalidate(uc.cache, cacheNamespaceExperiences, existingExperience.UserID)
 	return updatedExperience, nil
 }
 
-func (uc *ExperienceUseCase) PatchExperience(ctx context.Context, experienceID int, updates *entities.Experience) (*entities.Experience, error) {
+// PatchExperience sets the fields present in req on the experience.
+func (uc *ExperienceUseCase) PatchExperience(ctx context.Context, experienceID int, req *experienceDto.PatchExperienceRequest) (*entities.Experience, error) {
 	if experienceID <= 0 {
 		uc.logger.Error("Experience ID is required")
 		return nil, domain.NewValidationError("experienceID", "experience ID must be positive", nil)
@@ -136,30 +201,36 @@ func (uc *ExperienceUseCase) PatchExperience(ctx context.Context, experienceID i
 		return nil, domain.NewNotFoundError("Experience", fmt.Sprint(experienceID))
 	}
 
-	if updates.JobTitle != "" {
-		existingExperience.JobTitle = updates.JobTitle
-	}
-	if updates.CompanyName != "" {
-		existingExperience.CompanyName = updates.CompanyName
+	if err := checkExpectedVersion(ctx, "Experience", experienceID, existingExperience.Version); err != nil {
+		return nil, err
 	}
-	if !updates.StartDate.IsZero() {
-		existingExperience.StartDate = updates.StartDate
-	}
-	if updates.EndDate.IsZero() {
-		existingExperience.EndDate = updates.EndDate
+
+	auditBefore(ctx, existingExperience)
+
+	patched := *existingExperience
+	if err := req.ApplyTo(&patched); err != nil {
+		return nil, domain.NewValidationError("Invalid experience data", "experience", &err)
 	}
-	if updates.Description != "" {
-		existingExperience.Description = updates.Description
+	if err := uc.checkDetails(ctx, existingExperience.UserID, &patched); err != nil {
+		return nil, err
 	}
 
-	existingExperience.MarkAsUpdated()
-
-	patchedExperience, err := uc.experienceRepo.Patch(ctx, experienceID, existingExperience)
+	patched.MarkAsUpdated()
+	var patchedExperience *entities.Experience
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, err := uc.experienceRepo.Patch(ctx, experienceID, &patched); err != nil {
+			return err
+		}
+		patchedExperience, err = uc.saveDetails(ctx, experienceID, &patched)
+		return err
+	})
 	if err != nil {
 		uc.logger.Error("Failed to update experience: %v", err)
-		return nil, domain.NewInternalError("failed to update experience", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update experience", err))
 	}
 
+	uc.snapshotRevision(ctx, experienceID, entities.RevisionActionPatch)
+	invalidate(uc.cache, cacheNamespaceExperiences, existingExperience.UserID)
 	return patchedExperience, nil
 }
 
@@ -169,22 +240,49 @@ func (uc *ExperienceUseCase) DeleteExperience(ctx context.Context, experienceID
 		return domain.NewValidationError("experienceID", "experience ID must be positive", nil)
 	}
 
-	exists, err := uc.experienceRepo.ExistsByID(ctx, experienceID)
+	existingExperience, err := uc.experienceRepo.GetByID(ctx, experienceID)
 	if err != nil {
 		uc.logger.Error("Failed to check if experience exists: %v", err)
 		return domain.NewInternalError("failed to check experience existence", err)
 	}
-	if !exists {
+	if existingExperience == nil {
 		uc.logger.Error("Experience not found for ID %d", experienceID)
 		return domain.NewNotFoundError("Experience", fmt.Sprint(experienceID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Experience", experienceID, existingExperience.Version); err != nil {
+		return err
+	}
+
 	err = uc.experienceRepo.Delete(ctx, experienceID)
 	if err != nil {
 		uc.logger.Error("Failed to delete experience: %v", err)
-		return domain.NewInternalError("failed to delete experience", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete experience", err))
 	}
 
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeExperience, experienceID, entities.RevisionActionDelete, existingExperience)
+	invalidate(uc.cache, cacheNamespaceExperiences, existingExperience.UserID)
+	return nil
+}
+
+// ReorderExperiences moves the user's experiences into the order of req, which must list
+// every live one of them exactly once. Lists follow that order unless
+// another sort is requested.
+func (uc *ExperienceUseCase) ReorderExperiences(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.experienceRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder experiences of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceExperiences, userID)
 	return nil
 }
 
@@ -212,3 +310,54 @@ func (uc *ExperienceUseCase) GetCurrentExperiences(ctx context.Context, userID i
 
 	return experiences, nil
 }
+
+// checkDetails checks the employment type of experience and replaces its
+// technologies, which may carry only an ID or a name, with the user's live
+// technologies they name.
+func (uc *ExperienceUseCase) checkDetails(ctx context.Context, userID int, experience *entities.Experience) error {
+	if experience.EmploymentType == "" {
+		experience.EmploymentType = entities.EmploymentTypeFullTime
+	}
+	if !experience.HasValidEmploymentType() {
+		uc.logger.Error("Invalid employment type for experience: %v", experience)
+		return domain.NewValidationError("Invalid employment type", "employment_type", nil)
+	}
+
+	if experience.Technologies == nil {
+		return nil
+	}
+	technologies, err := resolveTechnologies(ctx, uc.technologyRepo, uc.logger, userID, experience.Technologies)
+	if err != nil {
+		return err
+	}
+	experience.Technologies = technologies
+	return nil
+}
+
+// saveDetails stores the achievements and technologies of experience, unless
+// they are nil, and returns the stored experience.
+func (uc *ExperienceUseCase) saveDetails(ctx context.Context, experienceID int, experience *entities.Experience) (*entities.Experience, error) {
+	if experience.Achievements != nil {
+		if err := uc.experienceRepo.SetAchievements(ctx, experienceID, experience.Achievements); err != nil {
+			uc.logger.Error("Failed to save achievements of experience %d: %v", experienceID, err)
+			return nil, err
+		}
+	}
+	if experience.Technologies != nil {
+		if err := uc.experienceRepo.SetTechnologies(ctx, experienceID, experience.Technologies); err != nil {
+			uc.logger.Error("Failed to link technologies to experience %d: %v", experienceID, err)
+			return nil, err
+		}
+	}
+	return uc.experienceRepo.GetByID(ctx, experienceID)
+}
+
+// snapshotRevision records the stored state of the experience as a revision.
+func (uc *ExperienceUseCase) snapshotRevision(ctx context.Context, experienceID int, action string) {
+	experience, err := uc.experienceRepo.GetByID(ctx, experienceID)
+	if err != nil || experience == nil {
+		uc.logger.Error("Failed to load experience %d for revision: %v", experienceID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeExperience, experienceID, action, experience)
+}
@@ -10,16 +10,22 @@ import (
 	"portfolio/domain/validation"
 	dto "portfolio/dto/personal_info"
 	"portfolio/logger"
+	"portfolio/service"
+	"strconv"
 )
 
 type PersonalInfoUseCase struct {
 	personalInfoRepo interfaces.PersonalInfoRepository
+	revisionRepo     interfaces.RevisionRepository
+	cache            *service.CacheService
 	logger           *logger.Logger
 }
 
-func NewPersonalInfoUseCase(personalInfoRepo interfaces.PersonalInfoRepository, logger *logger.Logger) *PersonalInfoUseCase {
+func NewPersonalInfoUseCase(personalInfoRepo interfaces.PersonalInfoRepository, revisionRepo interfaces.RevisionRepository, cache *service.CacheService, logger *logger.Logger) *PersonalInfoUseCase {
 	return &PersonalInfoUseCase{
 		personalInfoRepo: personalInfoRepo,
+		revisionRepo:     revisionRepo,
+		cache:            cache,
 		logger:           logger,
 	}
 }
@@ -141,7 +147,7 @@ func (uc *PersonalInfoUseCase) GetUserByID(ctx context.Context, userID int) (*en
 		return nil, domain.NewValidationError("User ID must be positive", "user_id", nil)
 	}
 
-	user, err := uc.personalInfoRepo.GetUserByID(ctx, userID)
+	user, err := uc.getUser(ctx, userID)
 	if err != nil {
 		uc.logger.Error("Failed to get user by ID: %v", err)
 		return nil, domain.NewDatabaseError("retrieving user", err)
@@ -153,12 +159,36 @@ func (uc *PersonalInfoUseCase) GetUserByID(ctx context.Context, userID int) (*en
 	return user, nil
 }
 
+func (uc *PersonalInfoUseCase) getUser(ctx context.Context, userID int) (*entities.User, error) {
+	return readThrough(uc.cache, service.CacheKey(cacheNamespacePersonalInfo, "user", strconv.Itoa(userID)), userID, func() (*entities.User, error) {
+		return uc.personalInfoRepo.GetUserByID(ctx, userID)
+	})
+}
+
+func (uc *PersonalInfoUseCase) GetPersonalInfoByID(ctx context.Context, personalInfoId int) (*entities.PersonalInfo, error) {
+	if personalInfoId <= 0 {
+		uc.logger.Error("Invalid personal info ID provided")
+		return nil, domain.NewValidationError("Personal info ID must be positive", "id", nil)
+	}
+
+	personalInfo, err := uc.personalInfoRepo.GetByID(ctx, personalInfoId)
+	if err != nil {
+		uc.logger.Error("Failed to get personal info by ID: %v", err)
+		return nil, domain.NewDatabaseError("retrieving personal info", err)
+	}
+	if personalInfo == nil {
+		uc.logger.Warn("No personal info found with ID %d", personalInfoId)
+		return nil, domain.NewNotFoundError("Personal Info", fmt.Sprintf("%d", personalInfoId))
+	}
+	return personalInfo, nil
+}
+
 func (uc *PersonalInfoUseCase) GetPersonalInfoByUserID(ctx context.Context, userID int) (*entities.PersonalInfo, error) {
 	if userID <= 0 {
 		uc.logger.Error("Invalid user ID provided")
 		return nil, domain.NewValidationError("User ID must be positive", "user_id", nil)
 	}
-	user, err := uc.personalInfoRepo.GetUserByID(ctx, userID)
+	user, err := uc.getUser(ctx, userID)
 	if err != nil {
 		uc.logger.Error("Failed to get user by ID: %v", err)
 		return nil, domain.NewDatabaseError("retrieving user", err)
@@ -167,7 +197,9 @@ func (uc *PersonalInfoUseCase) GetPersonalInfoByUserID(ctx context.Context, user
 		uc.logger.Warn("No user found with ID %d", userID)
 		return nil, domain.NewNotFoundError("User", fmt.Sprintf("%d", userID))
 	}
-	personalInfo, err := uc.personalInfoRepo.GetByUserID(ctx, userID)
+	personalInfo, err := readThrough(uc.cache, service.CacheKey(cacheNamespacePersonalInfo, "owner", strconv.Itoa(userID)), userID, func() (*entities.PersonalInfo, error) {
+		return uc.personalInfoRepo.GetByUserID(ctx, userID)
+	})
 	if err != nil {
 		uc.logger.Error("Failed to get personal info by user ID: %v", err)
 		return nil, domain.NewDatabaseError("retrieving personal info", err)
@@ -197,6 +229,8 @@ func (uc *PersonalInfoUseCase) CreatePersonalInfo(ctx context.Context, userID in
 		return nil, domain.NewDatabaseError("creating personal info", err)
 	}
 
+	uc.snapshotRevision(ctx, createdInfo.PersonalInfoID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespacePersonalInfo, userID)
 	return createdInfo, nil
 }
 
@@ -222,6 +256,12 @@ func (uc *PersonalInfoUseCase) UpdatePersonalInfo(ctx context.Context, personalI
 		return nil, domain.NewNotFoundError("personal information", fmt.Sprintf("%d", personalInfoId))
 	}
 
+	if err := checkExpectedVersion(ctx, "Personal info", personalInfoId, existingPersonalInfo.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingPersonalInfo)
+
 	personalInfo := dto.FromUpdatePersonalInfoRequestToEntity(personalInfoId, request)
 	if personalInfo == nil {
 		uc.logger.Error("Failed to convert request to entity")
@@ -231,9 +271,11 @@ func (uc *PersonalInfoUseCase) UpdatePersonalInfo(ctx context.Context, personalI
 	updatedPersonalInfo, err := uc.personalInfoRepo.Update(ctx, personalInfoId, personalInfo)
 	if err != nil {
 		uc.logger.Error("Failed to update personal info: %v", err)
-		return nil, domain.NewDatabaseError("updating personal info", err)
+		return nil, writeFailure(err, domain.NewDatabaseError("updating personal info", err))
 	}
 
+	uc.snapshotRevision(ctx, personalInfoId, entities.RevisionActionUpdate)
+	invalidate(uc.cache, cacheNamespacePersonalInfo, existingPersonalInfo.UserID)
 	return updatedPersonalInfo, nil
 }
 
@@ -259,6 +301,12 @@ func (uc *PersonalInfoUseCase) PatchPersonalInfo(ctx context.Context, personalIn
 		return nil, domain.NewNotFoundError("personal information", fmt.Sprintf("%d", personalInfoId))
 	}
 
+	if err := checkExpectedVersion(ctx, "Personal info", personalInfoId, existingPersonalInfo.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingPersonalInfo)
+
 	personalInfo, err := dto.FromPatchPersonalInfoRequestToEntity(personalInfoId, request)
 	if err != nil {
 		uc.logger.Error("Failed to convert patch request to entity: %v", err)
@@ -268,9 +316,11 @@ func (uc *PersonalInfoUseCase) PatchPersonalInfo(ctx context.Context, personalIn
 	patchedPersonalInfo, err := uc.personalInfoRepo.Patch(ctx, personalInfoId, personalInfo)
 	if err != nil {
 		uc.logger.Error("Failed to patch personal info: %v", err)
-		return nil, domain.NewDatabaseError("patching personal info", err)
+		return nil, writeFailure(err, domain.NewDatabaseError("patching personal info", err))
 	}
 
+	uc.snapshotRevision(ctx, personalInfoId, entities.RevisionActionPatch)
+	invalidate(uc.cache, cacheNamespacePersonalInfo, existingPersonalInfo.UserID)
 	return patchedPersonalInfo, nil
 }
 
@@ -291,12 +341,18 @@ func (uc *PersonalInfoUseCase) DeletePersonalInfo(ctx context.Context, personalI
 		return domain.NewNotFoundError("personal information", fmt.Sprintf("%d", personalInfoId))
 	}
 
+	if err := checkExpectedVersion(ctx, "Personal info", personalInfoId, existingPersonalInfo.Version); err != nil {
+		return err
+	}
+
 	err = uc.personalInfoRepo.Delete(ctx, personalInfoId)
 	if err != nil {
 		uc.logger.Error("Failed to delete personal info: %v", err)
-		return domain.NewDatabaseError("deleting personal info", err)
+		return writeFailure(err, domain.NewDatabaseError("deleting personal info", err))
 	}
 
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypePersonalInfo, personalInfoId, entities.RevisionActionDelete, existingPersonalInfo)
+	invalidate(uc.cache, cacheNamespacePersonalInfo, existingPersonalInfo.UserID)
 	return nil
 }
 
@@ -314,3 +370,13 @@ func (uc *PersonalInfoUseCase) ExistsPersonalInfoByUserID(ctx context.Context, u
 
 	return exists, nil
 }
+
+// snapshotRevision records the stored state of the personal info as a revision.
+func (uc *PersonalInfoUseCase) snapshotRevision(ctx context.Context, personalInfoId int, action string) {
+	personalInfo, err := uc.personalInfoRepo.GetByID(ctx, personalInfoId)
+	if err != nil || personalInfo == nil {
+		uc.logger.Error("Failed to load personal info %d for revision: %v", personalInfoId, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypePersonalInfo, personalInfoId, action, personalInfo)
+}
@@ -0,0 +1,199 @@
+package usecases
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
+	dto "portfolio/dto/project"
+	"portfolio/logger"
+	"portfolio/service"
+	"strconv"
+)
+
+// maxProjectLinks bounds the links of one project.
+const maxProjectLinks = 50
+
+type ProjectLinkUseCase struct {
+	linkRepo    interfaces.ProjectLinkRepository
+	projectRepo interfaces.ProjectRepository
+	unitOfWork  interfaces.UnitOfWork
+	cache       *service.CacheService
+	logger      *logger.Logger
+}
+
+func NewProjectLinkUseCase(linkRepo interfaces.ProjectLinkRepository, projectRepo interfaces.ProjectRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *ProjectLinkUseCase {
+	return &ProjectLinkUseCase{
+		linkRepo:    linkRepo,
+		projectRepo: projectRepo,
+		unitOfWork:  unitOfWork,
+		cache:       cache,
+		logger:      logger,
+	}
+}
+
+func (uc *ProjectLinkUseCase) GetProjectLinks(ctx context.Context, projectID int) ([]*entities.ProjectLink, error) {
+	if _, err := uc.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, err
+	}
+	return uc.linkRepo.GetByProjectID(ctx, projectID)
+}
+
+func (uc *ProjectLinkUseCase) CreateProjectLink(ctx context.Context, projectID int, req *dto.ProjectLinkRequest) (*entities.ProjectLink, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := uc.projectOwnerID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *entities.ProjectLink
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		links, err := uc.GetProjectLinks(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		if len(links) >= maxProjectLinks {
+			return domain.NewValidationError("A project can have at most "+strconv.Itoa(maxProjectLinks)+" links", "links", nil)
+		}
+
+		created, err = uc.linkRepo.Create(ctx, req.ToEntity(projectID))
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to add link to project %d: %v", projectID, err)
+		return nil, err
+	}
+
+	auditAction(ctx, entities.RevisionActionCreate)
+	auditResource(ctx, "project_links", strconv.Itoa(created.ProjectLinkID))
+	auditAfter(ctx, created)
+	invalidate(uc.cache, cacheNamespaceProjects, ownerID)
+	return created, nil
+}
+
+func (uc *ProjectLinkUseCase) UpdateProjectLink(ctx context.Context, projectID, linkID int, req *dto.ProjectLinkRequest) (*entities.ProjectLink, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := uc.projectOwnerID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.getProjectLink(ctx, projectID, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	auditResource(ctx, "project_links", strconv.Itoa(linkID))
+	auditBefore(ctx, existing)
+
+	var updated *entities.ProjectLink
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		updated, err = uc.linkRepo.Update(ctx, linkID, req.ToEntity(projectID))
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update link %d of project %d: %v", linkID, projectID, err)
+		return nil, err
+	}
+
+	auditAfter(ctx, updated)
+	invalidate(uc.cache, cacheNamespaceProjects, ownerID)
+	return updated, nil
+}
+
+func (uc *ProjectLinkUseCase) DeleteProjectLink(ctx context.Context, projectID, linkID int) error {
+	ownerID, err := uc.projectOwnerID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := uc.getProjectLink(ctx, projectID, linkID)
+	if err != nil {
+		return err
+	}
+
+	auditResource(ctx, "project_links", strconv.Itoa(linkID))
+	auditBefore(ctx, existing)
+
+	if err := uc.linkRepo.Delete(ctx, linkID); err != nil {
+		uc.logger.Error("Failed to delete link %d of project %d: %v", linkID, projectID, err)
+		return err
+	}
+
+	invalidate(uc.cache, cacheNamespaceProjects, ownerID)
+	return nil
+}
+
+// ReorderProjectLinks moves the project's links into the order of
+// req.IDs, which must list every link of the project.
+func (uc *ProjectLinkUseCase) ReorderProjectLinks(ctx context.Context, projectID int, req *orderDto.OrderRequest) ([]*entities.ProjectLink, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := uc.projectOwnerID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reordered []*entities.ProjectLink
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		links, err := uc.GetProjectLinks(ctx, projectID)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int, 0, len(links))
+		for _, item := range links {
+			ids = append(ids, item.ProjectLinkID)
+		}
+		if !req.IsPermutationOf(ids) {
+			return domain.NewValidationError("IDs must list every link of the project exactly once", "ids", nil)
+		}
+		auditBefore(ctx, ids)
+
+		if err := uc.linkRepo.Reorder(ctx, projectID, req.IDs); err != nil {
+			return err
+		}
+		reordered, err = uc.linkRepo.GetByProjectID(ctx, projectID)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder links of project %d: %v", projectID, err)
+		return nil, err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceProjects, ownerID)
+	return reordered, nil
+}
+
+// getProjectLink returns a link of the project; links of other
+// projects are not found.
+func (uc *ProjectLinkUseCase) getProjectLink(ctx context.Context, projectID, linkID int) (*entities.ProjectLink, error) {
+	link, err := uc.linkRepo.GetByID(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if !link.BelongsToProject(projectID) {
+		return nil, domain.NewNotFoundError("Project link", strconv.Itoa(linkID))
+	}
+	return link, nil
+}
+
+// projectOwnerID returns the owner of the project, whose cached projects embed
+// its links.
+func (uc *ProjectLinkUseCase) projectOwnerID(ctx context.Context, projectID int) (int, error) {
+	project, err := uc.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	return project.UserID, nil
+}
@@ -0,0 +1,199 @@
+package usecases
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
+	dto "portfolio/dto/project"
+	"portfolio/logger"
+	"portfolio/service"
+	"strconv"
+)
+
+// maxProjectMedia bounds the gallery of one project.
+const maxProjectMedia = 50
+
+type ProjectMediaUseCase struct {
+	mediaRepo   interfaces.ProjectMediaRepository
+	projectRepo interfaces.ProjectRepository
+	unitOfWork  interfaces.UnitOfWork
+	cache       *service.CacheService
+	logger      *logger.Logger
+}
+
+func NewProjectMediaUseCase(mediaRepo interfaces.ProjectMediaRepository, projectRepo interfaces.ProjectRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *ProjectMediaUseCase {
+	return &ProjectMediaUseCase{
+		mediaRepo:   mediaRepo,
+		projectRepo: projectRepo,
+		unitOfWork:  unitOfWork,
+		cache:       cache,
+		logger:      logger,
+	}
+}
+
+func (uc *ProjectMediaUseCase) GetProjectMedia(ctx context.Context, projectID int) ([]*entities.ProjectMedia, error) {
+	if _, err := uc.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, err
+	}
+	return uc.mediaRepo.GetByProjectID(ctx, projectID)
+}
+
+func (uc *ProjectMediaUseCase) CreateProjectMedia(ctx context.Context, projectID int, req *dto.ProjectMediaRequest) (*entities.ProjectMedia, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := uc.projectOwnerID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *entities.ProjectMedia
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		media, err := uc.GetProjectMedia(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		if len(media) >= maxProjectMedia {
+			return domain.NewValidationError("A project can have at most "+strconv.Itoa(maxProjectMedia)+" media items", "media", nil)
+		}
+
+		created, err = uc.mediaRepo.Create(ctx, req.ToEntity(projectID))
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to add media to project %d: %v", projectID, err)
+		return nil, err
+	}
+
+	auditAction(ctx, entities.RevisionActionCreate)
+	auditResource(ctx, "project_media", strconv.Itoa(created.ProjectMediaID))
+	auditAfter(ctx, created)
+	invalidate(uc.cache, cacheNamespaceProjects, ownerID)
+	return created, nil
+}
+
+func (uc *ProjectMediaUseCase) UpdateProjectMedia(ctx context.Context, projectID, mediaID int, req *dto.ProjectMediaRequest) (*entities.ProjectMedia, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := uc.projectOwnerID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.getProjectMediaItem(ctx, projectID, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	auditResource(ctx, "project_media", strconv.Itoa(mediaID))
+	auditBefore(ctx, existing)
+
+	var updated *entities.ProjectMedia
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		updated, err = uc.mediaRepo.Update(ctx, mediaID, req.ToEntity(projectID))
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update media %d of project %d: %v", mediaID, projectID, err)
+		return nil, err
+	}
+
+	auditAfter(ctx, updated)
+	invalidate(uc.cache, cacheNamespaceProjects, ownerID)
+	return updated, nil
+}
+
+func (uc *ProjectMediaUseCase) DeleteProjectMedia(ctx context.Context, projectID, mediaID int) error {
+	ownerID, err := uc.projectOwnerID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := uc.getProjectMediaItem(ctx, projectID, mediaID)
+	if err != nil {
+		return err
+	}
+
+	auditResource(ctx, "project_media", strconv.Itoa(mediaID))
+	auditBefore(ctx, existing)
+
+	if err := uc.mediaRepo.Delete(ctx, mediaID); err != nil {
+		uc.logger.Error("Failed to delete media %d of project %d: %v", mediaID, projectID, err)
+		return err
+	}
+
+	invalidate(uc.cache, cacheNamespaceProjects, ownerID)
+	return nil
+}
+
+// ReorderProjectMedia moves the project's gallery into the order of
+// req.IDs, which must list every item of the gallery.
+func (uc *ProjectMediaUseCase) ReorderProjectMedia(ctx context.Context, projectID int, req *orderDto.OrderRequest) ([]*entities.ProjectMedia, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := uc.projectOwnerID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reordered []*entities.ProjectMedia
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		media, err := uc.GetProjectMedia(ctx, projectID)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int, 0, len(media))
+		for _, item := range media {
+			ids = append(ids, item.ProjectMediaID)
+		}
+		if !req.IsPermutationOf(ids) {
+			return domain.NewValidationError("IDs must list every media item of the project exactly once", "ids", nil)
+		}
+		auditBefore(ctx, ids)
+
+		if err := uc.mediaRepo.Reorder(ctx, projectID, req.IDs); err != nil {
+			return err
+		}
+		reordered, err = uc.mediaRepo.GetByProjectID(ctx, projectID)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder media of project %d: %v", projectID, err)
+		return nil, err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceProjects, ownerID)
+	return reordered, nil
+}
+
+// getProjectMediaItem returns an item of the project's gallery; items of
+// other projects are not found.
+func (uc *ProjectMediaUseCase) getProjectMediaItem(ctx context.Context, projectID, mediaID int) (*entities.ProjectMedia, error) {
+	media, err := uc.mediaRepo.GetByID(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	if !media.BelongsToProject(projectID) {
+		return nil, domain.NewNotFoundError("Project media", strconv.Itoa(mediaID))
+	}
+	return media, nil
+}
+
+// projectOwnerID returns the owner of the project, whose cached projects embed
+// its media.
+func (uc *ProjectMediaUseCase) projectOwnerID(ctx context.Context, projectID int) (int, error) {
+	project, err := uc.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	return project.UserID, nil
+}
@@ -5,33 +5,69 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
 	dto "portfolio/dto/project"
 	"portfolio/logger"
+	"portfolio/service"
+	"slices"
 	"strconv"
+	"strings"
 )
 
 type ProjectUseCase struct {
-	projectRepo interfaces.ProjectRepository
-	userRepo    interfaces.UserRepository
-	settingRepo interfaces.SettingRepository
-	logger      *logger.Logger
+	projectRepo    interfaces.ProjectRepository
+	technologyRepo interfaces.TechnologyRepository
+	mediaRepo      interfaces.ProjectMediaRepository
+	linkRepo       interfaces.ProjectLinkRepository
+	userRepo       interfaces.UserRepository
+	settingRepo    interfaces.SettingRepository
+	revisionRepo   interfaces.RevisionRepository
+	unitOfWork     interfaces.UnitOfWork
+	cache          *service.CacheService
+	logger         *logger.Logger
 }
 
-func NewProjectUseCase(projectRepo interfaces.ProjectRepository, userRepo interfaces.UserRepository, settingRepo interfaces.SettingRepository, logger *logger.Logger) *ProjectUseCase {
+func NewProjectUseCase(projectRepo interfaces.ProjectRepository, technologyRepo interfaces.TechnologyRepository, mediaRepo interfaces.ProjectMediaRepository, linkRepo interfaces.ProjectLinkRepository, userRepo interfaces.UserRepository, settingRepo interfaces.SettingRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *ProjectUseCase {
 	return &ProjectUseCase{
-		projectRepo: projectRepo,
-		userRepo:    userRepo,
-		settingRepo: settingRepo,
-		logger:      logger,
+		projectRepo:    projectRepo,
+		technologyRepo: technologyRepo,
+		mediaRepo:      mediaRepo,
+		linkRepo:       linkRepo,
+		userRepo:       userRepo,
+		settingRepo:    settingRepo,
+		revisionRepo:   revisionRepo,
+		unitOfWork:     unitOfWork,
+		cache:          cache,
+		logger:         logger,
 	}
 }
 
-func (uc *ProjectUseCase) GetProjectsByUserID(ctx context.Context, userID int) ([]*entities.Project, error) {
-	return uc.projectRepo.GetAll(ctx, userID)
+func (uc *ProjectUseCase) GetProjectsByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Project], error) {
+	return readThrough(uc.cache, listCacheKey(cacheNamespaceProjects, userID, query), userID, func() (*entities.ListPage[*entities.Project], error) {
+		return uc.projectRepo.GetAll(ctx, userID, query)
+	})
+}
+
+func (uc *ProjectUseCase) GetProjectsByTechnologyID(ctx context.Context, userID, technologyID int, query *entities.ListQuery) (*entities.ListPage[*entities.Project], error) {
+	key := service.CacheKey(cacheNamespaceProjects, "technology", strconv.Itoa(technologyID), strconv.Itoa(userID), query.String())
+	return readThrough(uc.cache, key, userID, func() (*entities.ListPage[*entities.Project], error) {
+		return uc.projectRepo.GetByTechnologyID(ctx, userID, technologyID, query)
+	})
 }
 
 func (uc *ProjectUseCase) GetProjectByID(ctx context.Context, projectID int) (*entities.Project, error) {
-	return uc.projectRepo.GetByID(ctx, projectID)
+	return readItem(uc.cache, itemCacheKey(cacheNamespaceProjects, projectID), projectOwner, func() (*entities.Project, error) {
+		return uc.projectRepo.GetByID(ctx, projectID)
+	})
+}
+
+// GetProjectBySlug returns the user's project named by slug, which may also
+// be one of its former slugs.
+func (uc *ProjectUseCase) GetProjectBySlug(ctx context.Context, userID int, slug string) (*entities.Project, error) {
+	key := service.CacheKey(cacheNamespaceProjects, "slug", strconv.Itoa(userID), slug)
+	return readThrough(uc.cache, key, userID, func() (*entities.Project, error) {
+		return uc.projectRepo.GetBySlug(ctx, userID, slug)
+	})
 }
 
 func (uc *ProjectUseCase) CreateProject(ctx context.Context, userID int, req *dto.CreateProjectRequest) (*entities.Project, error) {
@@ -39,16 +75,21 @@ func (uc *ProjectUseCase) CreateProject(ctx context.Context, userID int, req *dt
 		return nil, err
 	}
 
+	technologies, err := uc.resolveTechnologies(ctx, userID, req.Technologies)
+	if err != nil {
+		return nil, err
+	}
+
 	project := &entities.Project{
 		UserID:           userID,
 		Title:            req.Title,
 		Description:      req.Description,
 		ShortDescription: req.ShortDescription,
-		Technologies:     req.Technologies,
-		GithubURL:        req.GithubURL,
-		ImageURL:         req.ImageURL,
 		Status:           req.Status,
+		Featured:         req.Featured,
+		Publishing:       req.PublishingEntity(),
 	}
+	project.SetTechnologies(technologies)
 
 	if !project.HasRequiredFields() {
 		uc.logger.Error("Required fields are missing for project: %v", project)
@@ -60,15 +101,58 @@ func (uc *ProjectUseCase) CreateProject(ctx context.Context, userID int, req *dt
 		return nil, domain.NewValidationError("Invalid project status", "status", nil)
 	}
 
-	createdProject, err := uc.projectRepo.Create(ctx, project)
+	var createdProject *entities.Project
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := uc.assignSlug(ctx, project, req.Slug); err != nil {
+			return err
+		}
+		created, err := uc.projectRepo.Create(ctx, project)
+		if err != nil {
+			return err
+		}
+		if err := uc.savePrimaryURLs(ctx, created.ProjectID, req.ImageURL, req.GithubURL); err != nil {
+			return err
+		}
+		createdProject, err = uc.saveTechnologies(ctx, created.ProjectID, technologies)
+		return err
+	})
 	if err != nil {
 		uc.logger.Error("Failed to create project: %v", err)
 		return nil, err
 	}
 
+	uc.snapshotRevision(ctx, createdProject.ProjectID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespaceProjects, userID)
 	return createdProject, nil
 }
 
+// CreateProjectsAtomically creates every project or none: the first failure
+// rolls back the projects created before it.
+//
+// The cache is invalidated after the unit of work ends, as the creates
+// inside it invalidate before the commit.
+func (uc *ProjectUseCase) CreateProjectsAtomically(ctx context.Context, userID int, reqs []dto.CreateProjectRequest) ([]*entities.Project, error) {
+	defer invalidate(uc.cache, cacheNamespaceProjects, userID)
+
+	var createdProjects []*entities.Project
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i := range reqs {
+			createdProject, err := uc.CreateProject(ctx, userID, &reqs[i])
+			if err != nil {
+				uc.logger.Error("Failed to create project at index %d (title: %s), rolling back: %v", i, reqs[i].Title, err)
+				return err
+			}
+			createdProjects = append(createdProjects, createdProject)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdProjects, nil
+}
+
 func (uc *ProjectUseCase) UpdateProject(ctx context.Context, projectID int, req *dto.UpdateProjectRequest) (*entities.Project, error) {
 	if err := req.Validate(); err != nil {
 		uc.logger.Error("Invalid update project request: %v", err)
@@ -81,12 +165,23 @@ func (uc *ProjectUseCase) UpdateProject(ctx context.Context, projectID int, req
 		return nil, err
 	}
 
+	if err := checkExpectedVersion(ctx, "Project", projectID, existingProject.Version); err != nil {
+		return nil, err
+	}
+
+	technologies, err := uc.resolveTechnologies(ctx, existingProject.UserID, req.Technologies)
+	if err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingProject)
+
+	existingProject.SetTechnologies(technologies)
 	existingProject.Title = req.Title
 	existingProject.Description = req.Description
 	existingProject.ShortDescription = req.ShortDescription
-	existingProject.Technologies = req.Technologies
-	existingProject.GithubURL = req.GithubURL
-	existingProject.ImageURL = req.ImageURL
+	existingProject.Featured = req.Featured
+	oldSlug := existingProject.Slug
 
 	if !existingProject.SetStatus(req.Status) {
 		uc.logger.Error("Invalid project status for project: %v", existingProject)
@@ -98,12 +193,30 @@ func (uc *ProjectUseCase) UpdateProject(ctx context.Context, projectID int, req
 		return nil, domain.NewValidationError("Required fields are missing after update", "project", nil)
 	}
 
-	updatedProject, err := uc.projectRepo.Update(ctx, projectID, existingProject)
+	var updatedProject *entities.Project
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := uc.changeSlug(ctx, existingProject, req.Slug); err != nil {
+			return err
+		}
+		if _, err := uc.projectRepo.Update(ctx, projectID, existingProject); err != nil {
+			return err
+		}
+		if err := uc.retireSlug(ctx, existingProject, oldSlug); err != nil {
+			return err
+		}
+		if err := uc.savePrimaryURLs(ctx, projectID, req.ImageURL, req.GithubURL); err != nil {
+			return err
+		}
+		updatedProject, err = uc.saveTechnologies(ctx, projectID, technologies)
+		return err
+	})
 	if err != nil {
 		uc.logger.Error("Failed to update project: %v", err)
 		return nil, err
 	}
 
+	uc.snapshotRevision(ctx, projectID, entities.RevisionActionUpdate)
+	invalidate(uc.cache, cacheNamespaceProjects, existingProject.UserID)
 	return updatedProject, nil
 }
 
@@ -119,6 +232,23 @@ func (uc *ProjectUseCase) PatchProject(ctx context.Context, projectID int, req *
 		return nil, err
 	}
 
+	if err := checkExpectedVersion(ctx, "Project", projectID, existingProject.Version); err != nil {
+		return nil, err
+	}
+
+	var technologies []*entities.Technology
+	if req.Technologies != nil {
+		technologies, err = uc.resolveTechnologies(ctx, existingProject.UserID, req.Technologies)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	auditBefore(ctx, existingProject)
+
+	if req.Technologies != nil {
+		existingProject.SetTechnologies(technologies)
+	}
 	if req.Title != "" {
 		existingProject.Title = req.Title
 	}
@@ -128,15 +258,10 @@ func (uc *ProjectUseCase) PatchProject(ctx context.Context, projectID int, req *
 	if req.ShortDescription != "" {
 		existingProject.ShortDescription = req.ShortDescription
 	}
-	if req.Technologies != "" {
-		existingProject.Technologies = req.Technologies
-	}
-	if req.GithubURL != "" {
-		existingProject.GithubURL = req.GithubURL
-	}
-	if req.ImageURL != "" {
-		existingProject.ImageURL = req.ImageURL
+	if req.Featured != nil {
+		existingProject.Featured = *req.Featured
 	}
+	oldSlug := existingProject.Slug
 	if req.Status != "" {
 		if !existingProject.SetStatus(req.Status) {
 			uc.logger.Error("Invalid project status for project: %v", existingProject)
@@ -146,23 +271,208 @@ func (uc *ProjectUseCase) PatchProject(ctx context.Context, projectID int, req *
 		existingProject.MarkAsUpdated()
 	}
 
-	patchedProject, err := uc.projectRepo.Patch(ctx, projectID, existingProject)
+	var patchedProject *entities.Project
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := uc.changeSlug(ctx, existingProject, req.Slug); err != nil {
+			return err
+		}
+		if _, err := uc.projectRepo.Patch(ctx, projectID, existingProject); err != nil {
+			return err
+		}
+		if err := uc.retireSlug(ctx, existingProject, oldSlug); err != nil {
+			return err
+		}
+		if err := uc.savePrimaryURLs(ctx, projectID, req.ImageURL, req.GithubURL); err != nil {
+			return err
+		}
+		if req.Technologies == nil {
+			patchedProject, err = uc.projectRepo.GetByID(ctx, projectID)
+			return err
+		}
+		patchedProject, err = uc.saveTechnologies(ctx, projectID, technologies)
+		return err
+	})
 	if err != nil {
 		uc.logger.Error("Failed to patch project: %v", err)
 		return nil, err
 	}
 
+	uc.snapshotRevision(ctx, projectID, entities.RevisionActionPatch)
+	invalidate(uc.cache, cacheNamespaceProjects, existingProject.UserID)
 	return patchedProject, nil
 }
 
 func (uc *ProjectUseCase) DeleteProject(ctx context.Context, projectID int) error {
-	_, err := uc.projectRepo.GetByID(ctx, projectID)
+	existingProject, err := uc.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
 		uc.logger.Error("Failed to get existing project: %v", err)
 		return err
 	}
 
-	return uc.projectRepo.Delete(ctx, projectID)
+	if err := checkExpectedVersion(ctx, "Project", projectID, existingProject.Version); err != nil {
+		return err
+	}
+
+	if err := uc.projectRepo.Delete(ctx, projectID); err != nil {
+		return err
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeProject, projectID, entities.RevisionActionDelete, existingProject)
+	invalidate(uc.cache, cacheNamespaceProjects, existingProject.UserID)
+	return nil
+}
+
+// ReorderProjects moves the user's projects into the order of req, which must list
+// every live one of them exactly once. Lists follow that order unless
+// another sort is requested.
+func (uc *ProjectUseCase) ReorderProjects(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.projectRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder projects of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceProjects, userID)
+	return nil
+}
+
+// assignSlug gives a new project slug, or else one derived from its title and
+// numbered until no project of the user uses or used it before.
+func (uc *ProjectUseCase) assignSlug(ctx context.Context, project *entities.Project, slug string) error {
+	if slug != "" {
+		return uc.changeSlug(ctx, project, slug)
+	}
+
+	base := entities.NewProjectSlug(project.Title)
+	candidate := base
+	for n := 2; ; n++ {
+		exists, err := uc.projectRepo.SlugExists(ctx, project.UserID, candidate, project.ProjectID)
+		if err != nil {
+			uc.logger.Error("Failed to check project slug %q: %v", candidate, err)
+			return err
+		}
+		if !exists {
+			project.Slug = candidate
+			return nil
+		}
+		suffix := "-" + strconv.Itoa(n)
+		candidate = strings.TrimRight(base[:min(len(base), entities.MaxProjectSlugLength-len(suffix))], "-") + suffix
+	}
+}
+
+// changeSlug sets the slug of project to slug unless slug is empty. Another
+// project of the user, even a trashed one, must not use it nor have used it,
+// as its old URLs redirect to that project.
+func (uc *ProjectUseCase) changeSlug(ctx context.Context, project *entities.Project, slug string) error {
+	if slug == "" || slug == project.Slug {
+		return nil
+	}
+
+	exists, err := uc.projectRepo.SlugExists(ctx, project.UserID, slug, project.ProjectID)
+	if err != nil {
+		uc.logger.Error("Failed to check project slug %q: %v", slug, err)
+		return err
+	}
+	if exists {
+		return domain.NewAlreadyExistsError("Project", slug)
+	}
+	project.Slug = slug
+	return nil
+}
+
+// retireSlug keeps oldSlug in the history of the project when its slug
+// changed, so that links using it still resolve.
+func (uc *ProjectUseCase) retireSlug(ctx context.Context, project *entities.Project, oldSlug string) error {
+	if oldSlug == project.Slug {
+		return nil
+	}
+	if err := uc.projectRepo.RetireSlug(ctx, project, oldSlug); err != nil {
+		uc.logger.Error("Failed to retire slug %q of project %d: %v", oldSlug, project.ProjectID, err)
+		return err
+	}
+	return nil
+}
+
+// resolveTechnologies looks up the user's live technologies that refs name.
+func (uc *ProjectUseCase) resolveTechnologies(ctx context.Context, userID int, refs dto.TechnologyRefs) ([]*entities.Technology, error) {
+	return resolveTechnologies(ctx, uc.technologyRepo, uc.logger, userID, refs.ToEntities())
+}
+
+// saveTechnologies links the project to technologies and returns the stored
+// project.
+func (uc *ProjectUseCase) saveTechnologies(ctx context.Context, projectID int, technologies []*entities.Technology) (*entities.Project, error) {
+	if err := uc.projectRepo.SetTechnologies(ctx, projectID, technologies); err != nil {
+		uc.logger.Error("Failed to link technologies to project %d: %v", projectID, err)
+		return nil, err
+	}
+	return uc.projectRepo.GetByID(ctx, projectID)
+}
+
+// savePrimaryURLs makes imageURL the project's primary image and githubURL
+// its primary GitHub link, as image_url and github_url were single fields
+// before projects had media and links. An item with the same URL is promoted,
+// otherwise one is added; empty URLs leave the project as is.
+func (uc *ProjectUseCase) savePrimaryURLs(ctx context.Context, projectID int, imageURL, githubURL string) error {
+	if imageURL != "" {
+		media, err := uc.mediaRepo.GetByProjectID(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		primary := &entities.ProjectMedia{ProjectID: projectID, Type: entities.ProjectMediaTypeImage, URL: imageURL, IsPrimary: true}
+		if i := slices.IndexFunc(media, func(item *entities.ProjectMedia) bool { return item.IsImage() && item.URL == imageURL }); i >= 0 {
+			if !media[i].IsPrimary {
+				media[i].IsPrimary = true
+				_, err = uc.mediaRepo.Update(ctx, media[i].ProjectMediaID, media[i])
+			}
+		} else {
+			_, err = uc.mediaRepo.Create(ctx, primary)
+		}
+		if err != nil {
+			uc.logger.Error("Failed to save the image of project %d: %v", projectID, err)
+			return err
+		}
+	}
+
+	if githubURL != "" {
+		links, err := uc.linkRepo.GetByProjectID(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		primary := &entities.ProjectLink{ProjectID: projectID, Type: entities.ProjectLinkTypeGithub, URL: githubURL, Label: "GitHub", IsPrimary: true}
+		if i := slices.IndexFunc(links, func(link *entities.ProjectLink) bool {
+			return link.Type == entities.ProjectLinkTypeGithub && link.URL == githubURL
+		}); i >= 0 {
+			if !links[i].IsPrimary {
+				links[i].IsPrimary = true
+				_, err = uc.linkRepo.Update(ctx, links[i].ProjectLinkID, links[i])
+			}
+		} else {
+			_, err = uc.linkRepo.Create(ctx, primary)
+		}
+		if err != nil {
+			uc.logger.Error("Failed to save the GitHub link of project %d: %v", projectID, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// snapshotRevision records the stored state of the project as a revision.
+func (uc *ProjectUseCase) snapshotRevision(ctx context.Context, projectID int, action string) {
+	project, err := uc.projectRepo.GetByID(ctx, projectID)
+	if err != nil || project == nil {
+		uc.logger.Error("Failed to load project %d for revision: %v", projectID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeProject, projectID, action, project)
 }
 
 func (uc *ProjectUseCase) ValidateProjectOwnership(ctx context.Context, projectID, userID int) error {
@@ -0,0 +1,335 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
+	publicationDto "portfolio/dto/publication"
+	"portfolio/logger"
+	"portfolio/service"
+	"time"
+)
+
+type PublicationUseCase struct {
+	publicationRepo  interfaces.PublicationRepository
+	personalInfoRepo interfaces.PersonalInfoRepository
+	userRepo         interfaces.UserRepository
+	revisionRepo     interfaces.RevisionRepository
+	unitOfWork       interfaces.UnitOfWork
+	cache            *service.CacheService
+	logger           *logger.Logger
+}
+
+func NewPublicationUseCase(publicationRepo interfaces.PublicationRepository, personalInfoRepo interfaces.PersonalInfoRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *PublicationUseCase {
+	return &PublicationUseCase{
+		publicationRepo:  publicationRepo,
+		personalInfoRepo: personalInfoRepo,
+		userRepo:         userRepo,
+		revisionRepo:     revisionRepo,
+		unitOfWork:       unitOfWork,
+		cache:            cache,
+		logger:           logger,
+	}
+}
+
+func (uc *PublicationUseCase) CreatePublication(ctx context.Context, publication *entities.Publication) (*entities.Publication, error) {
+	if err := uc.checkFields(publication); err != nil {
+		return nil, err
+	}
+
+	userExists, err := uc.userRepo.ExistsByID(ctx, publication.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to check if user exists: %v", err)
+		return nil, domain.NewInternalError("failed to validate user", err)
+	}
+	if !userExists {
+		uc.logger.Error("User not found for ID %d", publication.UserID)
+		return nil, domain.NewNotFoundError("User", fmt.Sprint(publication.UserID))
+	}
+
+	publication.SetDefaultState(time.Now())
+	var createdPublication *entities.Publication
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		created, err := uc.publicationRepo.Create(ctx, publication)
+		if err != nil {
+			return err
+		}
+		createdPublication, err = uc.saveCoAuthors(ctx, created.PublicationID, publication)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to create publication: %v", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to create publication", err))
+	}
+
+	uc.snapshotRevision(ctx, createdPublication.PublicationID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespacePublications, createdPublication.UserID)
+	return createdPublication, nil
+}
+
+// CreatePublicationsAtomically creates every publication or none: the first
+// failure rolls back the publications created before it.
+func (uc *PublicationUseCase) CreatePublicationsAtomically(ctx context.Context, publications []*entities.Publication) ([]*entities.Publication, error) {
+	defer invalidate(uc.cache, cacheNamespacePublications, batchOwners(publications, publicationOwner)...)
+
+	var createdPublications []*entities.Publication
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, publication := range publications {
+			createdPublication, err := uc.CreatePublication(ctx, publication)
+			if err != nil {
+				uc.logger.Error("Failed to create publication at index %d (title: %s), rolling back: %v", i, publication.Title, err)
+				return err
+			}
+			createdPublications = append(createdPublications, createdPublication)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdPublications, nil
+}
+
+func (uc *PublicationUseCase) GetPublicationByID(ctx context.Context, publicationID int) (*entities.Publication, error) {
+	if publicationID <= 0 {
+		uc.logger.Error("Invalid publication ID: %d", publicationID)
+		return nil, domain.NewValidationError("Publication ID must be positive", "publicationID", nil)
+	}
+
+	publication, err := readItem(uc.cache, itemCacheKey(cacheNamespacePublications, publicationID), publicationOwner, func() (*entities.Publication, error) {
+		return uc.publicationRepo.GetByID(ctx, publicationID)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get publication by ID %d: %v", publicationID, err)
+		return nil, domain.NewInternalError("failed to retrieve publication", err)
+	}
+
+	if publication == nil {
+		uc.logger.Error("Publication not found for ID %d", publicationID)
+		return nil, domain.NewNotFoundError("Publication", fmt.Sprint(publicationID))
+	}
+
+	return publication, nil
+}
+
+func (uc *PublicationUseCase) GetPublicationsByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Publication], error) {
+	if userID <= 0 {
+		uc.logger.Error("Invalid user ID: %d", userID)
+		return nil, domain.NewValidationError("User ID must be positive", "userID", nil)
+	}
+
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespacePublications, userID, query), userID, func() (*entities.ListPage[*entities.Publication], error) {
+		return uc.publicationRepo.GetByUserID(ctx, userID, query)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get publications for user %d: %v", userID, err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve publications", err))
+	}
+
+	return page, nil
+}
+
+// ExportPublications returns every publication of the user that matches the
+// filters of query, in its sort order, whatever its page size and cursors,
+// with the user's name as the first author when their personal info is set.
+func (uc *PublicationUseCase) ExportPublications(ctx context.Context, userID int, query *entities.ListQuery) ([]*entities.Publication, string, error) {
+	pageQuery := *query
+	pageQuery.Size = entities.MaxPageSize
+	pageQuery.After = 0
+	pageQuery.Before = 0
+
+	var publications []*entities.Publication
+	for {
+		page, err := uc.GetPublicationsByUserID(ctx, userID, &pageQuery)
+		if err != nil {
+			return nil, "", err
+		}
+		publications = append(publications, page.Items...)
+		if page.NextAfter == 0 {
+			break
+		}
+		pageQuery.After = page.NextAfter
+	}
+
+	personalInfo, err := uc.personalInfoRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("Failed to get personal info of user %d for the publication export: %v", userID, err)
+		return nil, "", domain.NewInternalError("failed to retrieve personal info", err)
+	}
+
+	author := ""
+	if personalInfo != nil {
+		author = personalInfo.GetFullName()
+	}
+	return publications, author, nil
+}
+
+func (uc *PublicationUseCase) UpdatePublication(ctx context.Context, publicationID int, publication *entities.Publication) (*entities.Publication, error) {
+	if err := uc.checkFields(publication); err != nil {
+		return nil, err
+	}
+
+	existingPublication, err := uc.publicationRepo.GetByID(ctx, publicationID)
+	if err != nil {
+		uc.logger.Error("Failed to check if publication exists: %v", err)
+		return nil, domain.NewInternalError("failed to check publication existence", err)
+	}
+	if existingPublication == nil {
+		uc.logger.Error("Publication not found for ID %d", publicationID)
+		return nil, domain.NewNotFoundError("Publication", fmt.Sprint(publicationID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Publication", publicationID, existingPublication.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingPublication)
+
+	return uc.save(ctx, publicationID, publication, entities.RevisionActionUpdate)
+}
+
+func (uc *PublicationUseCase) PatchPublication(ctx context.Context, publicationID int, req *publicationDto.PatchPublicationRequest) (*entities.Publication, error) {
+	if publicationID <= 0 {
+		uc.logger.Error("Publication ID is required")
+		return nil, domain.NewValidationError("Publication ID must be positive", "publicationID", nil)
+	}
+
+	existingPublication, err := uc.publicationRepo.GetByID(ctx, publicationID)
+	if err != nil {
+		uc.logger.Error("Failed to get publication by ID %d: %v", publicationID, err)
+		return nil, domain.NewInternalError("failed to get publication", err)
+	}
+	if existingPublication == nil {
+		uc.logger.Error("Publication not found: %d", publicationID)
+		return nil, domain.NewNotFoundError("Publication", fmt.Sprint(publicationID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Publication", publicationID, existingPublication.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingPublication)
+
+	patched := *existingPublication
+	req.ApplyTo(&patched)
+	if err := uc.checkFields(&patched); err != nil {
+		return nil, err
+	}
+
+	return uc.save(ctx, publicationID, &patched, entities.RevisionActionPatch)
+}
+
+// save writes the fields and the co-authors of publication over the stored
+// ones, for both updates and patches.
+func (uc *PublicationUseCase) save(ctx context.Context, publicationID int, publication *entities.Publication, action string) (*entities.Publication, error) {
+	publication.MarkAsUpdated()
+	var savedPublication *entities.Publication
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, err := uc.publicationRepo.Update(ctx, publicationID, publication); err != nil {
+			return err
+		}
+		var err error
+		savedPublication, err = uc.saveCoAuthors(ctx, publicationID, publication)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update publication: %v", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update publication", err))
+	}
+
+	uc.snapshotRevision(ctx, publicationID, action)
+	invalidate(uc.cache, cacheNamespacePublications, savedPublication.UserID)
+	return savedPublication, nil
+}
+
+func (uc *PublicationUseCase) DeletePublication(ctx context.Context, publicationID int) error {
+	if publicationID <= 0 {
+		uc.logger.Error("Invalid publication ID: %d", publicationID)
+		return domain.NewValidationError("Publication ID must be positive", "publicationID", nil)
+	}
+
+	existingPublication, err := uc.publicationRepo.GetByID(ctx, publicationID)
+	if err != nil {
+		uc.logger.Error("Failed to check if publication exists: %v", err)
+		return domain.NewInternalError("failed to check publication existence", err)
+	}
+	if existingPublication == nil {
+		uc.logger.Error("Publication not found for ID %d", publicationID)
+		return domain.NewNotFoundError("Publication", fmt.Sprint(publicationID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Publication", publicationID, existingPublication.Version); err != nil {
+		return err
+	}
+
+	if err := uc.publicationRepo.Delete(ctx, publicationID); err != nil {
+		uc.logger.Error("Failed to delete publication: %v", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete publication", err))
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypePublication, publicationID, entities.RevisionActionDelete, existingPublication)
+	invalidate(uc.cache, cacheNamespacePublications, existingPublication.UserID)
+	return nil
+}
+
+// ReorderPublications moves the user's publications into the order of req,
+// which must list every live one of them exactly once. Lists follow that
+// order unless another sort is requested.
+func (uc *PublicationUseCase) ReorderPublications(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.publicationRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder publications of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespacePublications, userID)
+	return nil
+}
+
+func (uc *PublicationUseCase) checkFields(publication *entities.Publication) error {
+	if !publication.HasRequiredFields() {
+		uc.logger.Error("Invalid publication fields: %v", publication)
+		return domain.NewValidationError("Title, type, date and user ID are required", "publication", nil)
+	}
+	if !publication.HasValidType() {
+		uc.logger.Error("Invalid publication type: %s", publication.Type)
+		return domain.NewValidationError("Invalid publication type", "type", nil)
+	}
+	return nil
+}
+
+// saveCoAuthors stores the co-authors of publication and returns the stored
+// publication.
+func (uc *PublicationUseCase) saveCoAuthors(ctx context.Context, publicationID int, publication *entities.Publication) (*entities.Publication, error) {
+	coAuthors := publication.CoAuthors
+	if coAuthors == nil {
+		coAuthors = []string{}
+	}
+	if err := uc.publicationRepo.SetCoAuthors(ctx, publicationID, coAuthors); err != nil {
+		uc.logger.Error("Failed to save co-authors of publication %d: %v", publicationID, err)
+		return nil, err
+	}
+	return uc.publicationRepo.GetByID(ctx, publicationID)
+}
+
+// snapshotRevision records the stored state of the publication as a
+// revision.
+func (uc *PublicationUseCase) snapshotRevision(ctx context.Context, publicationID int, action string) {
+	publication, err := uc.publicationRepo.GetByID(ctx, publicationID)
+	if err != nil || publication == nil {
+		uc.logger.Error("Failed to load publication %d for revision: %v", publicationID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypePublication, publicationID, action, publication)
+}
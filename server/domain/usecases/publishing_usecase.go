@@ -0,0 +1,156 @@
+package usecases
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	dto "portfolio/dto/publishing"
+	"portfolio/logger"
+	"portfolio/service"
+	"strconv"
+	"time"
+)
+
+type PublishingUseCase struct {
+	publishingRepo interfaces.PublishingRepository
+	unitOfWork     interfaces.UnitOfWork
+	events         *service.EventService
+	cache          *service.CacheService
+	logger         *logger.Logger
+}
+
+func NewPublishingUseCase(publishingRepo interfaces.PublishingRepository, unitOfWork interfaces.UnitOfWork, events *service.EventService, cache *service.CacheService, logger *logger.Logger) *PublishingUseCase {
+	return &PublishingUseCase{
+		publishingRepo: publishingRepo,
+		unitOfWork:     unitOfWork,
+		events:         events,
+		cache:          cache,
+		logger:         logger,
+	}
+}
+
+// GetPublishingItems lists the publishing state of the user's content, by type and
+// ID. An empty itemType or state lists every type or state.
+func (uc *PublishingUseCase) GetPublishingItems(ctx context.Context, userID int, itemType, state string) ([]*entities.PublishingItem, error) {
+	if itemType != "" && !entities.IsPublishingType(itemType) {
+		uc.logger.Error("Invalid publishing item type: %s", itemType)
+		return nil, domain.NewValidationError("Unknown publishing item type", "type", nil)
+	}
+	if state != "" && !entities.IsPublishingState(state) {
+		uc.logger.Error("Invalid publishing state: %s", state)
+		return nil, domain.NewValidationError("Unknown publishing state", "state", nil)
+	}
+
+	items, err := uc.publishingRepo.GetAll(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list publishing states: %v", err)
+		return nil, err
+	}
+
+	filtered := make([]*entities.PublishingItem, 0, len(items))
+	for _, item := range items {
+		if item.UserID == userID && (itemType == "" || item.Type == itemType) && (state == "" || item.State == state) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// GetPublishing returns the publishing state of one of the user's items; items
+// of other users are reported as not found.
+func (uc *PublishingUseCase) GetPublishing(ctx context.Context, userID int, itemType string, id int) (*entities.PublishingItem, error) {
+	item, err := uc.publishingRepo.Get(ctx, itemType, id)
+	if err != nil {
+		uc.logger.Error("Failed to get the publishing state of %s %d: %v", itemType, id, err)
+		return nil, err
+	}
+	if item.UserID != userID {
+		uc.logger.Error("Publishing item %s/%d not found for user %d", itemType, id, userID)
+		return nil, domain.NewNotFoundError("Publishing item", itemType+"/"+strconv.Itoa(id))
+	}
+	return item, nil
+}
+
+// SetPublishing replaces the publishing state of one of the user's items.
+func (uc *PublishingUseCase) SetPublishing(ctx context.Context, userID int, itemType string, id int, req *dto.PublishingRequest) (*entities.PublishingItem, error) {
+	if !entities.IsPublishingType(itemType) {
+		uc.logger.Error("Invalid publishing item type: %s", itemType)
+		return nil, domain.NewValidationError("Unknown publishing item type", "type", nil)
+	}
+	if id <= 0 {
+		uc.logger.Error("Invalid publishing item ID: %d", id)
+		return nil, domain.NewValidationError("ID must be a positive integer", "id", nil)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	item, err := uc.GetPublishing(ctx, userID, itemType, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkExpectedVersion(ctx, "Publishing item", id, item.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, item.Publishing)
+
+	var updated *entities.PublishingItem
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := uc.publishingRepo.Set(ctx, itemType, id, req.ToEntity()); err != nil {
+			return err
+		}
+		updated, err = uc.publishingRepo.Get(ctx, itemType, id)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to set the publishing state of %s %d: %v", itemType, id, err)
+		return nil, err
+	}
+
+	auditAction(ctx, "publishing")
+	auditResource(ctx, itemType, strconv.Itoa(id))
+	auditAfter(ctx, updated.Publishing)
+	invalidate(uc.cache, itemType, userID)
+	return updated, nil
+}
+
+// PublishDue moves every item whose publish_at or unpublish_at has passed to
+// its next state and publishes one event per change. An item that changed
+// since it was read is left for the next run.
+func (uc *PublishingUseCase) PublishDue(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	items, err := uc.publishingRepo.GetDue(ctx, now)
+	if err != nil {
+		uc.logger.Error("Failed to list due publishing changes: %v", err)
+		return 0, err
+	}
+
+	changed := 0
+	for _, item := range items {
+		publishing := item.Publishing
+		publishing.State = item.Due(now)
+		if publishing.State == "" {
+			continue
+		}
+
+		err := uc.publishingRepo.Set(domain.WithExpectedVersion(ctx, item.Version), item.Type, item.ID, publishing)
+		if err != nil {
+			uc.logger.Error("Failed to move %s %d from %s to %s: %v", item.Type, item.ID, item.State, publishing.State, err)
+			continue
+		}
+
+		changed++
+		invalidate(uc.cache, item.Type, item.UserID)
+		uc.events.Publish(ctx, &entities.PublishingEvent{
+			Type:       item.Type,
+			ID:         item.ID,
+			UserID:     item.UserID,
+			From:       item.State,
+			To:         publishing.State,
+			OccurredAt: now,
+		})
+	}
+	return changed, nil
+}
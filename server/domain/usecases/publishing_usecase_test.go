@@ -0,0 +1,153 @@
+package usecases_test
+
+import (
+	"context"
+	"io"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	dto "portfolio/dto/publishing"
+	"portfolio/logger"
+	"portfolio/service"
+	"slices"
+	"testing"
+	"time"
+)
+
+// publishedNames lists the names of the user's published technologies, as
+// the public handlers read them.
+func publishedNames(t *testing.T, f *fixture) []string {
+	t.Helper()
+
+	page, err := f.technologies.GetTechnologiesByUserID(t.Context(), f.userID, &entities.ListQuery{
+		Size:    50,
+		Filters: map[string]string{"state": entities.PublishingStatePublished},
+	})
+	if err != nil {
+		t.Fatalf("GetTechnologiesByUserID failed: %v", err)
+	}
+	names := make([]string, 0, len(page.Items))
+	for _, technology := range page.Items {
+		names = append(names, technology.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestPublishDue(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		past := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+		future := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+
+		ids := map[string]int{}
+		for name, publishing := range map[string]entities.Publishing{
+			"Due":      {State: entities.PublishingStateScheduled, PublishAt: &past},
+			"Upcoming": {State: entities.PublishingStateScheduled, PublishAt: &future},
+			"Expired":  {State: entities.PublishingStatePublished, UnpublishAt: &past},
+			"Live":     {State: entities.PublishingStatePublished, UnpublishAt: &future},
+			"Draft":    {State: entities.PublishingStateDraft, PublishAt: &past},
+		} {
+			technology := newTechnology(f.userID, name)
+			technology.Publishing = publishing
+			created, err := f.technologies.CreateTechnology(t.Context(), technology)
+			if err != nil {
+				t.Fatalf("CreateTechnology(%q) failed: %v", name, err)
+			}
+			ids[name] = created.TechnologyID
+		}
+		// Cache the public list, so that a missed invalidation shows up below.
+		if names := publishedNames(t, f); !slices.Equal(names, []string{"Expired", "Live"}) {
+			t.Fatalf("published technologies before the run = %v, want [Expired Live]", names)
+		}
+
+		events := service.NewEventService()
+		var moves []string
+		events.Subscribe(func(_ context.Context, event *entities.PublishingEvent) {
+			moves = append(moves, event.Type+" "+event.From+" -> "+event.To)
+		})
+		publishing := usecases.NewPublishingUseCase(f.repos.Publishing, f.repos.UnitOfWork, events, f.cache,
+			logger.NewWriterLogger(io.Discard))
+
+		changed, err := publishing.PublishDue(t.Context())
+		if err != nil {
+			t.Fatalf("PublishDue failed: %v", err)
+		}
+		if changed != 2 {
+			t.Errorf("PublishDue changed %d items, want 2", changed)
+		}
+		slices.Sort(moves)
+		if want := []string{"technologies published -> archived", "technologies scheduled -> published"}; !slices.Equal(moves, want) {
+			t.Errorf("events = %v, want %v", moves, want)
+		}
+
+		for name, want := range map[string]string{
+			"Due":      entities.PublishingStatePublished,
+			"Upcoming": entities.PublishingStateScheduled,
+			"Expired":  entities.PublishingStateArchived,
+			"Live":     entities.PublishingStatePublished,
+			"Draft":    entities.PublishingStateDraft,
+		} {
+			item, err := publishing.GetPublishing(t.Context(), f.userID, entities.TrashTypeTechnology, ids[name])
+			if err != nil {
+				t.Fatalf("GetPublishing(%q) failed: %v", name, err)
+			}
+			if item.State != want {
+				t.Errorf("state of %q = %q, want %q", name, item.State, want)
+			}
+		}
+		if names := publishedNames(t, f); !slices.Equal(names, []string{"Due", "Live"}) {
+			t.Errorf("published technologies after the run = %v, want [Due Live]", names)
+		}
+
+		// Nothing is due any more.
+		if changed, err := publishing.PublishDue(t.Context()); err != nil || changed != 0 {
+			t.Errorf("second PublishDue = %d, %v, want 0, nil", changed, err)
+		}
+	})
+}
+
+func TestSetPublishing(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		publishing := usecases.NewPublishingUseCase(f.repos.Publishing, f.repos.UnitOfWork, service.NewEventService(),
+			f.cache, logger.NewWriterLogger(io.Discard))
+		technology, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go"))
+		if err != nil {
+			t.Fatalf("CreateTechnology failed: %v", err)
+		}
+		if names := publishedNames(t, f); !slices.Equal(names, []string{"Go"}) {
+			t.Fatalf("published technologies = %v, want [Go]", names)
+		}
+		item, err := publishing.GetPublishing(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID)
+		if err != nil {
+			t.Fatalf("GetPublishing failed: %v", err)
+		}
+
+		draft := &dto.PublishingRequest{State: entities.PublishingStateDraft}
+		updated, err := publishing.SetPublishing(domain.WithExpectedVersion(t.Context(), item.Version), f.userID,
+			entities.TrashTypeTechnology, technology.TechnologyID, draft)
+		if err != nil {
+			t.Fatalf("SetPublishing failed: %v", err)
+		}
+		if updated.State != entities.PublishingStateDraft || updated.Version == item.Version {
+			t.Errorf("SetPublishing returned state %q, version %d, want draft with a new version", updated.State, updated.Version)
+		}
+		if names := publishedNames(t, f); len(names) != 0 {
+			t.Errorf("published technologies after unpublishing = %v, want none", names)
+		}
+
+		_, err = publishing.SetPublishing(domain.WithExpectedVersion(t.Context(), item.Version), f.userID,
+			entities.TrashTypeTechnology, technology.TechnologyID, &dto.PublishingRequest{State: entities.PublishingStatePublished})
+		assertCode(t, "SetPublishing with a stale version", err, domain.ErrCodePreconditionFailed)
+
+		_, err = publishing.SetPublishing(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID,
+			&dto.PublishingRequest{State: entities.PublishingStateScheduled})
+		assertCode(t, "SetPublishing of a schedule without publish_at", err, domain.ErrCodeValidation)
+
+		_, err = publishing.SetPublishing(t.Context(), f.userID, "users", technology.TechnologyID, draft)
+		assertCode(t, "SetPublishing of an unknown type", err, domain.ErrCodeValidation)
+
+		otherID := createUser(t, f.repos, "other")
+		_, err = publishing.SetPublishing(t.Context(), otherID, entities.TrashTypeTechnology, technology.TechnologyID, draft)
+		assertCode(t, "SetPublishing of another user's technology", err, domain.ErrCodeNotFound)
+	})
+}
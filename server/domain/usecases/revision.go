@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"portfolio/shared"
+	"strconv"
+)
+
+// recordRevision stores a JSON snapshot of entity with the author and request
+// ID found in ctx, and notes the same snapshot in the request's audit frame.
+// History is best effort: a failure is logged and never fails the change it
+// describes.
+func recordRevision(ctx context.Context, revisionRepo interfaces.RevisionRepository, logger *logger.Logger, entityType string, entityID int, action string, entity any) {
+	snapshot, err := json.Marshal(entity)
+	if err != nil {
+		logger.Error("Failed to encode %s %d revision: %v", entityType, entityID, err)
+		return
+	}
+
+	auditResource(ctx, entityType, strconv.Itoa(entityID))
+	if action == entities.RevisionActionDelete {
+		auditBefore(ctx, json.RawMessage(snapshot))
+	} else {
+		auditAfter(ctx, json.RawMessage(snapshot))
+	}
+
+	revision := &entities.Revision{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Snapshot:   snapshot,
+	}
+	if userID, ok := ctx.Value(shared.USER_ID_KEY).(int); ok {
+		revision.UserID = &userID
+	}
+	if requestID, ok := ctx.Value(shared.REQUEST_ID_KEY).(string); ok {
+		revision.RequestID = requestID
+	}
+
+	if _, err := revisionRepo.Create(ctx, revision); err != nil {
+		logger.Error("Failed to record %s %d revision: %v", entityType, entityID, err)
+	}
+}
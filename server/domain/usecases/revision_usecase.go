@@ -0,0 +1,550 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	awardDto "portfolio/dto/award"
+	certificationDto "portfolio/dto/certification"
+	educationDto "portfolio/dto/education"
+	experienceDto "portfolio/dto/experience"
+	personalInfoDto "portfolio/dto/personal_info"
+	projectDto "portfolio/dto/project"
+	publicationDto "portfolio/dto/publication"
+	skillDto "portfolio/dto/skill"
+	spokenLanguageDto "portfolio/dto/spoken_language"
+	technologyDto "portfolio/dto/technology"
+	testimonialDto "portfolio/dto/testimonial"
+	volunteeringDto "portfolio/dto/volunteering"
+	"portfolio/logger"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type RevisionUseCase struct {
+	revisionRepo          interfaces.RevisionRepository
+	projectUseCase        *ProjectUseCase
+	skillUseCase          *SkillUseCase
+	experienceUseCase     *ExperienceUseCase
+	educationUseCase      *EducationUseCase
+	certificationUseCase  *CertificationUseCase
+	publicationUseCase    *PublicationUseCase
+	spokenLanguageUseCase *SpokenLanguageUseCase
+	awardUseCase          *AwardUseCase
+	volunteeringUseCase   *VolunteeringUseCase
+	testimonialUseCase    *TestimonialUseCase
+	technologyUseCase     *TechnologyUseCase
+	personalInfoUseCase   *PersonalInfoUseCase
+	logger                *logger.Logger
+}
+
+func NewRevisionUseCase(
+	revisionRepo interfaces.RevisionRepository,
+	projectUseCase *ProjectUseCase,
+	skillUseCase *SkillUseCase,
+	experienceUseCase *ExperienceUseCase,
+	educationUseCase *EducationUseCase,
+	certificationUseCase *CertificationUseCase,
+	publicationUseCase *PublicationUseCase,
+	spokenLanguageUseCase *SpokenLanguageUseCase,
+	awardUseCase *AwardUseCase,
+	volunteeringUseCase *VolunteeringUseCase,
+	testimonialUseCase *TestimonialUseCase,
+	technologyUseCase *TechnologyUseCase,
+	personalInfoUseCase *PersonalInfoUseCase,
+	logger *logger.Logger,
+) *RevisionUseCase {
+	return &RevisionUseCase{
+		revisionRepo:          revisionRepo,
+		projectUseCase:        projectUseCase,
+		skillUseCase:          skillUseCase,
+		experienceUseCase:     experienceUseCase,
+		educationUseCase:      educationUseCase,
+		certificationUseCase:  certificationUseCase,
+		publicationUseCase:    publicationUseCase,
+		spokenLanguageUseCase: spokenLanguageUseCase,
+		awardUseCase:          awardUseCase,
+		volunteeringUseCase:   volunteeringUseCase,
+		testimonialUseCase:    testimonialUseCase,
+		technologyUseCase:     technologyUseCase,
+		personalInfoUseCase:   personalInfoUseCase,
+		logger:                logger,
+	}
+}
+
+// GetRevisions lists the revisions of an entity owned by userID, newest
+// first. History of other users' entities is reported as not found.
+func (uc *RevisionUseCase) GetRevisions(ctx context.Context, userID int, entityType string, entityID int) ([]*entities.Revision, error) {
+	if !entities.IsValidTrashType(entityType) {
+		uc.logger.Error("Invalid revision entity type: %s", entityType)
+		return nil, domain.NewValidationError("Unknown entity type", "type", nil)
+	}
+
+	if entityID <= 0 {
+		uc.logger.Error("Invalid revision entity ID: %d", entityID)
+		return nil, domain.NewValidationError("ID must be a positive integer", "id", nil)
+	}
+
+	revisions, err := uc.revisionRepo.GetByEntity(ctx, entityType, entityID)
+	if err != nil {
+		uc.logger.Error("Failed to list revisions of %s %d: %v", entityType, entityID, err)
+		return nil, err
+	}
+
+	if len(revisions) == 0 || snapshotOwner(revisions[0]) != userID {
+		uc.logger.Error("No revisions of %s %d for user %d", entityType, entityID, userID)
+		return nil, domain.NewNotFoundError("Revision history", entityType+"/"+strconv.Itoa(entityID))
+	}
+
+	return revisions, nil
+}
+
+// Diff compares two revisions of the same entity field by field. Fields are
+// the snapshot fields, sorted by name.
+func (uc *RevisionUseCase) Diff(ctx context.Context, userID int, entityType string, entityID, fromID, toID int) ([]*entities.RevisionChange, error) {
+	revisions, err := uc.GetRevisions(ctx, userID, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := findRevision(revisions, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := findRevision(revisions, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	var fromFields, toFields map[string]any
+	if err := json.Unmarshal(from.Snapshot, &fromFields); err != nil {
+		uc.logger.Error("Failed to decode revision %d: %v", from.RevisionID, err)
+		return nil, domain.NewInternalError("failed to decode revision", err)
+	}
+	if err := json.Unmarshal(to.Snapshot, &toFields); err != nil {
+		uc.logger.Error("Failed to decode revision %d: %v", to.RevisionID, err)
+		return nil, domain.NewInternalError("failed to decode revision", err)
+	}
+
+	fields := make([]string, 0, len(toFields))
+	for field := range fromFields {
+		fields = append(fields, field)
+	}
+	for field := range toFields {
+		if _, ok := fromFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := make([]*entities.RevisionChange, 0)
+	for _, field := range fields {
+		if !reflect.DeepEqual(fromFields[field], toFields[field]) {
+			changes = append(changes, &entities.RevisionChange{
+				Field: field,
+				From:  fromFields[field],
+				To:    toFields[field],
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+// Rollback restores an entity to the state stored in a revision. The snapshot
+// is turned back into an update request and goes through the same validation
+// and use case as a regular update, which records a new revision. Trashed
+// entities have to be restored first.
+func (uc *RevisionUseCase) Rollback(ctx context.Context, userID int, entityType string, entityID, revisionID int) (*entities.Revision, error) {
+	revisions, err := uc.GetRevisions(ctx, userID, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := findRevision(revisions, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.rollback(ctx, userID, revision); err != nil {
+		uc.logger.Error("Failed to roll back %s %d to revision %d: %v", entityType, entityID, revisionID, err)
+		return nil, err
+	}
+
+	revisions, err = uc.revisionRepo.GetByEntity(ctx, entityType, entityID)
+	if err != nil {
+		uc.logger.Error("Failed to list revisions of %s %d: %v", entityType, entityID, err)
+		return nil, err
+	}
+	return revisions[0], nil
+}
+
+// snapshotTechnologies names the technologies of a project snapshot: the
+// linked ones by ID or, for snapshots taken before technologies were linked,
+// the names in its text.
+func snapshotTechnologies(project *entities.Project) projectDto.TechnologyRefs {
+	refs := projectDto.TechnologyRefs{}
+	if project.LinkedTechnologies == nil {
+		for _, name := range strings.Split(project.Technologies, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				refs = append(refs, projectDto.TechnologyRef{Name: name})
+			}
+		}
+		return refs
+	}
+
+	for _, technology := range project.LinkedTechnologies {
+		refs = append(refs, projectDto.TechnologyRef{ID: technology.TechnologyID})
+	}
+	return refs
+}
+
+// snapshotExperienceTechnologies names the technologies of an experience
+// snapshot by ID.
+func snapshotExperienceTechnologies(experience *entities.Experience) projectDto.TechnologyRefs {
+	refs := projectDto.TechnologyRefs{}
+	for _, technology := range experience.Technologies {
+		refs = append(refs, projectDto.TechnologyRef{ID: technology.TechnologyID})
+	}
+	return refs
+}
+
+func (uc *RevisionUseCase) rollback(ctx context.Context, userID int, revision *entities.Revision) error {
+	id := revision.EntityID
+
+	switch revision.EntityType {
+	case entities.TrashTypeProject:
+		var project entities.Project
+		if err := decodeSnapshot(revision, &project); err != nil {
+			return err
+		}
+		_, err := uc.projectUseCase.UpdateProject(ctx, id, &projectDto.UpdateProjectRequest{
+			Title:            project.Title,
+			Slug:             project.Slug,
+			Description:      project.Description,
+			ShortDescription: project.ShortDescription,
+			Technologies:     snapshotTechnologies(&project),
+			GithubURL:        project.GithubURL,
+			ImageURL:         project.ImageURL,
+			Status:           project.Status,
+			Featured:         project.Featured,
+		})
+		return err
+
+	case entities.TrashTypeSkill:
+		var skill entities.Skill
+		if err := decodeSnapshot(revision, &skill); err != nil {
+			return err
+		}
+		req := &skillDto.UpdateSkillRequest{
+			Name:  skill.Name,
+			Level: skill.Level,
+			SkillDetails: skillDto.SkillDetails{
+				CategoryID:        skill.CategoryID,
+				YearsOfExperience: skill.YearsOfExperience,
+				ProjectIDs:        entities.SkillEvidenceIDs(skill.Projects),
+				ExperienceIDs:     entities.SkillEvidenceIDs(skill.Experiences),
+			},
+		}
+		if skill.LastUsedAt != nil {
+			req.LastUsedAt = skill.LastUsedAt.Format("2006-01-02")
+		}
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		entity, err := req.ToEntity(id, userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid skill revision", "revision", &err)
+		}
+		_, err = uc.skillUseCase.UpdateSkill(ctx, id, entity)
+		return err
+
+	case entities.TrashTypeExperience:
+		var experience entities.Experience
+		if err := decodeSnapshot(revision, &experience); err != nil {
+			return err
+		}
+		req := &experienceDto.UpdateExperienceRequest{
+			JobTitle:    experience.JobTitle,
+			CompanyName: experience.CompanyName,
+			StartDate:   experience.StartDate.Format("2006-01-02"),
+			Description: experience.Description,
+			ExperienceDetails: experienceDto.ExperienceDetails{
+				EmploymentType: experience.EmploymentType,
+				Location:       experience.Location,
+				Remote:         experience.Remote,
+				Achievements:   experience.Achievements,
+				Technologies:   snapshotExperienceTechnologies(&experience),
+			},
+		}
+		if !experience.EndDate.IsZero() {
+			req.EndDate = experience.EndDate.Format("2006-01-02")
+		}
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		entity, err := req.ToEntity(id, userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid experience revision", "revision", &err)
+		}
+		_, err = uc.experienceUseCase.UpdateExperience(ctx, id, entity)
+		return err
+
+	case entities.TrashTypeEducation:
+		var education entities.Education
+		if err := decodeSnapshot(revision, &education); err != nil {
+			return err
+		}
+		req := &educationDto.UpdateEducationRequest{
+			ID:          id,
+			Degree:      education.Degree,
+			Institution: education.Institution,
+			StartDate:   education.StartDate,
+			EndDate:     education.EndDate,
+			Description: &education.Description,
+		}
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		entity, err := req.ToEntity(userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid education revision", "revision", &err)
+		}
+		_, err = uc.educationUseCase.UpdateEducation(ctx, id, entity)
+		return err
+
+	case entities.TrashTypeCertification:
+		var certification entities.Certification
+		if err := decodeSnapshot(revision, &certification); err != nil {
+			return err
+		}
+		req := &certificationDto.UpdateCertificationRequest{
+			CertificationFields: certificationDto.CertificationFields{
+				Name:            certification.Name,
+				Issuer:          certification.Issuer,
+				CredentialID:    certification.CredentialID,
+				VerificationURL: certification.VerificationURL,
+				IssueDate:       certification.IssueDate.Format("2006-01-02"),
+				Description:     certification.Description,
+			},
+		}
+		if certification.HasExpiryDate() {
+			req.ExpiryDate = certification.ExpiryDate.Format("2006-01-02")
+		}
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		entity, err := req.ToEntity(id, userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid certification revision", "revision", &err)
+		}
+		_, err = uc.certificationUseCase.UpdateCertification(ctx, id, entity)
+		return err
+
+	case entities.TrashTypePublication:
+		var publication entities.Publication
+		if err := decodeSnapshot(revision, &publication); err != nil {
+			return err
+		}
+		req := &publicationDto.UpdatePublicationRequest{
+			PublicationFields: publicationDto.PublicationFields{
+				Title:       publication.Title,
+				Type:        publication.Type,
+				Venue:       publication.Venue,
+				Date:        publication.Date.Format("2006-01-02"),
+				URL:         publication.URL,
+				SlidesURL:   publication.SlidesURL,
+				VideoURL:    publication.VideoURL,
+				Description: publication.Description,
+				CoAuthors:   publication.CoAuthors,
+			},
+		}
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		entity, err := req.ToEntity(id, userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid publication revision", "revision", &err)
+		}
+		_, err = uc.publicationUseCase.UpdatePublication(ctx, id, entity)
+		return err
+
+	case entities.TrashTypeSpokenLanguage:
+		var spokenLanguage entities.SpokenLanguage
+		if err := decodeSnapshot(revision, &spokenLanguage); err != nil {
+			return err
+		}
+		req := &spokenLanguageDto.UpdateSpokenLanguageRequest{
+			SpokenLanguageFields: spokenLanguageDto.SpokenLanguageFields{
+				Name:        spokenLanguage.Name,
+				Level:       spokenLanguage.Level,
+				Description: spokenLanguage.Description,
+			},
+		}
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		entity, err := req.ToEntity(id, userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid spoken language revision", "revision", &err)
+		}
+		_, err = uc.spokenLanguageUseCase.UpdateSpokenLanguage(ctx, id, entity)
+		return err
+
+	case entities.TrashTypeAward:
+		var award entities.Award
+		if err := decodeSnapshot(revision, &award); err != nil {
+			return err
+		}
+		req := &awardDto.UpdateAwardRequest{
+			AwardFields: awardDto.AwardFields{
+				Title:       award.Title,
+				Issuer:      award.Issuer,
+				Date:        award.Date.Format("2006-01-02"),
+				URL:         award.URL,
+				Description: award.Description,
+			},
+		}
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		entity, err := req.ToEntity(id, userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid award revision", "revision", &err)
+		}
+		_, err = uc.awardUseCase.UpdateAward(ctx, id, entity)
+		return err
+
+	case entities.TrashTypeVolunteering:
+		var volunteering entities.Volunteering
+		if err := decodeSnapshot(revision, &volunteering); err != nil {
+			return err
+		}
+		req := &volunteeringDto.UpdateVolunteeringRequest{
+			VolunteeringFields: volunteeringDto.VolunteeringFields{
+				Role:         volunteering.Role,
+				Organization: volunteering.Organization,
+				Cause:        volunteering.Cause,
+				StartDate:    volunteering.StartDate.Format("2006-01-02"),
+				URL:          volunteering.URL,
+				Description:  volunteering.Description,
+			},
+		}
+		if volunteering.HasEndDate() {
+			req.EndDate = volunteering.EndDate.Format("2006-01-02")
+		}
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		entity, err := req.ToEntity(id, userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid volunteering revision", "revision", &err)
+		}
+		_, err = uc.volunteeringUseCase.UpdateVolunteering(ctx, id, entity)
+		return err
+
+	case entities.TrashTypeTestimonial:
+		// The moderation status is not rolled back: an approval or a
+		// rejection stays until it is changed explicitly.
+		var testimonial entities.Testimonial
+		if err := decodeSnapshot(revision, &testimonial); err != nil {
+			return err
+		}
+		req := &testimonialDto.UpdateTestimonialRequest{
+			TestimonialFields: testimonialDto.TestimonialFields{
+				AuthorName:    testimonial.AuthorName,
+				AuthorTitle:   testimonial.AuthorTitle,
+				AuthorCompany: testimonial.AuthorCompany,
+				AuthorEmail:   testimonial.AuthorEmail,
+				AuthorURL:     testimonial.AuthorURL,
+				Content:       testimonial.Content,
+				ProjectID:     testimonial.ProjectID,
+				ExperienceID:  testimonial.ExperienceID,
+			},
+		}
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		entity, err := req.ToEntity(id, userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid testimonial revision", "revision", &err)
+		}
+		_, err = uc.testimonialUseCase.UpdateTestimonial(ctx, id, entity)
+		return err
+
+	case entities.TrashTypeTechnology:
+		var technology entities.Technology
+		if err := decodeSnapshot(revision, &technology); err != nil {
+			return err
+		}
+		req := &technologyDto.UpdateTechnologyRequest{ID: id, Name: technology.Name, IconURL: technology.IconURL}
+		entity, err := req.ToEntity(userID)
+		if err != nil {
+			return domain.NewValidationError("Invalid technology revision", "revision", &err)
+		}
+		_, err = uc.technologyUseCase.UpdateTechnology(ctx, id, entity)
+		return err
+
+	case entities.TrashTypePersonalInfo:
+		var personalInfo entities.PersonalInfo
+		if err := decodeSnapshot(revision, &personalInfo); err != nil {
+			return err
+		}
+		req := &personalInfoDto.UpdatePersonalInfoRequest{
+			CreatePersonalInfoRequest: personalInfoDto.CreatePersonalInfoRequest{
+				FirstName:         personalInfo.FirstName,
+				LastName:          personalInfo.LastName,
+				ProfessionalTitle: personalInfo.ProfessionalTitle,
+				Intro:             personalInfo.Intro,
+				Location:          personalInfo.Location,
+				ResumeURL:         personalInfo.ResumeURL,
+				WebsiteURL:        personalInfo.WebsiteURL,
+				LinkedinURL:       personalInfo.LinkedinURL,
+				GithubURL:         personalInfo.GithubURL,
+				XURL:              personalInfo.XURL,
+				PhoneNumber:       personalInfo.PhoneNumber,
+				Interests:         personalInfo.Interests,
+				ProfilePicture:    personalInfo.ProfilePicture,
+			},
+		}
+		if personalInfo.AboutMe != nil {
+			req.AboutMe = *personalInfo.AboutMe
+		}
+		if personalInfo.DateOfBirth != nil {
+			req.DateOfBirth = personalInfo.DateOfBirth.String()
+		}
+		_, err := uc.personalInfoUseCase.UpdatePersonalInfo(ctx, id, req)
+		return err
+	}
+
+	return domain.NewValidationError("Unknown entity type", "type", nil)
+}
+
+func findRevision(revisions []*entities.Revision, revisionID int) (*entities.Revision, error) {
+	for _, revision := range revisions {
+		if revision.RevisionID == revisionID {
+			return revision, nil
+		}
+	}
+	return nil, domain.NewNotFoundError("Revision", strconv.Itoa(revisionID))
+}
+
+func decodeSnapshot(revision *entities.Revision, entity any) error {
+	if err := json.Unmarshal(revision.Snapshot, entity); err != nil {
+		return domain.NewInternalError("failed to decode revision", err)
+	}
+	return nil
+}
+
+// snapshotOwner returns the UserID field every portfolio entity carries.
+func snapshotOwner(revision *entities.Revision) int {
+	var owner struct{ UserID int }
+	if err := json.Unmarshal(revision.Snapshot, &owner); err != nil {
+		return 0
+	}
+	return owner.UserID
+}
@@ -0,0 +1,70 @@
+package usecases_test
+
+import (
+	"io"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/usecases"
+	"portfolio/logger"
+	"testing"
+)
+
+func TestRevisionDiffAndRollback(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		revisions := usecases.NewRevisionUseCase(f.repos.Revision,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			f.technologies, nil, logger.NewWriterLogger(io.Discard))
+
+		technology, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go"))
+		if err != nil {
+			t.Fatalf("CreateTechnology failed: %v", err)
+		}
+		if _, err := f.technologies.PatchTechnology(t.Context(), technology.TechnologyID, &entities.Technology{Name: "Golang"}); err != nil {
+			t.Fatalf("PatchTechnology failed: %v", err)
+		}
+
+		history, err := revisions.GetRevisions(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID)
+		if err != nil {
+			t.Fatalf("GetRevisions failed: %v", err)
+		}
+		if len(history) != 2 {
+			t.Fatalf("history has %d revisions, want 2", len(history))
+		}
+		created, patched := history[1], history[0]
+
+		changes, err := revisions.Diff(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID, created.RevisionID, patched.RevisionID)
+		if err != nil {
+			t.Fatalf("Diff failed: %v", err)
+		}
+		var nameChange *entities.RevisionChange
+		for _, change := range changes {
+			if change.Field == "Name" {
+				nameChange = change
+			}
+		}
+		if nameChange == nil || nameChange.From != "Go" || nameChange.To != "Golang" {
+			t.Fatalf("diff = %+v, want Name changed from Go to Golang", changes)
+		}
+
+		rolledBack, err := revisions.Rollback(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID, created.RevisionID)
+		if err != nil {
+			t.Fatalf("Rollback failed: %v", err)
+		}
+		if rolledBack.RevisionID == patched.RevisionID {
+			t.Fatal("Rollback did not record a new revision")
+		}
+		current, err := f.technologies.GetTechnologyByID(t.Context(), technology.TechnologyID)
+		if err != nil {
+			t.Fatalf("GetTechnologyByID failed: %v", err)
+		}
+		if current.Name != "Go" {
+			t.Fatalf("name after rollback = %q, want %q", current.Name, "Go")
+		}
+
+		otherID := createUser(t, f.repos, "other")
+		_, err = revisions.GetRevisions(t.Context(), otherID, entities.TrashTypeTechnology, technology.TechnologyID)
+		assertCode(t, "GetRevisions of another user's technology", err, domain.ErrCodeNotFound)
+		_, err = revisions.Rollback(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID, 9999)
+		assertCode(t, "Rollback to a missing revision", err, domain.ErrCodeNotFound)
+	})
+}
@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSearchResultsPerType caps the limit query parameter of the search
+	// endpoints; DefaultSearchResultsPerType applies when it is missing.
+	MaxSearchResultsPerType     = 50
+	DefaultSearchResultsPerType = 10
+	maxSearchQueryLength        = 200
+	maxSearchTerms              = 10
+)
+
+type SearchUseCase struct {
+	searchRepo interfaces.SearchRepository
+	logger     *logger.Logger
+}
+
+func NewSearchUseCase(searchRepo interfaces.SearchRepository, logger *logger.Logger) *SearchUseCase {
+	return &SearchUseCase{
+		searchRepo: searchRepo,
+		logger:     logger,
+	}
+}
+
+// Search matches text against the content of userID, best match first within
+// each type. Every term must match, and a term also matches the words it is a
+// prefix of. Content that is not published only matches when includeHidden
+// is set.
+func (uc *SearchUseCase) Search(ctx context.Context, userID int, text string, limit int, includeHidden bool) ([]*entities.SearchHit, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, domain.NewRequiredFieldError("q")
+	}
+	if utf8.RuneCountInString(text) > maxSearchQueryLength {
+		return nil, domain.NewValidationError("Search query is too long", "q", nil)
+	}
+
+	terms := searchTerms(text)
+	if len(terms) == 0 {
+		return nil, domain.NewValidationError("Search query must contain a letter or a digit", "q", nil)
+	}
+
+	if limit == 0 {
+		limit = DefaultSearchResultsPerType
+	}
+	if limit < 1 || limit > MaxSearchResultsPerType {
+		return nil, domain.NewValidationError("Limit must be between 1 and 50", "limit", nil)
+	}
+
+	hits, err := uc.searchRepo.Search(ctx, entities.SearchQuery{
+		Terms:         terms,
+		UserID:        userID,
+		IncludeHidden: includeHidden,
+		Limit:         limit,
+	})
+	if err != nil {
+		uc.logger.Error("Failed to search for %q: %v", text, err)
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+// searchTerms splits text into lowercase words of letters and digits, so the
+// repositories never have to escape the query syntax of their database.
+func searchTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		if seen[word] {
+			continue
+		}
+		seen[word] = true
+		terms = append(terms, word)
+		if len(terms) == maxSearchTerms {
+			break
+		}
+	}
+	return terms
+}
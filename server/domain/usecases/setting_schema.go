@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"strings"
+)
+
+// settingUpgrade moves a namespace document one schema version forward.
+type settingUpgrade func(document map[string]any) map[string]any
+
+// settingUpgrades[namespace][v] upgrades a document from version v to v+1.
+// Version 0 is the flat document written before settings had namespaces, so
+// each namespace starts by picking its own fields out of it.
+var settingUpgrades = map[entities.SettingNamespace][]settingUpgrade{
+	entities.SettingNamespaceSite: {
+		pickLegacySettings(map[string]string{
+			"PortfolioOwnerID": "portfolio_owner_id",
+			"SiteName":         "site_name",
+			"SiteDescription":  "site_description",
+			"Language":         "language",
+			"MaintenanceMode":  "maintenance_mode",
+			"UpdatedAt":        "updated_at",
+		}),
+	},
+	entities.SettingNamespaceDisplay: {
+		pickLegacySettings(map[string]string{
+			"ShowProjects": "show_projects",
+			"Theme":        "theme",
+			"UpdatedAt":    "updated_at",
+		}),
+	},
+	entities.SettingNamespaceSEO: {
+		truncateSettings(pickLegacySettings(map[string]string{
+			"SiteName":        "meta_title",
+			"SiteDescription": "meta_description",
+			"UpdatedAt":       "updated_at",
+		}), map[string]int{
+			"meta_title":       entities.MaxMetaTitleLength,
+			"meta_description": entities.MaxMetaDescriptionLength,
+		}),
+	},
+	entities.SettingNamespaceContact: {
+		pickLegacySettings(map[string]string{
+			"ContactEmail": "contact_email",
+			"UpdatedAt":    "updated_at",
+		}),
+	},
+}
+
+var settingSchemaVersions = map[entities.SettingNamespace]int{
+	entities.SettingNamespaceSite:    entities.SiteSettingsSchemaVersion,
+	entities.SettingNamespaceDisplay: entities.DisplaySettingsSchemaVersion,
+	entities.SettingNamespaceSEO:     entities.SEOSettingsSchemaVersion,
+	entities.SettingNamespaceContact: entities.ContactSettingsSchemaVersion,
+}
+
+func pickLegacySettings(fields map[string]string) settingUpgrade {
+	return func(legacy map[string]any) map[string]any {
+		document := make(map[string]any, len(fields))
+		for from, to := range fields {
+			if value, ok := legacy[from]; ok {
+				document[to] = value
+			}
+		}
+		return document
+	}
+}
+
+// truncateSettings runs upgrade, then cuts the given string fields down to
+// their maximum length in characters, for fields copied from a longer one.
+func truncateSettings(upgrade settingUpgrade, maxLengths map[string]int) settingUpgrade {
+	return func(legacy map[string]any) map[string]any {
+		document := upgrade(legacy)
+		for field, maxLength := range maxLengths {
+			if value, ok := document[field].(string); ok {
+				if runes := []rune(value); len(runes) > maxLength {
+					document[field] = strings.TrimSpace(string(runes[:maxLength]))
+				}
+			}
+		}
+		return document
+	}
+}
+
+// upgradeSettingDocument brings a stored document up to the namespace's
+// current schema version and decodes it into target. Unknown fields are an
+// error, so a field added or removed without an upgrade function is caught
+// here instead of being silently dropped. It reports whether an upgrade ran
+// and returns the document as it should now be stored.
+func upgradeSettingDocument(namespace entities.SettingNamespace, document []byte, target any) ([]byte, bool, error) {
+	var fields map[string]any
+	if err := json.Unmarshal(document, &fields); err != nil {
+		return nil, false, domain.NewInternalError(fmt.Sprintf("stored %s settings are not valid JSON", namespace), err)
+	}
+	if fields == nil {
+		fields = map[string]any{}
+	}
+
+	version := 0
+	if stored, ok := fields["schema_version"].(float64); ok {
+		version = int(stored)
+	}
+
+	current := settingSchemaVersions[namespace]
+	upgrades := settingUpgrades[namespace]
+	if len(upgrades) != current {
+		return nil, false, domain.NewInternalError(fmt.Sprintf("%s settings are at schema version %d but have %d upgrades", namespace, current, len(upgrades)), nil)
+	}
+	if version > current {
+		return nil, false, domain.NewInternalError(fmt.Sprintf("stored %s settings use schema version %d, newer than this release supports (%d)", namespace, version, current), nil)
+	}
+
+	upgraded := version < current
+	for ; version < current; version++ {
+		fields = upgrades[version](fields)
+	}
+	fields["schema_version"] = current
+
+	document, err := json.Marshal(fields)
+	if err != nil {
+		return nil, false, domain.NewInternalError(fmt.Sprintf("failed to encode %s settings", namespace), err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return nil, false, domain.NewInternalError(fmt.Sprintf("stored %s settings do not match schema version %d", namespace, current), err)
+	}
+
+	return document, upgraded, nil
+}
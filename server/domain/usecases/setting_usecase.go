@@ -2,27 +2,212 @@ package usecases
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
 	"portfolio/logger"
+	"portfolio/service"
+	"time"
 )
 
 type SettingUseCase struct {
 	settingRepo interfaces.SettingRepository
+	unitOfWork  interfaces.UnitOfWork
+	cache       *service.CacheService
 	logger      *logger.Logger
 }
 
-func NewSettingUseCase(settingRepo interfaces.SettingRepository, logger *logger.Logger) *SettingUseCase {
+func NewSettingUseCase(settingRepo interfaces.SettingRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *SettingUseCase {
 	return &SettingUseCase{
 		settingRepo: settingRepo,
+		unitOfWork:  unitOfWork,
+		cache:       cache,
 		logger:      logger,
 	}
 }
 
-func (suc *SettingUseCase) Upsert(ctx context.Context, settingJson *entities.SettingJson) error {
-	return suc.settingRepo.Upsert(ctx, settingJson)
+// GetSettings returns every namespace, upgraded to its current schema.
+// Namespaces that were never saved come back with zero values.
+func (suc *SettingUseCase) GetSettings(ctx context.Context) (*entities.Settings, error) {
+	settings, err := readThrough(suc.cache, service.CacheKey(cacheNamespaceSettings, "current"), sharedOwner, func() (*entities.Settings, error) {
+		return suc.loadSettings(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Callers may modify the settings they get back, so hand out a copy.
+	copied := *settings
+	copied.SEO.Keywords = append([]string(nil), settings.SEO.Keywords...)
+	return &copied, nil
+}
+
+func (suc *SettingUseCase) UpdateSettings(ctx context.Context, settings *entities.Settings) error {
+	return suc.upsert(ctx, map[entities.SettingNamespace]any{
+		entities.SettingNamespaceSite:    &settings.Site,
+		entities.SettingNamespaceDisplay: &settings.Display,
+		entities.SettingNamespaceSEO:     &settings.SEO,
+		entities.SettingNamespaceContact: &settings.Contact,
+	})
+}
+
+func (suc *SettingUseCase) UpdateSiteSettings(ctx context.Context, site *entities.SiteSettings) error {
+	return suc.upsert(ctx, map[entities.SettingNamespace]any{entities.SettingNamespaceSite: site})
+}
+
+func (suc *SettingUseCase) UpdateDisplaySettings(ctx context.Context, display *entities.DisplaySettings) error {
+	return suc.upsert(ctx, map[entities.SettingNamespace]any{entities.SettingNamespaceDisplay: display})
+}
+
+func (suc *SettingUseCase) UpdateSEOSettings(ctx context.Context, seo *entities.SEOSettings) error {
+	return suc.upsert(ctx, map[entities.SettingNamespace]any{entities.SettingNamespaceSEO: seo})
+}
+
+func (suc *SettingUseCase) UpdateContactSettings(ctx context.Context, contact *entities.ContactSettings) error {
+	return suc.upsert(ctx, map[entities.SettingNamespace]any{entities.SettingNamespaceContact: contact})
 }
 
-func (suc *SettingUseCase) GetSettings(ctx context.Context) (*entities.SettingJson, error) {
-	return suc.settingRepo.GetSettings(ctx)
+// ResetSettings overwrites every namespace with the defaults, owned by the
+// given user. The defaults pass the same validation as an update. There is
+// no sensible default contact email, so the current one is kept.
+func (suc *SettingUseCase) ResetSettings(ctx context.Context, ownerID int) error {
+	if ownerID <= 0 {
+		suc.logger.Error("Invalid portfolio owner ID: %d", ownerID)
+		return domain.NewValidationError("Portfolio owner ID must be positive", "portfolio_owner_id", nil)
+	}
+
+	current, err := suc.loadSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	return suc.UpdateSettings(ctx, &entities.Settings{
+		Site: entities.SiteSettings{
+			PortfolioOwnerID: ownerID,
+			SiteName:         "My Portfolio",
+			SiteDescription:  "Personal portfolio showcasing my projects and skills",
+			Language:         "en",
+		},
+		Display: entities.DisplaySettings{
+			ShowProjects: true,
+			Theme:        "light",
+		},
+		SEO: entities.SEOSettings{
+			MetaTitle:       "My Portfolio",
+			MetaDescription: "Personal portfolio showcasing my projects and skills",
+		},
+		Contact: entities.ContactSettings{
+			ContactEmail: current.Contact.ContactEmail,
+		},
+	})
+}
+
+// upsert writes the given namespaces in one unit of work, stamping each with
+// its current schema version and the update time.
+func (suc *SettingUseCase) upsert(ctx context.Context, values map[entities.SettingNamespace]any) error {
+	defer invalidate(suc.cache, cacheNamespaceSettings, sharedOwner)
+
+	if current, err := suc.loadSettings(ctx); err == nil {
+		auditBefore(ctx, current)
+	}
+
+	now := time.Now()
+	err := suc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for namespace, value := range values {
+			document, err := encodeSettingDocument(namespace, value, now)
+			if err != nil {
+				return err
+			}
+			if err := suc.settingRepo.UpsertNamespace(ctx, namespace, document); err != nil {
+				suc.logger.Error("Failed to save %s settings: %v", namespace, err)
+				return domain.NewDatabaseError(fmt.Sprintf("saving %s settings", namespace), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if updated, err := suc.loadSettings(ctx); err == nil {
+		auditAfter(ctx, updated)
+	}
+	return nil
+}
+
+func (suc *SettingUseCase) loadSettings(ctx context.Context) (*entities.Settings, error) {
+	settings := &entities.Settings{}
+	targets := map[entities.SettingNamespace]any{
+		entities.SettingNamespaceSite:    &settings.Site,
+		entities.SettingNamespaceDisplay: &settings.Display,
+		entities.SettingNamespaceSEO:     &settings.SEO,
+		entities.SettingNamespaceContact: &settings.Contact,
+	}
+
+	for _, namespace := range entities.SettingNamespaces {
+		if err := suc.loadNamespace(ctx, namespace, targets[namespace]); err != nil {
+			return nil, err
+		}
+	}
+	return settings, nil
+}
+
+// loadNamespace decodes one namespace, falling back to the legacy flat
+// document when the namespace was never saved. A document that needed an
+// upgrade is written back so the upgrade only runs once.
+func (suc *SettingUseCase) loadNamespace(ctx context.Context, namespace entities.SettingNamespace, target any) error {
+	document, err := suc.settingRepo.GetNamespace(ctx, namespace)
+	if err != nil {
+		suc.logger.Error("Failed to get %s settings: %v", namespace, err)
+		return domain.NewDatabaseError(fmt.Sprintf("retrieving %s settings", namespace), err)
+	}
+
+	stored := document != nil
+	if !stored {
+		document, err = suc.settingRepo.GetLegacy(ctx)
+		if err != nil {
+			suc.logger.Error("Failed to get legacy settings: %v", err)
+			return domain.NewDatabaseError("retrieving legacy settings", err)
+		}
+		if document == nil {
+			document = []byte("{}")
+		}
+	}
+
+	upgradedDocument, upgraded, err := upgradeSettingDocument(namespace, document, target)
+	if err != nil {
+		suc.logger.Error("Failed to read %s settings: %v", namespace, err)
+		return err
+	}
+
+	if upgraded && (stored || string(document) != "{}") {
+		if err := suc.settingRepo.UpsertNamespace(ctx, namespace, upgradedDocument); err != nil {
+			suc.logger.Warn("Failed to save upgraded %s settings: %v", namespace, err)
+		} else {
+			suc.logger.Info("Upgraded %s settings to schema version %d", namespace, settingSchemaVersions[namespace])
+		}
+	}
+	return nil
+}
+
+func encodeSettingDocument(namespace entities.SettingNamespace, value any, updatedAt time.Time) ([]byte, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, domain.NewInternalError(fmt.Sprintf("failed to encode %s settings", namespace), err)
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, domain.NewInternalError(fmt.Sprintf("failed to encode %s settings", namespace), err)
+	}
+	fields["schema_version"] = settingSchemaVersions[namespace]
+	fields["updated_at"] = updatedAt
+
+	document, err := json.Marshal(fields)
+	if err != nil {
+		return nil, domain.NewInternalError(fmt.Sprintf("failed to encode %s settings", namespace), err)
+	}
+	return document, nil
 }
@@ -0,0 +1,140 @@
+package usecases_test
+
+import (
+	"encoding/json"
+	"portfolio/domain/entities"
+	settingDto "portfolio/dto/setting"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestResetSettingsPassesValidation(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		if err := f.settings.ResetSettings(t.Context(), f.userID); err != nil {
+			t.Fatalf("ResetSettings failed: %v", err)
+		}
+
+		settings, err := f.settings.GetSettings(t.Context())
+		if err != nil {
+			t.Fatalf("GetSettings failed: %v", err)
+		}
+		if settings.Site.PortfolioOwnerID != f.userID {
+			t.Fatalf("portfolio owner after reset = %d, want %d", settings.Site.PortfolioOwnerID, f.userID)
+		}
+
+		req := settingDto.UpdateSettingRequest{
+			Site: settingDto.UpdateSiteSettingsRequest{
+				PortfolioOwnerID: settings.Site.PortfolioOwnerID,
+				SiteName:         settings.Site.SiteName,
+				SiteDescription:  settings.Site.SiteDescription,
+				Language:         settings.Site.Language,
+			},
+			Display: settingDto.UpdateDisplaySettingsRequest{
+				ShowProjects: settings.Display.ShowProjects,
+				Theme:        settings.Display.Theme,
+			},
+			SEO: settingDto.UpdateSEOSettingsRequest{
+				MetaTitle:       settings.SEO.MetaTitle,
+				MetaDescription: settings.SEO.MetaDescription,
+				Keywords:        settings.SEO.Keywords,
+			},
+			Contact: settingDto.UpdateContactSettingsRequest{
+				ContactEmail: settings.Contact.ContactEmail,
+			},
+		}
+		if err := req.Validate(); err != nil {
+			t.Fatalf("reset settings fail validation: %v", err)
+		}
+	})
+}
+
+func TestLegacySEOSettingsAreTruncated(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		// A document without a schema version is the flat one written
+		// before settings had namespaces.
+		legacy, err := json.Marshal(map[string]any{
+			"SiteName":        strings.Repeat("Portfolio ", 10),
+			"SiteDescription": strings.Repeat("A long site description. ", 20),
+		})
+		if err != nil {
+			t.Fatalf("Failed to encode legacy settings: %v", err)
+		}
+		if err := f.repos.Setting.UpsertNamespace(t.Context(), entities.SettingNamespaceSEO, legacy); err != nil {
+			t.Fatalf("UpsertNamespace failed: %v", err)
+		}
+
+		settings, err := f.settings.GetSettings(t.Context())
+		if err != nil {
+			t.Fatalf("GetSettings failed: %v", err)
+		}
+		if length := utf8.RuneCountInString(settings.SEO.MetaTitle); length == 0 || length > entities.MaxMetaTitleLength {
+			t.Fatalf("upgraded meta title has %d characters, want 1 to %d", length, entities.MaxMetaTitleLength)
+		}
+		if length := utf8.RuneCountInString(settings.SEO.MetaDescription); length == 0 || length > entities.MaxMetaDescriptionLength {
+			t.Fatalf("upgraded meta description has %d characters, want 1 to %d", length, entities.MaxMetaDescriptionLength)
+		}
+	})
+}
+
+func TestResetSettingsKeepsContactEmail(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		if err := f.settings.UpdateContactSettings(t.Context(), &entities.ContactSettings{ContactEmail: "me@portfolio.dev"}); err != nil {
+			t.Fatalf("UpdateContactSettings failed: %v", err)
+		}
+		if err := f.settings.ResetSettings(t.Context(), f.userID); err != nil {
+			t.Fatalf("ResetSettings failed: %v", err)
+		}
+
+		settings, err := f.settings.GetSettings(t.Context())
+		if err != nil {
+			t.Fatalf("GetSettings failed: %v", err)
+		}
+		if settings.Contact.ContactEmail != "me@portfolio.dev" {
+			t.Fatalf("contact email after reset = %q, want it kept", settings.Contact.ContactEmail)
+		}
+	})
+}
+
+func TestUpdateSettingRequestAcceptsLegacyFlatBody(t *testing.T) {
+	body := `{
+		"show_projects": true,
+		"portfolio_owner_id": 1,
+		"site_name": "Portfolio",
+		"site_description": "Projects and writing",
+		"contact_email": "Me@Portfolio.dev",
+		"theme": "dark",
+		"language": "fr",
+		"maintenance_mode": false
+	}`
+
+	var req settingDto.UpdateSettingRequest
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !req.IsLegacy() {
+		t.Fatal("flat body was not recognised as legacy")
+	}
+	if err := req.Validate(); err != nil {
+		t.Fatalf("legacy body fails validation: %v", err)
+	}
+
+	settings := req.ToEntity()
+	if settings.Site.SiteName != "Portfolio" || settings.Site.Language != "fr" || settings.Site.PortfolioOwnerID != 1 {
+		t.Errorf("site settings = %+v", settings.Site)
+	}
+	if !settings.Display.ShowProjects || settings.Display.Theme != "dark" {
+		t.Errorf("display settings = %+v", settings.Display)
+	}
+	if settings.Contact.ContactEmail != "me@portfolio.dev" {
+		t.Errorf("contact email = %q", settings.Contact.ContactEmail)
+	}
+
+	var namespaced settingDto.UpdateSettingRequest
+	if err := json.Unmarshal([]byte(`{"site": {"site_name": "Portfolio"}, "display": {"theme": "dark"}}`), &namespaced); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if namespaced.IsLegacy() || namespaced.Site.SiteName != "Portfolio" || namespaced.Display.Theme != "dark" {
+		t.Errorf("namespaced body decoded as %+v", namespaced)
+	}
+}
@@ -0,0 +1,196 @@
+package usecases
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
+	dto "portfolio/dto/skill"
+	"portfolio/logger"
+	"portfolio/service"
+	"strconv"
+)
+
+// maxSkillCategories bounds the skill categories of one user.
+const maxSkillCategories = 50
+
+// SkillCategoryUseCase manages the categories skills are grouped by. They
+// are cached with the skills, whose grouped lists embed them.
+type SkillCategoryUseCase struct {
+	categoryRepo interfaces.SkillCategoryRepository
+	unitOfWork   interfaces.UnitOfWork
+	cache        *service.CacheService
+	logger       *logger.Logger
+}
+
+func NewSkillCategoryUseCase(categoryRepo interfaces.SkillCategoryRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *SkillCategoryUseCase {
+	return &SkillCategoryUseCase{
+		categoryRepo: categoryRepo,
+		unitOfWork:   unitOfWork,
+		cache:        cache,
+		logger:       logger,
+	}
+}
+
+// GetSkillCategories returns the user's skill categories by position.
+func (uc *SkillCategoryUseCase) GetSkillCategories(ctx context.Context, userID int) ([]*entities.SkillCategory, error) {
+	key := service.CacheKey(cacheNamespaceSkills, "categories", strconv.Itoa(userID))
+	categories, err := readThrough(uc.cache, key, userID, func() ([]*entities.SkillCategory, error) {
+		return uc.categoryRepo.GetByUserID(ctx, userID)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get skill categories of user %d: %v", userID, err)
+		return nil, err
+	}
+	return categories, nil
+}
+
+// GetSkillCategory returns a category of the user; categories of other
+// users are not found.
+func (uc *SkillCategoryUseCase) GetSkillCategory(ctx context.Context, userID, categoryID int) (*entities.SkillCategory, error) {
+	category, err := uc.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if !category.BelongsToUser(userID) {
+		return nil, domain.NewNotFoundError("Skill category", strconv.Itoa(categoryID))
+	}
+	return category, nil
+}
+
+func (uc *SkillCategoryUseCase) CreateSkillCategory(ctx context.Context, userID int, req *dto.SkillCategoryRequest) (*entities.SkillCategory, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var created *entities.SkillCategory
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		categories, err := uc.categoryRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if len(categories) >= maxSkillCategories {
+			return domain.NewValidationError("A user can have at most "+strconv.Itoa(maxSkillCategories)+" skill categories", "categories", nil)
+		}
+		if err := uc.checkNameAvailable(ctx, userID, req.Name, 0); err != nil {
+			return err
+		}
+
+		created, err = uc.categoryRepo.Create(ctx, req.ToEntity(userID))
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to create skill category for user %d: %v", userID, err)
+		return nil, err
+	}
+
+	auditAction(ctx, entities.RevisionActionCreate)
+	auditResource(ctx, "skill_categories", strconv.Itoa(created.SkillCategoryID))
+	auditAfter(ctx, created)
+	invalidate(uc.cache, cacheNamespaceSkills, userID)
+	return created, nil
+}
+
+func (uc *SkillCategoryUseCase) UpdateSkillCategory(ctx context.Context, userID, categoryID int, req *dto.SkillCategoryRequest) (*entities.SkillCategory, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.GetSkillCategory(ctx, userID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	auditResource(ctx, "skill_categories", strconv.Itoa(categoryID))
+	auditBefore(ctx, existing)
+
+	var updated *entities.SkillCategory
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := uc.checkNameAvailable(ctx, userID, req.Name, categoryID); err != nil {
+			return err
+		}
+		updated, err = uc.categoryRepo.Update(ctx, categoryID, req.ToEntity(userID))
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update skill category %d: %v", categoryID, err)
+		return nil, err
+	}
+
+	auditAfter(ctx, updated)
+	invalidate(uc.cache, cacheNamespaceSkills, userID)
+	return updated, nil
+}
+
+// DeleteSkillCategory deletes a category of the user. Its skills are kept
+// outside of any category.
+func (uc *SkillCategoryUseCase) DeleteSkillCategory(ctx context.Context, userID, categoryID int) error {
+	existing, err := uc.GetSkillCategory(ctx, userID, categoryID)
+	if err != nil {
+		return err
+	}
+
+	auditResource(ctx, "skill_categories", strconv.Itoa(categoryID))
+	auditBefore(ctx, existing)
+
+	if err := uc.categoryRepo.Delete(ctx, categoryID); err != nil {
+		uc.logger.Error("Failed to delete skill category %d: %v", categoryID, err)
+		return err
+	}
+
+	invalidate(uc.cache, cacheNamespaceSkills, userID)
+	return nil
+}
+
+// ReorderSkillCategories moves the user's categories into the order of
+// req.IDs, which must list every one of them exactly once. Grouped skill
+// lists follow this order.
+func (uc *SkillCategoryUseCase) ReorderSkillCategories(ctx context.Context, userID int, req *orderDto.OrderRequest) ([]*entities.SkillCategory, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var reordered []*entities.SkillCategory
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		categories, err := uc.categoryRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int, 0, len(categories))
+		for _, item := range categories {
+			ids = append(ids, item.SkillCategoryID)
+		}
+		if !req.IsPermutationOf(ids) {
+			return domain.NewValidationError("IDs must list every skill category exactly once", "ids", nil)
+		}
+		auditBefore(ctx, ids)
+
+		if err := uc.categoryRepo.Reorder(ctx, userID, req.IDs); err != nil {
+			return err
+		}
+		reordered, err = uc.categoryRepo.GetByUserID(ctx, userID)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder skill categories of user %d: %v", userID, err)
+		return nil, err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceSkills, userID)
+	return reordered, nil
+}
+
+// checkNameAvailable rejects a name another category of the user has.
+func (uc *SkillCategoryUseCase) checkNameAvailable(ctx context.Context, userID int, name string, exceptCategoryID int) error {
+	exists, err := uc.categoryRepo.ExistsByNameAndUserID(ctx, name, userID, exceptCategoryID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return domain.NewAlreadyExistsError("Skill category", name)
+	}
+	return nil
+}
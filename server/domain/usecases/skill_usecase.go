@@ -6,20 +6,36 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
 	"portfolio/logger"
+	"portfolio/service"
+	"strconv"
+	"time"
 )
 
 type SkillUseCase struct {
-	skillRepo interfaces.SkillRepository
-	userRepo  interfaces.UserRepository
-	logger    *logger.Logger
+	skillRepo      interfaces.SkillRepository
+	categoryRepo   interfaces.SkillCategoryRepository
+	projectRepo    interfaces.ProjectRepository
+	experienceRepo interfaces.ExperienceRepository
+	userRepo       interfaces.UserRepository
+	revisionRepo   interfaces.RevisionRepository
+	unitOfWork     interfaces.UnitOfWork
+	cache          *service.CacheService
+	logger         *logger.Logger
 }
 
-func NewSkillUseCase(skillRepo interfaces.SkillRepository, userRepo interfaces.UserRepository, logger *logger.Logger) *SkillUseCase {
+func NewSkillUseCase(skillRepo interfaces.SkillRepository, categoryRepo interfaces.SkillCategoryRepository, projectRepo interfaces.ProjectRepository, experienceRepo interfaces.ExperienceRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *SkillUseCase {
 	return &SkillUseCase{
-		skillRepo: skillRepo,
-		userRepo:  userRepo,
-		logger:    logger,
+		skillRepo:      skillRepo,
+		categoryRepo:   categoryRepo,
+		projectRepo:    projectRepo,
+		experienceRepo: experienceRepo,
+		userRepo:       userRepo,
+		revisionRepo:   revisionRepo,
+		unitOfWork:     unitOfWork,
+		cache:          cache,
+		logger:         logger,
 	}
 }
 
@@ -34,6 +50,10 @@ func (uc *SkillUseCase) CreateSkill(ctx context.Context, skill *entities.Skill)
 		return nil, domain.NewValidationError("level", "skill level must be between 1 and 5", nil)
 	}
 
+	if err := uc.checkDetails(ctx, skill.UserID, skill); err != nil {
+		return nil, err
+	}
+
 	userExists, err := uc.userRepo.ExistsByID(ctx, skill.UserID)
 	if err != nil {
 		uc.logger.Error("Failed to check if user exists: %v", err)
@@ -54,22 +74,59 @@ func (uc *SkillUseCase) CreateSkill(ctx context.Context, skill *entities.Skill)
 		return nil, domain.NewAlreadyExistsError("Skill", skill.Name)
 	}
 
-	createdSkill, err := uc.skillRepo.Create(ctx, skill)
+	skill.SetDefaultState(time.Now())
+	var createdSkill *entities.Skill
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		created, err := uc.skillRepo.Create(ctx, skill)
+		if err != nil {
+			return err
+		}
+		createdSkill, err = uc.saveEvidence(ctx, created.SkillID, skill)
+		return err
+	})
 	if err != nil {
 		uc.logger.Error("Failed to create skill: %v", err)
-		return nil, domain.NewInternalError("failed to create skill", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to create skill", err))
 	}
 
+	uc.snapshotRevision(ctx, createdSkill.SkillID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespaceSkills, createdSkill.UserID)
 	return createdSkill, nil
 }
 
+// CreateSkillsAtomically creates every skill or none: the first failure rolls
+// back the skills created before it.
+func (uc *SkillUseCase) CreateSkillsAtomically(ctx context.Context, skills []*entities.Skill) ([]*entities.Skill, error) {
+	defer invalidate(uc.cache, cacheNamespaceSkills, batchOwners(skills, skillOwner)...)
+
+	var createdSkills []*entities.Skill
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, skill := range skills {
+			createdSkill, err := uc.CreateSkill(ctx, skill)
+			if err != nil {
+				uc.logger.Error("Failed to create skill at index %d (name: %s), rolling back: %v", i, skill.Name, err)
+				return err
+			}
+			createdSkills = append(createdSkills, createdSkill)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdSkills, nil
+}
+
 func (uc *SkillUseCase) GetSkillByID(ctx context.Context, skillID int) (*entities.Skill, error) {
 	if skillID <= 0 {
 		uc.logger.Error("Skill ID is required")
 		return nil, domain.NewValidationError("skillID", "skill ID must be positive", nil)
 	}
 
-	skill, err := uc.skillRepo.GetByID(ctx, skillID)
+	skill, err := readItem(uc.cache, itemCacheKey(cacheNamespaceSkills, skillID), skillOwner, func() (*entities.Skill, error) {
+		return uc.skillRepo.GetByID(ctx, skillID)
+	})
 	if err != nil {
 		uc.logger.Error("Failed to get skill by ID %d: %v", skillID, err)
 		return nil, domain.NewInternalError("failed to retrieve skill", err)
@@ -83,19 +140,21 @@ func (uc *SkillUseCase) GetSkillByID(ctx context.Context, skillID int) (*entitie
 	return skill, nil
 }
 
-func (uc *SkillUseCase) GetSkillsByUserID(ctx context.Context, userID int) ([]*entities.Skill, error) {
+func (uc *SkillUseCase) GetSkillsByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Skill], error) {
 	if userID <= 0 {
 		uc.logger.Error("User ID is required")
 		return nil, domain.NewValidationError("userID", "user ID must be positive", nil)
 	}
 
-	skills, err := uc.skillRepo.GetByUserID(ctx, userID)
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespaceSkills, userID, query), userID, func() (*entities.ListPage[*entities.Skill], error) {
+		return uc.skillRepo.GetByUserID(ctx, userID, query)
+	})
 	if err != nil {
 		uc.logger.Error("Failed to get skills for user %d: %v", userID, err)
-		return nil, domain.NewInternalError("failed to retrieve skills", err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve skills", err))
 	}
 
-	return skills, nil
+	return page, nil
 }
 
 func (uc *SkillUseCase) UpdateSkill(ctx context.Context, skillID int, skill *entities.Skill) (*entities.Skill, error) {
@@ -109,23 +168,42 @@ func (uc *SkillUseCase) UpdateSkill(ctx context.Context, skillID int, skill *ent
 		return nil, domain.NewValidationError("level", "skill level must be between 1 and 5", nil)
 	}
 
-	exists, err := uc.skillRepo.ExistsByID(ctx, skill.SkillID)
+	existingSkill, err := uc.skillRepo.GetByID(ctx, skill.SkillID)
 	if err != nil {
 		uc.logger.Error("Failed to check if skill exists: %v", err)
 		return nil, domain.NewInternalError("failed to check skill existence", err)
 	}
-	if !exists {
+	if existingSkill == nil {
 		uc.logger.Error("Skill not found: %d", skill.SkillID)
 		return nil, domain.NewNotFoundError("Skill", fmt.Sprint(skill.SkillID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Skill", skillID, existingSkill.Version); err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkDetails(ctx, existingSkill.UserID, skill); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingSkill)
+
 	skill.MarkAsUpdated()
-	updatedSkill, err := uc.skillRepo.Update(ctx, skillID, skill)
+	var updatedSkill *entities.Skill
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, err := uc.skillRepo.Update(ctx, skillID, skill); err != nil {
+			return err
+		}
+		updatedSkill, err = uc.saveEvidence(ctx, skillID, skill)
+		return err
+	})
 	if err != nil {
 		uc.logger.Error("Failed to update skill: %v", err)
-		return nil, domain.NewInternalError("failed to update skill", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update skill", err))
 	}
 
+	uc.snapshotRevision(ctx, skillID, entities.RevisionActionUpdate)
+	invalidate(uc.cache, cacheNamespaceSkills, existingSkill.UserID)
 	return updatedSkill, nil
 }
 
@@ -146,6 +224,12 @@ func (uc *SkillUseCase) PatchSkill(ctx context.Context, skillID int, patchData *
 		return nil, domain.NewNotFoundError("Skill", fmt.Sprint(skillID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Skill", skillID, existingSkill.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingSkill)
+
 	if patchData.Name != "" {
 		existingSkill.Name = patchData.Name
 	}
@@ -156,14 +240,41 @@ func (uc *SkillUseCase) PatchSkill(ctx context.Context, skillID int, patchData *
 		}
 		existingSkill.Level = patchData.Level
 	}
+	if patchData.CategoryID != nil {
+		existingSkill.CategoryID = patchData.CategoryID
+		if *patchData.CategoryID == 0 {
+			existingSkill.CategoryID = nil
+		}
+	}
+	if patchData.YearsOfExperience != nil {
+		existingSkill.YearsOfExperience = patchData.YearsOfExperience
+	}
+	if patchData.LastUsedAt != nil {
+		existingSkill.LastUsedAt = patchData.LastUsedAt
+	}
+	existingSkill.Projects = patchData.Projects
+	existingSkill.Experiences = patchData.Experiences
+
+	if err := uc.checkDetails(ctx, existingSkill.UserID, existingSkill); err != nil {
+		return nil, err
+	}
 
 	existingSkill.MarkAsUpdated()
-	patchedSkill, err := uc.skillRepo.Patch(ctx, skillID, existingSkill)
+	var patchedSkill *entities.Skill
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, err := uc.skillRepo.Patch(ctx, skillID, existingSkill); err != nil {
+			return err
+		}
+		patchedSkill, err = uc.saveEvidence(ctx, skillID, existingSkill)
+		return err
+	})
 	if err != nil {
 		uc.logger.Error("Failed to patch skill: %v", err)
-		return nil, domain.NewInternalError("failed to patch skill", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to patch skill", err))
 	}
 
+	uc.snapshotRevision(ctx, skillID, entities.RevisionActionPatch)
+	invalidate(uc.cache, cacheNamespaceSkills, existingSkill.UserID)
 	return patchedSkill, nil
 }
 
@@ -173,22 +284,49 @@ func (uc *SkillUseCase) DeleteSkill(ctx context.Context, skillID int) error {
 		return domain.NewValidationError("skillID", "skill ID must be positive", nil)
 	}
 
-	exists, err := uc.skillRepo.ExistsByID(ctx, skillID)
+	existingSkill, err := uc.skillRepo.GetByID(ctx, skillID)
 	if err != nil {
 		uc.logger.Error("Failed to check if skill exists: %v", err)
 		return domain.NewInternalError("failed to check skill existence", err)
 	}
-	if !exists {
+	if existingSkill == nil {
 		uc.logger.Error("Skill not found: %d", skillID)
 		return domain.NewNotFoundError("Skill", fmt.Sprint(skillID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Skill", skillID, existingSkill.Version); err != nil {
+		return err
+	}
+
 	err = uc.skillRepo.Delete(ctx, skillID)
 	if err != nil {
 		uc.logger.Error("Failed to delete skill: %v", err)
-		return domain.NewInternalError("failed to delete skill", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete skill", err))
 	}
 
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeSkill, skillID, entities.RevisionActionDelete, existingSkill)
+	invalidate(uc.cache, cacheNamespaceSkills, existingSkill.UserID)
+	return nil
+}
+
+// ReorderSkills moves the user's skills into the order of req, which must list
+// every live one of them exactly once. Lists follow that order unless
+// another sort is requested.
+func (uc *SkillUseCase) ReorderSkills(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.skillRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder skills of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceSkills, userID)
 	return nil
 }
 
@@ -201,3 +339,86 @@ func (uc *SkillUseCase) GetAllSkills(ctx context.Context) ([]*entities.Skill, er
 
 	return skills, nil
 }
+
+// checkDetails checks the skill's years of experience and that its category
+// and evidence belong to the user and are live, filling in the titles of
+// the evidence. Projects and experiences in the trash cannot be linked.
+func (uc *SkillUseCase) checkDetails(ctx context.Context, userID int, skill *entities.Skill) error {
+	if !skill.HasValidYearsOfExperience() {
+		return domain.NewValidationError("Years of experience must be between 0 and "+strconv.Itoa(entities.MaxSkillYearsOfExperience), "years_of_experience", nil)
+	}
+
+	if skill.CategoryID != nil {
+		category, err := uc.categoryRepo.GetByID(ctx, *skill.CategoryID)
+		if err != nil && !domain.IsNotFound(err) {
+			uc.logger.Error("Failed to look up skill category %d: %v", *skill.CategoryID, err)
+			return err
+		}
+		if category == nil || !category.BelongsToUser(userID) {
+			return domain.NewValidationError("Unknown skill category "+strconv.Itoa(*skill.CategoryID)+"; create it before using it", "category_id", nil)
+		}
+	}
+
+	seen := make(map[int]bool, len(skill.Projects))
+	for _, evidence := range skill.Projects {
+		project, err := uc.projectRepo.GetByID(ctx, evidence.ID)
+		if err != nil && !domain.IsNotFound(err) {
+			uc.logger.Error("Failed to look up project %d: %v", evidence.ID, err)
+			return err
+		}
+		if project == nil || !project.BelongsToUser(userID) {
+			return domain.NewValidationError("Unknown project "+strconv.Itoa(evidence.ID), "project_ids", nil)
+		}
+		if seen[evidence.ID] {
+			return domain.NewValidationError("Project "+strconv.Itoa(evidence.ID)+" is listed twice", "project_ids", nil)
+		}
+		seen[evidence.ID] = true
+		evidence.Title, evidence.Publishing = project.Title, project.Publishing
+	}
+
+	seen = make(map[int]bool, len(skill.Experiences))
+	for _, evidence := range skill.Experiences {
+		experience, err := uc.experienceRepo.GetByID(ctx, evidence.ID)
+		if err != nil && !domain.IsNotFound(err) {
+			uc.logger.Error("Failed to look up experience %d: %v", evidence.ID, err)
+			return err
+		}
+		if experience == nil || !experience.BelongsToUser(userID) {
+			return domain.NewValidationError("Unknown experience "+strconv.Itoa(evidence.ID), "experience_ids", nil)
+		}
+		if seen[evidence.ID] {
+			return domain.NewValidationError("Experience "+strconv.Itoa(evidence.ID)+" is listed twice", "experience_ids", nil)
+		}
+		seen[evidence.ID] = true
+		evidence.Title, evidence.Organization, evidence.Publishing = experience.JobTitle, experience.CompanyName, experience.Publishing
+	}
+	return nil
+}
+
+// saveEvidence links the skill to the evidence of skill, leaving the links
+// of a nil list as they are, and returns the stored skill.
+func (uc *SkillUseCase) saveEvidence(ctx context.Context, skillID int, skill *entities.Skill) (*entities.Skill, error) {
+	if skill.Projects != nil {
+		if err := uc.skillRepo.SetProjects(ctx, skillID, entities.SkillEvidenceIDs(skill.Projects)); err != nil {
+			uc.logger.Error("Failed to link projects to skill %d: %v", skillID, err)
+			return nil, err
+		}
+	}
+	if skill.Experiences != nil {
+		if err := uc.skillRepo.SetExperiences(ctx, skillID, entities.SkillEvidenceIDs(skill.Experiences)); err != nil {
+			uc.logger.Error("Failed to link experiences to skill %d: %v", skillID, err)
+			return nil, err
+		}
+	}
+	return uc.skillRepo.GetByID(ctx, skillID)
+}
+
+// snapshotRevision records the stored state of the skill as a revision.
+func (uc *SkillUseCase) snapshotRevision(ctx context.Context, skillID int, action string) {
+	skill, err := uc.skillRepo.GetByID(ctx, skillID)
+	if err != nil || skill == nil {
+		uc.logger.Error("Failed to load skill %d for revision: %v", skillID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeSkill, skillID, action, skill)
+}
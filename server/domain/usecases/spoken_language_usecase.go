@@ -0,0 +1,288 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
+	spokenLanguageDto "portfolio/dto/spoken_language"
+	"portfolio/logger"
+	"portfolio/service"
+	"time"
+)
+
+type SpokenLanguageUseCase struct {
+	spokenLanguageRepo interfaces.SpokenLanguageRepository
+	userRepo           interfaces.UserRepository
+	revisionRepo       interfaces.RevisionRepository
+	unitOfWork         interfaces.UnitOfWork
+	cache              *service.CacheService
+	logger             *logger.Logger
+}
+
+func NewSpokenLanguageUseCase(spokenLanguageRepo interfaces.SpokenLanguageRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *SpokenLanguageUseCase {
+	return &SpokenLanguageUseCase{
+		spokenLanguageRepo: spokenLanguageRepo,
+		userRepo:           userRepo,
+		revisionRepo:       revisionRepo,
+		unitOfWork:         unitOfWork,
+		cache:              cache,
+		logger:             logger,
+	}
+}
+
+func (uc *SpokenLanguageUseCase) CreateSpokenLanguage(ctx context.Context, spokenLanguage *entities.SpokenLanguage) (*entities.SpokenLanguage, error) {
+	if err := uc.checkFields(spokenLanguage); err != nil {
+		return nil, err
+	}
+
+	userExists, err := uc.userRepo.ExistsByID(ctx, spokenLanguage.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to check if user exists: %v", err)
+		return nil, domain.NewInternalError("failed to validate user", err)
+	}
+	if !userExists {
+		uc.logger.Error("User not found for ID %d", spokenLanguage.UserID)
+		return nil, domain.NewNotFoundError("User", fmt.Sprint(spokenLanguage.UserID))
+	}
+
+	if err := uc.checkNameAvailable(ctx, spokenLanguage, 0); err != nil {
+		return nil, err
+	}
+
+	spokenLanguage.SetDefaultState(time.Now())
+	createdSpokenLanguage, err := uc.spokenLanguageRepo.Create(ctx, spokenLanguage)
+	if err != nil {
+		uc.logger.Error("Failed to create spoken language: %v", err)
+		return nil, domain.NewInternalError("failed to create spoken language", err)
+	}
+
+	uc.snapshotRevision(ctx, createdSpokenLanguage.SpokenLanguageID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespaceSpokenLanguages, createdSpokenLanguage.UserID)
+	return createdSpokenLanguage, nil
+}
+
+// CreateSpokenLanguagesAtomically creates every spoken language or none: the
+// first failure rolls back the spoken languages created before it.
+func (uc *SpokenLanguageUseCase) CreateSpokenLanguagesAtomically(ctx context.Context, spokenLanguages []*entities.SpokenLanguage) ([]*entities.SpokenLanguage, error) {
+	defer invalidate(uc.cache, cacheNamespaceSpokenLanguages, batchOwners(spokenLanguages, spokenLanguageOwner)...)
+
+	var createdSpokenLanguages []*entities.SpokenLanguage
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, spokenLanguage := range spokenLanguages {
+			createdSpokenLanguage, err := uc.CreateSpokenLanguage(ctx, spokenLanguage)
+			if err != nil {
+				uc.logger.Error("Failed to create spoken language at index %d (name: %s), rolling back: %v", i, spokenLanguage.Name, err)
+				return err
+			}
+			createdSpokenLanguages = append(createdSpokenLanguages, createdSpokenLanguage)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdSpokenLanguages, nil
+}
+
+func (uc *SpokenLanguageUseCase) GetSpokenLanguageByID(ctx context.Context, spokenLanguageID int) (*entities.SpokenLanguage, error) {
+	if spokenLanguageID <= 0 {
+		uc.logger.Error("Invalid spoken language ID: %d", spokenLanguageID)
+		return nil, domain.NewValidationError("Spoken language ID must be positive", "spokenLanguageID", nil)
+	}
+
+	spokenLanguage, err := readItem(uc.cache, itemCacheKey(cacheNamespaceSpokenLanguages, spokenLanguageID), spokenLanguageOwner, func() (*entities.SpokenLanguage, error) {
+		return uc.spokenLanguageRepo.GetByID(ctx, spokenLanguageID)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get spoken language by ID %d: %v", spokenLanguageID, err)
+		return nil, domain.NewInternalError("failed to retrieve spoken language", err)
+	}
+
+	if spokenLanguage == nil {
+		uc.logger.Error("Spoken language not found for ID %d", spokenLanguageID)
+		return nil, domain.NewNotFoundError("Spoken language", fmt.Sprint(spokenLanguageID))
+	}
+
+	return spokenLanguage, nil
+}
+
+func (uc *SpokenLanguageUseCase) GetSpokenLanguagesByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.SpokenLanguage], error) {
+	if userID <= 0 {
+		uc.logger.Error("Invalid user ID: %d", userID)
+		return nil, domain.NewValidationError("User ID must be positive", "userID", nil)
+	}
+
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespaceSpokenLanguages, userID, query), userID, func() (*entities.ListPage[*entities.SpokenLanguage], error) {
+		return uc.spokenLanguageRepo.GetByUserID(ctx, userID, query)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get spoken languages for user %d: %v", userID, err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve spoken languages", err))
+	}
+
+	return page, nil
+}
+
+func (uc *SpokenLanguageUseCase) UpdateSpokenLanguage(ctx context.Context, spokenLanguageID int, spokenLanguage *entities.SpokenLanguage) (*entities.SpokenLanguage, error) {
+	if err := uc.checkFields(spokenLanguage); err != nil {
+		return nil, err
+	}
+
+	existingSpokenLanguage, err := uc.spokenLanguageRepo.GetByID(ctx, spokenLanguageID)
+	if err != nil {
+		uc.logger.Error("Failed to check if spoken language exists: %v", err)
+		return nil, domain.NewInternalError("failed to check spoken language existence", err)
+	}
+	if existingSpokenLanguage == nil {
+		uc.logger.Error("Spoken language not found for ID %d", spokenLanguageID)
+		return nil, domain.NewNotFoundError("Spoken language", fmt.Sprint(spokenLanguageID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Spoken language", spokenLanguageID, existingSpokenLanguage.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingSpokenLanguage)
+
+	return uc.save(ctx, spokenLanguageID, spokenLanguage, entities.RevisionActionUpdate)
+}
+
+func (uc *SpokenLanguageUseCase) PatchSpokenLanguage(ctx context.Context, spokenLanguageID int, req *spokenLanguageDto.PatchSpokenLanguageRequest) (*entities.SpokenLanguage, error) {
+	if spokenLanguageID <= 0 {
+		uc.logger.Error("Spoken language ID is required")
+		return nil, domain.NewValidationError("Spoken language ID must be positive", "spokenLanguageID", nil)
+	}
+
+	existingSpokenLanguage, err := uc.spokenLanguageRepo.GetByID(ctx, spokenLanguageID)
+	if err != nil {
+		uc.logger.Error("Failed to get spoken language by ID %d: %v", spokenLanguageID, err)
+		return nil, domain.NewInternalError("failed to get spoken language", err)
+	}
+	if existingSpokenLanguage == nil {
+		uc.logger.Error("Spoken language not found: %d", spokenLanguageID)
+		return nil, domain.NewNotFoundError("Spoken language", fmt.Sprint(spokenLanguageID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Spoken language", spokenLanguageID, existingSpokenLanguage.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingSpokenLanguage)
+
+	patched := *existingSpokenLanguage
+	req.ApplyTo(&patched)
+
+	return uc.save(ctx, spokenLanguageID, &patched, entities.RevisionActionPatch)
+}
+
+// save writes the fields of spokenLanguage over the stored ones, for both
+// updates and patches.
+func (uc *SpokenLanguageUseCase) save(ctx context.Context, spokenLanguageID int, spokenLanguage *entities.SpokenLanguage, action string) (*entities.SpokenLanguage, error) {
+	if err := uc.checkNameAvailable(ctx, spokenLanguage, spokenLanguageID); err != nil {
+		return nil, err
+	}
+
+	spokenLanguage.MarkAsUpdated()
+	savedSpokenLanguage, err := uc.spokenLanguageRepo.Update(ctx, spokenLanguageID, spokenLanguage)
+	if err != nil {
+		uc.logger.Error("Failed to update spoken language: %v", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update spoken language", err))
+	}
+
+	uc.snapshotRevision(ctx, spokenLanguageID, action)
+	invalidate(uc.cache, cacheNamespaceSpokenLanguages, savedSpokenLanguage.UserID)
+	return savedSpokenLanguage, nil
+}
+
+func (uc *SpokenLanguageUseCase) DeleteSpokenLanguage(ctx context.Context, spokenLanguageID int) error {
+	if spokenLanguageID <= 0 {
+		uc.logger.Error("Invalid spoken language ID: %d", spokenLanguageID)
+		return domain.NewValidationError("Spoken language ID must be positive", "spokenLanguageID", nil)
+	}
+
+	existingSpokenLanguage, err := uc.spokenLanguageRepo.GetByID(ctx, spokenLanguageID)
+	if err != nil {
+		uc.logger.Error("Failed to check if spoken language exists: %v", err)
+		return domain.NewInternalError("failed to check spoken language existence", err)
+	}
+	if existingSpokenLanguage == nil {
+		uc.logger.Error("Spoken language not found for ID %d", spokenLanguageID)
+		return domain.NewNotFoundError("Spoken language", fmt.Sprint(spokenLanguageID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Spoken language", spokenLanguageID, existingSpokenLanguage.Version); err != nil {
+		return err
+	}
+
+	if err := uc.spokenLanguageRepo.Delete(ctx, spokenLanguageID); err != nil {
+		uc.logger.Error("Failed to delete spoken language: %v", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete spoken language", err))
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeSpokenLanguage, spokenLanguageID, entities.RevisionActionDelete, existingSpokenLanguage)
+	invalidate(uc.cache, cacheNamespaceSpokenLanguages, existingSpokenLanguage.UserID)
+	return nil
+}
+
+// ReorderSpokenLanguages moves the user's spoken languages into the order of
+// req, which must list every live one of them exactly once. Lists follow
+// that order unless another sort is requested.
+func (uc *SpokenLanguageUseCase) ReorderSpokenLanguages(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.spokenLanguageRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder spoken languages of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceSpokenLanguages, userID)
+	return nil
+}
+
+func (uc *SpokenLanguageUseCase) checkFields(spokenLanguage *entities.SpokenLanguage) error {
+	if !spokenLanguage.HasRequiredFields() {
+		uc.logger.Error("Invalid spoken language fields: %v", spokenLanguage)
+		return domain.NewValidationError("Name, level and user ID are required", "spoken_language", nil)
+	}
+	if !spokenLanguage.HasValidLevel() {
+		uc.logger.Error("Invalid spoken language level: %s", spokenLanguage.Level)
+		return domain.NewValidationError("Invalid spoken language level", "level", nil)
+	}
+	return nil
+}
+
+// checkNameAvailable rejects a name that another spoken language of the user
+// already has, ignoring case.
+func (uc *SpokenLanguageUseCase) checkNameAvailable(ctx context.Context, spokenLanguage *entities.SpokenLanguage, spokenLanguageID int) error {
+	exists, err := uc.spokenLanguageRepo.ExistsByName(ctx, spokenLanguage.UserID, spokenLanguage.Name, spokenLanguageID)
+	if err != nil {
+		uc.logger.Error("Failed to check spoken language name: %v", err)
+		return domain.NewInternalError("failed to check spoken language existence", err)
+	}
+	if exists {
+		uc.logger.Error("Spoken language already exists: %s", spokenLanguage.Name)
+		return domain.NewAlreadyExistsError("Spoken language", spokenLanguage.Name)
+	}
+	return nil
+}
+
+// snapshotRevision records the stored state of the spoken language as a
+// revision.
+func (uc *SpokenLanguageUseCase) snapshotRevision(ctx context.Context, spokenLanguageID int, action string) {
+	spokenLanguage, err := uc.spokenLanguageRepo.GetByID(ctx, spokenLanguageID)
+	if err != nil || spokenLanguage == nil {
+		uc.logger.Error("Failed to load spoken language %d for revision: %v", spokenLanguageID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeSpokenLanguage, spokenLanguageID, action, spokenLanguage)
+}
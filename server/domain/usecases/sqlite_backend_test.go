@@ -0,0 +1,49 @@
+//go:build sqlite_fts5
+
+package usecases_test
+
+import (
+	"path/filepath"
+	"portfolio/config"
+	"portfolio/infrastructure/sqlite"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"testing"
+)
+
+func init() {
+	backends = append(backends, backend{name: "sqlite", open: openSQLite})
+}
+
+func openSQLite(t *testing.T, logger *logger.Logger) *repositories {
+	db, err := sqlite.NewConnection(&config.DatabaseConfig{
+		Driver:             config.DriverSQLite,
+		Path:               filepath.Join(t.TempDir(), "portfolio.sqlite3"),
+		MaxOpenConnections: 5,
+		MaxIdleConnections: 5,
+		Pragmas: map[string]string{
+			"foreign_keys": "ON",
+			"journal_mode": "WAL",
+			"busy_timeout": "5000",
+		},
+	}, logger)
+	if err != nil {
+		t.Fatalf("Failed to open SQLite database: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return &repositories{
+		User:        sqlite.NewUserRepository(db, logger),
+		Setting:     sqlite.NewSettingRepository(db, logger, "portfolio"),
+		Technology:  sqlite.NewTechnologyRepository(db, logger),
+		Project:     sqlite.NewProjectRepository(db, logger),
+		Experience:  sqlite.NewExperienceRepository(db, logger),
+		Testimonial: sqlite.NewTestimonialRepository(db, logger),
+		Revision:    sqlite.NewRevisionRepository(db, logger),
+		Publishing:  sqlite.NewPublishingRepository(db, logger),
+		Trash:       sqlite.NewTrashRepository(db, logger),
+		UnitOfWork:  transaction.NewUnitOfWork(db, logger),
+	}
+}
@@ -6,19 +6,29 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
 	"portfolio/logger"
+	"portfolio/service"
+	"strconv"
+	"time"
 )
 
 type TechnologyUseCase struct {
 	technologyRepo interfaces.TechnologyRepository
 	userRepo       interfaces.UserRepository
+	revisionRepo   interfaces.RevisionRepository
+	unitOfWork     interfaces.UnitOfWork
+	cache          *service.CacheService
 	logger         *logger.Logger
 }
 
-func NewTechnologyUseCase(technologyRepo interfaces.TechnologyRepository, userRepo interfaces.UserRepository, logger *logger.Logger) *TechnologyUseCase {
+func NewTechnologyUseCase(technologyRepo interfaces.TechnologyRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *TechnologyUseCase {
 	return &TechnologyUseCase{
 		technologyRepo: technologyRepo,
 		userRepo:       userRepo,
+		revisionRepo:   revisionRepo,
+		unitOfWork:     unitOfWork,
+		cache:          cache,
 		logger:         logger,
 	}
 }
@@ -49,22 +59,51 @@ func (uc *TechnologyUseCase) CreateTechnology(ctx context.Context, technology *e
 		return nil, domain.NewAlreadyExistsError("Technology", technology.Name)
 	}
 
+	technology.SetDefaultState(time.Now())
 	createdTechnology, err := uc.technologyRepo.Create(ctx, technology)
 	if err != nil {
 		uc.logger.Error("Failed to create technology: %v", err)
 		return nil, domain.NewInternalError("failed to create technology", err)
 	}
 
+	uc.snapshotRevision(ctx, createdTechnology.TechnologyID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespaceTechnologies, createdTechnology.UserID)
 	return createdTechnology, nil
 }
 
+// CreateTechnologiesAtomically creates every technology or none: the first failure rolls
+// back the technologies created before it.
+func (uc *TechnologyUseCase) CreateTechnologiesAtomically(ctx context.Context, technologies []*entities.Technology) ([]*entities.Technology, error) {
+	defer invalidate(uc.cache, cacheNamespaceTechnologies, batchOwners(technologies, technologyOwner)...)
+
+	var createdTechnologies []*entities.Technology
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, technology := range technologies {
+			createdTechnology, err := uc.CreateTechnology(ctx, technology)
+			if err != nil {
+				uc.logger.Error("Failed to create technology at index %d (name: %s), rolling back: %v", i, technology.Name, err)
+				return err
+			}
+			createdTechnologies = append(createdTechnologies, createdTechnology)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdTechnologies, nil
+}
+
 func (uc *TechnologyUseCase) GetTechnologyByID(ctx context.Context, technologyID int) (*entities.Technology, error) {
 	if technologyID <= 0 {
 		uc.logger.Error("Technology ID is required")
 		return nil, domain.NewValidationError("technologyID", "technology ID must be positive", nil)
 	}
 
-	technology, err := uc.technologyRepo.GetByID(ctx, technologyID)
+	technology, err := readItem(uc.cache, itemCacheKey(cacheNamespaceTechnologies, technologyID), technologyOwner, func() (*entities.Technology, error) {
+		return uc.technologyRepo.GetByID(ctx, technologyID)
+	})
 	if err != nil {
 		uc.logger.Error("Failed to get technology by ID %d: %v", technologyID, err)
 		return nil, domain.NewInternalError("failed to retrieve technology", err)
@@ -78,19 +117,21 @@ func (uc *TechnologyUseCase) GetTechnologyByID(ctx context.Context, technologyID
 	return technology, nil
 }
 
-func (uc *TechnologyUseCase) GetTechnologiesByUserID(ctx context.Context, userID int) ([]*entities.Technology, error) {
+func (uc *TechnologyUseCase) GetTechnologiesByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Technology], error) {
 	if userID <= 0 {
 		uc.logger.Error("User ID is required")
 		return nil, domain.NewValidationError("userID", "user ID must be positive", nil)
 	}
 
-	technologies, err := uc.technologyRepo.GetByUserID(ctx, userID)
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespaceTechnologies, userID, query), userID, func() (*entities.ListPage[*entities.Technology], error) {
+		return uc.technologyRepo.GetByUserID(ctx, userID, query)
+	})
 	if err != nil {
 		uc.logger.Error("Failed to get technologies for user %d: %v", userID, err)
-		return nil, domain.NewInternalError("failed to retrieve technologies", err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve technologies", err))
 	}
 
-	return technologies, nil
+	return page, nil
 }
 
 func (uc *TechnologyUseCase) UpdateTechnology(ctx context.Context, technologyID int, technology *entities.Technology) (*entities.Technology, error) {
@@ -99,23 +140,31 @@ func (uc *TechnologyUseCase) UpdateTechnology(ctx context.Context, technologyID
 		return nil, domain.NewValidationError("technology", "technology name, icon URL, and user ID are required", nil)
 	}
 
-	exists, err := uc.technologyRepo.ExistsByID(ctx, technology.TechnologyID)
+	existingTechnology, err := uc.technologyRepo.GetByID(ctx, technology.TechnologyID)
 	if err != nil {
 		uc.logger.Error("Failed to check if technology exists: %v", err)
 		return nil, domain.NewInternalError("failed to check technology existence", err)
 	}
-	if !exists {
+	if existingTechnology == nil {
 		uc.logger.Error("Technology not found: %d", technology.TechnologyID)
 		return nil, domain.NewNotFoundError("Technology", fmt.Sprint(technology.TechnologyID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Technology", technologyID, existingTechnology.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingTechnology)
+
 	technology.MarkAsUpdated()
 	updatedTechnology, err := uc.technologyRepo.Update(ctx, technologyID, technology)
 	if err != nil {
 		uc.logger.Error("Failed to update technology: %v", err)
-		return nil, domain.NewInternalError("failed to update technology", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update technology", err))
 	}
 
+	uc.snapshotRevision(ctx, technologyID, entities.RevisionActionUpdate)
+	invalidate(uc.cache, cacheNamespaceTechnologies, existingTechnology.UserID)
 	return updatedTechnology, nil
 }
 
@@ -135,6 +184,12 @@ func (uc *TechnologyUseCase) PatchTechnology(ctx context.Context, technologyID i
 		return nil, domain.NewNotFoundError("Technology", fmt.Sprint(technologyID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Technology", technologyID, existingTechnology.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingTechnology)
+
 	if patchData.Name != "" {
 		existingTechnology.Name = patchData.Name
 	}
@@ -146,9 +201,11 @@ func (uc *TechnologyUseCase) PatchTechnology(ctx context.Context, technologyID i
 	patchedTechnology, err := uc.technologyRepo.Patch(ctx, technologyID, existingTechnology)
 	if err != nil {
 		uc.logger.Error("Failed to patch technology: %v", err)
-		return nil, domain.NewInternalError("failed to patch technology", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to patch technology", err))
 	}
 
+	uc.snapshotRevision(ctx, technologyID, entities.RevisionActionPatch)
+	invalidate(uc.cache, cacheNamespaceTechnologies, existingTechnology.UserID)
 	return patchedTechnology, nil
 }
 
@@ -158,22 +215,49 @@ func (uc *TechnologyUseCase) DeleteTechnology(ctx context.Context, technologyID
 		return domain.NewValidationError("technologyID", "technology ID must be positive", nil)
 	}
 
-	exists, err := uc.technologyRepo.ExistsByID(ctx, technologyID)
+	existingTechnology, err := uc.technologyRepo.GetByID(ctx, technologyID)
 	if err != nil {
 		uc.logger.Error("Failed to check if technology exists: %v", err)
 		return domain.NewInternalError("failed to check technology existence", err)
 	}
-	if !exists {
+	if existingTechnology == nil {
 		uc.logger.Error("Technology not found: %d", technologyID)
 		return domain.NewNotFoundError("Technology", fmt.Sprint(technologyID))
 	}
 
+	if err := checkExpectedVersion(ctx, "Technology", technologyID, existingTechnology.Version); err != nil {
+		return err
+	}
+
 	err = uc.technologyRepo.Delete(ctx, technologyID)
 	if err != nil {
 		uc.logger.Error("Failed to delete technology: %v", err)
-		return domain.NewInternalError("failed to delete technology", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete technology", err))
 	}
 
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeTechnology, technologyID, entities.RevisionActionDelete, existingTechnology)
+	invalidate(uc.cache, cacheNamespaceTechnologies, existingTechnology.UserID)
+	return nil
+}
+
+// ReorderTechnologies moves the user's technologies into the order of req, which must list
+// every live one of them exactly once. Lists follow that order unless
+// another sort is requested.
+func (uc *TechnologyUseCase) ReorderTechnologies(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.technologyRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder technologies of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceTechnologies, userID)
 	return nil
 }
 
@@ -206,3 +290,67 @@ func (uc *TechnologyUseCase) GetTechnologiesByNames(ctx context.Context, names [
 
 	return technologies, nil
 }
+
+// snapshotRevision records the stored state of the technology as a revision.
+func (uc *TechnologyUseCase) snapshotRevision(ctx context.Context, technologyID int, action string) {
+	technology, err := uc.technologyRepo.GetByID(ctx, technologyID)
+	if err != nil || technology == nil {
+		uc.logger.Error("Failed to load technology %d for revision: %v", technologyID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeTechnology, technologyID, action, technology)
+}
+
+// resolveTechnologies looks up the user's live technologies that refs name,
+// by ID or else by name, in order and without duplicates. Unknown
+// technologies are rejected rather than created, as a technology needs an
+// icon.
+func resolveTechnologies(ctx context.Context, technologyRepo interfaces.TechnologyRepository, logger *logger.Logger, userID int, refs []*entities.Technology) ([]*entities.Technology, error) {
+	var names []string
+	for _, ref := range refs {
+		if ref.TechnologyID == 0 {
+			names = append(names, ref.Name)
+		}
+	}
+
+	byName := make(map[string]*entities.Technology, len(names))
+	if len(names) > 0 {
+		named, err := technologyRepo.GetByNames(ctx, names, userID)
+		if err != nil {
+			logger.Error("Failed to look up technologies by name for user %d: %v", userID, err)
+			return nil, err
+		}
+		for _, technology := range named {
+			byName[technology.GetNormalizedName()] = technology
+		}
+	}
+
+	technologies := make([]*entities.Technology, 0, len(refs))
+	seen := make(map[int]bool, len(refs))
+	for _, ref := range refs {
+		technology := byName[entities.NormalizeTechnologyName(ref.Name)]
+		if ref.TechnologyID != 0 {
+			found, err := technologyRepo.GetByID(ctx, ref.TechnologyID)
+			if err != nil && !domain.IsNotFound(err) {
+				logger.Error("Failed to look up technology %d: %v", ref.TechnologyID, err)
+				return nil, err
+			}
+			if found != nil && found.BelongsToUser(userID) {
+				technology = found
+			}
+		}
+
+		if technology == nil {
+			label := ref.Name
+			if ref.TechnologyID != 0 {
+				label = strconv.Itoa(ref.TechnologyID)
+			}
+			return nil, domain.NewValidationError("Unknown technology "+strconv.Quote(label)+"; create it before linking it", "technologies", nil)
+		}
+		if !seen[technology.TechnologyID] {
+			seen[technology.TechnologyID] = true
+			technologies = append(technologies, technology)
+		}
+	}
+	return technologies, nil
+}
@@ -0,0 +1,298 @@
+package usecases_test
+
+import (
+	"context"
+	"io"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/domain/usecases"
+	orderDto "portfolio/dto/order"
+	"portfolio/logger"
+	"portfolio/service"
+	"slices"
+	"testing"
+	"time"
+)
+
+func newTechnology(userID int, name string) *entities.Technology {
+	return &entities.Technology{
+		UserID:  userID,
+		Name:    name,
+		IconURL: "https://example.com/" + name + ".svg",
+	}
+}
+
+func technologyNames(t *testing.T, f *fixture) []string {
+	t.Helper()
+
+	page, err := f.technologies.GetTechnologiesByUserID(t.Context(), f.userID, &entities.ListQuery{Size: 50})
+	if err != nil {
+		t.Fatalf("GetTechnologiesByUserID failed: %v", err)
+	}
+	names := make([]string, 0, len(page.Items))
+	for _, technology := range page.Items {
+		names = append(names, technology.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestCreateTechnologyRejectsDuplicateName(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		if _, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go")); err != nil {
+			t.Fatalf("CreateTechnology failed: %v", err)
+		}
+
+		_, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go"))
+		assertCode(t, "CreateTechnology of a duplicate name", err, domain.ErrCodeAlreadyExists)
+
+		otherID := createUser(t, f.repos, "other")
+		if _, err := f.technologies.CreateTechnology(t.Context(), newTechnology(otherID, "Go")); err != nil {
+			t.Fatalf("CreateTechnology of another user's name failed: %v", err)
+		}
+	})
+}
+
+func TestTechnologyNotFound(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		_, err := f.technologies.GetTechnologyByID(t.Context(), 404)
+		assertCode(t, "GetTechnologyByID of a missing technology", err, domain.ErrCodeNotFound)
+
+		err = f.technologies.DeleteTechnology(t.Context(), 404)
+		assertCode(t, "DeleteTechnology of a missing technology", err, domain.ErrCodeNotFound)
+
+		_, err = f.technologies.CreateTechnology(t.Context(), newTechnology(404, "Go"))
+		assertCode(t, "CreateTechnology for a missing user", err, domain.ErrCodeNotFound)
+
+		err = f.trash.Restore(t.Context(), f.userID, entities.TrashTypeTechnology, 404)
+		assertCode(t, "Restore of a missing technology", err, domain.ErrCodeNotFound)
+	})
+}
+
+func TestDeleteTechnologyMovesItToTrash(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		technology, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go"))
+		if err != nil {
+			t.Fatalf("CreateTechnology failed: %v", err)
+		}
+		// Cache the technology, so that a missed invalidation shows up below.
+		if _, err := f.technologies.GetTechnologyByID(t.Context(), technology.TechnologyID); err != nil {
+			t.Fatalf("GetTechnologyByID failed: %v", err)
+		}
+
+		if err := f.technologies.DeleteTechnology(t.Context(), technology.TechnologyID); err != nil {
+			t.Fatalf("DeleteTechnology failed: %v", err)
+		}
+		_, err = f.technologies.GetTechnologyByID(t.Context(), technology.TechnologyID)
+		assertCode(t, "GetTechnologyByID of a deleted technology", err, domain.ErrCodeNotFound)
+		if names := technologyNames(t, f); len(names) != 0 {
+			t.Fatalf("technologies after delete = %v, want none", names)
+		}
+
+		trash, err := f.trash.GetTrash(t.Context(), f.userID, entities.TrashTypeTechnology)
+		if err != nil {
+			t.Fatalf("GetTrash failed: %v", err)
+		}
+		if len(trash) != 1 || trash[0].ID != technology.TechnologyID {
+			t.Fatalf("trash = %v, want technology %d", trash, technology.TechnologyID)
+		}
+
+		// The name stays taken while the technology is in the trash.
+		_, err = f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go"))
+		assertCode(t, "CreateTechnology of a trashed name", err, domain.ErrCodeAlreadyExists)
+
+		if err := f.trash.Restore(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID); err != nil {
+			t.Fatalf("Restore failed: %v", err)
+		}
+		if _, err := f.technologies.GetTechnologyByID(t.Context(), technology.TechnologyID); err != nil {
+			t.Fatalf("GetTechnologyByID after restore failed: %v", err)
+		}
+		err = f.trash.Restore(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID)
+		assertCode(t, "Restore of a live technology", err, domain.ErrCodeNotFound)
+	})
+}
+
+func TestCreateTechnologiesAtomicallyRollsBack(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		if _, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go")); err != nil {
+			t.Fatalf("CreateTechnology failed: %v", err)
+		}
+		// Cache the list, so that a missed invalidation shows up below.
+		technologyNames(t, f)
+
+		_, err := f.technologies.CreateTechnologiesAtomically(t.Context(), []*entities.Technology{
+			newTechnology(f.userID, "Rust"),
+			newTechnology(f.userID, "Zig"),
+			newTechnology(f.userID, "Go"),
+		})
+		assertCode(t, "CreateTechnologiesAtomically with a duplicate name", err, domain.ErrCodeAlreadyExists)
+		if names := technologyNames(t, f); !slices.Equal(names, []string{"Go"}) {
+			t.Fatalf("technologies after rollback = %v, want [Go]", names)
+		}
+
+		created, err := f.technologies.CreateTechnologiesAtomically(t.Context(), []*entities.Technology{
+			newTechnology(f.userID, "Rust"),
+			newTechnology(f.userID, "Zig"),
+		})
+		if err != nil {
+			t.Fatalf("CreateTechnologiesAtomically failed: %v", err)
+		}
+		if len(created) != 2 {
+			t.Fatalf("CreateTechnologiesAtomically created %d technologies, want 2", len(created))
+		}
+		if names := technologyNames(t, f); !slices.Equal(names, []string{"Go", "Rust", "Zig"}) {
+			t.Fatalf("technologies after commit = %v, want [Go Rust Zig]", names)
+		}
+	})
+}
+
+// readBeforeCommit is a unit of work that calls read once fn has succeeded
+// but before the commit, as a concurrent public request could.
+type readBeforeCommit struct {
+	interfaces.UnitOfWork
+	read func()
+}
+
+func (u *readBeforeCommit) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return u.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		u.read()
+		return nil
+	})
+}
+
+func TestCreateTechnologiesAtomicallyInvalidatesAfterCommit(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		unitOfWork := &readBeforeCommit{UnitOfWork: f.repos.UnitOfWork}
+		f.technologies = usecases.NewTechnologyUseCase(f.repos.Technology, f.repos.User, f.repos.Revision, unitOfWork,
+			service.NewCacheService(true, 100, time.Minute), logger.NewWriterLogger(io.Discard))
+		unitOfWork.read = func() {
+			technologyNames(t, f)
+		}
+
+		if _, err := f.technologies.CreateTechnologiesAtomically(t.Context(), []*entities.Technology{
+			newTechnology(f.userID, "Go"),
+			newTechnology(f.userID, "Rust"),
+		}); err != nil {
+			t.Fatalf("CreateTechnologiesAtomically failed: %v", err)
+		}
+		if names := technologyNames(t, f); !slices.Equal(names, []string{"Go", "Rust"}) {
+			t.Fatalf("technologies after commit = %v, want [Go Rust]", names)
+		}
+	})
+}
+
+func TestTechnologyWritesOnlyInvalidateTheirOwner(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		otherID := createUser(t, f.repos, "other")
+		mine, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go"))
+		if err != nil {
+			t.Fatalf("CreateTechnology failed: %v", err)
+		}
+		theirs, err := f.technologies.CreateTechnology(t.Context(), newTechnology(otherID, "Rust"))
+		if err != nil {
+			t.Fatalf("CreateTechnology failed: %v", err)
+		}
+
+		namesOf := func(userID int) []string {
+			t.Helper()
+
+			page, err := f.technologies.GetTechnologiesByUserID(t.Context(), userID, &entities.ListQuery{Size: 50})
+			if err != nil {
+				t.Fatalf("GetTechnologiesByUserID(%d) failed: %v", userID, err)
+			}
+			names := make([]string, 0, len(page.Items))
+			for _, technology := range page.Items {
+				names = append(names, technology.Name)
+			}
+			return names
+		}
+		namesOf(f.userID)
+		namesOf(otherID)
+		if _, err := f.technologies.GetTechnologyByID(t.Context(), mine.TechnologyID); err != nil {
+			t.Fatalf("GetTechnologyByID failed: %v", err)
+		}
+
+		// Renaming the other user's technology behind the use case's back
+		// leaves their cached list stale, which shows whether it was evicted.
+		theirs.Name = "Zig"
+		if _, err := f.repos.Technology.Update(t.Context(), theirs.TechnologyID, theirs); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+
+		if _, err := f.technologies.PatchTechnology(t.Context(), mine.TechnologyID, &entities.Technology{Name: "Golang"}); err != nil {
+			t.Fatalf("PatchTechnology failed: %v", err)
+		}
+
+		if names := namesOf(f.userID); !slices.Equal(names, []string{"Golang"}) {
+			t.Errorf("own technologies after the patch = %v, want [Golang]", names)
+		}
+		if names := namesOf(otherID); !slices.Equal(names, []string{"Rust"}) {
+			t.Errorf("other user's cached technologies = %v, want them kept as [Rust]", names)
+		}
+		technology, err := f.technologies.GetTechnologyByID(t.Context(), mine.TechnologyID)
+		if err != nil {
+			t.Fatalf("GetTechnologyByID failed: %v", err)
+		}
+		if technology.Name != "Golang" {
+			t.Errorf("cached technology after the patch = %q, want %q", technology.Name, "Golang")
+		}
+	})
+}
+
+func TestReorderTechnologies(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		var ids []int
+		for _, name := range []string{"Go", "Rust", "Zig"} {
+			technology, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, name))
+			if err != nil {
+				t.Fatalf("CreateTechnology failed: %v", err)
+			}
+			ids = append(ids, technology.TechnologyID)
+		}
+		inOrder := func() []string {
+			t.Helper()
+
+			page, err := f.technologies.GetTechnologiesByUserID(t.Context(), f.userID, &entities.ListQuery{
+				Size: 50,
+				Sort: []entities.SortField{{Name: "position"}},
+			})
+			if err != nil {
+				t.Fatalf("GetTechnologiesByUserID failed: %v", err)
+			}
+			names := make([]string, 0, len(page.Items))
+			for _, technology := range page.Items {
+				names = append(names, technology.Name)
+			}
+			return names
+		}
+		// Cache the list, so that a missed invalidation shows up below.
+		if names := inOrder(); !slices.Equal(names, []string{"Go", "Rust", "Zig"}) {
+			t.Fatalf("technologies before the reorder = %v, want [Go Rust Zig]", names)
+		}
+
+		if err := f.technologies.ReorderTechnologies(t.Context(), f.userID, &orderDto.OrderRequest{IDs: []int{ids[2], ids[0], ids[1]}}); err != nil {
+			t.Fatalf("ReorderTechnologies failed: %v", err)
+		}
+		if names := inOrder(); !slices.Equal(names, []string{"Zig", "Go", "Rust"}) {
+			t.Fatalf("technologies after the reorder = %v, want [Zig Go Rust]", names)
+		}
+
+		for what, invalid := range map[string][]int{
+			"no IDs":       nil,
+			"a zero ID":    {ids[0], ids[1], 0},
+			"a repeat":     {ids[0], ids[0], ids[1]},
+			"a missing ID": {ids[0], ids[1]},
+			"an extra ID":  {ids[0], ids[1], ids[2], 404},
+		} {
+			err := f.technologies.ReorderTechnologies(t.Context(), f.userID, &orderDto.OrderRequest{IDs: invalid})
+			assertCode(t, "ReorderTechnologies with "+what, err, domain.ErrCodeValidation)
+		}
+		if names := inOrder(); !slices.Equal(names, []string{"Zig", "Go", "Rust"}) {
+			t.Fatalf("technologies after rejected reorders = %v, want [Zig Go Rust]", names)
+		}
+	})
+}
@@ -0,0 +1,362 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
+	testimonialDto "portfolio/dto/testimonial"
+	"portfolio/logger"
+	"portfolio/service"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type TestimonialUseCase struct {
+	testimonialRepo interfaces.TestimonialRepository
+	projectRepo     interfaces.ProjectRepository
+	experienceRepo  interfaces.ExperienceRepository
+	userRepo        interfaces.UserRepository
+	revisionRepo    interfaces.RevisionRepository
+	unitOfWork      interfaces.UnitOfWork
+	cache           *service.CacheService
+	logger          *logger.Logger
+}
+
+func NewTestimonialUseCase(testimonialRepo interfaces.TestimonialRepository, projectRepo interfaces.ProjectRepository, experienceRepo interfaces.ExperienceRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *TestimonialUseCase {
+	return &TestimonialUseCase{
+		testimonialRepo: testimonialRepo,
+		projectRepo:     projectRepo,
+		experienceRepo:  experienceRepo,
+		userRepo:        userRepo,
+		revisionRepo:    revisionRepo,
+		unitOfWork:      unitOfWork,
+		cache:           cache,
+		logger:          logger,
+	}
+}
+
+// SubmitTestimonial stores a testimonial from a visitor as pending. Content
+// with too many links is refused. The same content sent twice from one email
+// and too many pending submissions from one email are dropped as spam: no
+// testimonial and no error are returned, so that the caller answers them like
+// an accepted submission and does not reveal what an email sent before. A
+// submission can only be about a published project or experience.
+func (uc *TestimonialUseCase) SubmitTestimonial(ctx context.Context, testimonial *entities.Testimonial) (*entities.Testimonial, error) {
+	testimonial.Status = entities.TestimonialStatusPending
+	if err := uc.checkFields(testimonial); err != nil {
+		return nil, err
+	}
+	if testimonial.LinkCount() > entities.MaxTestimonialLinks {
+		uc.logger.Warn("Refused testimonial from %s: too many links", testimonial.AuthorEmail)
+		return nil, domain.NewValidationError("Content must not contain more than "+strconv.Itoa(entities.MaxTestimonialLinks)+" links", "content", nil)
+	}
+
+	userExists, err := uc.userRepo.ExistsByID(ctx, testimonial.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to check if user exists: %v", err)
+		return nil, domain.NewInternalError("failed to validate user", err)
+	}
+	if !userExists {
+		uc.logger.Error("User not found for ID %d", testimonial.UserID)
+		return nil, domain.NewNotFoundError("User", fmt.Sprint(testimonial.UserID))
+	}
+
+	if err := uc.checkSubjects(ctx, testimonial, true); err != nil {
+		return nil, err
+	}
+
+	// The checks run in the unit of work that creates the testimonial, so
+	// that concurrent submissions cannot all pass them.
+	var createdTestimonial *entities.Testimonial
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		previous, err := uc.testimonialRepo.GetByAuthorEmail(ctx, testimonial.UserID, testimonial.AuthorEmail)
+		if err != nil {
+			uc.logger.Error("Failed to get testimonials from %s: %v", testimonial.AuthorEmail, err)
+			return domain.NewInternalError("failed to check previous testimonials", err)
+		}
+		pending := 0
+		for _, existing := range previous {
+			if normalizeContent(existing.Content) == normalizeContent(testimonial.Content) {
+				uc.logger.Warn("Dropped testimonial from %s: duplicate of testimonial %d", testimonial.AuthorEmail, existing.TestimonialID)
+				return nil
+			}
+			if existing.Status == entities.TestimonialStatusPending {
+				pending++
+			}
+		}
+		if pending >= entities.MaxPendingTestimonialsPerEmail {
+			uc.logger.Warn("Dropped testimonial from %s: %d submissions are pending", testimonial.AuthorEmail, pending)
+			return nil
+		}
+
+		createdTestimonial, err = uc.testimonialRepo.Create(ctx, testimonial)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to create testimonial: %v", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to create testimonial", err))
+	}
+	if createdTestimonial == nil {
+		return nil, nil
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeTestimonial, createdTestimonial.TestimonialID, entities.RevisionActionCreate, createdTestimonial)
+	invalidate(uc.cache, cacheNamespaceTestimonials, createdTestimonial.UserID)
+	return createdTestimonial, nil
+}
+
+func (uc *TestimonialUseCase) GetTestimonialByID(ctx context.Context, testimonialID int) (*entities.Testimonial, error) {
+	if testimonialID <= 0 {
+		uc.logger.Error("Invalid testimonial ID: %d", testimonialID)
+		return nil, domain.NewValidationError("Testimonial ID must be positive", "testimonialID", nil)
+	}
+
+	testimonial, err := readItem(uc.cache, itemCacheKey(cacheNamespaceTestimonials, testimonialID), testimonialOwner, func() (*entities.Testimonial, error) {
+		return uc.testimonialRepo.GetByID(ctx, testimonialID)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get testimonial by ID %d: %v", testimonialID, err)
+		return nil, domain.NewInternalError("failed to retrieve testimonial", err)
+	}
+
+	if testimonial == nil {
+		uc.logger.Error("Testimonial not found for ID %d", testimonialID)
+		return nil, domain.NewNotFoundError("Testimonial", fmt.Sprint(testimonialID))
+	}
+
+	return testimonial, nil
+}
+
+func (uc *TestimonialUseCase) GetTestimonialsByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Testimonial], error) {
+	if userID <= 0 {
+		uc.logger.Error("Invalid user ID: %d", userID)
+		return nil, domain.NewValidationError("User ID must be positive", "userID", nil)
+	}
+
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespaceTestimonials, userID, query), userID, func() (*entities.ListPage[*entities.Testimonial], error) {
+		return uc.testimonialRepo.GetByUserID(ctx, userID, query)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get testimonials for user %d: %v", userID, err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve testimonials", err))
+	}
+
+	return page, nil
+}
+
+func (uc *TestimonialUseCase) UpdateTestimonial(ctx context.Context, testimonialID int, testimonial *entities.Testimonial) (*entities.Testimonial, error) {
+	existingTestimonial, err := uc.getExisting(ctx, testimonialID)
+	if err != nil {
+		return nil, err
+	}
+
+	testimonial.Status = existingTestimonial.Status
+	if err := uc.checkFields(testimonial); err != nil {
+		return nil, err
+	}
+	if err := uc.checkSubjects(ctx, testimonial, false); err != nil {
+		return nil, err
+	}
+
+	if err := checkExpectedVersion(ctx, "Testimonial", testimonialID, existingTestimonial.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingTestimonial)
+
+	return uc.save(ctx, testimonialID, testimonial, entities.RevisionActionUpdate)
+}
+
+func (uc *TestimonialUseCase) PatchTestimonial(ctx context.Context, testimonialID int, req *testimonialDto.PatchTestimonialRequest) (*entities.Testimonial, error) {
+	existingTestimonial, err := uc.getExisting(ctx, testimonialID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkExpectedVersion(ctx, "Testimonial", testimonialID, existingTestimonial.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingTestimonial)
+
+	patched := *existingTestimonial
+	req.ApplyTo(&patched)
+	if err := uc.checkFields(&patched); err != nil {
+		return nil, err
+	}
+	if err := uc.checkSubjects(ctx, &patched, false); err != nil {
+		return nil, err
+	}
+
+	return uc.save(ctx, testimonialID, &patched, entities.RevisionActionPatch)
+}
+
+// save writes the author, the content and the links of testimonial over the
+// stored ones, for both updates and patches.
+func (uc *TestimonialUseCase) save(ctx context.Context, testimonialID int, testimonial *entities.Testimonial, action string) (*entities.Testimonial, error) {
+	testimonial.MarkAsUpdated()
+	var savedTestimonial *entities.Testimonial
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		savedTestimonial, err = uc.testimonialRepo.Update(ctx, testimonialID, testimonial)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update testimonial: %v", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update testimonial", err))
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeTestimonial, testimonialID, action, savedTestimonial)
+	invalidate(uc.cache, cacheNamespaceTestimonials, savedTestimonial.UserID)
+	return savedTestimonial, nil
+}
+
+// ApproveTestimonial shows the testimonial to visitors.
+func (uc *TestimonialUseCase) ApproveTestimonial(ctx context.Context, testimonialID int) (*entities.Testimonial, error) {
+	return uc.moderate(ctx, testimonialID, entities.TestimonialStatusApproved, "")
+}
+
+// RejectTestimonial hides the testimonial from visitors, keeping the reason
+// for the admin.
+func (uc *TestimonialUseCase) RejectTestimonial(ctx context.Context, testimonialID int, reason string) (*entities.Testimonial, error) {
+	return uc.moderate(ctx, testimonialID, entities.TestimonialStatusRejected, strings.TrimSpace(reason))
+}
+
+func (uc *TestimonialUseCase) moderate(ctx context.Context, testimonialID int, status, reason string) (*entities.Testimonial, error) {
+	existingTestimonial, err := uc.getExisting(ctx, testimonialID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkExpectedVersion(ctx, "Testimonial", testimonialID, existingTestimonial.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingTestimonial)
+
+	moderated := *existingTestimonial
+	moderated.Moderate(status, reason, time.Now())
+	var savedTestimonial *entities.Testimonial
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		savedTestimonial, err = uc.testimonialRepo.SetStatus(ctx, testimonialID, &moderated)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("Failed to set status of testimonial %d to %s: %v", testimonialID, status, err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to moderate testimonial", err))
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeTestimonial, testimonialID, entities.RevisionActionUpdate, savedTestimonial)
+	invalidate(uc.cache, cacheNamespaceTestimonials, existingTestimonial.UserID)
+	return savedTestimonial, nil
+}
+
+func (uc *TestimonialUseCase) DeleteTestimonial(ctx context.Context, testimonialID int) error {
+	existingTestimonial, err := uc.getExisting(ctx, testimonialID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkExpectedVersion(ctx, "Testimonial", testimonialID, existingTestimonial.Version); err != nil {
+		return err
+	}
+
+	if err := uc.testimonialRepo.Delete(ctx, testimonialID); err != nil {
+		uc.logger.Error("Failed to delete testimonial: %v", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete testimonial", err))
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeTestimonial, testimonialID, entities.RevisionActionDelete, existingTestimonial)
+	invalidate(uc.cache, cacheNamespaceTestimonials, existingTestimonial.UserID)
+	return nil
+}
+
+// ReorderTestimonials moves the user's testimonials into the order of req,
+// which must list every live one of them exactly once. Lists follow that
+// order unless another sort is requested.
+func (uc *TestimonialUseCase) ReorderTestimonials(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.testimonialRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder testimonials of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceTestimonials, userID)
+	return nil
+}
+
+// getExisting returns the stored testimonial, or a not found error.
+func (uc *TestimonialUseCase) getExisting(ctx context.Context, testimonialID int) (*entities.Testimonial, error) {
+	if testimonialID <= 0 {
+		uc.logger.Error("Invalid testimonial ID: %d", testimonialID)
+		return nil, domain.NewValidationError("Testimonial ID must be positive", "testimonialID", nil)
+	}
+
+	existingTestimonial, err := uc.testimonialRepo.GetByID(ctx, testimonialID)
+	if err != nil {
+		uc.logger.Error("Failed to check if testimonial exists: %v", err)
+		return nil, domain.NewInternalError("failed to check testimonial existence", err)
+	}
+	if existingTestimonial == nil {
+		uc.logger.Error("Testimonial not found for ID %d", testimonialID)
+		return nil, domain.NewNotFoundError("Testimonial", fmt.Sprint(testimonialID))
+	}
+	return existingTestimonial, nil
+}
+
+func (uc *TestimonialUseCase) checkFields(testimonial *entities.Testimonial) error {
+	if !testimonial.HasRequiredFields() {
+		uc.logger.Error("Invalid testimonial fields: %v", testimonial)
+		return domain.NewValidationError("Author name, author email, content and user ID are required", "testimonial", nil)
+	}
+	if !testimonial.HasValidStatus() {
+		uc.logger.Error("Invalid testimonial status: %s", testimonial.Status)
+		return domain.NewValidationError("Invalid testimonial status", "status", nil)
+	}
+	return nil
+}
+
+// checkSubjects checks that the project and the experience of the
+// testimonial belong to its user and are live. Submissions can only be about
+// published ones, so that visitors cannot find out about the others.
+func (uc *TestimonialUseCase) checkSubjects(ctx context.Context, testimonial *entities.Testimonial, published bool) error {
+	if testimonial.ProjectID != nil {
+		project, err := uc.projectRepo.GetByID(ctx, *testimonial.ProjectID)
+		if err != nil && !domain.IsNotFound(err) {
+			uc.logger.Error("Failed to look up project %d: %v", *testimonial.ProjectID, err)
+			return err
+		}
+		if project == nil || !project.BelongsToUser(testimonial.UserID) || (published && !project.IsPublished()) {
+			return domain.NewValidationError("Unknown project "+strconv.Itoa(*testimonial.ProjectID), "project_id", nil)
+		}
+	}
+	if testimonial.ExperienceID != nil {
+		experience, err := uc.experienceRepo.GetByID(ctx, *testimonial.ExperienceID)
+		if err != nil && !domain.IsNotFound(err) {
+			uc.logger.Error("Failed to look up experience %d: %v", *testimonial.ExperienceID, err)
+			return err
+		}
+		if experience == nil || !experience.BelongsToUser(testimonial.UserID) || (published && !experience.IsPublished()) {
+			return domain.NewValidationError("Unknown experience "+strconv.Itoa(*testimonial.ExperienceID), "experience_id", nil)
+		}
+	}
+	return nil
+}
+
+// normalizeContent folds the case and the spacing of content, so that
+// resubmissions with small changes are caught as duplicates.
+func normalizeContent(content string) string {
+	return strings.Join(strings.Fields(strings.ToLower(content)), " ")
+}
@@ -0,0 +1,146 @@
+package usecases_test
+
+import (
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"strconv"
+	"testing"
+)
+
+func newTestimonial(userID int, email, content string) *entities.Testimonial {
+	return &entities.Testimonial{
+		UserID:      userID,
+		AuthorName:  "Jane Doe",
+		AuthorEmail: email,
+		Content:     content,
+	}
+}
+
+// testimonialCount counts the user's testimonials with status, through the
+// cache.
+func testimonialCount(t *testing.T, f *fixture, status string) int {
+	t.Helper()
+
+	page, err := f.testimonials.GetTestimonialsByUserID(t.Context(), f.userID, &entities.ListQuery{
+		Size:    50,
+		Filters: map[string]string{"status": status},
+	})
+	if err != nil {
+		t.Fatalf("GetTestimonialsByUserID failed: %v", err)
+	}
+	return len(page.Items)
+}
+
+func TestSubmitTestimonialSpamChecks(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		submit := func(email, content string) *entities.Testimonial {
+			t.Helper()
+
+			testimonial, err := f.testimonials.SubmitTestimonial(t.Context(), newTestimonial(f.userID, email, content))
+			if err != nil {
+				t.Fatalf("SubmitTestimonial(%q) failed: %v", content, err)
+			}
+			return testimonial
+		}
+
+		first := submit("jane@example.com", "Jane is a great engineer to work with.")
+		if first == nil || first.Status != entities.TestimonialStatusPending {
+			t.Fatalf("first submission = %+v, want a pending testimonial", first)
+		}
+
+		// Resubmissions and the submissions past the pending cap are dropped
+		// without an error.
+		if dropped := submit("jane@example.com", "  JANE is a great   engineer to work with. "); dropped != nil {
+			t.Errorf("resubmission with other case and spacing was stored as %d", dropped.TestimonialID)
+		}
+		for i := 2; i <= entities.MaxPendingTestimonialsPerEmail; i++ {
+			if submit("jane@example.com", "Another kind word about the team, number "+strconv.Itoa(i)+".") == nil {
+				t.Fatalf("submission %d under the pending cap was dropped", i)
+			}
+		}
+		if dropped := submit("jane@example.com", "One submission too many from the same author."); dropped != nil {
+			t.Errorf("submission past the pending cap was stored as %d", dropped.TestimonialID)
+		}
+		if submit("john@example.com", "One submission too many from the same author.") == nil {
+			t.Error("submission from another email was dropped")
+		}
+		if count := testimonialCount(t, f, entities.TestimonialStatusPending); count != entities.MaxPendingTestimonialsPerEmail+1 {
+			t.Errorf("pending testimonials = %d, want %d", count, entities.MaxPendingTestimonialsPerEmail+1)
+		}
+
+		// Moderation frees a place under the cap.
+		if _, err := f.testimonials.ApproveTestimonial(t.Context(), first.TestimonialID); err != nil {
+			t.Fatalf("ApproveTestimonial failed: %v", err)
+		}
+		if submit("jane@example.com", "A last word now that one was approved.") == nil {
+			t.Error("submission after a moderation was dropped")
+		}
+
+		_, err := f.testimonials.SubmitTestimonial(t.Context(), newTestimonial(f.userID, "spam@example.com",
+			"Visit https://a.example, http://b.example and www.c.example today"))
+		assertCode(t, "SubmitTestimonial with too many links", err, domain.ErrCodeValidation)
+
+		project, err := f.repos.Project.Create(t.Context(), &entities.Project{
+			UserID: f.userID, Title: "Draft", Slug: "draft", Description: "Draft", Status: "active",
+			Publishing: entities.Publishing{State: entities.PublishingStateDraft},
+		})
+		if err != nil {
+			t.Fatalf("Create project failed: %v", err)
+		}
+		about := newTestimonial(f.userID, "jim@example.com", "Great work on a project nobody has seen.")
+		about.ProjectID = &project.ProjectID
+		_, err = f.testimonials.SubmitTestimonial(t.Context(), about)
+		assertCode(t, "SubmitTestimonial about a draft project", err, domain.ErrCodeValidation)
+	})
+}
+
+func TestModerateTestimonial(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		testimonial, err := f.testimonials.SubmitTestimonial(t.Context(),
+			newTestimonial(f.userID, "jane@example.com", "Jane is a great engineer to work with."))
+		if err != nil {
+			t.Fatalf("SubmitTestimonial failed: %v", err)
+		}
+		// Cache the approved list, so that a missed invalidation shows up
+		// below.
+		if count := testimonialCount(t, f, entities.TestimonialStatusApproved); count != 0 {
+			t.Fatalf("approved testimonials before moderation = %d, want 0", count)
+		}
+
+		approved, err := f.testimonials.ApproveTestimonial(t.Context(), testimonial.TestimonialID)
+		if err != nil {
+			t.Fatalf("ApproveTestimonial failed: %v", err)
+		}
+		if approved.Status != entities.TestimonialStatusApproved || approved.ModeratedAt == nil {
+			t.Errorf("approved testimonial = %+v, want approved with a moderation time", approved)
+		}
+		if count := testimonialCount(t, f, entities.TestimonialStatusApproved); count != 1 {
+			t.Errorf("approved testimonials after approval = %d, want 1", count)
+		}
+
+		rejected, err := f.testimonials.RejectTestimonial(t.Context(), testimonial.TestimonialID, "  Not about our work  ")
+		if err != nil {
+			t.Fatalf("RejectTestimonial failed: %v", err)
+		}
+		if rejected.Status != entities.TestimonialStatusRejected || rejected.RejectionReason != "Not about our work" {
+			t.Errorf("rejected testimonial = %q, %q, want rejected with the trimmed reason", rejected.Status, rejected.RejectionReason)
+		}
+		if count := testimonialCount(t, f, entities.TestimonialStatusApproved); count != 0 {
+			t.Errorf("approved testimonials after rejection = %d, want 0", count)
+		}
+
+		// Approving again drops the reason of the rejection.
+		approved, err = f.testimonials.ApproveTestimonial(domain.WithExpectedVersion(t.Context(), rejected.Version), testimonial.TestimonialID)
+		if err != nil {
+			t.Fatalf("ApproveTestimonial after a rejection failed: %v", err)
+		}
+		if approved.RejectionReason != "" {
+			t.Errorf("rejection reason after approval = %q, want none", approved.RejectionReason)
+		}
+
+		_, err = f.testimonials.RejectTestimonial(domain.WithExpectedVersion(t.Context(), rejected.Version), testimonial.TestimonialID, "")
+		assertCode(t, "RejectTestimonial with a stale version", err, domain.ErrCodePreconditionFailed)
+		_, err = f.testimonials.ApproveTestimonial(t.Context(), 404)
+		assertCode(t, "ApproveTestimonial of a missing testimonial", err, domain.ErrCodeNotFound)
+	})
+}
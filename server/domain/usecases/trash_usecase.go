@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"portfolio/service"
+	"strconv"
+	"time"
+)
+
+type TrashUseCase struct {
+	trashRepo interfaces.TrashRepository
+	cache     *service.CacheService
+	logger    *logger.Logger
+}
+
+func NewTrashUseCase(trashRepo interfaces.TrashRepository, cache *service.CacheService, logger *logger.Logger) *TrashUseCase {
+	return &TrashUseCase{
+		trashRepo: trashRepo,
+		cache:     cache,
+		logger:    logger,
+	}
+}
+
+// GetTrash lists the user's soft-deleted items, most recently deleted first.
+// An empty itemType lists every type.
+func (uc *TrashUseCase) GetTrash(ctx context.Context, userID int, itemType string) ([]*entities.TrashItem, error) {
+	if itemType != "" && !entities.IsValidTrashType(itemType) {
+		uc.logger.Error("Invalid trash item type: %s", itemType)
+		return nil, domain.NewValidationError("Unknown trash item type", "type", nil)
+	}
+
+	items, err := uc.trashRepo.GetAll(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list trash: %v", err)
+		return nil, err
+	}
+
+	filtered := make([]*entities.TrashItem, 0, len(items))
+	for _, item := range items {
+		if item.UserID == userID && (itemType == "" || item.Type == itemType) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+func (uc *TrashUseCase) Restore(ctx context.Context, userID int, itemType string, id int) error {
+	if err := uc.validateItem(ctx, userID, itemType, id); err != nil {
+		return err
+	}
+
+	if err := uc.trashRepo.Restore(ctx, itemType, id); err != nil {
+		uc.logger.Error("Failed to restore %s %d: %v", itemType, id, err)
+		return err
+	}
+
+	auditResource(ctx, itemType, strconv.Itoa(id))
+	invalidate(uc.cache, itemType, userID)
+	return nil
+}
+
+func (uc *TrashUseCase) Purge(ctx context.Context, userID int, itemType string, id int) error {
+	if err := uc.validateItem(ctx, userID, itemType, id); err != nil {
+		return err
+	}
+
+	if err := uc.trashRepo.Purge(ctx, itemType, id); err != nil {
+		uc.logger.Error("Failed to purge %s %d: %v", itemType, id, err)
+		return err
+	}
+
+	auditAction(ctx, "purge")
+	auditResource(ctx, itemType, strconv.Itoa(id))
+	return nil
+}
+
+// PurgeExpired permanently removes items that have been in the trash for
+// longer than retention.
+func (uc *TrashUseCase) PurgeExpired(ctx context.Context, retention time.Duration) (int64, error) {
+	purged, err := uc.trashRepo.PurgeDeletedBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		uc.logger.Error("Failed to purge expired trash: %v", err)
+		return purged, err
+	}
+
+	return purged, nil
+}
+
+// validateItem checks the item reference and that the item is in the user's
+// trash; items of other users are reported as not found.
+func (uc *TrashUseCase) validateItem(ctx context.Context, userID int, itemType string, id int) error {
+	if !entities.IsValidTrashType(itemType) {
+		uc.logger.Error("Invalid trash item type: %s", itemType)
+		return domain.NewValidationError("Unknown trash item type", "type", nil)
+	}
+
+	if id <= 0 {
+		uc.logger.Error("Invalid trash item ID: %d", id)
+		return domain.NewValidationError("ID must be a positive integer", "id", nil)
+	}
+
+	items, err := uc.GetTrash(ctx, userID, itemType)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if item.ID == id {
+			return nil
+		}
+	}
+
+	uc.logger.Error("Trash item %s/%d not found for user %d", itemType, id, userID)
+	return domain.NewNotFoundError("Trash item", itemType+"/"+strconv.Itoa(id))
+}
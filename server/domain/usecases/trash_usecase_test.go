@@ -0,0 +1,85 @@
+package usecases_test
+
+import (
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"testing"
+	"time"
+)
+
+func TestPurgeOnlyRemovesTheUsersTrashedItems(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		technology, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go"))
+		if err != nil {
+			t.Fatalf("CreateTechnology failed: %v", err)
+		}
+
+		err = f.trash.Purge(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID)
+		assertCode(t, "Purge of a live technology", err, domain.ErrCodeNotFound)
+
+		if err := f.technologies.DeleteTechnology(t.Context(), technology.TechnologyID); err != nil {
+			t.Fatalf("DeleteTechnology failed: %v", err)
+		}
+
+		otherID := createUser(t, f.repos, "other")
+		err = f.trash.Purge(t.Context(), otherID, entities.TrashTypeTechnology, technology.TechnologyID)
+		assertCode(t, "Purge of another user's technology", err, domain.ErrCodeNotFound)
+		err = f.trash.Purge(t.Context(), f.userID, "unknown", technology.TechnologyID)
+		assertCode(t, "Purge of an unknown type", err, domain.ErrCodeValidation)
+
+		if err := f.trash.Purge(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID); err != nil {
+			t.Fatalf("Purge failed: %v", err)
+		}
+		trash, err := f.trash.GetTrash(t.Context(), f.userID, entities.TrashTypeTechnology)
+		if err != nil {
+			t.Fatalf("GetTrash failed: %v", err)
+		}
+		if len(trash) != 0 {
+			t.Fatalf("trash after purge = %v, want it empty", trash)
+		}
+		err = f.trash.Restore(t.Context(), f.userID, entities.TrashTypeTechnology, technology.TechnologyID)
+		assertCode(t, "Restore of a purged technology", err, domain.ErrCodeNotFound)
+
+		// Purging frees the name for a new technology.
+		if _, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go")); err != nil {
+			t.Fatalf("CreateTechnology after purge failed: %v", err)
+		}
+	})
+}
+
+func TestPurgeExpiredKeepsItemsWithinRetention(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *fixture) {
+		technology, err := f.technologies.CreateTechnology(t.Context(), newTechnology(f.userID, "Go"))
+		if err != nil {
+			t.Fatalf("CreateTechnology failed: %v", err)
+		}
+		if err := f.technologies.DeleteTechnology(t.Context(), technology.TechnologyID); err != nil {
+			t.Fatalf("DeleteTechnology failed: %v", err)
+		}
+
+		purged, err := f.trash.PurgeExpired(t.Context(), time.Hour)
+		if err != nil {
+			t.Fatalf("PurgeExpired failed: %v", err)
+		}
+		if purged != 0 {
+			t.Fatalf("PurgeExpired within retention purged %d items, want 0", purged)
+		}
+
+		// A negative retention puts the cutoff in the future, so everything
+		// in the trash has expired.
+		purged, err = f.trash.PurgeExpired(t.Context(), -time.Minute)
+		if err != nil {
+			t.Fatalf("PurgeExpired failed: %v", err)
+		}
+		if purged != 1 {
+			t.Fatalf("PurgeExpired past retention purged %d items, want 1", purged)
+		}
+		trash, err := f.trash.GetTrash(t.Context(), f.userID, entities.TrashTypeTechnology)
+		if err != nil {
+			t.Fatalf("GetTrash failed: %v", err)
+		}
+		if len(trash) != 0 {
+			t.Fatalf("trash after expiry = %v, want it empty", trash)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package usecases_test
+
+import (
+	"errors"
+	"io"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/domain/usecases"
+	"portfolio/infrastructure/memory"
+	"portfolio/logger"
+	"portfolio/service"
+	"testing"
+	"time"
+)
+
+// repositories are the repositories the use case tests build on, from one
+// backend.
+type repositories struct {
+	User        interfaces.UserRepository
+	Setting     interfaces.SettingRepository
+	Technology  interfaces.TechnologyRepository
+	Project     interfaces.ProjectRepository
+	Experience  interfaces.ExperienceRepository
+	Testimonial interfaces.TestimonialRepository
+	Revision    interfaces.RevisionRepository
+	Publishing  interfaces.PublishingRepository
+	Trash       interfaces.TrashRepository
+	UnitOfWork  interfaces.UnitOfWork
+}
+
+type backend struct {
+	name string
+	open func(t *testing.T, logger *logger.Logger) *repositories
+}
+
+// backends lists the backends every use case test runs against. The
+// in-memory store always runs; SQLite is added when the sqlite_fts5 build
+// tag is set, so that both give the same results.
+var backends = []backend{{name: "memory", open: openMemory}}
+
+func openMemory(t *testing.T, logger *logger.Logger) *repositories {
+	store := memory.NewStore()
+
+	return &repositories{
+		User:        memory.NewUserRepository(store, logger),
+		Setting:     memory.NewSettingRepository(store, logger, "portfolio"),
+		Technology:  memory.NewTechnologyRepository(store, logger),
+		Project:     memory.NewProjectRepository(store, logger),
+		Experience:  memory.NewExperienceRepository(store, logger),
+		Testimonial: memory.NewTestimonialRepository(store, logger),
+		Revision:    memory.NewRevisionRepository(store, logger),
+		Publishing:  memory.NewPublishingRepository(store, logger),
+		Trash:       memory.NewTrashRepository(store, logger),
+		UnitOfWork:  memory.NewUnitOfWork(store, logger),
+	}
+}
+
+// fixture is a fresh backend with one admin user and the use cases built on
+// it, with the cache enabled so that stale reads show up.
+type fixture struct {
+	repos  *repositories
+	userID int
+	cache  *service.CacheService
+
+	settings     *usecases.SettingUseCase
+	technologies *usecases.TechnologyUseCase
+	testimonials *usecases.TestimonialUseCase
+	trash        *usecases.TrashUseCase
+}
+
+// forEachBackend runs test once per backend, on a fresh fixture.
+func forEachBackend(t *testing.T, test func(t *testing.T, f *fixture)) {
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			logger := logger.NewWriterLogger(io.Discard)
+			repos := backend.open(t, logger)
+			cache := service.NewCacheService(true, 100, time.Minute)
+
+			test(t, &fixture{
+				repos:        repos,
+				userID:       createUser(t, repos, "admin"),
+				cache:        cache,
+				settings:     usecases.NewSettingUseCase(repos.Setting, repos.UnitOfWork, cache, logger),
+				technologies: usecases.NewTechnologyUseCase(repos.Technology, repos.User, repos.Revision, repos.UnitOfWork, cache, logger),
+				testimonials: usecases.NewTestimonialUseCase(repos.Testimonial, repos.Project, repos.Experience, repos.User, repos.Revision, repos.UnitOfWork, cache, logger),
+				trash:        usecases.NewTrashUseCase(repos.Trash, cache, logger),
+			})
+		})
+	}
+}
+
+func createUser(t *testing.T, repos *repositories, username string) int {
+	t.Helper()
+
+	now := time.Now()
+	user, err := repos.User.CreateUser(t.Context(), &entities.User{
+		Username:  username,
+		Email:     username + "@example.com",
+		Password:  "hashed",
+		Role:      entities.RoleAdmin,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser(%q) failed: %v", username, err)
+	}
+	return user.ID
+}
+
+func assertCode(t *testing.T, what string, err error, code domain.ErrorCode) {
+	t.Helper()
+
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != code {
+		t.Fatalf("%s returned %v, want a %s error", what, err, code)
+	}
+}
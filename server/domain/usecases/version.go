@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"context"
+	"portfolio/domain"
+	"strconv"
+)
+
+// checkExpectedVersion fails fast when the request carries an If-Match version
+// that no longer matches the stored row. The repository repeats the check
+// atomically in its UPDATE, so this only spares a doomed write.
+func checkExpectedVersion(ctx context.Context, resource string, id, current int) error {
+	if !domain.MatchesExpectedVersion(ctx, current) {
+		return domain.NewPreconditionFailedError(resource, strconv.Itoa(id))
+	}
+	return nil
+}
+
+// writeFailure wraps a failed repository write, except for a stale version,
+// which has to reach the client unchanged.
+func writeFailure(err error, wrapped *domain.DomainError) error {
+	if domain.IsPreconditionFailed(err) {
+		return err
+	}
+	return wrapped
+}
+
+// readFailure wraps a failed repository read, except for a bad list query,
+// which the client has to fix.
+func readFailure(err error, wrapped *domain.DomainError) error {
+	if domain.IsValidationError(err) {
+		return err
+	}
+	return wrapped
+}
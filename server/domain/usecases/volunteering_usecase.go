@@ -0,0 +1,263 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	orderDto "portfolio/dto/order"
+	volunteeringDto "portfolio/dto/volunteering"
+	"portfolio/logger"
+	"portfolio/service"
+	"time"
+)
+
+type VolunteeringUseCase struct {
+	volunteeringRepo interfaces.VolunteeringRepository
+	userRepo         interfaces.UserRepository
+	revisionRepo     interfaces.RevisionRepository
+	unitOfWork       interfaces.UnitOfWork
+	cache            *service.CacheService
+	logger           *logger.Logger
+}
+
+func NewVolunteeringUseCase(volunteeringRepo interfaces.VolunteeringRepository, userRepo interfaces.UserRepository, revisionRepo interfaces.RevisionRepository, unitOfWork interfaces.UnitOfWork, cache *service.CacheService, logger *logger.Logger) *VolunteeringUseCase {
+	return &VolunteeringUseCase{
+		volunteeringRepo: volunteeringRepo,
+		userRepo:         userRepo,
+		revisionRepo:     revisionRepo,
+		unitOfWork:       unitOfWork,
+		cache:            cache,
+		logger:           logger,
+	}
+}
+
+func (uc *VolunteeringUseCase) CreateVolunteering(ctx context.Context, volunteering *entities.Volunteering) (*entities.Volunteering, error) {
+	if err := uc.checkFields(volunteering); err != nil {
+		return nil, err
+	}
+
+	userExists, err := uc.userRepo.ExistsByID(ctx, volunteering.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to check if user exists: %v", err)
+		return nil, domain.NewInternalError("failed to validate user", err)
+	}
+	if !userExists {
+		uc.logger.Error("User not found for ID %d", volunteering.UserID)
+		return nil, domain.NewNotFoundError("User", fmt.Sprint(volunteering.UserID))
+	}
+
+	volunteering.SetDefaultState(time.Now())
+	createdVolunteering, err := uc.volunteeringRepo.Create(ctx, volunteering)
+	if err != nil {
+		uc.logger.Error("Failed to create volunteering: %v", err)
+		return nil, domain.NewInternalError("failed to create volunteering", err)
+	}
+
+	uc.snapshotRevision(ctx, createdVolunteering.VolunteeringID, entities.RevisionActionCreate)
+	invalidate(uc.cache, cacheNamespaceVolunteerings, createdVolunteering.UserID)
+	return createdVolunteering, nil
+}
+
+// CreateVolunteeringsAtomically creates every volunteering or none: the
+// first failure rolls back the volunteerings created before it.
+func (uc *VolunteeringUseCase) CreateVolunteeringsAtomically(ctx context.Context, volunteerings []*entities.Volunteering) ([]*entities.Volunteering, error) {
+	defer invalidate(uc.cache, cacheNamespaceVolunteerings, batchOwners(volunteerings, volunteeringOwner)...)
+
+	var createdVolunteerings []*entities.Volunteering
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, volunteering := range volunteerings {
+			createdVolunteering, err := uc.CreateVolunteering(ctx, volunteering)
+			if err != nil {
+				uc.logger.Error("Failed to create volunteering at index %d (role: %s), rolling back: %v", i, volunteering.Role, err)
+				return err
+			}
+			createdVolunteerings = append(createdVolunteerings, createdVolunteering)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdVolunteerings, nil
+}
+
+func (uc *VolunteeringUseCase) GetVolunteeringByID(ctx context.Context, volunteeringID int) (*entities.Volunteering, error) {
+	if volunteeringID <= 0 {
+		uc.logger.Error("Invalid volunteering ID: %d", volunteeringID)
+		return nil, domain.NewValidationError("Volunteering ID must be positive", "volunteeringID", nil)
+	}
+
+	volunteering, err := readItem(uc.cache, itemCacheKey(cacheNamespaceVolunteerings, volunteeringID), volunteeringOwner, func() (*entities.Volunteering, error) {
+		return uc.volunteeringRepo.GetByID(ctx, volunteeringID)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get volunteering by ID %d: %v", volunteeringID, err)
+		return nil, domain.NewInternalError("failed to retrieve volunteering", err)
+	}
+
+	if volunteering == nil {
+		uc.logger.Error("Volunteering not found for ID %d", volunteeringID)
+		return nil, domain.NewNotFoundError("Volunteering", fmt.Sprint(volunteeringID))
+	}
+
+	return volunteering, nil
+}
+
+func (uc *VolunteeringUseCase) GetVolunteeringsByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Volunteering], error) {
+	if userID <= 0 {
+		uc.logger.Error("Invalid user ID: %d", userID)
+		return nil, domain.NewValidationError("User ID must be positive", "userID", nil)
+	}
+
+	page, err := readThrough(uc.cache, listCacheKey(cacheNamespaceVolunteerings, userID, query), userID, func() (*entities.ListPage[*entities.Volunteering], error) {
+		return uc.volunteeringRepo.GetByUserID(ctx, userID, query)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to get volunteerings for user %d: %v", userID, err)
+		return nil, readFailure(err, domain.NewInternalError("failed to retrieve volunteerings", err))
+	}
+
+	return page, nil
+}
+
+func (uc *VolunteeringUseCase) UpdateVolunteering(ctx context.Context, volunteeringID int, volunteering *entities.Volunteering) (*entities.Volunteering, error) {
+	if err := uc.checkFields(volunteering); err != nil {
+		return nil, err
+	}
+
+	existingVolunteering, err := uc.volunteeringRepo.GetByID(ctx, volunteeringID)
+	if err != nil {
+		uc.logger.Error("Failed to check if volunteering exists: %v", err)
+		return nil, domain.NewInternalError("failed to check volunteering existence", err)
+	}
+	if existingVolunteering == nil {
+		uc.logger.Error("Volunteering not found for ID %d", volunteeringID)
+		return nil, domain.NewNotFoundError("Volunteering", fmt.Sprint(volunteeringID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Volunteering", volunteeringID, existingVolunteering.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingVolunteering)
+
+	return uc.save(ctx, volunteeringID, volunteering, entities.RevisionActionUpdate)
+}
+
+func (uc *VolunteeringUseCase) PatchVolunteering(ctx context.Context, volunteeringID int, req *volunteeringDto.PatchVolunteeringRequest) (*entities.Volunteering, error) {
+	if volunteeringID <= 0 {
+		uc.logger.Error("Volunteering ID is required")
+		return nil, domain.NewValidationError("Volunteering ID must be positive", "volunteeringID", nil)
+	}
+
+	existingVolunteering, err := uc.volunteeringRepo.GetByID(ctx, volunteeringID)
+	if err != nil {
+		uc.logger.Error("Failed to get volunteering by ID %d: %v", volunteeringID, err)
+		return nil, domain.NewInternalError("failed to get volunteering", err)
+	}
+	if existingVolunteering == nil {
+		uc.logger.Error("Volunteering not found: %d", volunteeringID)
+		return nil, domain.NewNotFoundError("Volunteering", fmt.Sprint(volunteeringID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Volunteering", volunteeringID, existingVolunteering.Version); err != nil {
+		return nil, err
+	}
+
+	auditBefore(ctx, existingVolunteering)
+
+	patched := *existingVolunteering
+	if err := req.ApplyTo(&patched); err != nil {
+		return nil, err
+	}
+
+	return uc.save(ctx, volunteeringID, &patched, entities.RevisionActionPatch)
+}
+
+// save writes the fields of volunteering over the stored ones, for both
+// updates and patches.
+func (uc *VolunteeringUseCase) save(ctx context.Context, volunteeringID int, volunteering *entities.Volunteering, action string) (*entities.Volunteering, error) {
+	volunteering.MarkAsUpdated()
+	savedVolunteering, err := uc.volunteeringRepo.Update(ctx, volunteeringID, volunteering)
+	if err != nil {
+		uc.logger.Error("Failed to update volunteering: %v", err)
+		return nil, writeFailure(err, domain.NewInternalError("failed to update volunteering", err))
+	}
+
+	uc.snapshotRevision(ctx, volunteeringID, action)
+	invalidate(uc.cache, cacheNamespaceVolunteerings, savedVolunteering.UserID)
+	return savedVolunteering, nil
+}
+
+func (uc *VolunteeringUseCase) DeleteVolunteering(ctx context.Context, volunteeringID int) error {
+	if volunteeringID <= 0 {
+		uc.logger.Error("Invalid volunteering ID: %d", volunteeringID)
+		return domain.NewValidationError("Volunteering ID must be positive", "volunteeringID", nil)
+	}
+
+	existingVolunteering, err := uc.volunteeringRepo.GetByID(ctx, volunteeringID)
+	if err != nil {
+		uc.logger.Error("Failed to check if volunteering exists: %v", err)
+		return domain.NewInternalError("failed to check volunteering existence", err)
+	}
+	if existingVolunteering == nil {
+		uc.logger.Error("Volunteering not found for ID %d", volunteeringID)
+		return domain.NewNotFoundError("Volunteering", fmt.Sprint(volunteeringID))
+	}
+
+	if err := checkExpectedVersion(ctx, "Volunteering", volunteeringID, existingVolunteering.Version); err != nil {
+		return err
+	}
+
+	if err := uc.volunteeringRepo.Delete(ctx, volunteeringID); err != nil {
+		uc.logger.Error("Failed to delete volunteering: %v", err)
+		return writeFailure(err, domain.NewInternalError("failed to delete volunteering", err))
+	}
+
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeVolunteering, volunteeringID, entities.RevisionActionDelete, existingVolunteering)
+	invalidate(uc.cache, cacheNamespaceVolunteerings, existingVolunteering.UserID)
+	return nil
+}
+
+// ReorderVolunteerings moves the user's volunteerings into the order of req,
+// which must list every live one of them exactly once. Lists follow that
+// order unless another sort is requested.
+func (uc *VolunteeringUseCase) ReorderVolunteerings(ctx context.Context, userID int, req *orderDto.OrderRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return uc.volunteeringRepo.Reorder(ctx, userID, req.IDs)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reorder volunteerings of user %d: %v", userID, err)
+		return err
+	}
+
+	auditAfter(ctx, req.IDs)
+	invalidate(uc.cache, cacheNamespaceVolunteerings, userID)
+	return nil
+}
+
+func (uc *VolunteeringUseCase) checkFields(volunteering *entities.Volunteering) error {
+	if !volunteering.HasRequiredFields() {
+		uc.logger.Error("Invalid volunteering fields: %v", volunteering)
+		return domain.NewValidationError("Role, organization, start date and user ID are required", "volunteering", nil)
+	}
+	return nil
+}
+
+// snapshotRevision records the stored state of the volunteering as a
+// revision.
+func (uc *VolunteeringUseCase) snapshotRevision(ctx context.Context, volunteeringID int, action string) {
+	volunteering, err := uc.volunteeringRepo.GetByID(ctx, volunteeringID)
+	if err != nil || volunteering == nil {
+		uc.logger.Error("Failed to load volunteering %d for revision: %v", volunteeringID, err)
+		return
+	}
+	recordRevision(ctx, uc.revisionRepo, uc.logger, entities.TrashTypeVolunteering, volunteeringID, action, volunteering)
+}
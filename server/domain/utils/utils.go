@@ -1,6 +1,9 @@
 package utils
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 type Date time.Time
 
@@ -24,3 +27,21 @@ func ParseDate(dateStr string) (*Date, error) {
 	d := Date(t)
 	return &d, nil
 }
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var dateStr string
+	if err := json.Unmarshal(data, &dateStr); err != nil {
+		return err
+	}
+
+	parsed, err := ParseDate(dateStr)
+	if err != nil {
+		return err
+	}
+	*d = *parsed
+	return nil
+}
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"strconv"
+	"time"
+)
+
+// @Description AuditLog is one successful admin write
+type AuditLog struct {
+	ID           int       `json:"id"`
+	UserID       *int      `json:"user_id"`
+	Action       string    `json:"action"`
+	ResourceType string    `json:"resource_type"`
+	ResourceID   string    `json:"resource_id"`
+	Before       string    `json:"before"`
+	After        string    `json:"after"`
+	IP           string    `json:"ip"`
+	RequestID    string    `json:"request_id"`
+	CreatedAt    time.Time `json:"created_at"`
+} // @name AuditLog
+
+// @Description Response for one page of the audit log
+type AuditLogListResponse struct {
+	AuditLogs []*AuditLog  `json:"audit_logs"`
+	Meta      *shared.Meta `json:"meta"`
+} //@name AuditLogListResponse
+
+// AuditLogCSVHeader is the first row of a CSV export.
+var AuditLogCSVHeader = []string{"id", "created_at", "user_id", "action", "resource_type", "resource_id", "before", "after", "ip", "request_id"}
+
+func FromAuditLogEntity(auditLog *entities.AuditLog) *AuditLog {
+	return &AuditLog{
+		ID:           auditLog.AuditLogID,
+		UserID:       auditLog.UserID,
+		Action:       auditLog.Action,
+		ResourceType: auditLog.ResourceType,
+		ResourceID:   auditLog.ResourceID,
+		Before:       auditLog.Before,
+		After:        auditLog.After,
+		IP:           auditLog.IP,
+		RequestID:    auditLog.RequestID,
+		CreatedAt:    auditLog.CreatedAt,
+	}
+}
+
+func FromAuditLogsEntityToResponse(auditLogs []*entities.AuditLog, meta *shared.Meta) *AuditLogListResponse {
+	auditLogResponses := make([]*AuditLog, 0, len(auditLogs))
+
+	for _, auditLog := range auditLogs {
+		auditLogResponses = append(auditLogResponses, FromAuditLogEntity(auditLog))
+	}
+
+	return &AuditLogListResponse{
+		AuditLogs: auditLogResponses,
+		Meta:      meta,
+	}
+}
+
+// ToCSVRecord returns the audit log as a row matching AuditLogCSVHeader.
+func (a *AuditLog) ToCSVRecord() []string {
+	userID := ""
+	if a.UserID != nil {
+		userID = strconv.Itoa(*a.UserID)
+	}
+
+	return []string{
+		strconv.Itoa(a.ID),
+		a.CreatedAt.Format(time.RFC3339),
+		userID,
+		a.Action,
+		a.ResourceType,
+		a.ResourceID,
+		a.Before,
+		a.After,
+		a.IP,
+		a.RequestID,
+	}
+}
@@ -0,0 +1,204 @@
+package dto
+
+import (
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	publishingDto "portfolio/dto/publishing"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxBulkAwards bounds the awards of one bulk request.
+const maxBulkAwards = 50
+
+// AwardFields holds the fields that create and update requests share. The
+// date is YYYY-MM-DD and cannot be in the future.
+type AwardFields struct {
+	Title       string `json:"title" validate:"required,max=200" example:"Open Source Contributor of the Year"`
+	Issuer      string `json:"issuer" validate:"required,max=150" example:"Go Community Awards"`
+	Date        string `json:"date" validate:"required" example:"2023-11-15"`
+	URL         string `json:"url,omitempty" validate:"omitempty,url" example:"https://example.com/awards/2023"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=1000"`
+}
+
+// @Description Request to create an award or an honor
+type CreateAwardRequest struct {
+	AwardFields
+	publishingDto.Publishing
+} // @name CreateAwardRequest
+
+// @Description Request to create multiple awards in bulk
+type CreateBulkAwardsRequest struct {
+	Awards []CreateAwardRequest `json:"awards" validate:"required"`
+} // @name CreateBulkAwardsRequest
+
+// @Description Request to update an existing award
+type UpdateAwardRequest struct {
+	AwardFields
+} // @name UpdateAwardRequest
+
+// @Description Request to patch an existing award. An empty url or
+// @Description description clears it.
+type PatchAwardRequest struct {
+	Title       *string `json:"title,omitempty" validate:"omitempty,max=200"`
+	Issuer      *string `json:"issuer,omitempty" validate:"omitempty,max=150"`
+	Date        *string `json:"date,omitempty" example:"2023-11-15"`
+	URL         *string `json:"url,omitempty" validate:"omitempty,url"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+} // @name PatchAwardRequest
+
+// Validate adds the errors of the fields to validator.
+func (f *AwardFields) Validate(validator *validation.Validator) {
+	validator.Required("title", f.Title)
+	validator.MaxLength("title", f.Title, 200)
+	validator.Required("issuer", f.Issuer)
+	validator.MaxLength("issuer", f.Issuer, 150)
+	validator.URL("url", strings.TrimSpace(f.URL))
+	validator.MaxLength("description", f.Description, 1000)
+
+	validator.Required("date", f.Date)
+	validateAwardDate(validator, f.Date)
+}
+
+func (req *CreateAwardRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.AwardFields.Validate(validator)
+	req.Publishing.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *CreateAwardRequest) ToEntity(userID int) (*entities.Award, error) {
+	award := &entities.Award{
+		UserID:     userID,
+		Publishing: req.Publishing.ToEntity(),
+	}
+	req.AwardFields.apply(award)
+	return award, nil
+}
+
+func (req *CreateBulkAwardsRequest) Validate() error {
+	if len(req.Awards) == 0 {
+		return domain.NewValidationError("At least one award is required", "awards", nil)
+	}
+
+	if len(req.Awards) > maxBulkAwards {
+		return domain.NewValidationError("Cannot create more than "+strconv.Itoa(maxBulkAwards)+" awards at once", "awards", nil)
+	}
+
+	for i, award := range req.Awards {
+		if err := award.Validate(); err != nil {
+			return domain.NewValidationError("Award "+strconv.Itoa(i+1)+": "+err.Error(), "awards", &err)
+		}
+	}
+
+	return nil
+}
+
+func (req *CreateBulkAwardsRequest) ToEntities(userID int) ([]*entities.Award, error) {
+	awards := make([]*entities.Award, 0, len(req.Awards))
+	for _, awardReq := range req.Awards {
+		award, err := awardReq.ToEntity(userID)
+		if err != nil {
+			return nil, err
+		}
+		awards = append(awards, award)
+	}
+	return awards, nil
+}
+
+func (req *UpdateAwardRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.AwardFields.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *UpdateAwardRequest) ToEntity(id, userID int) (*entities.Award, error) {
+	award := &entities.Award{
+		AwardID: id,
+		UserID:  userID,
+	}
+	req.AwardFields.apply(award)
+	return award, nil
+}
+
+func (req *PatchAwardRequest) Validate() error {
+	validator := validation.NewValidator()
+	if req.Title != nil {
+		validator.Required("title", *req.Title)
+		validator.MaxLength("title", *req.Title, 200)
+	}
+	if req.Issuer != nil {
+		validator.Required("issuer", *req.Issuer)
+		validator.MaxLength("issuer", *req.Issuer, 150)
+	}
+	if req.URL != nil {
+		validator.URL("url", strings.TrimSpace(*req.URL))
+	}
+	if req.Description != nil {
+		validator.MaxLength("description", *req.Description, 1000)
+	}
+	if req.Date != nil {
+		validator.Required("date", *req.Date)
+		validateAwardDate(validator, *req.Date)
+	}
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// ApplyTo sets the fields present in the request on award.
+func (req *PatchAwardRequest) ApplyTo(award *entities.Award) {
+	if req.Title != nil {
+		award.Title = strings.TrimSpace(*req.Title)
+	}
+	if req.Issuer != nil {
+		award.Issuer = strings.TrimSpace(*req.Issuer)
+	}
+	if req.Date != nil {
+		award.Date = parseAwardDate(*req.Date)
+	}
+	if req.URL != nil {
+		award.URL = strings.TrimSpace(*req.URL)
+	}
+	if req.Description != nil {
+		award.Description = strings.TrimSpace(*req.Description)
+	}
+}
+
+// apply sets the fields on award.
+func (f *AwardFields) apply(award *entities.Award) {
+	award.Title = strings.TrimSpace(f.Title)
+	award.Issuer = strings.TrimSpace(f.Issuer)
+	award.Date = parseAwardDate(f.Date)
+	award.URL = strings.TrimSpace(f.URL)
+	award.Description = strings.TrimSpace(f.Description)
+}
+
+// validateAwardDate checks a YYYY-MM-DD date that is not in the future, if
+// any.
+func validateAwardDate(validator *validation.Validator, value string) {
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	date, err := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	if err != nil {
+		validator.Custom("date", false, "Date must be in YYYY-MM-DD format")
+		return
+	}
+	validator.DateNotFuture("date", date)
+}
+
+// parseAwardDate parses a date checked by validateAwardDate.
+func parseAwardDate(value string) time.Time {
+	date, _ := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	return date
+}
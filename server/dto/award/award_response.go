@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description Award represents an award or an honor in the portfolio
+type Award struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Issuer      string `json:"issuer"`
+	Date        string `json:"date" example:"2023-11-15"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Position    int    `json:"position"`
+	publishingDto.Publishing
+} // @name Award
+
+// @Description Response for a list of awards
+type AwardListResponse struct {
+	Awards []*Award           `json:"awards"`
+	Meta   *shared.Meta       `json:"meta"`
+	Errors []*shared.APIError `json:"errors,omitempty"`
+} // @name AwardListResponse
+
+// @Description Response for an award
+type AwardResponse struct {
+	Award *Award       `json:"award"`
+	Meta  *shared.Meta `json:"meta"`
+} // @name AwardResponse
+
+func FromAwardEntityToResponse(award *entities.Award, meta *shared.Meta) *AwardResponse {
+	if award == nil {
+		return nil
+	}
+
+	return &AwardResponse{
+		Award: fromAwardEntity(award),
+		Meta:  meta,
+	}
+}
+
+func FromAwardsEntityToResponse(awards []*entities.Award, meta *shared.Meta) *AwardListResponse {
+	if awards == nil {
+		return nil
+	}
+
+	awardResponses := make([]*Award, 0, len(awards))
+	for _, award := range awards {
+		awardResponses = append(awardResponses, fromAwardEntity(award))
+	}
+
+	return &AwardListResponse{
+		Awards: awardResponses,
+		Meta:   meta,
+	}
+}
+
+func FromAwardsEntityForBulkToResponse(awards []*entities.Award, meta *shared.Meta) *AwardListResponse {
+	response := FromAwardsEntityToResponse(awards, meta)
+	if response == nil {
+		return &AwardListResponse{Awards: []*Award{}, Meta: meta}
+	}
+	return response
+}
+
+func fromAwardEntity(award *entities.Award) *Award {
+	return &Award{
+		ID:          award.AwardID,
+		Title:       award.Title,
+		Issuer:      award.Issuer,
+		Date:        award.Date.Format(time.DateOnly),
+		URL:         award.URL,
+		Description: award.Description,
+		CreatedAt:   award.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   award.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Position:    award.Position,
+		Publishing:  publishingDto.FromPublishingEntity(award.Publishing),
+	}
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"portfolio/service"
+	"portfolio/shared"
+)
+
+// @Description CacheStatsResponse reports the state of the public read cache
+type CacheStatsResponse struct {
+	Stats service.CacheStats `json:"stats"`
+	Meta  *shared.Meta       `json:"meta"`
+} //@name CacheStatsResponse
+
+func FromCacheStatsToResponse(stats service.CacheStats, meta *shared.Meta) *CacheStatsResponse {
+	return &CacheStatsResponse{
+		Stats: stats,
+		Meta:  meta,
+	}
+}
@@ -0,0 +1,261 @@
+package dto
+
+import (
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	publishingDto "portfolio/dto/publishing"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxBulkCertifications bounds the certifications of one bulk request.
+const maxBulkCertifications = 50
+
+// CertificationFields holds the fields that create and update requests
+// share. Dates are YYYY-MM-DD; a certification without an expiry_date never
+// expires.
+type CertificationFields struct {
+	Name            string `json:"name" validate:"required,max=150" example:"Certified Kubernetes Administrator"`
+	Issuer          string `json:"issuer" validate:"required,max=150" example:"The Linux Foundation"`
+	CredentialID    string `json:"credential_id,omitempty" validate:"omitempty,max=100" example:"LF-CKA-000002"`
+	VerificationURL string `json:"verification_url,omitempty" validate:"omitempty,url" example:"https://example.com/verify/LF-CKA-000002"`
+	IssueDate       string `json:"issue_date" validate:"required" example:"2024-05-01"`
+	ExpiryDate      string `json:"expiry_date,omitempty" example:"2027-05-01"`
+	Description     string `json:"description,omitempty" validate:"omitempty,max=1000"`
+}
+
+// @Description Request to create a certification or a license
+type CreateCertificationRequest struct {
+	CertificationFields
+	publishingDto.Publishing
+} // @name CreateCertificationRequest
+
+// @Description Request to create multiple certifications in bulk
+type CreateBulkCertificationsRequest struct {
+	Certifications []CreateCertificationRequest `json:"certifications" validate:"required"`
+} // @name CreateBulkCertificationsRequest
+
+// @Description Request to update an existing certification
+type UpdateCertificationRequest struct {
+	CertificationFields
+} // @name UpdateCertificationRequest
+
+// @Description Request to patch an existing certification. An empty
+// @Description credential_id, verification_url, expiry_date or description
+// @Description clears it.
+type PatchCertificationRequest struct {
+	Name            *string `json:"name,omitempty" validate:"omitempty,max=150"`
+	Issuer          *string `json:"issuer,omitempty" validate:"omitempty,max=150"`
+	CredentialID    *string `json:"credential_id,omitempty" validate:"omitempty,max=100"`
+	VerificationURL *string `json:"verification_url,omitempty" validate:"omitempty,url"`
+	IssueDate       *string `json:"issue_date,omitempty" example:"2024-05-01"`
+	ExpiryDate      *string `json:"expiry_date,omitempty" example:"2027-05-01"`
+	Description     *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+} // @name PatchCertificationRequest
+
+// Validate adds the errors of the fields to validator.
+func (f *CertificationFields) Validate(validator *validation.Validator) {
+	validator.Required("name", f.Name)
+	validator.MaxLength("name", f.Name, 150)
+	validator.Required("issuer", f.Issuer)
+	validator.MaxLength("issuer", f.Issuer, 150)
+	validator.MaxLength("credential_id", f.CredentialID, 100)
+	validator.URL("verification_url", strings.TrimSpace(f.VerificationURL))
+	validator.MaxLength("description", f.Description, 1000)
+
+	validator.Required("issue_date", f.IssueDate)
+	issueDate, issueDateOK := validateCertificationDate(validator, "issue_date", f.IssueDate)
+	if issueDateOK {
+		validator.DateNotFuture("issue_date", issueDate)
+	}
+	expiryDate, expiryDateOK := validateCertificationDate(validator, "expiry_date", f.ExpiryDate)
+	if issueDateOK && expiryDateOK {
+		validator.Custom("expiry_date", expiryDate.After(issueDate), "Expiry date must be after the issue date")
+	}
+}
+
+func (req *CreateCertificationRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.CertificationFields.Validate(validator)
+	req.Publishing.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *CreateCertificationRequest) ToEntity(userID int) (*entities.Certification, error) {
+	certification := &entities.Certification{
+		UserID:     userID,
+		Publishing: req.Publishing.ToEntity(),
+	}
+	req.CertificationFields.apply(certification)
+	return certification, nil
+}
+
+func (req *CreateBulkCertificationsRequest) Validate() error {
+	if len(req.Certifications) == 0 {
+		return domain.NewValidationError("At least one certification is required", "certifications", nil)
+	}
+
+	if len(req.Certifications) > maxBulkCertifications {
+		return domain.NewValidationError("Cannot create more than "+strconv.Itoa(maxBulkCertifications)+" certifications at once", "certifications", nil)
+	}
+
+	credentials := make(map[string]bool)
+	for i, certification := range req.Certifications {
+		if err := certification.Validate(); err != nil {
+			return domain.NewValidationError("Certification "+strconv.Itoa(i+1)+": "+err.Error(), "certifications", &err)
+		}
+
+		credentialID := strings.TrimSpace(certification.CredentialID)
+		if credentialID == "" {
+			continue
+		}
+		credential := strings.TrimSpace(certification.Issuer) + "\x00" + credentialID
+		if credentials[credential] {
+			return domain.NewAlreadyExistsError("certification", credentialID)
+		}
+		credentials[credential] = true
+	}
+
+	return nil
+}
+
+func (req *CreateBulkCertificationsRequest) ToEntities(userID int) ([]*entities.Certification, error) {
+	certifications := make([]*entities.Certification, 0, len(req.Certifications))
+	for _, certificationReq := range req.Certifications {
+		certification, err := certificationReq.ToEntity(userID)
+		if err != nil {
+			return nil, err
+		}
+		certifications = append(certifications, certification)
+	}
+	return certifications, nil
+}
+
+func (req *UpdateCertificationRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.CertificationFields.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *UpdateCertificationRequest) ToEntity(id, userID int) (*entities.Certification, error) {
+	certification := &entities.Certification{
+		CertificationID: id,
+		UserID:          userID,
+	}
+	req.CertificationFields.apply(certification)
+	return certification, nil
+}
+
+func (req *PatchCertificationRequest) Validate() error {
+	validator := validation.NewValidator()
+	if req.Name != nil {
+		validator.Required("name", *req.Name)
+		validator.MaxLength("name", *req.Name, 150)
+	}
+	if req.Issuer != nil {
+		validator.Required("issuer", *req.Issuer)
+		validator.MaxLength("issuer", *req.Issuer, 150)
+	}
+	if req.CredentialID != nil {
+		validator.MaxLength("credential_id", *req.CredentialID, 100)
+	}
+	if req.VerificationURL != nil {
+		validator.URL("verification_url", strings.TrimSpace(*req.VerificationURL))
+	}
+	if req.Description != nil {
+		validator.MaxLength("description", *req.Description, 1000)
+	}
+	if req.IssueDate != nil {
+		validator.Required("issue_date", *req.IssueDate)
+		if issueDate, ok := validateCertificationDate(validator, "issue_date", *req.IssueDate); ok {
+			validator.DateNotFuture("issue_date", issueDate)
+		}
+	}
+	if req.ExpiryDate != nil {
+		validateCertificationDate(validator, "expiry_date", *req.ExpiryDate)
+	}
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// ApplyTo sets the fields present in the request on certification. The
+// dates are checked against each other once both are known.
+func (req *PatchCertificationRequest) ApplyTo(certification *entities.Certification) error {
+	if req.Name != nil {
+		certification.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Issuer != nil {
+		certification.Issuer = strings.TrimSpace(*req.Issuer)
+	}
+	if req.CredentialID != nil {
+		certification.CredentialID = strings.TrimSpace(*req.CredentialID)
+	}
+	if req.VerificationURL != nil {
+		certification.VerificationURL = strings.TrimSpace(*req.VerificationURL)
+	}
+	if req.Description != nil {
+		certification.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.IssueDate != nil {
+		certification.IssueDate = parseCertificationDate(*req.IssueDate)
+	}
+	if req.ExpiryDate != nil {
+		certification.ExpiryDate = parseOptionalCertificationDate(*req.ExpiryDate)
+	}
+
+	if certification.HasExpiryDate() && !certification.ExpiryDate.After(certification.IssueDate) {
+		return domain.NewValidationError("Expiry date must be after the issue date", "expiry_date", nil)
+	}
+	return nil
+}
+
+// apply sets the fields on certification.
+func (f *CertificationFields) apply(certification *entities.Certification) {
+	certification.Name = strings.TrimSpace(f.Name)
+	certification.Issuer = strings.TrimSpace(f.Issuer)
+	certification.CredentialID = strings.TrimSpace(f.CredentialID)
+	certification.VerificationURL = strings.TrimSpace(f.VerificationURL)
+	certification.IssueDate = parseCertificationDate(f.IssueDate)
+	certification.ExpiryDate = parseOptionalCertificationDate(f.ExpiryDate)
+	certification.Description = strings.TrimSpace(f.Description)
+}
+
+// validateCertificationDate checks a YYYY-MM-DD date, if any, and returns it
+// with whether it is set and valid.
+func validateCertificationDate(validator *validation.Validator, field, value string) (time.Time, bool) {
+	if strings.TrimSpace(value) == "" {
+		return time.Time{}, false
+	}
+	date, err := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	if err != nil {
+		validator.Custom(field, false, "Date must be in YYYY-MM-DD format")
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// parseCertificationDate parses a date checked by validateCertificationDate.
+func parseCertificationDate(value string) time.Time {
+	date, _ := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	return date
+}
+
+// parseOptionalCertificationDate parses a date checked by
+// validateCertificationDate; an empty one is nil.
+func parseOptionalCertificationDate(value string) *time.Time {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	date := parseCertificationDate(value)
+	return &date
+}
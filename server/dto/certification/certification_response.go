@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description Certification represents a certification or a license in the
+// @Description portfolio. expiry_status flags certifications that have expired
+// @Description or expire within 90 days; days_until_expiry is null for the
+// @Description ones that never expire.
+type Certification struct {
+	ID              int     `json:"id"`
+	Name            string  `json:"name"`
+	Issuer          string  `json:"issuer"`
+	CredentialID    string  `json:"credential_id"`
+	VerificationURL string  `json:"verification_url"`
+	IssueDate       string  `json:"issue_date" example:"2024-05-01"`
+	ExpiryDate      *string `json:"expiry_date" example:"2027-05-01"`
+	ExpiryStatus    string  `json:"expiry_status" enums:"valid,expiring_soon,expired"`
+	DaysUntilExpiry *int    `json:"days_until_expiry"`
+	Description     string  `json:"description"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+	Position        int     `json:"position"`
+	publishingDto.Publishing
+} // @name Certification
+
+// @Description Response for a list of certifications
+type CertificationListResponse struct {
+	Certifications []*Certification   `json:"certifications"`
+	Meta           *shared.Meta       `json:"meta"`
+	Errors         []*shared.APIError `json:"errors,omitempty"`
+} // @name CertificationListResponse
+
+// @Description Response for a certification
+type CertificationResponse struct {
+	Certification *Certification `json:"certification"`
+	Meta          *shared.Meta   `json:"meta"`
+} // @name CertificationResponse
+
+func FromCertificationEntityToResponse(certification *entities.Certification, meta *shared.Meta) *CertificationResponse {
+	if certification == nil {
+		return nil
+	}
+
+	return &CertificationResponse{
+		Certification: fromCertificationEntity(certification, time.Now()),
+		Meta:          meta,
+	}
+}
+
+func FromCertificationsEntityToResponse(certifications []*entities.Certification, meta *shared.Meta) *CertificationListResponse {
+	if certifications == nil {
+		return nil
+	}
+
+	now := time.Now()
+	certificationResponses := make([]*Certification, 0, len(certifications))
+	for _, certification := range certifications {
+		certificationResponses = append(certificationResponses, fromCertificationEntity(certification, now))
+	}
+
+	return &CertificationListResponse{
+		Certifications: certificationResponses,
+		Meta:           meta,
+	}
+}
+
+func FromCertificationsEntityForBulkToResponse(certifications []*entities.Certification, meta *shared.Meta) *CertificationListResponse {
+	response := FromCertificationsEntityToResponse(certifications, meta)
+	if response == nil {
+		return &CertificationListResponse{Certifications: []*Certification{}, Meta: meta}
+	}
+	return response
+}
+
+// fromCertificationEntity flags the expiry of certification as of now.
+func fromCertificationEntity(certification *entities.Certification, now time.Time) *Certification {
+	response := &Certification{
+		ID:              certification.CertificationID,
+		Name:            certification.Name,
+		Issuer:          certification.Issuer,
+		CredentialID:    certification.CredentialID,
+		VerificationURL: certification.VerificationURL,
+		IssueDate:       certification.IssueDate.Format(time.DateOnly),
+		ExpiryStatus:    certification.ExpiryStatus(now),
+		DaysUntilExpiry: certification.DaysUntilExpiry(now),
+		Description:     certification.Description,
+		CreatedAt:       certification.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       certification.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Position:        certification.Position,
+		Publishing:      publishingDto.FromPublishingEntity(certification.Publishing),
+	}
+
+	if certification.HasExpiryDate() {
+		expiryDate := certification.ExpiryDate.Format(time.DateOnly)
+		response.ExpiryDate = &expiryDate
+	}
+
+	return response
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
 	"strings"
 	"time"
 )
@@ -13,6 +14,7 @@ type CreateEducationRequest struct {
 	StartDate   time.Time  `json:"start_date" validate:"required"`
 	EndDate     *time.Time `json:"end_date,omitempty"`
 	Description *string    `json:"description,omitempty"`
+	publishingDto.Publishing
 } // @name CreateEducationRequest
 
 type CreateBulkEducationsRequest struct {
@@ -80,7 +82,7 @@ func (req *CreateEducationRequest) Validate() error {
 		return domain.NewRequiredFieldError("start_date")
 	}
 
-	return nil
+	return req.Publishing.Check()
 }
 
 func (req *UpdateEducationRequest) Validate() error {
@@ -112,6 +114,7 @@ func (req *CreateEducationRequest) ToEntity(userID int) (*entities.Education, er
 		Description: "",
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
+		Publishing:  req.Publishing.ToEntity(),
 	}
 
 	if req.EndDate != nil {
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
 	"portfolio/shared"
 )
 
@@ -15,6 +16,8 @@ type Education struct {
 	Description string  `json:"description"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   string  `json:"updated_at"`
+	Position    int     `json:"position"`
+	publishingDto.Publishing
 } //@name Education
 
 // @Description Response for a list of educations
@@ -43,6 +46,8 @@ func FromEducationEntityToResponse(education *entities.Education, meta *shared.M
 		Description: education.Description,
 		CreatedAt:   education.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:   education.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Position:    education.Position,
+		Publishing:  publishingDto.FromPublishingEntity(education.Publishing),
 	}
 
 	if education.EndDate != nil && !education.EndDate.IsZero() {
@@ -61,7 +66,7 @@ func FromEducationsEntityToResponse(educations []*entities.Education, meta *shar
 		return nil
 	}
 
-	var educationResponses []*Education
+	educationResponses := make([]*Education, 0, len(educations))
 	for _, education := range educations {
 		_education := &Education{
 			ID:          education.EducationID,
@@ -71,6 +76,8 @@ func FromEducationsEntityToResponse(educations []*entities.Education, meta *shar
 			Description: education.Description,
 			CreatedAt:   education.CreatedAt.Format("2006-01-02 15:04:05"),
 			UpdatedAt:   education.UpdatedAt.Format("2006-01-02 15:04:05"),
+			Position:    education.Position,
+			Publishing:  publishingDto.FromPublishingEntity(education.Publishing),
 		}
 		if education.EndDate != nil && !education.EndDate.IsZero() {
 			endDate := education.EndDate.Format("01/2006")
@@ -100,6 +107,8 @@ func FromEducationsEntityForBulkToResponse(educations []*entities.Education, met
 			Description: education.Description,
 			CreatedAt:   education.CreatedAt.Format("2006-01-02 15:04:05"),
 			UpdatedAt:   education.UpdatedAt.Format("2006-01-02 15:04:05"),
+			Position:    education.Position,
+			Publishing:  publishingDto.FromPublishingEntity(education.Publishing),
 		}
 		if education.EndDate != nil && !education.EndDate.IsZero() {
 			endDate := education.EndDate.Format("01/2006")
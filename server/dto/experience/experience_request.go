@@ -4,16 +4,36 @@ import (
 	"fmt"
 	"portfolio/domain"
 	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	projectDto "portfolio/dto/project"
+	publishingDto "portfolio/dto/publishing"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// ExperienceDetails holds the optional fields that create and update
+// requests share. The employment type defaults to full_time. Achievements
+// and technologies are kept in order; an update replaces the earlier ones.
+type ExperienceDetails struct {
+	EmploymentType string                    `json:"employment_type,omitempty" validate:"omitempty,oneof=full_time contract freelance" example:"full_time"`
+	Location       string                    `json:"location,omitempty" validate:"omitempty,max=100" example:"Berlin, Germany"`
+	Remote         bool                      `json:"remote,omitempty"`
+	Achievements   []string                  `json:"achievements,omitempty" validate:"omitempty,max=20"`
+	Technologies   projectDto.TechnologyRefs `json:"technologies,omitempty" validate:"omitempty,max=50" swaggertype:"array,string"`
+}
+
+// @Description Request to create an experience, one position at a company.
+// @Description Positions at the same company share its company_name.
 type CreateExperienceRequest struct {
 	JobTitle    string `json:"job_title" validate:"required"`
 	CompanyName string `json:"company_name" validate:"required"`
 	StartDate   string `json:"start_date" validate:"required"`
 	EndDate     string `json:"end_date"`
 	Description string `json:"description"`
+	ExperienceDetails
+	publishingDto.Publishing
 } // @name CreateExperienceRequest
 
 // @Description Request to create multiple experiences in bulk
@@ -30,17 +50,20 @@ func (req *CreateBulkExperiencesRequest) Validate() error {
 		return domain.NewValidationError("Cannot create more than 50 experiences at once", "experiences", nil)
 	}
 
-	nameMap := make(map[string]bool)
+	positionMap := make(map[string]bool)
 	for i, experience := range req.Experiences {
 		if err := experience.Validate(); err != nil {
 			return domain.NewValidationError("Experience "+string(rune(i+1))+": "+err.Error(), "experiences", &err)
 		}
 
-		normalizedName := strings.ToLower(strings.TrimSpace(experience.JobTitle))
-		if nameMap[normalizedName] {
-			return domain.NewAlreadyExistsError("experience", experience.JobTitle)
+		// A company can list the same title twice, but not from the same
+		// start date.
+		position := strings.ToLower(strings.TrimSpace(experience.JobTitle)) + "\x00" +
+			strings.ToLower(strings.TrimSpace(experience.CompanyName)) + "\x00" + experience.StartDate
+		if positionMap[position] {
+			return domain.NewAlreadyExistsError("experience", experience.JobTitle+" at "+experience.CompanyName)
 		}
-		nameMap[normalizedName] = true
+		positionMap[position] = true
 	}
 
 	return nil
@@ -52,14 +75,22 @@ type UpdateExperienceRequest struct {
 	StartDate   string `json:"start_date" validate:"required"`
 	EndDate     string `json:"end_date"`
 	Description string `json:"description"`
+	ExperienceDetails
 } // @name UpdateExperienceRequest
 
+// @Description Request to patch an existing experience. achievements and
+// @Description technologies replace the earlier ones when present.
 type PatchExperienceRequest struct {
-	JobTitle    string `json:"job_title,omitempty" validate:"omitempty"`
-	CompanyName string `json:"company_name,omitempty" validate:"omitempty"`
-	StartDate   string `json:"start_date,omitempty" validate:"omitempty"`
-	EndDate     string `json:"end_date,omitempty"`
-	Description string `json:"description,omitempty"`
+	JobTitle       string                    `json:"job_title,omitempty" validate:"omitempty"`
+	CompanyName    string                    `json:"company_name,omitempty" validate:"omitempty"`
+	StartDate      string                    `json:"start_date,omitempty" validate:"omitempty"`
+	EndDate        string                    `json:"end_date,omitempty"`
+	Description    string                    `json:"description,omitempty"`
+	EmploymentType *string                   `json:"employment_type,omitempty" validate:"omitempty,oneof=full_time contract freelance"`
+	Location       *string                   `json:"location,omitempty" validate:"omitempty,max=100"`
+	Remote         *bool                     `json:"remote,omitempty"`
+	Achievements   []string                  `json:"achievements,omitempty" validate:"omitempty,max=20"`
+	Technologies   projectDto.TechnologyRefs `json:"technologies,omitempty" validate:"omitempty,max=50" swaggertype:"array,string"`
 } // @name PatchExperienceRequest
 
 type DeleteExperienceRequest struct {
@@ -107,7 +138,11 @@ func (req *CreateExperienceRequest) Validate() error {
 		return domain.NewValidationError("Description cannot exceed 1000 characters", "description", nil)
 	}
 
-	return nil
+	if err := req.ExperienceDetails.Check(); err != nil {
+		return err
+	}
+
+	return req.Publishing.Check()
 }
 
 func (req *UpdateExperienceRequest) Validate() error {
@@ -151,7 +186,7 @@ func (req *UpdateExperienceRequest) Validate() error {
 		return domain.NewValidationError("Description cannot exceed 1000 characters", "description", nil)
 	}
 
-	return nil
+	return req.ExperienceDetails.Check()
 }
 
 func (req *CreateExperienceRequest) ToEntity(userID int) (*entities.Experience, error) {
@@ -169,14 +204,17 @@ func (req *CreateExperienceRequest) ToEntity(userID int) (*entities.Experience,
 		endDate = parsedEndDate
 	}
 
-	return &entities.Experience{
+	experience := &entities.Experience{
 		UserID:      userID,
 		JobTitle:    strings.TrimSpace(req.JobTitle),
 		CompanyName: strings.TrimSpace(req.CompanyName),
 		StartDate:   startDate,
 		EndDate:     endDate,
 		Description: strings.TrimSpace(req.Description),
-	}, nil
+		Publishing:  req.Publishing.ToEntity(),
+	}
+	req.ExperienceDetails.apply(experience)
+	return experience, nil
 }
 
 func (req *CreateBulkExperiencesRequest) ToEntities(userID int) ([]*entities.Experience, error) {
@@ -206,7 +244,7 @@ func (req *UpdateExperienceRequest) ToEntity(experienceID, userID int) (*entitie
 		endDate = parsedEndDate
 	}
 
-	return &entities.Experience{
+	experience := &entities.Experience{
 		ExperienceID: experienceID,
 		UserID:       userID,
 		JobTitle:     strings.TrimSpace(req.JobTitle),
@@ -214,7 +252,9 @@ func (req *UpdateExperienceRequest) ToEntity(experienceID, userID int) (*entitie
 		StartDate:    startDate,
 		EndDate:      endDate,
 		Description:  strings.TrimSpace(req.Description),
-	}, nil
+	}
+	req.ExperienceDetails.apply(experience)
+	return experience, nil
 }
 
 func (req *PatchExperienceRequest) Validate() error {
@@ -248,15 +288,26 @@ func (req *PatchExperienceRequest) Validate() error {
 		}
 	}
 
+	validator := validation.NewValidator()
+	if req.EmploymentType != nil {
+		validateEmploymentType(validator, *req.EmploymentType)
+	}
+	if req.Location != nil {
+		validator.MaxLength("location", *req.Location, 100)
+	}
+	validateAchievements(validator, req.Achievements)
+	req.Technologies.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
 	return nil
 }
 
-func (req *PatchExperienceRequest) ToEntity(experienceID, userID int) (*entities.Experience, error) {
-	experience := &entities.Experience{
-		ExperienceID: experienceID,
-		UserID:       userID,
-	}
-
+// ApplyTo sets the fields present in the request on experience. Absent
+// achievements and technologies are left nil, which keeps the stored ones;
+// present technologies carry only an ID or a name, for the use case to look
+// up.
+func (req *PatchExperienceRequest) ApplyTo(experience *entities.Experience) error {
 	if req.JobTitle != "" {
 		experience.JobTitle = strings.TrimSpace(req.JobTitle)
 	}
@@ -268,24 +319,93 @@ func (req *PatchExperienceRequest) ToEntity(experienceID, userID int) (*entities
 	if req.StartDate != "" {
 		startDate, err := time.Parse("2006-01-02", req.StartDate)
 		if err != nil {
-			return nil, fmt.Errorf("invalid start date format: %v", err)
+			return fmt.Errorf("invalid start date format: %v", err)
 		}
 		experience.StartDate = startDate
 	}
 
-	if req.EndDate != "" {
-		if strings.TrimSpace(req.EndDate) != "" {
-			endDate, err := time.Parse("2006-01-02", req.EndDate)
-			if err != nil {
-				return nil, fmt.Errorf("invalid end date format: %v", err)
-			}
-			experience.EndDate = endDate
+	if strings.TrimSpace(req.EndDate) != "" {
+		endDate, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return fmt.Errorf("invalid end date format: %v", err)
 		}
+		experience.EndDate = endDate
 	}
 
 	if req.Description != "" {
 		experience.Description = strings.TrimSpace(req.Description)
 	}
 
-	return experience, nil
+	if req.EmploymentType != nil {
+		experience.EmploymentType = *req.EmploymentType
+	}
+	if req.Location != nil {
+		experience.Location = strings.TrimSpace(*req.Location)
+	}
+	if req.Remote != nil {
+		experience.Remote = *req.Remote
+	}
+	experience.Achievements = trimAchievements(req.Achievements)
+	experience.Technologies = req.Technologies.ToEntities()
+
+	return nil
+}
+
+// Check validates the details on their own, like publishingDto.Publishing.
+func (d *ExperienceDetails) Check() error {
+	validator := validation.NewValidator()
+	if d.EmploymentType != "" {
+		validateEmploymentType(validator, d.EmploymentType)
+	}
+	validator.MaxLength("location", d.Location, 100)
+	validateAchievements(validator, d.Achievements)
+	d.Technologies.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// apply sets the details on experience. Missing achievements and
+// technologies are set empty rather than nil, so that an update clears the
+// earlier ones.
+func (d *ExperienceDetails) apply(experience *entities.Experience) {
+	experience.EmploymentType = d.EmploymentType
+	if experience.EmploymentType == "" {
+		experience.EmploymentType = entities.EmploymentTypeFullTime
+	}
+	experience.Location = strings.TrimSpace(d.Location)
+	experience.Remote = d.Remote
+	experience.Achievements = trimAchievements(append([]string{}, d.Achievements...))
+	experience.Technologies = append(projectDto.TechnologyRefs{}, d.Technologies...).ToEntities()
+}
+
+func validateEmploymentType(validator *validation.Validator, employmentType string) {
+	validator.Custom("employment_type", slices.Contains(entities.EmploymentTypes, employmentType),
+		"Employment type must be one of: "+strings.Join(entities.EmploymentTypes, ", "))
+}
+
+func validateAchievements(validator *validation.Validator, achievements []string) {
+	validator.Custom("achievements", len(achievements) <= entities.MaxExperienceAchievements,
+		"An experience can have at most "+strconv.Itoa(entities.MaxExperienceAchievements)+" achievements")
+	for _, achievement := range achievements {
+		if strings.TrimSpace(achievement) == "" {
+			validator.Custom("achievements", false, "Achievements cannot be empty")
+			return
+		}
+		validator.MaxLength("achievements", achievement, entities.MaxExperienceAchievementLength)
+	}
+}
+
+// trimAchievements trims the achievements checked by validateAchievements;
+// nil stays nil.
+func trimAchievements(achievements []string) []string {
+	if achievements == nil {
+		return nil
+	}
+	trimmed := make([]string, 0, len(achievements))
+	for _, achievement := range achievements {
+		trimmed = append(trimmed, strings.TrimSpace(achievement))
+	}
+	return trimmed
 }
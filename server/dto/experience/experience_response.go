@@ -2,25 +2,40 @@ package dto
 
 import (
 	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
 	"portfolio/shared"
 	"time"
 )
 
-// @Description Experience represents a work experience entry in the portfolio
+// @Description Experience represents a work experience entry in the portfolio: one position at a company
 type Experience struct {
-	ExperienceID      int       `json:"experience_id"`
-	UserID            int       `json:"user_id"`
-	JobTitle          string    `json:"job_title"`
-	CompanyName       string    `json:"company_name"`
-	StartDate         string    `json:"start_date"`
-	EndDate           string    `json:"end_date,omitempty"`
-	Description       string    `json:"description"`
-	IsCurrentPosition bool      `json:"is_current_position"`
-	DurationInMonths  int       `json:"duration_in_months"`
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ExperienceID      int                     `json:"experience_id"`
+	UserID            int                     `json:"user_id"`
+	JobTitle          string                  `json:"job_title"`
+	CompanyName       string                  `json:"company_name"`
+	StartDate         string                  `json:"start_date"`
+	EndDate           string                  `json:"end_date,omitempty"`
+	Description       string                  `json:"description"`
+	EmploymentType    string                  `json:"employment_type"`
+	Location          string                  `json:"location"`
+	Remote            bool                    `json:"remote"`
+	Achievements      []string                `json:"achievements"`
+	Technologies      []*ExperienceTechnology `json:"technologies"`
+	IsCurrentPosition bool                    `json:"is_current_position"`
+	DurationInMonths  int                     `json:"duration_in_months"`
+	CreatedAt         time.Time               `json:"created_at"`
+	UpdatedAt         time.Time               `json:"updated_at"`
+	Position          int                     `json:"position"`
+	publishingDto.Publishing
 } //@name Experience
 
+// @Description ExperienceTechnology is a technology linked to an experience
+type ExperienceTechnology struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	IconURL string `json:"icon_url"`
+} // @name ExperienceTechnology
+
 // @Description Response for a list of experiences
 type ExperienceListResponse struct {
 	Experiences []*Experience      `json:"experiences"`
@@ -28,6 +43,22 @@ type ExperienceListResponse struct {
 	Errors      []*shared.APIError `json:"errors,omitempty"`
 } //@name ExperienceListResponse
 
+// @Description The positions at one company, in list order. start_date is the
+// @Description earliest start of the positions and end_date the latest end,
+// @Description omitted while one of them is current.
+type ExperienceGroup struct {
+	CompanyName string        `json:"company_name"`
+	StartDate   string        `json:"start_date"`
+	EndDate     string        `json:"end_date,omitempty"`
+	Positions   []*Experience `json:"positions"`
+} // @name ExperienceGroup
+
+// @Description Response for a list of experiences grouped by company
+type ExperienceGroupListResponse struct {
+	Groups []*ExperienceGroup `json:"groups"`
+	Meta   *shared.Meta       `json:"meta"`
+} // @name ExperienceGroupListResponse
+
 // @Description Response for an experience
 type ExperienceResponse struct {
 	Experience *Experience  `json:"experience"`
@@ -39,25 +70,8 @@ func FromExperienceEntityToResponse(experience *entities.Experience, meta *share
 		return nil
 	}
 
-	_experience := &Experience{
-		ExperienceID:      experience.ExperienceID,
-		UserID:            experience.UserID,
-		JobTitle:          experience.JobTitle,
-		CompanyName:       experience.CompanyName,
-		StartDate:         experience.StartDate.Format("2006-01-02"),
-		Description:       experience.Description,
-		IsCurrentPosition: experience.IsCurrentPosition(),
-		DurationInMonths:  experience.GetDurationInMonths(),
-		CreatedAt:         experience.CreatedAt,
-		UpdatedAt:         experience.UpdatedAt,
-	}
-
-	if !experience.IsCurrentPosition() {
-		_experience.EndDate = experience.EndDate.Format("2006-01-02")
-	}
-
 	return &ExperienceResponse{
-		Experience: _experience,
+		Experience: fromExperienceEntity(experience),
 		Meta:       meta,
 	}
 }
@@ -67,26 +81,9 @@ func FromExperiencesEntityToResponse(experiences []*entities.Experience, meta *s
 		return nil
 	}
 
-	var experienceResponses []*Experience
+	experienceResponses := make([]*Experience, 0, len(experiences))
 	for _, experience := range experiences {
-		_experience := &Experience{
-			ExperienceID:      experience.ExperienceID,
-			UserID:            experience.UserID,
-			JobTitle:          experience.JobTitle,
-			CompanyName:       experience.CompanyName,
-			StartDate:         experience.StartDate.Format("2006-01-02"),
-			Description:       experience.Description,
-			IsCurrentPosition: experience.IsCurrentPosition(),
-			DurationInMonths:  experience.GetDurationInMonths(),
-			CreatedAt:         experience.CreatedAt,
-			UpdatedAt:         experience.UpdatedAt,
-		}
-
-		if !experience.IsCurrentPosition() {
-			_experience.EndDate = experience.EndDate.Format("2006-01-02")
-		}
-
-		experienceResponses = append(experienceResponses, _experience)
+		experienceResponses = append(experienceResponses, fromExperienceEntity(experience))
 	}
 
 	return &ExperienceListResponse{
@@ -101,24 +98,8 @@ func FromExperiencesEntityForBulkToResponse(experiences []*entities.Experience,
 	}
 
 	var experienceResponses []*Experience
-
 	for _, experience := range experiences {
-		_experience := &Experience{
-			ExperienceID:      experience.ExperienceID,
-			UserID:            experience.UserID,
-			JobTitle:          experience.JobTitle,
-			CompanyName:       experience.CompanyName,
-			StartDate:         experience.StartDate.Format("2006-01-02"),
-			Description:       experience.Description,
-			IsCurrentPosition: experience.IsCurrentPosition(),
-			DurationInMonths:  experience.GetDurationInMonths(),
-			CreatedAt:         experience.CreatedAt,
-			UpdatedAt:         experience.UpdatedAt,
-		}
-		if !experience.IsCurrentPosition() {
-			_experience.EndDate = experience.EndDate.Format("2006-01-02")
-		}
-		experienceResponses = append(experienceResponses, _experience)
+		experienceResponses = append(experienceResponses, fromExperienceEntity(experience))
 	}
 
 	return &ExperienceListResponse{
@@ -126,3 +107,91 @@ func FromExperiencesEntityForBulkToResponse(experiences []*entities.Experience,
 		Meta:        meta,
 	}
 }
+
+// FromExperienceGroupsToResponse groups experiences, which must be sorted by
+// company first, under their companies.
+func FromExperienceGroupsToResponse(experiences []*entities.Experience, meta *shared.Meta) *ExperienceGroupListResponse {
+	var companies [][]*entities.Experience
+	for _, experience := range experiences {
+		last := len(companies) - 1
+		if last < 0 || companies[last][0].CompanyKey() != experience.CompanyKey() {
+			companies = append(companies, nil)
+			last++
+		}
+		companies[last] = append(companies[last], experience)
+	}
+
+	groups := make([]*ExperienceGroup, 0, len(companies))
+	for _, positions := range companies {
+		groups = append(groups, fromCompanyPositions(positions))
+	}
+
+	return &ExperienceGroupListResponse{
+		Groups: groups,
+		Meta:   meta,
+	}
+}
+
+// fromCompanyPositions builds the group of the positions at one company.
+func fromCompanyPositions(positions []*entities.Experience) *ExperienceGroup {
+	group := &ExperienceGroup{
+		CompanyName: positions[0].CompanyName,
+		Positions:   make([]*Experience, 0, len(positions)),
+	}
+
+	start, end, current := positions[0].StartDate, positions[0].EndDate, false
+	for _, position := range positions {
+		if position.StartDate.Before(start) {
+			start = position.StartDate
+		}
+		if position.EndDate.After(end) {
+			end = position.EndDate
+		}
+		current = current || position.IsCurrentPosition()
+		group.Positions = append(group.Positions, fromExperienceEntity(position))
+	}
+
+	group.StartDate = start.Format("2006-01-02")
+	if !current {
+		group.EndDate = end.Format("2006-01-02")
+	}
+	return group
+}
+
+func fromExperienceEntity(experience *entities.Experience) *Experience {
+	response := &Experience{
+		ExperienceID:      experience.ExperienceID,
+		UserID:            experience.UserID,
+		JobTitle:          experience.JobTitle,
+		CompanyName:       experience.CompanyName,
+		StartDate:         experience.StartDate.Format("2006-01-02"),
+		Description:       experience.Description,
+		EmploymentType:    experience.EmploymentType,
+		Location:          experience.Location,
+		Remote:            experience.Remote,
+		Achievements:      experience.Achievements,
+		Technologies:      make([]*ExperienceTechnology, 0, len(experience.Technologies)),
+		IsCurrentPosition: experience.IsCurrentPosition(),
+		DurationInMonths:  experience.GetDurationInMonths(),
+		CreatedAt:         experience.CreatedAt,
+		UpdatedAt:         experience.UpdatedAt,
+		Position:          experience.Position,
+		Publishing:        publishingDto.FromPublishingEntity(experience.Publishing),
+	}
+	if response.Achievements == nil {
+		response.Achievements = []string{}
+	}
+	for _, technology := range experience.Technologies {
+		response.Technologies = append(response.Technologies, &ExperienceTechnology{
+			ID:      technology.TechnologyID,
+			Name:    technology.Name,
+			IconURL: technology.IconURL,
+		})
+	}
+
+	if !experience.IsCurrentPosition() {
+		response.EndDate = experience.EndDate.Format("2006-01-02")
+	}
+
+	return response
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"portfolio/domain"
+	"strconv"
+)
+
+// @Description Request to reorder a collection: every ID of the collection,
+// in the new order
+type OrderRequest struct {
+	IDs []int `json:"ids" validate:"required"`
+} // @name OrderRequest
+
+func (req *OrderRequest) Validate() error {
+	if len(req.IDs) == 0 {
+		return domain.NewValidationError("At least one ID is required", "ids", nil)
+	}
+
+	seen := make(map[int]bool, len(req.IDs))
+	for _, id := range req.IDs {
+		if id <= 0 {
+			return domain.NewValidationError("IDs must be positive integers", "ids", nil)
+		}
+		if seen[id] {
+			return domain.NewValidationError("ID "+strconv.Itoa(id)+" is listed more than once", "ids", nil)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// IsPermutationOf reports whether the request lists exactly the IDs of the
+// collection.
+func (req *OrderRequest) IsPermutationOf(ids []int) bool {
+	if len(req.IDs) != len(ids) {
+		return false
+	}
+
+	listed := make(map[int]bool, len(req.IDs))
+	for _, id := range req.IDs {
+		listed[id] = true
+	}
+	for _, id := range ids {
+		if !listed[id] {
+			return false
+		}
+	}
+	return true
+}
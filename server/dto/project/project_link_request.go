@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	"slices"
+	"strings"
+)
+
+// @Description Request to add or replace a link of a project
+type ProjectLinkRequest struct {
+	Type      string `json:"type" validate:"required,oneof=github demo docs video website other"`
+	URL       string `json:"url" validate:"required,url"`
+	Label     string `json:"label,omitempty" validate:"omitempty,max=100"`
+	IsPrimary bool   `json:"is_primary"`
+} // @name ProjectLinkRequest
+
+func (r *ProjectLinkRequest) Validate() error {
+	r.Sanitize()
+
+	validator := validation.NewValidator()
+	validator.Required("type", r.Type)
+	if r.Type != "" {
+		validator.Custom("type", slices.Contains(entities.ProjectLinkTypes, r.Type), "Type must be one of: "+strings.Join(entities.ProjectLinkTypes, ", "))
+	}
+	validator.Required("url", r.URL).URL("url", r.URL)
+	validator.MaxLength("label", r.Label, 100)
+
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (r *ProjectLinkRequest) Sanitize() {
+	r.Type = strings.ToLower(strings.TrimSpace(r.Type))
+	r.URL = strings.TrimSpace(r.URL)
+	r.Label = strings.TrimSpace(r.Label)
+}
+
+func (r *ProjectLinkRequest) ToEntity(projectID int) *entities.ProjectLink {
+	return &entities.ProjectLink{
+		ProjectID: projectID,
+		Type:      r.Type,
+		URL:       r.URL,
+		Label:     r.Label,
+		IsPrimary: r.IsPrimary,
+	}
+}
@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description ProjectLink is a link of a project, such as its repository or a demo
+type ProjectLink struct {
+	ID        int       `json:"id"`
+	Type      string    `json:"type"`
+	URL       string    `json:"url"`
+	Label     string    `json:"label"`
+	Position  int       `json:"position"`
+	IsPrimary bool      `json:"is_primary"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+} // @name ProjectLink
+
+// @Description Response for a link of a project
+type ProjectLinkResponse struct {
+	Link *ProjectLink `json:"link"`
+	Meta *shared.Meta `json:"meta"`
+} // @name ProjectLinkResponse
+
+// @Description Response for the links of a project
+type ProjectLinkListResponse struct {
+	Links []*ProjectLink `json:"links"`
+	Meta  *shared.Meta   `json:"meta"`
+} // @name ProjectLinkListResponse
+
+func FromProjectLinkEntityToResponse(link *entities.ProjectLink, meta *shared.Meta) *ProjectLinkResponse {
+	return &ProjectLinkResponse{
+		Link: fromProjectLinkEntity(link),
+		Meta: meta,
+	}
+}
+
+func FromProjectLinkEntitiesToResponse(links []*entities.ProjectLink, meta *shared.Meta) *ProjectLinkListResponse {
+	return &ProjectLinkListResponse{
+		Links: fromProjectLinkEntities(links),
+		Meta:  meta,
+	}
+}
+
+func fromProjectLinkEntity(link *entities.ProjectLink) *ProjectLink {
+	return &ProjectLink{
+		ID:        link.ProjectLinkID,
+		Type:      link.Type,
+		URL:       link.URL,
+		Label:     link.Label,
+		Position:  link.Position,
+		IsPrimary: link.IsPrimary,
+		CreatedAt: link.CreatedAt,
+		UpdatedAt: link.UpdatedAt,
+	}
+}
+
+func fromProjectLinkEntities(links []*entities.ProjectLink) []*ProjectLink {
+	responses := make([]*ProjectLink, 0, len(links))
+	for _, link := range links {
+		responses = append(responses, fromProjectLinkEntity(link))
+	}
+	return responses
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	"slices"
+	"strings"
+)
+
+// @Description Request to add or replace an item of a project's gallery
+type ProjectMediaRequest struct {
+	Type      string `json:"type" validate:"omitempty,oneof=image video"`
+	URL       string `json:"url" validate:"required,url"`
+	Caption   string `json:"caption,omitempty" validate:"omitempty,max=500"`
+	AltText   string `json:"alt_text,omitempty" validate:"omitempty,max=300"`
+	IsPrimary bool   `json:"is_primary"`
+} // @name ProjectMediaRequest
+
+func (r *ProjectMediaRequest) Validate() error {
+	r.Sanitize()
+
+	validator := validation.NewValidator()
+	validator.Custom("type", slices.Contains(entities.ProjectMediaTypes, r.Type), "Type must be one of: "+strings.Join(entities.ProjectMediaTypes, ", "))
+	validator.Required("url", r.URL).URL("url", r.URL)
+	validator.MaxLength("caption", r.Caption, 500)
+	validator.MaxLength("alt_text", r.AltText, 300)
+
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// Sanitize trims the request; the type defaults to image.
+func (r *ProjectMediaRequest) Sanitize() {
+	r.Type = strings.ToLower(strings.TrimSpace(r.Type))
+	if r.Type == "" {
+		r.Type = entities.ProjectMediaTypeImage
+	}
+	r.URL = strings.TrimSpace(r.URL)
+	r.Caption = strings.TrimSpace(r.Caption)
+	r.AltText = strings.TrimSpace(r.AltText)
+}
+
+func (r *ProjectMediaRequest) ToEntity(projectID int) *entities.ProjectMedia {
+	return &entities.ProjectMedia{
+		ProjectID: projectID,
+		Type:      r.Type,
+		URL:       r.URL,
+		Caption:   r.Caption,
+		AltText:   r.AltText,
+		IsPrimary: r.IsPrimary,
+	}
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description ProjectMedia is an image or video of a project's gallery
+type ProjectMedia struct {
+	ID        int       `json:"id"`
+	Type      string    `json:"type"`
+	URL       string    `json:"url"`
+	Caption   string    `json:"caption"`
+	AltText   string    `json:"alt_text"`
+	Position  int       `json:"position"`
+	IsPrimary bool      `json:"is_primary"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+} // @name ProjectMedia
+
+// @Description Response for an item of a project's gallery
+type ProjectMediaResponse struct {
+	Media *ProjectMedia `json:"media"`
+	Meta  *shared.Meta  `json:"meta"`
+} // @name ProjectMediaResponse
+
+// @Description Response for a project's gallery
+type ProjectMediaListResponse struct {
+	Media []*ProjectMedia `json:"media"`
+	Meta  *shared.Meta    `json:"meta"`
+} // @name ProjectMediaListResponse
+
+func FromProjectMediaEntityToResponse(media *entities.ProjectMedia, meta *shared.Meta) *ProjectMediaResponse {
+	return &ProjectMediaResponse{
+		Media: fromProjectMediaEntity(media),
+		Meta:  meta,
+	}
+}
+
+func FromProjectMediaEntitiesToResponse(media []*entities.ProjectMedia, meta *shared.Meta) *ProjectMediaListResponse {
+	return &ProjectMediaListResponse{
+		Media: fromProjectMediaEntities(media),
+		Meta:  meta,
+	}
+}
+
+func fromProjectMediaEntity(media *entities.ProjectMedia) *ProjectMedia {
+	return &ProjectMedia{
+		ID:        media.ProjectMediaID,
+		Type:      media.Type,
+		URL:       media.URL,
+		Caption:   media.Caption,
+		AltText:   media.AltText,
+		Position:  media.Position,
+		IsPrimary: media.IsPrimary,
+		CreatedAt: media.CreatedAt,
+		UpdatedAt: media.UpdatedAt,
+	}
+}
+
+func fromProjectMediaEntities(media []*entities.ProjectMedia) []*ProjectMedia {
+	responses := make([]*ProjectMedia, 0, len(media))
+	for _, item := range media {
+		responses = append(responses, fromProjectMediaEntity(item))
+	}
+	return responses
+}
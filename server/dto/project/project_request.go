@@ -1,27 +1,129 @@
 package dto
 
 import (
+	"encoding/json"
+	"errors"
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/validation"
+	publishingDto "portfolio/dto/publishing"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// maxProjectTechnologies bounds the technologies linked to one project.
+const maxProjectTechnologies = 50
+
+// TechnologyRef names a technology by ID, as a JSON number, or by name, as a
+// JSON string.
+type TechnologyRef struct {
+	ID   int
+	Name string
+}
+
+func (ref *TechnologyRef) UnmarshalJSON(data []byte) error {
+	var id int
+	if err := json.Unmarshal(data, &id); err == nil {
+		if id <= 0 {
+			return errors.New("technology IDs must be positive")
+		}
+		ref.ID = id
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return errors.New("technologies must be IDs or names")
+	}
+	ref.Name = strings.TrimSpace(name)
+	return nil
+}
+
+// TechnologyRefs are the technologies of a project: an array of IDs and
+// names or, as before technologies were linked, one comma-separated string
+// of names. It is nil when the field is absent and empty when it is cleared.
+type TechnologyRefs []TechnologyRef
+
+func (refs *TechnologyRefs) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*refs = TechnologyRefs{}
+		for _, name := range strings.Split(text, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				*refs = append(*refs, TechnologyRef{Name: name})
+			}
+		}
+		return nil
+	}
+
+	list := []TechnologyRef{}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*refs = list
+	return nil
+}
+
+// Validate adds the errors of the technologies to validator. Experiences
+// link technologies with the same refs.
+func (refs TechnologyRefs) Validate(validator *validation.Validator) {
+	validator.Custom("technologies", len(refs) <= maxProjectTechnologies,
+		"At most "+strconv.Itoa(maxProjectTechnologies)+" technologies can be linked")
+	for _, ref := range refs {
+		if ref.ID == 0 {
+			validator.Custom("technologies", ref.Name != "", "Technology names cannot be empty")
+			validator.MaxLength("technologies", ref.Name, 100)
+		}
+	}
+}
+
+// ToEntities turns the refs into technologies that carry only an ID or a
+// name, for the use case to look up; nil stays nil.
+func (refs TechnologyRefs) ToEntities() []*entities.Technology {
+	if refs == nil {
+		return nil
+	}
+	technologies := make([]*entities.Technology, 0, len(refs))
+	for _, ref := range refs {
+		technologies = append(technologies, &entities.Technology{TechnologyID: ref.ID, Name: ref.Name})
+	}
+	return technologies
+}
+
+// validateSlug checks a slug given in a request; empty slugs are left to the
+// caller, which derives or keeps one.
+func validateSlug(validator *validation.Validator, slug string) {
+	if slug == "" {
+		return
+	}
+	validator.MaxLength("slug", slug, entities.MaxProjectSlugLength)
+	validator.Custom("slug", entities.IsProjectSlug(slug), "Slug must be lowercase letters and digits joined by single hyphens")
+	validator.Custom("slug", !entities.IsReservedProjectSlug(slug), "Slug cannot be only digits or a reserved word")
+}
+
 type CreateProjectRequest struct {
-	Title            string `json:"title" validate:"required,max=200"`
-	Description      string `json:"description,omitempty" validate:"omitempty,max=2000"`
-	ShortDescription string `json:"short_description,omitempty" validate:"omitempty,max=500"`
-	Technologies     string `json:"technologies,omitempty" validate:"omitempty,max=500"`
-	GithubURL        string `json:"github_url,omitempty" validate:"omitempty,url"`
-	ImageURL         string `json:"image_url,omitempty" validate:"omitempty,url"`
-	Status           string `json:"status" validate:"required,oneof=active inactive archived"`
+	Title            string         `json:"title" validate:"required,max=200"`
+	Slug             string         `json:"slug,omitempty" validate:"omitempty,max=100"`
+	Description      string         `json:"description,omitempty" validate:"omitempty,max=2000"`
+	ShortDescription string         `json:"short_description,omitempty" validate:"omitempty,max=500"`
+	Technologies     TechnologyRefs `json:"technologies,omitempty" validate:"omitempty,max=50" swaggertype:"array,string"`
+	GithubURL        string         `json:"github_url,omitempty" validate:"omitempty,url"`
+	ImageURL         string         `json:"image_url,omitempty" validate:"omitempty,url"`
+	Status           string         `json:"status" validate:"required,oneof=active inactive archived"`
+	Featured         bool           `json:"featured,omitempty"`
+	publishingDto.Publishing
 } // @name CreateProjectRequest
 
 func (r *CreateProjectRequest) Validate() error {
 	validator := validation.NewValidator()
 
 	validator.Required("title", r.Title).MaxLength("title", r.Title, 200)
+	validateSlug(validator, r.Slug)
 
 	if r.Description != "" {
 		validator.MaxLength("description", r.Description, 2000)
@@ -31,9 +133,7 @@ func (r *CreateProjectRequest) Validate() error {
 		validator.MaxLength("short_description", r.ShortDescription, 500)
 	}
 
-	if r.Technologies != "" {
-		validator.MaxLength("technologies", r.Technologies, 500)
-	}
+	r.Technologies.Validate(validator)
 
 	if r.GithubURL != "" && strings.TrimSpace(r.GithubURL) != "" {
 		validator.URL("github_url", r.GithubURL)
@@ -56,6 +156,8 @@ func (r *CreateProjectRequest) Validate() error {
 		validator.Custom("status", false, "Status must be one of: active, inactive, archived")
 	}
 
+	r.Publishing.Validate(validator)
+
 	if validator.HasErrors() {
 		return validator.FirstError()
 	}
@@ -64,6 +166,22 @@ func (r *CreateProjectRequest) Validate() error {
 	return nil
 }
 
+// PublishingEntity returns the publishing state of the new project. Without a
+// state or publish_at, an inactive project starts as a draft and an archived
+// one archived, as the migration mapped existing projects.
+func (r *CreateProjectRequest) PublishingEntity() entities.Publishing {
+	publishing := r.Publishing
+	if publishing.State == "" && publishing.PublishAt == nil {
+		switch r.Status {
+		case "inactive":
+			publishing.State = entities.PublishingStateDraft
+		case "archived":
+			publishing.State = entities.PublishingStateArchived
+		}
+	}
+	return publishing.ToEntity()
+}
+
 func (r *CreateProjectRequest) Sanitize() {
 	r.Title = strings.TrimSpace(r.Title)
 	r.Status = strings.TrimSpace(strings.ToLower(r.Status))
@@ -86,15 +204,6 @@ func (r *CreateProjectRequest) Sanitize() {
 		}
 	}
 
-	if r.Technologies != "" {
-		trimmed := strings.TrimSpace(r.Technologies)
-		if trimmed == "" {
-			r.Technologies = ""
-		} else {
-			r.Technologies = trimmed
-		}
-	}
-
 	if r.GithubURL != "" {
 		trimmed := strings.TrimSpace(r.GithubURL)
 		if trimmed == "" {
@@ -134,23 +243,27 @@ func (req *CreateBulkProjectsRequest) Validate() error {
 }
 
 type UpdateProjectRequest struct {
-	Title            string `json:"title" validate:"required,max=200"`
-	Description      string `json:"description,omitempty" validate:"omitempty,max=2000"`
-	ShortDescription string `json:"short_description,omitempty" validate:"omitempty,max=500"`
-	Technologies     string `json:"technologies,omitempty" validate:"omitempty,max=500"`
-	GithubURL        string `json:"github_url,omitempty" validate:"omitempty,url"`
-	ImageURL         string `json:"image_url,omitempty" validate:"omitempty,url"`
-	Status           string `json:"status" validate:"required,oneof=active inactive archived"`
+	Title            string         `json:"title" validate:"required,max=200"`
+	Slug             string         `json:"slug,omitempty" validate:"omitempty,max=100"`
+	Description      string         `json:"description,omitempty" validate:"omitempty,max=2000"`
+	ShortDescription string         `json:"short_description,omitempty" validate:"omitempty,max=500"`
+	Technologies     TechnologyRefs `json:"technologies,omitempty" validate:"omitempty,max=50" swaggertype:"array,string"`
+	GithubURL        string         `json:"github_url,omitempty" validate:"omitempty,url"`
+	ImageURL         string         `json:"image_url,omitempty" validate:"omitempty,url"`
+	Status           string         `json:"status" validate:"required,oneof=active inactive archived"`
+	Featured         bool           `json:"featured,omitempty"`
 }
 
 type PatchProjectRequest struct {
-	Title            string `json:"title,omitempty" validate:"omitempty,max=200"`
-	Description      string `json:"description,omitempty" validate:"omitempty,max=2000"`
-	ShortDescription string `json:"short_description,omitempty" validate:"omitempty,max=500"`
-	Technologies     string `json:"technologies,omitempty" validate:"omitempty,max=500"`
-	GithubURL        string `json:"github_url,omitempty" validate:"omitempty,url"`
-	ImageURL         string `json:"image_url,omitempty" validate:"omitempty,url"`
-	Status           string `json:"status,omitempty" validate:"omitempty,oneof=active inactive archived"`
+	Title            string         `json:"title,omitempty" validate:"omitempty,max=200"`
+	Slug             string         `json:"slug,omitempty" validate:"omitempty,max=100"`
+	Description      string         `json:"description,omitempty" validate:"omitempty,max=2000"`
+	ShortDescription string         `json:"short_description,omitempty" validate:"omitempty,max=500"`
+	Technologies     TechnologyRefs `json:"technologies,omitempty" validate:"omitempty,max=50" swaggertype:"array,string"`
+	GithubURL        string         `json:"github_url,omitempty" validate:"omitempty,url"`
+	ImageURL         string         `json:"image_url,omitempty" validate:"omitempty,url"`
+	Status           string         `json:"status,omitempty" validate:"omitempty,oneof=active inactive archived"`
+	Featured         *bool          `json:"featured,omitempty"`
 }
 
 func (r *UpdateProjectRequest) Validate() error {
@@ -158,6 +271,7 @@ func (r *UpdateProjectRequest) Validate() error {
 
 	validator.Required("title", r.Title).MaxLength("title", r.Title, 200)
 	validator.Required("status", r.Status)
+	validateSlug(validator, r.Slug)
 
 	if r.Description != "" {
 		validator.MaxLength("description", r.Description, 2000)
@@ -167,9 +281,7 @@ func (r *UpdateProjectRequest) Validate() error {
 		validator.MaxLength("short_description", r.ShortDescription, 500)
 	}
 
-	if r.Technologies != "" {
-		validator.MaxLength("technologies", r.Technologies, 500)
-	}
+	r.Technologies.Validate(validator)
 
 	if r.GithubURL != "" && strings.TrimSpace(r.GithubURL) != "" {
 		validator.URL("github_url", r.GithubURL)
@@ -205,6 +317,7 @@ func (r *PatchProjectRequest) Validate() error {
 	if r.Title != "" {
 		validator.Required("title", r.Title).MaxLength("title", r.Title, 200)
 	}
+	validateSlug(validator, r.Slug)
 
 	if r.Description != "" {
 		validator.MaxLength("description", r.Description, 2000)
@@ -214,9 +327,7 @@ func (r *PatchProjectRequest) Validate() error {
 		validator.MaxLength("short_description", r.ShortDescription, 500)
 	}
 
-	if r.Technologies != "" {
-		validator.MaxLength("technologies", r.Technologies, 500)
-	}
+	r.Technologies.Validate(validator)
 
 	if r.GithubURL != "" && strings.TrimSpace(r.GithubURL) != "" {
 		validator.URL("github_url", r.GithubURL)
@@ -270,15 +381,6 @@ func (r *UpdateProjectRequest) Sanitize() {
 		}
 	}
 
-	if r.Technologies != "" {
-		trimmed := strings.TrimSpace(r.Technologies)
-		if trimmed == "" {
-			r.Technologies = ""
-		} else {
-			r.Technologies = trimmed
-		}
-	}
-
 	if r.GithubURL != "" {
 		trimmed := strings.TrimSpace(r.GithubURL)
 		if trimmed == "" {
@@ -308,9 +410,6 @@ func (r *PatchProjectRequest) Sanitize() {
 	if r.ShortDescription != "" {
 		r.ShortDescription = strings.TrimSpace(r.ShortDescription)
 	}
-	if r.Technologies != "" {
-		r.Technologies = strings.TrimSpace(r.Technologies)
-	}
 	if r.GithubURL != "" {
 		r.GithubURL = strings.TrimSpace(r.GithubURL)
 	}
@@ -329,12 +428,12 @@ func (r *CreateProjectRequest) ToEntity(userID int) (*entities.Project, error) {
 		Title:            strings.TrimSpace(r.Title),
 		Description:      r.Description,
 		ShortDescription: r.ShortDescription,
-		Technologies:     r.Technologies,
 		GithubURL:        r.GithubURL,
 		ImageURL:         r.ImageURL,
 		Status:           r.Status,
 		CreatedAt:        now,
 		UpdatedAt:        now,
+		Publishing:       r.PublishingEntity(),
 	}, nil
 }
 
@@ -353,9 +452,6 @@ func (r *UpdateProjectRequest) ToEntity(id, userID int) (*entities.Project, erro
 	if r.ShortDescription != "" {
 		project.ShortDescription = r.ShortDescription
 	}
-	if r.Technologies != "" {
-		project.Technologies = r.Technologies
-	}
 	if r.GithubURL != "" {
 		project.GithubURL = r.GithubURL
 	}
@@ -382,9 +478,6 @@ func (r *PatchProjectRequest) ToEntity(id, userID int) (*entities.Project, error
 	if r.ShortDescription != "" {
 		project.ShortDescription = r.ShortDescription
 	}
-	if r.Technologies != "" {
-		project.Technologies = r.Technologies
-	}
 	if r.GithubURL != "" {
 		project.GithubURL = r.GithubURL
 	}
@@ -2,25 +2,39 @@ package dto
 
 import (
 	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
 	"portfolio/shared"
 	"time"
 )
 
 // @Description Project represents a project entry in the portfolio
 type Project struct {
-	ID               int       `json:"id"`
-	UserID           int       `json:"user_id"`
-	Title            string    `json:"title"`
-	Description      string    `json:"description"`
-	ShortDescription string    `json:"short_description"`
-	Technologies     string    `json:"technologies"`
-	GithubURL        string    `json:"github_url"`
-	ImageURL         string    `json:"image_url"`
-	Status           string    `json:"status"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	ID               int                  `json:"id"`
+	UserID           int                  `json:"user_id"`
+	Title            string               `json:"title"`
+	Slug             string               `json:"slug"`
+	Description      string               `json:"description"`
+	ShortDescription string               `json:"short_description"`
+	Technologies     []*ProjectTechnology `json:"technologies"`
+	Media            []*ProjectMedia      `json:"media"`
+	Links            []*ProjectLink       `json:"links"`
+	GithubURL        string               `json:"github_url"`
+	ImageURL         string               `json:"image_url"`
+	Status           string               `json:"status"`
+	Featured         bool                 `json:"featured"`
+	CreatedAt        time.Time            `json:"created_at"`
+	UpdatedAt        time.Time            `json:"updated_at"`
+	Position         int                  `json:"position"`
+	publishingDto.Publishing
 } // @name Project
 
+// @Description ProjectTechnology is a technology linked to a project
+type ProjectTechnology struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	IconURL string `json:"icon_url"`
+} // @name ProjectTechnology
+
 // @Description Response for a list of projects
 type ProjectListResponse struct {
 	Projects []*Project         `json:"projects"`
@@ -44,14 +58,20 @@ func FromProjectEntityToResponse(project *entities.Project, meta *shared.Meta) *
 			ID:               project.ProjectID,
 			UserID:           project.UserID,
 			Title:            project.Title,
+			Slug:             project.Slug,
 			Description:      project.Description,
 			ShortDescription: project.ShortDescription,
-			Technologies:     project.Technologies,
+			Technologies:     fromTechnologyEntities(project.LinkedTechnologies),
+			Media:            fromProjectMediaEntities(project.Media),
+			Links:            fromProjectLinkEntities(project.Links),
 			GithubURL:        project.GithubURL,
 			ImageURL:         project.ImageURL,
 			Status:           project.Status,
+			Featured:         project.Featured,
 			CreatedAt:        project.CreatedAt,
 			UpdatedAt:        project.UpdatedAt,
+			Position:         project.Position,
+			Publishing:       publishingDto.FromPublishingEntity(project.Publishing),
 		},
 		Meta: meta,
 	}
@@ -62,20 +82,26 @@ func FromProjectsEntityToResponse(projects []*entities.Project, meta *shared.Met
 		return nil
 	}
 
-	var projectResponses []*Project
+	projectResponses := make([]*Project, 0, len(projects))
 	for _, project := range projects {
 		_project := &Project{
 			ID:               project.ProjectID,
 			UserID:           project.UserID,
 			Title:            project.Title,
+			Slug:             project.Slug,
 			Description:      project.Description,
 			ShortDescription: project.ShortDescription,
-			Technologies:     project.Technologies,
+			Technologies:     fromTechnologyEntities(project.LinkedTechnologies),
+			Media:            fromProjectMediaEntities(project.Media),
+			Links:            fromProjectLinkEntities(project.Links),
 			GithubURL:        project.GithubURL,
 			ImageURL:         project.ImageURL,
 			Status:           project.Status,
+			Featured:         project.Featured,
 			CreatedAt:        project.CreatedAt,
 			UpdatedAt:        project.UpdatedAt,
+			Position:         project.Position,
+			Publishing:       publishingDto.FromPublishingEntity(project.Publishing),
 		}
 
 		projectResponses = append(projectResponses, _project)
@@ -98,14 +124,20 @@ func FromProjectsEntityForBulkToResponse(projects []*entities.Project, meta *sha
 			ID:               project.ProjectID,
 			UserID:           project.UserID,
 			Title:            project.Title,
+			Slug:             project.Slug,
 			Description:      project.Description,
 			ShortDescription: project.ShortDescription,
-			Technologies:     project.Technologies,
+			Technologies:     fromTechnologyEntities(project.LinkedTechnologies),
+			Media:            fromProjectMediaEntities(project.Media),
+			Links:            fromProjectLinkEntities(project.Links),
 			GithubURL:        project.GithubURL,
 			ImageURL:         project.ImageURL,
 			Status:           project.Status,
+			Featured:         project.Featured,
 			CreatedAt:        project.CreatedAt,
 			UpdatedAt:        project.UpdatedAt,
+			Position:         project.Position,
+			Publishing:       publishingDto.FromPublishingEntity(project.Publishing),
 		})
 	}
 
@@ -114,3 +146,15 @@ func FromProjectsEntityForBulkToResponse(projects []*entities.Project, meta *sha
 		Meta:     meta,
 	}
 }
+
+func fromTechnologyEntities(technologies []*entities.Technology) []*ProjectTechnology {
+	responses := make([]*ProjectTechnology, 0, len(technologies))
+	for _, technology := range technologies {
+		responses = append(responses, &ProjectTechnology{
+			ID:      technology.TechnologyID,
+			Name:    technology.Name,
+			IconURL: technology.IconURL,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,259 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Export formats of the publication list.
+const (
+	ExportFormatBibTeX  = "bibtex"
+	ExportFormatCSLJSON = "csl-json"
+)
+
+var ExportFormats = []string{ExportFormatBibTeX, ExportFormatCSLJSON}
+
+// bibTeXEntryTypes maps the publication types to BibTeX entry types. Talks,
+// podcasts and software have no standard BibTeX type, so they are @misc.
+var bibTeXEntryTypes = map[string]string{
+	entities.PublicationTypeArticle:    "article",
+	entities.PublicationTypePaper:      "inproceedings",
+	entities.PublicationTypeTalk:       "misc",
+	entities.PublicationTypePodcast:    "misc",
+	entities.PublicationTypeOpenSource: "misc",
+}
+
+// bibTeXVenueFields names the BibTeX field of the venue of each entry type.
+var bibTeXVenueFields = map[string]string{
+	"article":       "journal",
+	"inproceedings": "booktitle",
+	"misc":          "howpublished",
+}
+
+// cslTypes maps the publication types to CSL item types.
+var cslTypes = map[string]string{
+	entities.PublicationTypeArticle:    "article",
+	entities.PublicationTypePaper:      "paper-conference",
+	entities.PublicationTypeTalk:       "speech",
+	entities.PublicationTypePodcast:    "broadcast",
+	entities.PublicationTypeOpenSource: "software",
+}
+
+var bibTeXMonths = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+// CSLName is a CSL-JSON name: a family and a given name, or a literal for
+// names that cannot be split.
+type CSLName struct {
+	Family  string `json:"family,omitempty"`
+	Given   string `json:"given,omitempty"`
+	Literal string `json:"literal,omitempty"`
+}
+
+// CSLDate is a CSL-JSON date as [[year, month, day]].
+type CSLDate struct {
+	DateParts [][]int `json:"date-parts"`
+}
+
+// @Description CSLItem is one publication in CSL-JSON, the citation format
+// @Description read by Zotero, Pandoc and citeproc.
+type CSLItem struct {
+	ID             string     `json:"id"`
+	Type           string     `json:"type"`
+	Title          string     `json:"title"`
+	Author         []*CSLName `json:"author,omitempty"`
+	Issued         CSLDate    `json:"issued"`
+	ContainerTitle string     `json:"container-title,omitempty"`
+	EventTitle     string     `json:"event-title,omitempty"`
+	URL            string     `json:"URL,omitempty"`
+	Abstract       string     `json:"abstract,omitempty"`
+	Note           string     `json:"note,omitempty"`
+} // @name CSLItem
+
+// ToBibTeX renders publications as BibTeX entries. author, the portfolio
+// owner, comes first in every author list; it may be empty.
+func ToBibTeX(publications []*entities.Publication, author string) string {
+	keys := citationKeys(publications, author)
+
+	var builder strings.Builder
+	for i, publication := range publications {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+
+		entryType := bibTeXEntryTypes[publication.Type]
+		builder.WriteString("@" + entryType + "{" + keys[i] + ",\n")
+		writeField := func(name, value string) {
+			if value != "" {
+				builder.WriteString("  " + name + " = {" + value + "},\n")
+			}
+		}
+
+		writeField("title", escapeBibTeX(publication.Title))
+		names := make([]string, 0, len(publication.CoAuthors)+1)
+		for _, name := range authors(publication, author) {
+			family, given := splitName(name)
+			if given == "" {
+				names = append(names, "{"+escapeBibTeX(family)+"}")
+				continue
+			}
+			names = append(names, escapeBibTeX(family)+", "+escapeBibTeX(given))
+		}
+		writeField("author", strings.Join(names, " and "))
+		writeField(bibTeXVenueFields[entryType], escapeBibTeX(publication.Venue))
+		writeField("year", strconv.Itoa(publication.Date.Year()))
+		builder.WriteString("  month = " + bibTeXMonths[publication.Date.Month()-1] + ",\n")
+		writeField("url", bibTeXURL(publication.URL))
+		writeField("abstract", escapeBibTeX(publication.Description))
+		writeField("note", links(publication, bibTeXURL))
+		builder.WriteString("}\n")
+	}
+	return builder.String()
+}
+
+// ToCSLJSON converts publications to CSL-JSON items, with author first like
+// ToBibTeX. The item IDs are the BibTeX citation keys.
+func ToCSLJSON(publications []*entities.Publication, author string) []*CSLItem {
+	keys := citationKeys(publications, author)
+
+	items := make([]*CSLItem, 0, len(publications))
+	for i, publication := range publications {
+		item := &CSLItem{
+			ID:    keys[i],
+			Type:  cslTypes[publication.Type],
+			Title: publication.Title,
+			Issued: CSLDate{DateParts: [][]int{{
+				publication.Date.Year(), int(publication.Date.Month()), publication.Date.Day(),
+			}}},
+			URL:      publication.URL,
+			Abstract: publication.Description,
+			Note:     links(publication, func(url string) string { return url }),
+		}
+		if publication.Type == entities.PublicationTypeTalk {
+			item.EventTitle = publication.Venue
+		} else {
+			item.ContainerTitle = publication.Venue
+		}
+		for _, name := range authors(publication, author) {
+			family, given := splitName(name)
+			if given == "" {
+				item.Author = append(item.Author, &CSLName{Literal: family})
+				continue
+			}
+			item.Author = append(item.Author, &CSLName{Family: family, Given: given})
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// authors lists the owner, if known, then the co-authors.
+func authors(publication *entities.Publication, author string) []string {
+	names := make([]string, 0, len(publication.CoAuthors)+1)
+	if author != "" {
+		names = append(names, author)
+	}
+	return append(names, publication.CoAuthors...)
+}
+
+// links gathers the slides and video links, which neither format has a
+// field for, each URL written by formatURL.
+func links(publication *entities.Publication, formatURL func(string) string) string {
+	var parts []string
+	if publication.SlidesURL != "" {
+		parts = append(parts, "Slides: "+formatURL(publication.SlidesURL))
+	}
+	if publication.VideoURL != "" {
+		parts = append(parts, "Video: "+formatURL(publication.VideoURL))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// splitName splits "Given Family" or "Family, Given" into its family and
+// given names. A single word is a family name with no given name.
+func splitName(name string) (string, string) {
+	if family, given, ok := strings.Cut(name, ","); ok {
+		return strings.TrimSpace(family), strings.TrimSpace(given)
+	}
+	name = strings.TrimSpace(name)
+	if i := strings.LastIndex(name, " "); i >= 0 {
+		return name[i+1:], strings.TrimSpace(name[:i])
+	}
+	return name, ""
+}
+
+// citationKeys builds keys in the usual family-year-word form, like
+// doe2024zero, telling equal ones apart with a letter suffix.
+func citationKeys(publications []*entities.Publication, author string) []string {
+	family := "anonymous"
+	if author != "" {
+		family, _ = splitName(author)
+	}
+
+	keys := make([]string, 0, len(publications))
+	seen := make(map[string]int)
+	for _, publication := range publications {
+		word := ""
+		for _, field := range strings.Fields(publication.Title) {
+			if word = keyPart(field); len(word) > 3 {
+				break
+			}
+		}
+		key := keyPart(family) + strconv.Itoa(publication.Date.Year()) + word
+		if count := seen[key]; count > 0 {
+			seen[key]++
+			key += string(rune('a' + (count-1)%26))
+		} else {
+			seen[key] = 1
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// keyPart keeps the lowercase ASCII letters and digits of value.
+func keyPart(value string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+var bibTeXEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	"{", `\{`,
+	"}", `\}`,
+	"&", `\&`,
+	"%", `\%`,
+	"$", `\$`,
+	"#", `\#`,
+	"_", `\_`,
+	"~", `\textasciitilde{}`,
+	"^", `\textasciicircum{}`,
+)
+
+// escapeBibTeX escapes the characters that LaTeX treats specially.
+func escapeBibTeX(value string) string {
+	return bibTeXEscaper.Replace(value)
+}
+
+// bibTeXURLEscaper percent-encodes the only characters \url cannot take
+// as they are: the braces that delimit its argument and the backslash.
+var bibTeXURLEscaper = strings.NewReplacer(
+	`\`, "%5C",
+	"{", "%7B",
+	"}", "%7D",
+)
+
+// bibTeXURL wraps url in \url, which typesets it verbatim, so that
+// underscores, percent signs and the like need no escaping.
+func bibTeXURL(url string) string {
+	if url == "" {
+		return ""
+	}
+	return `\url{` + bibTeXURLEscaper.Replace(url) + "}"
+}
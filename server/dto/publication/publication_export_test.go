@@ -0,0 +1,71 @@
+package dto_test
+
+import (
+	"portfolio/domain/entities"
+	dto "portfolio/dto/publication"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToBibTeXWrapsURLs(t *testing.T) {
+	publication := &entities.Publication{
+		Title:     "Tuning 100% of the GC_ratio",
+		Type:      entities.PublicationTypeTalk,
+		Venue:     "GopherCon",
+		Date:      time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC),
+		URL:       "https://example.com/talks/gc_ratio?share=100%25#notes",
+		SlidesURL: "https://example.com/slides/gc_ratio.pdf",
+		VideoURL:  "https://example.com/watch?v=a_b&t={1}",
+	}
+
+	bibTeX := dto.ToBibTeX([]*entities.Publication{publication}, "Jane Doe")
+
+	for _, want := range []string{
+		`title = {Tuning 100\% of the GC\_ratio},`,
+		`url = {\url{https://example.com/talks/gc_ratio?share=100%25#notes}},`,
+		`note = {Slides: \url{https://example.com/slides/gc_ratio.pdf}; Video: \url{https://example.com/watch?v=a_b&t=%7B1%7D}},`,
+	} {
+		if !strings.Contains(bibTeX, want) {
+			t.Errorf("BibTeX lacks %s:\n%s", want, bibTeX)
+		}
+	}
+
+	items := dto.ToCSLJSON([]*entities.Publication{publication}, "Jane Doe")
+	if items[0].URL != publication.URL || !strings.Contains(items[0].Note, publication.VideoURL) {
+		t.Errorf("CSL-JSON URLs changed: %q, %q", items[0].URL, items[0].Note)
+	}
+}
+
+func TestToBibTeXEscapesText(t *testing.T) {
+	publication := &entities.Publication{
+		Title:       `C++ & Go: 50% faster_{x}~^ $ #1 \o/`,
+		Type:        entities.PublicationTypeArticle,
+		Venue:       "R&D Journal",
+		Date:        time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC),
+		Description: `Uses \n and {braces}`,
+		CoAuthors:   []string{"O'Brien_Smith, Pat", "Prince", "Ana {Maria} López"},
+	}
+
+	bibTeX := dto.ToBibTeX([]*entities.Publication{publication, publication}, "Jane Doe")
+
+	for _, want := range []string{
+		"@article{doe2025fasterx,\n",
+		"@article{doe2025fasterxa,\n",
+		`title = {C++ \& Go: 50\% faster\_\{x\}\textasciitilde{}\textasciicircum{} \$ \#1 \textbackslash{}o/},`,
+		`author = {Doe, Jane and O'Brien\_Smith, Pat and {Prince} and López, Ana \{Maria\}},`,
+		`journal = {R\&D Journal},`,
+		`abstract = {Uses \textbackslash{}n and \{braces\}},`,
+		"month = mar,",
+	} {
+		if !strings.Contains(bibTeX, want) {
+			t.Errorf("BibTeX lacks %s:\n%s", want, bibTeX)
+		}
+	}
+
+	// CSL-JSON is JSON, so its text is left as it was written.
+	items := dto.ToCSLJSON([]*entities.Publication{publication}, "Jane Doe")
+	if items[0].Title != publication.Title || items[0].ContainerTitle != publication.Venue {
+		t.Errorf("CSL-JSON text changed: %q, %q", items[0].Title, items[0].ContainerTitle)
+	}
+}
@@ -0,0 +1,270 @@
+package dto
+
+import (
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	publishingDto "portfolio/dto/publishing"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxBulkPublications bounds the publications of one bulk request.
+const maxBulkPublications = 50
+
+// PublicationFields holds the fields that create and update requests share.
+// The date is YYYY-MM-DD and may be in the future, for an upcoming talk. The
+// portfolio owner is the first author, so co_authors lists the others only.
+type PublicationFields struct {
+	Title       string   `json:"title" validate:"required,max=200" example:"Zero-downtime schema migrations"`
+	Type        string   `json:"type" validate:"required,oneof=article talk paper podcast open_source" example:"talk"`
+	Venue       string   `json:"venue,omitempty" validate:"omitempty,max=150" example:"GopherCon EU"`
+	Date        string   `json:"date" validate:"required" example:"2024-06-18"`
+	URL         string   `json:"url,omitempty" validate:"omitempty,url" example:"https://example.com/talks/zero-downtime"`
+	SlidesURL   string   `json:"slides_url,omitempty" validate:"omitempty,url" example:"https://example.com/slides/zero-downtime.pdf"`
+	VideoURL    string   `json:"video_url,omitempty" validate:"omitempty,url" example:"https://example.com/videos/zero-downtime"`
+	Description string   `json:"description,omitempty" validate:"omitempty,max=2000"`
+	CoAuthors   []string `json:"co_authors,omitempty" validate:"omitempty,max=20" example:"Ada Lovelace"`
+}
+
+// @Description Request to create a publication, a talk or an open-source
+// @Description contribution
+type CreatePublicationRequest struct {
+	PublicationFields
+	publishingDto.Publishing
+} // @name CreatePublicationRequest
+
+// @Description Request to create multiple publications in bulk
+type CreateBulkPublicationsRequest struct {
+	Publications []CreatePublicationRequest `json:"publications" validate:"required"`
+} // @name CreateBulkPublicationsRequest
+
+// @Description Request to update an existing publication
+type UpdatePublicationRequest struct {
+	PublicationFields
+} // @name UpdatePublicationRequest
+
+// @Description Request to patch an existing publication. An empty venue,
+// @Description url, slides_url, video_url or description clears it; an
+// @Description empty co_authors list removes the co-authors.
+type PatchPublicationRequest struct {
+	Title       *string  `json:"title,omitempty" validate:"omitempty,max=200"`
+	Type        *string  `json:"type,omitempty" validate:"omitempty,oneof=article talk paper podcast open_source"`
+	Venue       *string  `json:"venue,omitempty" validate:"omitempty,max=150"`
+	Date        *string  `json:"date,omitempty" example:"2024-06-18"`
+	URL         *string  `json:"url,omitempty" validate:"omitempty,url"`
+	SlidesURL   *string  `json:"slides_url,omitempty" validate:"omitempty,url"`
+	VideoURL    *string  `json:"video_url,omitempty" validate:"omitempty,url"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=2000"`
+	CoAuthors   []string `json:"co_authors,omitempty" validate:"omitempty,max=20"`
+} // @name PatchPublicationRequest
+
+// Validate adds the errors of the fields to validator.
+func (f *PublicationFields) Validate(validator *validation.Validator) {
+	validator.Required("title", f.Title)
+	validator.MaxLength("title", f.Title, 200)
+	validator.Required("type", f.Type)
+	if f.Type != "" {
+		validatePublicationType(validator, f.Type)
+	}
+	validator.MaxLength("venue", f.Venue, 150)
+	validator.Required("date", f.Date)
+	validatePublicationDate(validator, f.Date)
+	validator.URL("url", strings.TrimSpace(f.URL))
+	validator.URL("slides_url", strings.TrimSpace(f.SlidesURL))
+	validator.URL("video_url", strings.TrimSpace(f.VideoURL))
+	validator.MaxLength("description", f.Description, 2000)
+	validateCoAuthors(validator, f.CoAuthors)
+}
+
+func (req *CreatePublicationRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.PublicationFields.Validate(validator)
+	req.Publishing.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *CreatePublicationRequest) ToEntity(userID int) (*entities.Publication, error) {
+	publication := &entities.Publication{
+		UserID:     userID,
+		Publishing: req.Publishing.ToEntity(),
+	}
+	req.PublicationFields.apply(publication)
+	return publication, nil
+}
+
+func (req *CreateBulkPublicationsRequest) Validate() error {
+	if len(req.Publications) == 0 {
+		return domain.NewValidationError("At least one publication is required", "publications", nil)
+	}
+
+	if len(req.Publications) > maxBulkPublications {
+		return domain.NewValidationError("Cannot create more than "+strconv.Itoa(maxBulkPublications)+" publications at once", "publications", nil)
+	}
+
+	for i, publication := range req.Publications {
+		if err := publication.Validate(); err != nil {
+			return domain.NewValidationError("Publication "+strconv.Itoa(i+1)+": "+err.Error(), "publications", &err)
+		}
+	}
+
+	return nil
+}
+
+func (req *CreateBulkPublicationsRequest) ToEntities(userID int) ([]*entities.Publication, error) {
+	publications := make([]*entities.Publication, 0, len(req.Publications))
+	for _, publicationReq := range req.Publications {
+		publication, err := publicationReq.ToEntity(userID)
+		if err != nil {
+			return nil, err
+		}
+		publications = append(publications, publication)
+	}
+	return publications, nil
+}
+
+func (req *UpdatePublicationRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.PublicationFields.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *UpdatePublicationRequest) ToEntity(id, userID int) (*entities.Publication, error) {
+	publication := &entities.Publication{
+		PublicationID: id,
+		UserID:        userID,
+	}
+	req.PublicationFields.apply(publication)
+	return publication, nil
+}
+
+func (req *PatchPublicationRequest) Validate() error {
+	validator := validation.NewValidator()
+	if req.Title != nil {
+		validator.Required("title", *req.Title)
+		validator.MaxLength("title", *req.Title, 200)
+	}
+	if req.Type != nil {
+		validatePublicationType(validator, *req.Type)
+	}
+	if req.Venue != nil {
+		validator.MaxLength("venue", *req.Venue, 150)
+	}
+	if req.Date != nil {
+		validator.Required("date", *req.Date)
+		validatePublicationDate(validator, *req.Date)
+	}
+	if req.URL != nil {
+		validator.URL("url", strings.TrimSpace(*req.URL))
+	}
+	if req.SlidesURL != nil {
+		validator.URL("slides_url", strings.TrimSpace(*req.SlidesURL))
+	}
+	if req.VideoURL != nil {
+		validator.URL("video_url", strings.TrimSpace(*req.VideoURL))
+	}
+	if req.Description != nil {
+		validator.MaxLength("description", *req.Description, 2000)
+	}
+	validateCoAuthors(validator, req.CoAuthors)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// ApplyTo sets the fields present in the request on publication. Absent
+// co-authors keep the stored ones.
+func (req *PatchPublicationRequest) ApplyTo(publication *entities.Publication) {
+	if req.Title != nil {
+		publication.Title = strings.TrimSpace(*req.Title)
+	}
+	if req.Type != nil {
+		publication.Type = *req.Type
+	}
+	if req.Venue != nil {
+		publication.Venue = strings.TrimSpace(*req.Venue)
+	}
+	if req.Date != nil {
+		publication.Date = parsePublicationDate(*req.Date)
+	}
+	if req.URL != nil {
+		publication.URL = strings.TrimSpace(*req.URL)
+	}
+	if req.SlidesURL != nil {
+		publication.SlidesURL = strings.TrimSpace(*req.SlidesURL)
+	}
+	if req.VideoURL != nil {
+		publication.VideoURL = strings.TrimSpace(*req.VideoURL)
+	}
+	if req.Description != nil {
+		publication.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.CoAuthors != nil {
+		publication.CoAuthors = trimCoAuthors(req.CoAuthors)
+	}
+}
+
+// apply sets the fields on publication. Missing co-authors are set empty
+// rather than nil, so that an update clears the earlier ones.
+func (f *PublicationFields) apply(publication *entities.Publication) {
+	publication.Title = strings.TrimSpace(f.Title)
+	publication.Type = f.Type
+	publication.Venue = strings.TrimSpace(f.Venue)
+	publication.Date = parsePublicationDate(f.Date)
+	publication.URL = strings.TrimSpace(f.URL)
+	publication.SlidesURL = strings.TrimSpace(f.SlidesURL)
+	publication.VideoURL = strings.TrimSpace(f.VideoURL)
+	publication.Description = strings.TrimSpace(f.Description)
+	publication.CoAuthors = trimCoAuthors(append([]string{}, f.CoAuthors...))
+}
+
+func validatePublicationType(validator *validation.Validator, publicationType string) {
+	validator.Custom("type", slices.Contains(entities.PublicationTypes, publicationType),
+		"Type must be one of: "+strings.Join(entities.PublicationTypes, ", "))
+}
+
+// validatePublicationDate checks a YYYY-MM-DD date, if any.
+func validatePublicationDate(validator *validation.Validator, value string) {
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	if _, err := time.Parse(time.DateOnly, strings.TrimSpace(value)); err != nil {
+		validator.Custom("date", false, "Date must be in YYYY-MM-DD format")
+	}
+}
+
+func validateCoAuthors(validator *validation.Validator, coAuthors []string) {
+	validator.Custom("co_authors", len(coAuthors) <= entities.MaxPublicationCoAuthors,
+		"A publication can have at most "+strconv.Itoa(entities.MaxPublicationCoAuthors)+" co-authors")
+	for _, coAuthor := range coAuthors {
+		if strings.TrimSpace(coAuthor) == "" {
+			validator.Custom("co_authors", false, "Co-authors cannot be empty")
+			return
+		}
+		validator.MaxLength("co_authors", coAuthor, entities.MaxPublicationCoAuthorLength)
+	}
+}
+
+// parsePublicationDate parses a date checked by validatePublicationDate.
+func parsePublicationDate(value string) time.Time {
+	date, _ := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	return date
+}
+
+// trimCoAuthors trims the co-authors checked by validateCoAuthors.
+func trimCoAuthors(coAuthors []string) []string {
+	trimmed := make([]string, 0, len(coAuthors))
+	for _, coAuthor := range coAuthors {
+		trimmed = append(trimmed, strings.TrimSpace(coAuthor))
+	}
+	return trimmed
+}
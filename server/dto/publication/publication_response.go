@@ -0,0 +1,99 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description Publication represents an article, a talk, a paper, a podcast
+// @Description episode or an open-source contribution in the portfolio.
+type Publication struct {
+	ID          int      `json:"id"`
+	Title       string   `json:"title"`
+	Type        string   `json:"type" enums:"article,talk,paper,podcast,open_source"`
+	Venue       string   `json:"venue"`
+	Date        string   `json:"date" example:"2024-06-18"`
+	URL         string   `json:"url"`
+	SlidesURL   string   `json:"slides_url"`
+	VideoURL    string   `json:"video_url"`
+	Description string   `json:"description"`
+	CoAuthors   []string `json:"co_authors"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+	Position    int      `json:"position"`
+	publishingDto.Publishing
+} // @name Publication
+
+// @Description Response for a list of publications
+type PublicationListResponse struct {
+	Publications []*Publication     `json:"publications"`
+	Meta         *shared.Meta       `json:"meta"`
+	Errors       []*shared.APIError `json:"errors,omitempty"`
+} // @name PublicationListResponse
+
+// @Description Response for a publication
+type PublicationResponse struct {
+	Publication *Publication `json:"publication"`
+	Meta        *shared.Meta `json:"meta"`
+} // @name PublicationResponse
+
+func FromPublicationEntityToResponse(publication *entities.Publication, meta *shared.Meta) *PublicationResponse {
+	if publication == nil {
+		return nil
+	}
+
+	return &PublicationResponse{
+		Publication: fromPublicationEntity(publication),
+		Meta:        meta,
+	}
+}
+
+func FromPublicationsEntityToResponse(publications []*entities.Publication, meta *shared.Meta) *PublicationListResponse {
+	if publications == nil {
+		return nil
+	}
+
+	publicationResponses := make([]*Publication, 0, len(publications))
+	for _, publication := range publications {
+		publicationResponses = append(publicationResponses, fromPublicationEntity(publication))
+	}
+
+	return &PublicationListResponse{
+		Publications: publicationResponses,
+		Meta:         meta,
+	}
+}
+
+func FromPublicationsEntityForBulkToResponse(publications []*entities.Publication, meta *shared.Meta) *PublicationListResponse {
+	response := FromPublicationsEntityToResponse(publications, meta)
+	if response == nil {
+		return &PublicationListResponse{Publications: []*Publication{}, Meta: meta}
+	}
+	return response
+}
+
+func fromPublicationEntity(publication *entities.Publication) *Publication {
+	coAuthors := publication.CoAuthors
+	if coAuthors == nil {
+		coAuthors = []string{}
+	}
+
+	return &Publication{
+		ID:          publication.PublicationID,
+		Title:       publication.Title,
+		Type:        publication.Type,
+		Venue:       publication.Venue,
+		Date:        publication.Date.Format(time.DateOnly),
+		URL:         publication.URL,
+		SlidesURL:   publication.SlidesURL,
+		VideoURL:    publication.VideoURL,
+		Description: publication.Description,
+		CoAuthors:   coAuthors,
+		CreatedAt:   publication.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   publication.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Position:    publication.Position,
+		Publishing:  publishingDto.FromPublishingEntity(publication.Publishing),
+	}
+}
@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	"strings"
+	"time"
+)
+
+// Publishing holds the publishing fields that content requests and
+// responses share. In a create request every field is optional: content
+// without a state is published, or scheduled when publish_at is in the
+// future.
+type Publishing struct {
+	State       string     `json:"state,omitempty" validate:"omitempty,oneof=draft scheduled published archived"`
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty"`
+}
+
+// Validate adds the errors of the publishing fields to validator.
+func (p *Publishing) Validate(validator *validation.Validator) {
+	if p.State != "" {
+		validator.Custom("state", entities.IsPublishingState(p.State),
+			"State must be one of: "+strings.Join(entities.PublishingStates, ", "))
+	}
+	if p.State == entities.PublishingStateScheduled {
+		validator.Custom("publish_at", p.PublishAt != nil, "Scheduled content needs a publish_at")
+	}
+	if p.PublishAt != nil && p.UnpublishAt != nil {
+		validator.Custom("unpublish_at", p.UnpublishAt.After(*p.PublishAt), "unpublish_at must be after publish_at")
+	}
+}
+
+// Check validates the publishing fields on their own, for requests that
+// are not checked with a validation.Validator.
+func (p *Publishing) Check() error {
+	validator := validation.NewValidator()
+	p.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// ToEntity returns the publishing state, with a default state, and its times in
+// UTC so that the scheduler can compare them with stored ones.
+func (p *Publishing) ToEntity() entities.Publishing {
+	publishing := entities.Publishing{State: p.State}
+	if p.PublishAt != nil {
+		publishAt := p.PublishAt.UTC()
+		publishing.PublishAt = &publishAt
+	}
+	if p.UnpublishAt != nil {
+		unpublishAt := p.UnpublishAt.UTC()
+		publishing.UnpublishAt = &unpublishAt
+	}
+	publishing.SetDefaultState(time.Now())
+	return publishing
+}
+
+func FromPublishingEntity(publishing entities.Publishing) Publishing {
+	return Publishing{
+		State:       publishing.State,
+		PublishAt:   publishing.PublishAt,
+		UnpublishAt: publishing.UnpublishAt,
+	}
+}
+
+// @Description Request to set the publishing state of a piece of content. The
+// @Description scheduler publishes scheduled content at publish_at and
+// @Description archives published content at unpublish_at.
+type PublishingRequest struct {
+	State       string     `json:"state" validate:"required,oneof=draft scheduled published archived"`
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty"`
+} // @name PublishingRequest
+
+func (req *PublishingRequest) Validate() error {
+	validator := validation.NewValidator()
+
+	validator.Required("state", req.State)
+	publishing := req.fields()
+	publishing.Validate(validator)
+
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *PublishingRequest) ToEntity() entities.Publishing {
+	publishing := req.fields()
+	return publishing.ToEntity()
+}
+
+func (req *PublishingRequest) fields() Publishing {
+	return Publishing{State: req.State, PublishAt: req.PublishAt, UnpublishAt: req.UnpublishAt}
+}
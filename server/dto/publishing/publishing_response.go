@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description PublishingItem is the publishing state of one piece of content
+type PublishingItem struct {
+	Type        string     `json:"type"`
+	ID          int        `json:"id"`
+	UserID      int        `json:"user_id"`
+	Label       string     `json:"label"`
+	State       string     `json:"state"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+} // @name PublishingItem
+
+// @Description Response for the publishing state of every piece of content
+type PublishingListResponse struct {
+	Items []*PublishingItem `json:"items"`
+	Meta  *shared.Meta      `json:"meta"`
+} //@name PublishingListResponse
+
+// @Description Response for the publishing state of a piece of content
+type PublishingItemResponse struct {
+	Item *PublishingItem `json:"item"`
+	Meta *shared.Meta    `json:"meta"`
+} //@name PublishingItemResponse
+
+func fromPublishingItemEntity(item *entities.PublishingItem) *PublishingItem {
+	return &PublishingItem{
+		Type:        item.Type,
+		ID:          item.ID,
+		UserID:      item.UserID,
+		Label:       item.Label,
+		State:       item.State,
+		PublishAt:   item.PublishAt,
+		UnpublishAt: item.UnpublishAt,
+	}
+}
+
+func FromPublishingItemsEntityToResponse(items []*entities.PublishingItem, meta *shared.Meta) *PublishingListResponse {
+	itemResponses := make([]*PublishingItem, 0, len(items))
+	for _, item := range items {
+		itemResponses = append(itemResponses, fromPublishingItemEntity(item))
+	}
+
+	return &PublishingListResponse{
+		Items: itemResponses,
+		Meta:  meta,
+	}
+}
+
+func FromPublishingItemEntityToResponse(item *entities.PublishingItem, meta *shared.Meta) *PublishingItemResponse {
+	if item == nil {
+		return nil
+	}
+
+	return &PublishingItemResponse{
+		Item: fromPublishingItemEntity(item),
+		Meta: meta,
+	}
+}
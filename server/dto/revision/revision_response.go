@@ -0,0 +1,99 @@
+package dto
+
+import (
+	"encoding/json"
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description Revision is a snapshot of a portfolio entity after a change
+type Revision struct {
+	ID         int             `json:"id"`
+	EntityType string          `json:"entity_type"`
+	EntityID   int             `json:"entity_id"`
+	Action     string          `json:"action"`
+	Snapshot   json.RawMessage `json:"snapshot" swaggertype:"object"`
+	UserID     *int            `json:"user_id"`
+	RequestID  string          `json:"request_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+} // @name Revision
+
+// @Description RevisionChange is one field that differs between two revisions
+type RevisionChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+} // @name RevisionChange
+
+// @Description Response for the revision history of an entity
+type RevisionListResponse struct {
+	Revisions []*Revision  `json:"revisions"`
+	Meta      *shared.Meta `json:"meta"`
+} //@name RevisionListResponse
+
+// @Description Response for a single revision
+type RevisionResponse struct {
+	Revision *Revision    `json:"revision"`
+	Meta     *shared.Meta `json:"meta"`
+} //@name RevisionResponse
+
+// @Description Response for the field-level diff between two revisions
+type RevisionDiffResponse struct {
+	From    int               `json:"from"`
+	To      int               `json:"to"`
+	Changes []*RevisionChange `json:"changes"`
+	Meta    *shared.Meta      `json:"meta"`
+} //@name RevisionDiffResponse
+
+func fromRevisionEntity(revision *entities.Revision) *Revision {
+	return &Revision{
+		ID:         revision.RevisionID,
+		EntityType: revision.EntityType,
+		EntityID:   revision.EntityID,
+		Action:     revision.Action,
+		Snapshot:   json.RawMessage(revision.Snapshot),
+		UserID:     revision.UserID,
+		RequestID:  revision.RequestID,
+		CreatedAt:  revision.CreatedAt,
+	}
+}
+
+func FromRevisionsEntityToResponse(revisions []*entities.Revision, meta *shared.Meta) *RevisionListResponse {
+	revisionResponses := make([]*Revision, 0, len(revisions))
+
+	for _, revision := range revisions {
+		revisionResponses = append(revisionResponses, fromRevisionEntity(revision))
+	}
+
+	return &RevisionListResponse{
+		Revisions: revisionResponses,
+		Meta:      meta,
+	}
+}
+
+func FromRevisionEntityToResponse(revision *entities.Revision, meta *shared.Meta) *RevisionResponse {
+	return &RevisionResponse{
+		Revision: fromRevisionEntity(revision),
+		Meta:     meta,
+	}
+}
+
+func FromRevisionChangesEntityToResponse(from, to int, changes []*entities.RevisionChange, meta *shared.Meta) *RevisionDiffResponse {
+	changeResponses := make([]*RevisionChange, 0, len(changes))
+
+	for _, change := range changes {
+		changeResponses = append(changeResponses, &RevisionChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+
+	return &RevisionDiffResponse{
+		From:    from,
+		To:      to,
+		Changes: changeResponses,
+		Meta:    meta,
+	}
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"html"
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"strings"
+)
+
+// @Description SearchHit is one matching item. The snippet is HTML: the text is escaped and matched words are wrapped in <mark>
+type SearchHit struct {
+	ID      int     `json:"id"`
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"`
+	Score   float64 `json:"score"`
+} // @name SearchHit
+
+// @Description SearchGroup holds the hits of one type, best match first
+type SearchGroup struct {
+	Type string       `json:"type"`
+	Hits []*SearchHit `json:"hits"`
+} // @name SearchGroup
+
+// @Description Response for a search, with one group per type that has hits
+type SearchResponse struct {
+	Query  string         `json:"query"`
+	Total  int            `json:"total"`
+	Groups []*SearchGroup `json:"groups"`
+	Meta   *shared.Meta   `json:"meta"`
+} //@name SearchResponse
+
+var snippetMarks = strings.NewReplacer(
+	entities.SearchHighlightStart, "<mark>",
+	entities.SearchHighlightEnd, "</mark>",
+)
+
+// FromSearchHitsEntityToResponse groups hits by type. Hits must come in the
+// order of entities.SearchTypes, as the search repositories return them.
+func FromSearchHitsEntityToResponse(query string, hits []*entities.SearchHit, meta *shared.Meta) *SearchResponse {
+	groups := make([]*SearchGroup, 0, len(entities.SearchTypes))
+
+	var group *SearchGroup
+	for _, hit := range hits {
+		if group == nil || group.Type != hit.Type {
+			group = &SearchGroup{Type: hit.Type}
+			groups = append(groups, group)
+		}
+		group.Hits = append(group.Hits, &SearchHit{
+			ID:      hit.ID,
+			Title:   hit.Title,
+			Snippet: snippetMarks.Replace(html.EscapeString(hit.Snippet)),
+			Score:   hit.Score,
+		})
+	}
+
+	return &SearchResponse{
+		Query:  query,
+		Total:  len(hits),
+		Groups: groups,
+		Meta:   meta,
+	}
+}
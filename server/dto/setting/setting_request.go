@@ -1,49 +1,278 @@
 package dto
 
 import (
+	"encoding/json"
 	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	"slices"
 	"strings"
 )
 
-// @Description UpdateSettingRequest represents the payload to update settings
+// @Description UpdateSettingRequest replaces every settings namespace at once
 type UpdateSettingRequest struct {
+	Site    UpdateSiteSettingsRequest    `json:"site"`
+	Display UpdateDisplaySettingsRequest `json:"display"`
+	SEO     UpdateSEOSettingsRequest     `json:"seo"`
+	Contact UpdateContactSettingsRequest `json:"contact"`
+
+	legacy bool
+} // @name UpdateSettingRequest
+
+// legacyUpdateSettingRequest is the flat body PUT /settings took before
+// settings had namespaces. It carries no SEO settings or social links.
+type legacyUpdateSettingRequest struct {
 	ShowProjects     bool   `json:"show_projects"`
-	PortfolioOwnerID int    `json:"portfolio_owner_id" validate:"required,min=1"`
-	SiteName         string `json:"site_name" validate:"required,min=1,max=100"`
-	SiteDescription  string `json:"site_description" validate:"required,min=10,max=500"`
-	ContactEmail     string `json:"contact_email" validate:"required,email"`
-	Theme            string `json:"theme" validate:"required,oneof=light dark auto"`
-	Language         string `json:"language" validate:"required,oneof=fr en es"`
+	PortfolioOwnerID int    `json:"portfolio_owner_id"`
+	SiteName         string `json:"site_name"`
+	SiteDescription  string `json:"site_description"`
+	ContactEmail     string `json:"contact_email"`
+	Theme            string `json:"theme"`
+	Language         string `json:"language"`
 	MaintenanceMode  bool   `json:"maintenance_mode"`
-} // @name UpdateSettingRequest
+}
+
+// UnmarshalJSON accepts the namespaced body as well as the legacy flat one,
+// which is recognised by having none of the namespace keys.
+func (r *UpdateSettingRequest) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	legacy := len(fields) > 0
+	for _, namespace := range entities.SettingNamespaces {
+		if _, ok := fields[string(namespace)]; ok {
+			legacy = false
+		}
+	}
+	if !legacy {
+		type namespaced UpdateSettingRequest
+		return json.Unmarshal(data, (*namespaced)(r))
+	}
+
+	var flat legacyUpdateSettingRequest
+	if err := json.Unmarshal(data, &flat); err != nil {
+		return err
+	}
+	*r = UpdateSettingRequest{
+		Site: UpdateSiteSettingsRequest{
+			PortfolioOwnerID: flat.PortfolioOwnerID,
+			SiteName:         flat.SiteName,
+			SiteDescription:  flat.SiteDescription,
+			Language:         flat.Language,
+			MaintenanceMode:  flat.MaintenanceMode,
+		},
+		Display: UpdateDisplaySettingsRequest{
+			ShowProjects: flat.ShowProjects,
+			Theme:        flat.Theme,
+		},
+		Contact: UpdateContactSettingsRequest{
+			ContactEmail: flat.ContactEmail,
+		},
+		legacy: true,
+	}
+	return nil
+}
+
+// IsLegacy reports whether the request came in the legacy flat shape, in
+// which case the SEO settings and social links it lacks should be kept.
+func (r *UpdateSettingRequest) IsLegacy() bool {
+	return r.legacy
+}
+
+// @Description UpdateSiteSettingsRequest represents the payload to update site settings
+type UpdateSiteSettingsRequest struct {
+	PortfolioOwnerID int    `json:"portfolio_owner_id"`
+	SiteName         string `json:"site_name"`
+	SiteDescription  string `json:"site_description"`
+	Language         string `json:"language"`
+	MaintenanceMode  bool   `json:"maintenance_mode"`
+} // @name UpdateSiteSettingsRequest
+
+// @Description UpdateDisplaySettingsRequest represents the payload to update display settings
+type UpdateDisplaySettingsRequest struct {
+	ShowProjects bool   `json:"show_projects"`
+	Theme        string `json:"theme"`
+} // @name UpdateDisplaySettingsRequest
+
+// @Description UpdateSEOSettingsRequest represents the payload to update SEO settings
+type UpdateSEOSettingsRequest struct {
+	MetaTitle       string   `json:"meta_title"`
+	MetaDescription string   `json:"meta_description"`
+	Keywords        []string `json:"keywords"`
+	OGImageURL      string   `json:"og_image_url"`
+} // @name UpdateSEOSettingsRequest
+
+// @Description UpdateContactSettingsRequest represents the payload to update contact settings
+type UpdateContactSettingsRequest struct {
+	ContactEmail string `json:"contact_email"`
+	SocialLinks
+} // @name UpdateContactSettingsRequest
 
 type SocialLinks struct {
-	GitHub   string `json:"github" validate:"omitempty,url"`
-	LinkedIn string `json:"linkedin" validate:"omitempty,url"`
-	Twitter  string `json:"twitter" validate:"omitempty,url"`
-	Website  string `json:"website" validate:"omitempty,url"`
+	GitHub   string `json:"github"`
+	LinkedIn string `json:"linkedin"`
+	Twitter  string `json:"twitter"`
+	Website  string `json:"website"`
 }
 
+var (
+	settingLanguages = []string{"fr", "en", "es"}
+	settingThemes    = []string{"light", "dark", "auto"}
+)
+
 func (r *UpdateSettingRequest) Validate() error {
+	if err := r.Site.Validate(); err != nil {
+		return err
+	}
+	if err := r.Display.Validate(); err != nil {
+		return err
+	}
+	if err := r.SEO.Validate(); err != nil {
+		return err
+	}
+	return r.Contact.Validate()
+}
+
+func (r *UpdateSiteSettingsRequest) Validate() error {
 	r.Sanitize()
+
+	validator := validation.NewValidator()
+
+	validator.Custom("portfolio_owner_id", r.PortfolioOwnerID > 0, "Portfolio owner ID must be positive")
+	validator.Required("site_name", r.SiteName).MaxLength("site_name", r.SiteName, 100)
+	validator.Required("site_description", r.SiteDescription).
+		MinLength("site_description", r.SiteDescription, 10).
+		MaxLength("site_description", r.SiteDescription, 500)
+	validator.Required("language", r.Language)
+	if r.Language != "" {
+		validator.Custom("language", slices.Contains(settingLanguages, r.Language),
+			"Language must be one of: "+strings.Join(settingLanguages, ", "))
+	}
+
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
 	return nil
 }
 
-func (r *UpdateSettingRequest) Sanitize() {
+func (r *UpdateSiteSettingsRequest) Sanitize() {
 	r.SiteName = strings.TrimSpace(r.SiteName)
 	r.SiteDescription = strings.TrimSpace(r.SiteDescription)
+	r.Language = strings.TrimSpace(strings.ToLower(r.Language))
+}
+
+func (r *UpdateDisplaySettingsRequest) Validate() error {
+	r.Theme = strings.TrimSpace(strings.ToLower(r.Theme))
+
+	validator := validation.NewValidator()
+
+	validator.Required("theme", r.Theme)
+	if r.Theme != "" {
+		validator.Custom("theme", slices.Contains(settingThemes, r.Theme),
+			"Theme must be one of: "+strings.Join(settingThemes, ", "))
+	}
+
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (r *UpdateSEOSettingsRequest) Validate() error {
+	r.Sanitize()
+
+	validator := validation.NewValidator()
+
+	validator.MaxLength("meta_title", r.MetaTitle, entities.MaxMetaTitleLength)
+	validator.MaxLength("meta_description", r.MetaDescription, entities.MaxMetaDescriptionLength)
+	validator.Custom("keywords", len(r.Keywords) <= 20, "Cannot have more than 20 keywords")
+	for _, keyword := range r.Keywords {
+		validator.Required("keywords", keyword).MaxLength("keywords", keyword, 50)
+	}
+	validator.URL("og_image_url", r.OGImageURL)
+
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (r *UpdateSEOSettingsRequest) Sanitize() {
+	r.MetaTitle = strings.TrimSpace(r.MetaTitle)
+	r.MetaDescription = strings.TrimSpace(r.MetaDescription)
+	r.OGImageURL = strings.TrimSpace(r.OGImageURL)
+	for i, keyword := range r.Keywords {
+		r.Keywords[i] = strings.TrimSpace(keyword)
+	}
+}
+
+func (r *UpdateContactSettingsRequest) Validate() error {
+	r.Sanitize()
+
+	validator := validation.NewValidator()
+
+	validator.Email("contact_email", r.ContactEmail)
+	validator.URL("github", r.GitHub)
+	validator.URL("linkedin", r.LinkedIn)
+	validator.URL("twitter", r.Twitter)
+	validator.URL("website", r.Website)
+
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (r *UpdateContactSettingsRequest) Sanitize() {
 	r.ContactEmail = strings.TrimSpace(strings.ToLower(r.ContactEmail))
+	r.GitHub = strings.TrimSpace(r.GitHub)
+	r.LinkedIn = strings.TrimSpace(r.LinkedIn)
+	r.Twitter = strings.TrimSpace(r.Twitter)
+	r.Website = strings.TrimSpace(r.Website)
+}
+
+func (r *UpdateSettingRequest) ToEntity() *entities.Settings {
+	return &entities.Settings{
+		Site:    *r.Site.ToEntity(),
+		Display: *r.Display.ToEntity(),
+		SEO:     *r.SEO.ToEntity(),
+		Contact: *r.Contact.ToEntity(),
+	}
 }
 
-func (r *UpdateSettingRequest) ToEntity() *entities.SettingJson {
-	return &entities.SettingJson{
-		ShowProjects:     r.ShowProjects,
+func (r *UpdateSiteSettingsRequest) ToEntity() *entities.SiteSettings {
+	return &entities.SiteSettings{
 		PortfolioOwnerID: r.PortfolioOwnerID,
 		SiteName:         r.SiteName,
 		SiteDescription:  r.SiteDescription,
-		ContactEmail:     r.ContactEmail,
-		Theme:            r.Theme,
 		Language:         r.Language,
 		MaintenanceMode:  r.MaintenanceMode,
 	}
 }
+
+func (r *UpdateDisplaySettingsRequest) ToEntity() *entities.DisplaySettings {
+	return &entities.DisplaySettings{
+		ShowProjects: r.ShowProjects,
+		Theme:        r.Theme,
+	}
+}
+
+func (r *UpdateSEOSettingsRequest) ToEntity() *entities.SEOSettings {
+	return &entities.SEOSettings{
+		MetaTitle:       r.MetaTitle,
+		MetaDescription: r.MetaDescription,
+		Keywords:        r.Keywords,
+		OGImageURL:      r.OGImageURL,
+	}
+}
+
+func (r *UpdateContactSettingsRequest) ToEntity() *entities.ContactSettings {
+	return &entities.ContactSettings{
+		ContactEmail: r.ContactEmail,
+		GitHub:       r.GitHub,
+		LinkedIn:     r.LinkedIn,
+		Twitter:      r.Twitter,
+		Website:      r.Website,
+	}
+}
@@ -6,18 +6,53 @@ import (
 	"time"
 )
 
-// @Description Setting represents the settings of the portfolio
+// @Description Setting represents the settings of the portfolio, grouped by namespace
 type Setting struct {
-	ShowProjects     bool   `json:"show_projects"`
+	Site    *SiteSetting    `json:"site,omitempty"`
+	Display *DisplaySetting `json:"display,omitempty"`
+	SEO     *SEOSetting     `json:"seo,omitempty"`
+	Contact *ContactSetting `json:"contact,omitempty"`
+} // @name Setting
+
+// @Description SiteSetting represents the site settings namespace
+type SiteSetting struct {
+	SchemaVersion    int    `json:"schema_version"`
 	PortfolioOwnerID int    `json:"portfolio_owner_id"`
 	SiteName         string `json:"site_name"`
 	SiteDescription  string `json:"site_description"`
-	ContactEmail     string `json:"contact_email"`
-	Theme            string `json:"theme"`
 	Language         string `json:"language"`
 	MaintenanceMode  bool   `json:"maintenance_mode"`
 	UpdatedAt        string `json:"updated_at"`
-} // @name Setting
+} // @name SiteSetting
+
+// @Description DisplaySetting represents the display settings namespace
+type DisplaySetting struct {
+	SchemaVersion int    `json:"schema_version"`
+	ShowProjects  bool   `json:"show_projects"`
+	Theme         string `json:"theme"`
+	UpdatedAt     string `json:"updated_at"`
+} // @name DisplaySetting
+
+// @Description SEOSetting represents the SEO settings namespace
+type SEOSetting struct {
+	SchemaVersion   int      `json:"schema_version"`
+	MetaTitle       string   `json:"meta_title"`
+	MetaDescription string   `json:"meta_description"`
+	Keywords        []string `json:"keywords"`
+	OGImageURL      string   `json:"og_image_url"`
+	UpdatedAt       string   `json:"updated_at"`
+} // @name SEOSetting
+
+// @Description ContactSetting represents the contact settings namespace
+type ContactSetting struct {
+	SchemaVersion int    `json:"schema_version"`
+	ContactEmail  string `json:"contact_email"`
+	GitHub        string `json:"github"`
+	LinkedIn      string `json:"linkedin"`
+	Twitter       string `json:"twitter"`
+	Website       string `json:"website"`
+	UpdatedAt     string `json:"updated_at"`
+} // @name ContactSetting
 
 // @Description Response for a setting
 type SettingResponse struct {
@@ -25,23 +60,89 @@ type SettingResponse struct {
 	Meta    *shared.Meta `json:"meta"`
 } //@name SettingResponse
 
-func FromSettingEntityToResponse(setting *entities.SettingJson, meta *shared.Meta) *SettingResponse {
-	if setting == nil {
+func FromSettingEntityToResponse(settings *entities.Settings, meta *shared.Meta) *SettingResponse {
+	if settings == nil {
 		return nil
 	}
 
 	return &SettingResponse{
 		Setting: &Setting{
-			ShowProjects:     setting.ShowProjects,
-			PortfolioOwnerID: setting.PortfolioOwnerID,
-			SiteName:         setting.SiteName,
-			SiteDescription:  setting.SiteDescription,
-			ContactEmail:     setting.ContactEmail,
-			Theme:            setting.Theme,
-			Language:         setting.Language,
-			MaintenanceMode:  setting.MaintenanceMode,
-			UpdatedAt:        setting.UpdatedAt.Format(time.RFC3339),
+			Site:    fromSiteSettings(&settings.Site),
+			Display: fromDisplaySettings(&settings.Display),
+			SEO:     fromSEOSettings(&settings.SEO),
+			Contact: fromContactSettings(&settings.Contact),
 		},
 		Meta: meta,
 	}
 }
+
+// FromSettingNamespaceToResponse keeps only the given namespace.
+func FromSettingNamespaceToResponse(settings *entities.Settings, namespace entities.SettingNamespace, meta *shared.Meta) *SettingResponse {
+	response := FromSettingEntityToResponse(settings, meta)
+	if response == nil {
+		return nil
+	}
+
+	setting := response.Setting
+	response.Setting = &Setting{}
+	switch namespace {
+	case entities.SettingNamespaceSite:
+		response.Setting.Site = setting.Site
+	case entities.SettingNamespaceDisplay:
+		response.Setting.Display = setting.Display
+	case entities.SettingNamespaceSEO:
+		response.Setting.SEO = setting.SEO
+	case entities.SettingNamespaceContact:
+		response.Setting.Contact = setting.Contact
+	}
+	return response
+}
+
+func fromSiteSettings(site *entities.SiteSettings) *SiteSetting {
+	return &SiteSetting{
+		SchemaVersion:    site.SchemaVersion,
+		PortfolioOwnerID: site.PortfolioOwnerID,
+		SiteName:         site.SiteName,
+		SiteDescription:  site.SiteDescription,
+		Language:         site.Language,
+		MaintenanceMode:  site.MaintenanceMode,
+		UpdatedAt:        site.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func fromDisplaySettings(display *entities.DisplaySettings) *DisplaySetting {
+	return &DisplaySetting{
+		SchemaVersion: display.SchemaVersion,
+		ShowProjects:  display.ShowProjects,
+		Theme:         display.Theme,
+		UpdatedAt:     display.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func fromSEOSettings(seo *entities.SEOSettings) *SEOSetting {
+	keywords := seo.Keywords
+	if keywords == nil {
+		keywords = []string{}
+	}
+
+	return &SEOSetting{
+		SchemaVersion:   seo.SchemaVersion,
+		MetaTitle:       seo.MetaTitle,
+		MetaDescription: seo.MetaDescription,
+		Keywords:        keywords,
+		OGImageURL:      seo.OGImageURL,
+		UpdatedAt:       seo.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func fromContactSettings(contact *entities.ContactSettings) *ContactSetting {
+	return &ContactSetting{
+		SchemaVersion: contact.SchemaVersion,
+		ContactEmail:  contact.ContactEmail,
+		GitHub:        contact.GitHub,
+		LinkedIn:      contact.LinkedIn,
+		Twitter:       contact.Twitter,
+		Website:       contact.Website,
+		UpdatedAt:     contact.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	"strings"
+)
+
+// @Description Request to create or rename a skill category
+type SkillCategoryRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+} // @name SkillCategoryRequest
+
+func (r *SkillCategoryRequest) Validate() error {
+	r.Sanitize()
+
+	validator := validation.NewValidator()
+	validator.Required("name", r.Name).MaxLength("name", r.Name, 100)
+
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (r *SkillCategoryRequest) Sanitize() {
+	r.Name = strings.TrimSpace(r.Name)
+}
+
+func (r *SkillCategoryRequest) ToEntity(userID int) *entities.SkillCategory {
+	return &entities.SkillCategory{
+		UserID: userID,
+		Name:   r.Name,
+	}
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description SkillCategory groups skills, listed by position
+type SkillCategory struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+} // @name SkillCategory
+
+// @Description Response for a skill category
+type SkillCategoryResponse struct {
+	Category *SkillCategory `json:"category"`
+	Meta     *shared.Meta   `json:"meta"`
+} // @name SkillCategoryResponse
+
+// @Description Response for the skill categories, by position
+type SkillCategoryListResponse struct {
+	Categories []*SkillCategory `json:"categories"`
+	Meta       *shared.Meta     `json:"meta"`
+} // @name SkillCategoryListResponse
+
+func FromSkillCategoryEntityToResponse(category *entities.SkillCategory, meta *shared.Meta) *SkillCategoryResponse {
+	return &SkillCategoryResponse{
+		Category: fromSkillCategoryEntity(category),
+		Meta:     meta,
+	}
+}
+
+func FromSkillCategoryEntitiesToResponse(categories []*entities.SkillCategory, meta *shared.Meta) *SkillCategoryListResponse {
+	responses := make([]*SkillCategory, 0, len(categories))
+	for _, category := range categories {
+		responses = append(responses, fromSkillCategoryEntity(category))
+	}
+	return &SkillCategoryListResponse{
+		Categories: responses,
+		Meta:       meta,
+	}
+}
+
+func fromSkillCategoryEntity(category *entities.SkillCategory) *SkillCategory {
+	return &SkillCategory{
+		ID:        category.SkillCategoryID,
+		Name:      category.Name,
+		Position:  category.Position,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+}
@@ -3,26 +3,54 @@ package dto
 import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	publishingDto "portfolio/dto/publishing"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// maxSkillEvidence bounds the projects and the experiences linked to one
+// skill.
+const maxSkillEvidence = 50
+
+// SkillDetails holds the optional fields that create and update requests
+// share. The projects and experiences are linked to the skill as evidence,
+// in order; an update replaces the earlier links.
+type SkillDetails struct {
+	CategoryID        *int   `json:"category_id,omitempty"`
+	YearsOfExperience *int   `json:"years_of_experience,omitempty" validate:"omitempty,min=0,max=80"`
+	LastUsedAt        string `json:"last_used_at,omitempty" example:"2024-06-30"`
+	ProjectIDs        []int  `json:"project_ids,omitempty"`
+	ExperienceIDs     []int  `json:"experience_ids,omitempty"`
+}
+
 // @Description Request to create a new skill
 type CreateSkillRequest struct {
 	Name  string `json:"name" validate:"required"`
 	Level int    `json:"level" validate:"required"`
+	SkillDetails
+	publishingDto.Publishing
 } // @name CreateSkillRequest
 
 // @Description Request to update an existing skill
 type UpdateSkillRequest struct {
 	Name  string `json:"name" validate:"required"`
 	Level int    `json:"level" validate:"required"`
+	SkillDetails
 } // @name UpdateSkillRequest
 
-// @Description Request to patch an existing skill
+// @Description Request to patch an existing skill. A category_id of 0 takes
+// @Description the skill out of its category; project_ids and experience_ids
+// @Description replace the links when present.
 type PatchSkillRequest struct {
-	Name  *string `json:"name,omitempty" validate:"omitempty,max=100"`
-	Level *int    `json:"level,omitempty" validate:"omitempty,min=1,max=5"`
+	Name              *string `json:"name,omitempty" validate:"omitempty,max=100"`
+	Level             *int    `json:"level,omitempty" validate:"omitempty,min=1,max=5"`
+	CategoryID        *int    `json:"category_id,omitempty"`
+	YearsOfExperience *int    `json:"years_of_experience,omitempty" validate:"omitempty,min=0,max=80"`
+	LastUsedAt        *string `json:"last_used_at,omitempty" example:"2024-06-30"`
+	ProjectIDs        []int   `json:"project_ids,omitempty"`
+	ExperienceIDs     []int   `json:"experience_ids,omitempty"`
 } // @name PatchSkillRequest
 
 // @Description Request to create multiple skills in bulk
@@ -43,18 +71,25 @@ func (req *CreateSkillRequest) Validate() error {
 		return domain.NewValidationError("Skill level must be between 1 and 5", "level", nil)
 	}
 
-	return nil
+	if err := req.SkillDetails.Check(); err != nil {
+		return err
+	}
+
+	return req.Publishing.Check()
 }
 
 func (req *CreateSkillRequest) ToEntity(userID int) (*entities.Skill, error) {
 	now := time.Now()
-	return &entities.Skill{
-		Name:      strings.TrimSpace(req.Name),
-		Level:     req.Level,
-		UserID:    userID,
-		CreatedAt: now,
-		UpdatedAt: now,
-	}, nil
+	skill := &entities.Skill{
+		Name:       strings.TrimSpace(req.Name),
+		Level:      req.Level,
+		UserID:     userID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Publishing: req.Publishing.ToEntity(),
+	}
+	req.SkillDetails.apply(skill)
+	return skill, nil
 }
 
 func (req *CreateBulkSkillsRequest) Validate() error {
@@ -88,12 +123,14 @@ func (req *CreateBulkSkillsRequest) ToEntities(userID int) ([]*entities.Skill, e
 
 	for i, skillReq := range req.Skills {
 		skillEntities[i] = &entities.Skill{
-			Name:      strings.TrimSpace(skillReq.Name),
-			Level:     skillReq.Level,
-			UserID:    userID,
-			CreatedAt: now,
-			UpdatedAt: now,
+			Name:       strings.TrimSpace(skillReq.Name),
+			Level:      skillReq.Level,
+			UserID:     userID,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			Publishing: skillReq.Publishing.ToEntity(),
 		}
+		skillReq.SkillDetails.apply(skillEntities[i])
 	}
 
 	return skillEntities, nil
@@ -112,17 +149,19 @@ func (req *UpdateSkillRequest) Validate() error {
 		return domain.NewValidationError("Skill level must be between 1 and 5", "level", nil)
 	}
 
-	return nil
+	return req.SkillDetails.Check()
 }
 
 func (req *UpdateSkillRequest) ToEntity(id, userID int) (*entities.Skill, error) {
-	return &entities.Skill{
+	skill := &entities.Skill{
 		SkillID:   id,
 		Name:      strings.TrimSpace(req.Name),
 		Level:     req.Level,
 		UserID:    userID,
 		UpdatedAt: time.Now(),
-	}, nil
+	}
+	req.SkillDetails.apply(skill)
+	return skill, nil
 }
 
 func (req *PatchSkillRequest) Validate() error {
@@ -141,6 +180,19 @@ func (req *PatchSkillRequest) Validate() error {
 		}
 	}
 
+	validator := validation.NewValidator()
+	if req.CategoryID != nil {
+		validator.Custom("category_id", *req.CategoryID >= 0, "Category ID cannot be negative")
+	}
+	validateYearsOfExperience(validator, req.YearsOfExperience)
+	if req.LastUsedAt != nil {
+		validateLastUsedAt(validator, *req.LastUsedAt)
+	}
+	validateEvidenceIDs(validator, "project_ids", req.ProjectIDs)
+	validateEvidenceIDs(validator, "experience_ids", req.ExperienceIDs)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
 	return nil
 }
 
@@ -157,6 +209,90 @@ func (req *PatchSkillRequest) ToEntity(id, userID int) (*entities.Skill, error)
 	if req.Level != nil {
 		skill.Level = *req.Level
 	}
+	skill.CategoryID = req.CategoryID
+	skill.YearsOfExperience = req.YearsOfExperience
+	if req.LastUsedAt != nil {
+		lastUsedAt := parseLastUsedAt(*req.LastUsedAt)
+		skill.LastUsedAt = &lastUsedAt
+	}
+	skill.Projects = toEvidence(req.ProjectIDs)
+	skill.Experiences = toEvidence(req.ExperienceIDs)
 
 	return skill, nil
 }
+
+// Check validates the details on their own, like publishingDto.Publishing.
+func (d *SkillDetails) Check() error {
+	validator := validation.NewValidator()
+	if d.CategoryID != nil {
+		validator.Custom("category_id", *d.CategoryID > 0, "Category ID must be positive")
+	}
+	validateYearsOfExperience(validator, d.YearsOfExperience)
+	if d.LastUsedAt != "" {
+		validateLastUsedAt(validator, d.LastUsedAt)
+	}
+	validateEvidenceIDs(validator, "project_ids", d.ProjectIDs)
+	validateEvidenceIDs(validator, "experience_ids", d.ExperienceIDs)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// apply sets the details on skill. Missing evidence IDs link nothing, so
+// that an update clears the earlier links.
+func (d *SkillDetails) apply(skill *entities.Skill) {
+	skill.CategoryID = d.CategoryID
+	skill.YearsOfExperience = d.YearsOfExperience
+	if d.LastUsedAt != "" {
+		lastUsedAt := parseLastUsedAt(d.LastUsedAt)
+		skill.LastUsedAt = &lastUsedAt
+	}
+	skill.Projects = toEvidence(append([]int{}, d.ProjectIDs...))
+	skill.Experiences = toEvidence(append([]int{}, d.ExperienceIDs...))
+}
+
+func validateYearsOfExperience(validator *validation.Validator, years *int) {
+	if years != nil {
+		validator.Custom("years_of_experience", *years >= 0 && *years <= entities.MaxSkillYearsOfExperience,
+			"Years of experience must be between 0 and "+strconv.Itoa(entities.MaxSkillYearsOfExperience))
+	}
+}
+
+func validateLastUsedAt(validator *validation.Validator, value string) {
+	lastUsedAt, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		validator.Custom("last_used_at", false, "Last used date must be in YYYY-MM-DD format")
+		return
+	}
+	validator.DateNotFuture("last_used_at", lastUsedAt)
+}
+
+func validateEvidenceIDs(validator *validation.Validator, field string, ids []int) {
+	validator.Custom(field, len(ids) <= maxSkillEvidence, "A skill can link at most "+strconv.Itoa(maxSkillEvidence)+" items in "+field)
+	for _, id := range ids {
+		if id <= 0 {
+			validator.Custom(field, false, "IDs in "+field+" must be positive")
+			return
+		}
+	}
+}
+
+// parseLastUsedAt parses a date checked by validateLastUsedAt.
+func parseLastUsedAt(value string) time.Time {
+	lastUsedAt, _ := time.Parse(time.DateOnly, value)
+	return lastUsedAt
+}
+
+// toEvidence names evidence by ID, for the use case to look up; nil stays
+// nil.
+func toEvidence(ids []int) []*entities.SkillEvidence {
+	if ids == nil {
+		return nil
+	}
+	evidence := make([]*entities.SkillEvidence, 0, len(ids))
+	for _, id := range ids {
+		evidence = append(evidence, &entities.SkillEvidence{ID: id})
+	}
+	return evidence
+}
@@ -2,26 +2,60 @@ package dto
 
 import (
 	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
 	"portfolio/shared"
 	"time"
 )
 
 // @Description Skill represents a skill entry in the portfolio
 type Skill struct {
-	SkillID   int       `json:"skill_id"`
-	UserID    int       `json:"user_id"`
-	Name      string    `json:"name"`
-	Level     int       `json:"level"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	SkillID           int                `json:"skill_id"`
+	UserID            int                `json:"user_id"`
+	Name              string             `json:"name"`
+	Level             int                `json:"level"`
+	CategoryID        *int               `json:"category_id"`
+	YearsOfExperience *int               `json:"years_of_experience"`
+	LastUsedAt        string             `json:"last_used_at,omitempty"`
+	Projects          []*SkillProject    `json:"projects"`
+	Experiences       []*SkillExperience `json:"experiences"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+	Position          int                `json:"position"`
+	publishingDto.Publishing
 } // @name Skill
 
+// @Description SkillProject is a project linked to a skill as evidence of it
+type SkillProject struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+} // @name SkillProject
+
+// @Description SkillExperience is an experience linked to a skill as evidence of it
+type SkillExperience struct {
+	ID          int    `json:"id"`
+	JobTitle    string `json:"job_title"`
+	CompanyName string `json:"company_name"`
+} // @name SkillExperience
+
 // @Description Response for a list of skills
 type SkillListResponse struct {
 	Skills []*Skill     `json:"skills"`
 	Meta   *shared.Meta `json:"meta"`
 } //@name SkillListResponse
 
+// @Description Skills of one category, in list order; the category is null
+// @Description for the skills outside of any category
+type SkillGroup struct {
+	Category *SkillCategory `json:"category"`
+	Skills   []*Skill       `json:"skills"`
+} // @name SkillGroup
+
+// @Description Response for a list of skills grouped by category
+type SkillGroupListResponse struct {
+	Groups []*SkillGroup `json:"groups"`
+	Meta   *shared.Meta  `json:"meta"`
+} // @name SkillGroupListResponse
+
 // @Description Response for a skill
 type SkillResponse struct {
 	Skill *Skill       `json:"skill"`
@@ -40,18 +74,10 @@ func FromSkillsEntityToResponse(skills []*entities.Skill, meta *shared.Meta) *Sk
 		return nil
 	}
 
-	var skillResponses []*Skill
+	skillResponses := make([]*Skill, 0, len(skills))
 
 	for _, skill := range skills {
-		_skill := &Skill{
-			SkillID:   skill.SkillID,
-			UserID:    skill.UserID,
-			Name:      skill.Name,
-			Level:     skill.Level,
-			CreatedAt: skill.CreatedAt,
-			UpdatedAt: skill.UpdatedAt,
-		}
-		skillResponses = append(skillResponses, _skill)
+		skillResponses = append(skillResponses, fromSkillEntity(skill))
 	}
 
 	return &SkillListResponse{
@@ -60,21 +86,49 @@ func FromSkillsEntityToResponse(skills []*entities.Skill, meta *shared.Meta) *Sk
 	}
 }
 
+// FromSkillGroupsToResponse groups skills, which must be sorted by category
+// first, under their categories. Skills outside of categories form the
+// group without a category.
+func FromSkillGroupsToResponse(skills []*entities.Skill, categories []*entities.SkillCategory, meta *shared.Meta) *SkillGroupListResponse {
+	byID := make(map[int]*entities.SkillCategory, len(categories))
+	for _, category := range categories {
+		byID[category.SkillCategoryID] = category
+	}
+
+	groups := []*SkillGroup{}
+	var current *SkillGroup
+	currentID := -1
+	for _, skill := range skills {
+		categoryID := 0
+		if skill.CategoryID != nil && byID[*skill.CategoryID] != nil {
+			categoryID = *skill.CategoryID
+		}
+
+		if current == nil || categoryID != currentID {
+			current = &SkillGroup{Skills: []*Skill{}}
+			if categoryID != 0 {
+				current.Category = fromSkillCategoryEntity(byID[categoryID])
+			}
+			currentID = categoryID
+			groups = append(groups, current)
+		}
+		current.Skills = append(current.Skills, fromSkillEntity(skill))
+	}
+
+	return &SkillGroupListResponse{
+		Groups: groups,
+		Meta:   meta,
+	}
+}
+
 func FromSkillEntityToResponse(skill *entities.Skill, meta *shared.Meta) *SkillResponse {
 	if skill == nil {
 		return nil
 	}
 
 	return &SkillResponse{
-		Skill: &Skill{
-			SkillID:   skill.SkillID,
-			UserID:    skill.UserID,
-			Name:      skill.Name,
-			Level:     skill.Level,
-			CreatedAt: skill.CreatedAt,
-			UpdatedAt: skill.UpdatedAt,
-		},
-		Meta: meta,
+		Skill: fromSkillEntity(skill),
+		Meta:  meta,
 	}
 }
 
@@ -86,15 +140,7 @@ func FromSkillsEntityForBulkToResponse(skills []*entities.Skill, meta *shared.Me
 	var skillResponses []*Skill
 
 	for _, skill := range skills {
-		_skill := &Skill{
-			SkillID:   skill.SkillID,
-			UserID:    skill.UserID,
-			Name:      skill.Name,
-			Level:     skill.Level,
-			CreatedAt: skill.CreatedAt,
-			UpdatedAt: skill.UpdatedAt,
-		}
-		skillResponses = append(skillResponses, _skill)
+		skillResponses = append(skillResponses, fromSkillEntity(skill))
 	}
 
 	return &SkillBulkResponse{
@@ -102,3 +148,34 @@ func FromSkillsEntityForBulkToResponse(skills []*entities.Skill, meta *shared.Me
 		Meta:   meta,
 	}
 }
+
+func fromSkillEntity(skill *entities.Skill) *Skill {
+	response := &Skill{
+		SkillID:           skill.SkillID,
+		UserID:            skill.UserID,
+		Name:              skill.Name,
+		Level:             skill.Level,
+		CategoryID:        skill.CategoryID,
+		YearsOfExperience: skill.YearsOfExperience,
+		Projects:          make([]*SkillProject, 0, len(skill.Projects)),
+		Experiences:       make([]*SkillExperience, 0, len(skill.Experiences)),
+		CreatedAt:         skill.CreatedAt,
+		UpdatedAt:         skill.UpdatedAt,
+		Position:          skill.Position,
+		Publishing:        publishingDto.FromPublishingEntity(skill.Publishing),
+	}
+	if skill.LastUsedAt != nil {
+		response.LastUsedAt = skill.LastUsedAt.Format(time.DateOnly)
+	}
+	for _, project := range skill.Projects {
+		response.Projects = append(response.Projects, &SkillProject{ID: project.ID, Title: project.Title})
+	}
+	for _, experience := range skill.Experiences {
+		response.Experiences = append(response.Experiences, &SkillExperience{
+			ID:          experience.ID,
+			JobTitle:    experience.Title,
+			CompanyName: experience.Organization,
+		})
+	}
+	return response
+}
@@ -0,0 +1,174 @@
+package dto
+
+import (
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	publishingDto "portfolio/dto/publishing"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxBulkSpokenLanguages bounds the spoken languages of one bulk request.
+const maxBulkSpokenLanguages = 50
+
+// SpokenLanguageFields holds the fields that create and update requests
+// share. The level is a CEFR level, A1 to C2, or native.
+type SpokenLanguageFields struct {
+	Name        string `json:"name" validate:"required,max=100" example:"German"`
+	Level       string `json:"level" validate:"required,oneof=A1 A2 B1 B2 C1 C2 native" example:"B2"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=500" example:"Goethe-Zertifikat B2, 2021"`
+}
+
+// @Description Request to create a spoken language
+type CreateSpokenLanguageRequest struct {
+	SpokenLanguageFields
+	publishingDto.Publishing
+} // @name CreateSpokenLanguageRequest
+
+// @Description Request to create multiple spoken languages in bulk
+type CreateBulkSpokenLanguagesRequest struct {
+	SpokenLanguages []CreateSpokenLanguageRequest `json:"spoken_languages" validate:"required"`
+} // @name CreateBulkSpokenLanguagesRequest
+
+// @Description Request to update an existing spoken language
+type UpdateSpokenLanguageRequest struct {
+	SpokenLanguageFields
+} // @name UpdateSpokenLanguageRequest
+
+// @Description Request to patch an existing spoken language. An empty
+// @Description description clears it.
+type PatchSpokenLanguageRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,max=100"`
+	Level       *string `json:"level,omitempty" validate:"omitempty,oneof=A1 A2 B1 B2 C1 C2 native"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=500"`
+} // @name PatchSpokenLanguageRequest
+
+// Validate adds the errors of the fields to validator.
+func (f *SpokenLanguageFields) Validate(validator *validation.Validator) {
+	validator.Required("name", f.Name)
+	validator.MaxLength("name", f.Name, 100)
+	validator.Required("level", f.Level)
+	if f.Level != "" {
+		validateSpokenLanguageLevel(validator, f.Level)
+	}
+	validator.MaxLength("description", f.Description, 500)
+}
+
+func (req *CreateSpokenLanguageRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.SpokenLanguageFields.Validate(validator)
+	req.Publishing.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *CreateSpokenLanguageRequest) ToEntity(userID int) (*entities.SpokenLanguage, error) {
+	spokenLanguage := &entities.SpokenLanguage{
+		UserID:     userID,
+		Publishing: req.Publishing.ToEntity(),
+	}
+	req.SpokenLanguageFields.apply(spokenLanguage)
+	return spokenLanguage, nil
+}
+
+func (req *CreateBulkSpokenLanguagesRequest) Validate() error {
+	if len(req.SpokenLanguages) == 0 {
+		return domain.NewValidationError("At least one spoken language is required", "spoken_languages", nil)
+	}
+
+	if len(req.SpokenLanguages) > maxBulkSpokenLanguages {
+		return domain.NewValidationError("Cannot create more than "+strconv.Itoa(maxBulkSpokenLanguages)+" spoken languages at once", "spoken_languages", nil)
+	}
+
+	names := make(map[string]bool)
+	for i, spokenLanguage := range req.SpokenLanguages {
+		if err := spokenLanguage.Validate(); err != nil {
+			return domain.NewValidationError("Spoken language "+strconv.Itoa(i+1)+": "+err.Error(), "spoken_languages", &err)
+		}
+
+		name := strings.ToLower(strings.TrimSpace(spokenLanguage.Name))
+		if names[name] {
+			return domain.NewAlreadyExistsError("spoken language", strings.TrimSpace(spokenLanguage.Name))
+		}
+		names[name] = true
+	}
+
+	return nil
+}
+
+func (req *CreateBulkSpokenLanguagesRequest) ToEntities(userID int) ([]*entities.SpokenLanguage, error) {
+	spokenLanguages := make([]*entities.SpokenLanguage, 0, len(req.SpokenLanguages))
+	for _, spokenLanguageReq := range req.SpokenLanguages {
+		spokenLanguage, err := spokenLanguageReq.ToEntity(userID)
+		if err != nil {
+			return nil, err
+		}
+		spokenLanguages = append(spokenLanguages, spokenLanguage)
+	}
+	return spokenLanguages, nil
+}
+
+func (req *UpdateSpokenLanguageRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.SpokenLanguageFields.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *UpdateSpokenLanguageRequest) ToEntity(id, userID int) (*entities.SpokenLanguage, error) {
+	spokenLanguage := &entities.SpokenLanguage{
+		SpokenLanguageID: id,
+		UserID:           userID,
+	}
+	req.SpokenLanguageFields.apply(spokenLanguage)
+	return spokenLanguage, nil
+}
+
+func (req *PatchSpokenLanguageRequest) Validate() error {
+	validator := validation.NewValidator()
+	if req.Name != nil {
+		validator.Required("name", *req.Name)
+		validator.MaxLength("name", *req.Name, 100)
+	}
+	if req.Level != nil {
+		validateSpokenLanguageLevel(validator, *req.Level)
+	}
+	if req.Description != nil {
+		validator.MaxLength("description", *req.Description, 500)
+	}
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// ApplyTo sets the fields present in the request on spokenLanguage.
+func (req *PatchSpokenLanguageRequest) ApplyTo(spokenLanguage *entities.SpokenLanguage) {
+	if req.Name != nil {
+		spokenLanguage.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Level != nil {
+		spokenLanguage.Level = *req.Level
+	}
+	if req.Description != nil {
+		spokenLanguage.Description = strings.TrimSpace(*req.Description)
+	}
+}
+
+// apply sets the fields on spokenLanguage.
+func (f *SpokenLanguageFields) apply(spokenLanguage *entities.SpokenLanguage) {
+	spokenLanguage.Name = strings.TrimSpace(f.Name)
+	spokenLanguage.Level = f.Level
+	spokenLanguage.Description = strings.TrimSpace(f.Description)
+}
+
+func validateSpokenLanguageLevel(validator *validation.Validator, level string) {
+	validator.Custom("level", slices.Contains(entities.SpokenLanguageLevels, level),
+		"Level must be one of: "+strings.Join(entities.SpokenLanguageLevels, ", "))
+}
@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
+	"portfolio/shared"
+)
+
+// @Description SpokenLanguage represents a language the portfolio owner
+// @Description speaks, with its CEFR level or native. level_label names the
+// @Description level for display, like "Upper intermediate" for B2.
+type SpokenLanguage struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Level       string `json:"level" enums:"A1,A2,B1,B2,C1,C2,native"`
+	LevelLabel  string `json:"level_label" example:"Upper intermediate"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Position    int    `json:"position"`
+	publishingDto.Publishing
+} // @name SpokenLanguage
+
+// @Description Response for a list of spoken languages
+type SpokenLanguageListResponse struct {
+	SpokenLanguages []*SpokenLanguage  `json:"spoken_languages"`
+	Meta            *shared.Meta       `json:"meta"`
+	Errors          []*shared.APIError `json:"errors,omitempty"`
+} // @name SpokenLanguageListResponse
+
+// @Description Response for a spoken language
+type SpokenLanguageResponse struct {
+	SpokenLanguage *SpokenLanguage `json:"spoken_language"`
+	Meta           *shared.Meta    `json:"meta"`
+} // @name SpokenLanguageResponse
+
+func FromSpokenLanguageEntityToResponse(spokenLanguage *entities.SpokenLanguage, meta *shared.Meta) *SpokenLanguageResponse {
+	if spokenLanguage == nil {
+		return nil
+	}
+
+	return &SpokenLanguageResponse{
+		SpokenLanguage: fromSpokenLanguageEntity(spokenLanguage),
+		Meta:           meta,
+	}
+}
+
+func FromSpokenLanguagesEntityToResponse(spokenLanguages []*entities.SpokenLanguage, meta *shared.Meta) *SpokenLanguageListResponse {
+	if spokenLanguages == nil {
+		return nil
+	}
+
+	spokenLanguageResponses := make([]*SpokenLanguage, 0, len(spokenLanguages))
+	for _, spokenLanguage := range spokenLanguages {
+		spokenLanguageResponses = append(spokenLanguageResponses, fromSpokenLanguageEntity(spokenLanguage))
+	}
+
+	return &SpokenLanguageListResponse{
+		SpokenLanguages: spokenLanguageResponses,
+		Meta:            meta,
+	}
+}
+
+func FromSpokenLanguagesEntityForBulkToResponse(spokenLanguages []*entities.SpokenLanguage, meta *shared.Meta) *SpokenLanguageListResponse {
+	response := FromSpokenLanguagesEntityToResponse(spokenLanguages, meta)
+	if response == nil {
+		return &SpokenLanguageListResponse{SpokenLanguages: []*SpokenLanguage{}, Meta: meta}
+	}
+	return response
+}
+
+func fromSpokenLanguageEntity(spokenLanguage *entities.SpokenLanguage) *SpokenLanguage {
+	return &SpokenLanguage{
+		ID:          spokenLanguage.SpokenLanguageID,
+		Name:        spokenLanguage.Name,
+		Level:       spokenLanguage.Level,
+		LevelLabel:  spokenLanguage.LevelLabel(),
+		Description: spokenLanguage.Description,
+		CreatedAt:   spokenLanguage.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   spokenLanguage.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Position:    spokenLanguage.Position,
+		Publishing:  publishingDto.FromPublishingEntity(spokenLanguage.Publishing),
+	}
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
 	"strings"
 	"time"
 )
@@ -11,6 +12,7 @@ import (
 type CreateTechnologyRequest struct {
 	Name    string `json:"name" validate:"required"`
 	IconURL string `json:"icon_url" validate:"required,url"`
+	publishingDto.Publishing
 } // @name CreateTechnologyRequest
 
 // @Description Request to update an existing technology
@@ -45,16 +47,17 @@ func (req *CreateTechnologyRequest) Validate() error {
 	if strings.TrimSpace(req.IconURL) == "" {
 		return domain.NewValidationError("technology icon_url cannot be empty", "technology icon_url", nil)
 	}
-	return nil
+	return req.Publishing.Check()
 }
 
 func (req *CreateTechnologyRequest) ToEntity(userID int) (*entities.Technology, error) {
 	return &entities.Technology{
-		UserID:    userID,
-		Name:      req.Name,
-		IconURL:   req.IconURL,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		UserID:     userID,
+		Name:       req.Name,
+		IconURL:    req.IconURL,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Publishing: req.Publishing.ToEntity(),
 	}, nil
 }
 
@@ -89,11 +92,12 @@ func (req *CreateBulkTechnologiesRequest) ToEntities(userID int) ([]*entities.Te
 
 	for i, techReq := range req.Technologies {
 		technologyEntities[i] = &entities.Technology{
-			Name:      strings.TrimSpace(techReq.Name),
-			IconURL:   strings.TrimSpace(techReq.IconURL),
-			UserID:    userID,
-			CreatedAt: now,
-			UpdatedAt: now,
+			Name:       strings.TrimSpace(techReq.Name),
+			IconURL:    strings.TrimSpace(techReq.IconURL),
+			UserID:     userID,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			Publishing: techReq.Publishing.ToEntity(),
 		}
 	}
 
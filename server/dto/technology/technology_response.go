@@ -2,6 +2,7 @@ package dto
 
 import (
 	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
 	"portfolio/shared"
 	"time"
 )
@@ -14,6 +15,8 @@ type Technology struct {
 	IconURL   string    `json:"icon_url"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	Position  int       `json:"position"`
+	publishingDto.Publishing
 } // @name Technology
 
 // @Description Response for a list of technologies
@@ -40,16 +43,18 @@ func FromTechnologiesEntityToResponse(technologies []*entities.Technology, meta
 		return nil
 	}
 
-	var technologyResponses []*Technology
+	technologyResponses := make([]*Technology, 0, len(technologies))
 
 	for _, technology := range technologies {
 		_technology := &Technology{
-			ID:        technology.TechnologyID,
-			UserID:    technology.UserID,
-			Name:      technology.Name,
-			IconURL:   technology.IconURL,
-			CreatedAt: technology.CreatedAt,
-			UpdatedAt: technology.UpdatedAt,
+			ID:         technology.TechnologyID,
+			UserID:     technology.UserID,
+			Name:       technology.Name,
+			IconURL:    technology.IconURL,
+			CreatedAt:  technology.CreatedAt,
+			UpdatedAt:  technology.UpdatedAt,
+			Position:   technology.Position,
+			Publishing: publishingDto.FromPublishingEntity(technology.Publishing),
 		}
 		technologyResponses = append(technologyResponses, _technology)
 	}
@@ -67,12 +72,14 @@ func FromTechnologyEntityToResponse(technology *entities.Technology, meta *share
 
 	return &TechnologyResponse{
 		Technology: &Technology{
-			ID:        technology.TechnologyID,
-			UserID:    technology.UserID,
-			Name:      technology.Name,
-			IconURL:   technology.IconURL,
-			CreatedAt: technology.CreatedAt,
-			UpdatedAt: technology.UpdatedAt,
+			ID:         technology.TechnologyID,
+			UserID:     technology.UserID,
+			Name:       technology.Name,
+			IconURL:    technology.IconURL,
+			CreatedAt:  technology.CreatedAt,
+			UpdatedAt:  technology.UpdatedAt,
+			Position:   technology.Position,
+			Publishing: publishingDto.FromPublishingEntity(technology.Publishing),
 		},
 		Meta: meta,
 	}
@@ -87,12 +94,14 @@ func FromTechnologiesEntityForBulkToResponse(technologies []*entities.Technology
 
 	for _, technology := range technologies {
 		_technology := &Technology{
-			ID:        technology.TechnologyID,
-			UserID:    technology.UserID,
-			Name:      technology.Name,
-			IconURL:   technology.IconURL,
-			CreatedAt: technology.CreatedAt,
-			UpdatedAt: technology.UpdatedAt,
+			ID:         technology.TechnologyID,
+			UserID:     technology.UserID,
+			Name:       technology.Name,
+			IconURL:    technology.IconURL,
+			CreatedAt:  technology.CreatedAt,
+			UpdatedAt:  technology.UpdatedAt,
+			Position:   technology.Position,
+			Publishing: publishingDto.FromPublishingEntity(technology.Publishing),
 		}
 		technologyResponses = append(technologyResponses, _technology)
 	}
@@ -0,0 +1,223 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	"strconv"
+	"strings"
+)
+
+// maxRejectionReasonLength bounds the reason given for a rejection.
+const maxRejectionReasonLength = 500
+
+// TestimonialFields holds the fields that submissions and updates share. A
+// testimonial can be about one project and one experience of the portfolio.
+type TestimonialFields struct {
+	AuthorName    string `json:"author_name" validate:"required,max=100" example:"Sam Example"`
+	AuthorTitle   string `json:"author_title,omitempty" validate:"omitempty,max=100" example:"Engineering Manager"`
+	AuthorCompany string `json:"author_company,omitempty" validate:"omitempty,max=100" example:"Example Corp"`
+	AuthorEmail   string `json:"author_email" validate:"required,email,max=254" example:"sam@example.com"`
+	AuthorURL     string `json:"author_url,omitempty" validate:"omitempty,url" example:"https://www.linkedin.com/in/sam-example"`
+	Content       string `json:"content" validate:"required,min=20,max=2000"`
+	ProjectID     *int   `json:"project_id,omitempty" example:"1"`
+	ExperienceID  *int   `json:"experience_id,omitempty" example:"1"`
+}
+
+// @Description Request to submit a testimonial. It waits for moderation and
+// @Description is only shown once approved. website must be left empty.
+type SubmitTestimonialRequest struct {
+	TestimonialFields
+	// Website is a honeypot: the submission form hides it, so only bots
+	// fill it in.
+	Website string `json:"website,omitempty"`
+} // @name SubmitTestimonialRequest
+
+// @Description Request to update an existing testimonial; project_id and
+// @Description experience_id left out remove the links
+type UpdateTestimonialRequest struct {
+	TestimonialFields
+} // @name UpdateTestimonialRequest
+
+// @Description Request to patch an existing testimonial. An empty
+// @Description author_title, author_company or author_url clears it; a
+// @Description project_id or experience_id of 0 removes the link.
+type PatchTestimonialRequest struct {
+	AuthorName    *string `json:"author_name,omitempty" validate:"omitempty,max=100"`
+	AuthorTitle   *string `json:"author_title,omitempty" validate:"omitempty,max=100"`
+	AuthorCompany *string `json:"author_company,omitempty" validate:"omitempty,max=100"`
+	AuthorEmail   *string `json:"author_email,omitempty" validate:"omitempty,email,max=254"`
+	AuthorURL     *string `json:"author_url,omitempty" validate:"omitempty,url"`
+	Content       *string `json:"content,omitempty" validate:"omitempty,min=20,max=2000"`
+	ProjectID     *int    `json:"project_id,omitempty"`
+	ExperienceID  *int    `json:"experience_id,omitempty"`
+} // @name PatchTestimonialRequest
+
+// @Description Request to reject a testimonial, with an optional reason
+// @Description that is only shown to the admin
+type RejectTestimonialRequest struct {
+	Reason string `json:"reason,omitempty" validate:"omitempty,max=500" example:"Not a former colleague"`
+} // @name RejectTestimonialRequest
+
+// Validate adds the errors of the fields to validator.
+func (f *TestimonialFields) Validate(validator *validation.Validator) {
+	validator.Required("author_name", strings.TrimSpace(f.AuthorName))
+	validator.MaxLength("author_name", f.AuthorName, 100)
+	validator.MaxLength("author_title", f.AuthorTitle, 100)
+	validator.MaxLength("author_company", f.AuthorCompany, 100)
+	validator.Required("author_email", strings.TrimSpace(f.AuthorEmail))
+	validator.Email("author_email", strings.TrimSpace(f.AuthorEmail))
+	validator.MaxLength("author_email", f.AuthorEmail, 254)
+	validator.URL("author_url", strings.TrimSpace(f.AuthorURL))
+	validateContent(validator, f.Content)
+	validateSubjectID(validator, "project_id", f.ProjectID)
+	validateSubjectID(validator, "experience_id", f.ExperienceID)
+}
+
+func (req *SubmitTestimonialRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.TestimonialFields.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// IsBot reports whether the honeypot field is filled in.
+func (req *SubmitTestimonialRequest) IsBot() bool {
+	return strings.TrimSpace(req.Website) != ""
+}
+
+// ToEntity returns the submission as a pending testimonial for the
+// portfolio owner.
+func (req *SubmitTestimonialRequest) ToEntity(userID int) (*entities.Testimonial, error) {
+	testimonial := &entities.Testimonial{
+		UserID: userID,
+		Status: entities.TestimonialStatusPending,
+	}
+	req.TestimonialFields.apply(testimonial)
+	return testimonial, nil
+}
+
+func (req *UpdateTestimonialRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.TestimonialFields.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *UpdateTestimonialRequest) ToEntity(id, userID int) (*entities.Testimonial, error) {
+	testimonial := &entities.Testimonial{
+		TestimonialID: id,
+		UserID:        userID,
+	}
+	req.TestimonialFields.apply(testimonial)
+	return testimonial, nil
+}
+
+func (req *PatchTestimonialRequest) Validate() error {
+	validator := validation.NewValidator()
+	if req.AuthorName != nil {
+		validator.Required("author_name", strings.TrimSpace(*req.AuthorName))
+		validator.MaxLength("author_name", *req.AuthorName, 100)
+	}
+	if req.AuthorTitle != nil {
+		validator.MaxLength("author_title", *req.AuthorTitle, 100)
+	}
+	if req.AuthorCompany != nil {
+		validator.MaxLength("author_company", *req.AuthorCompany, 100)
+	}
+	if req.AuthorEmail != nil {
+		validator.Required("author_email", strings.TrimSpace(*req.AuthorEmail))
+		validator.Email("author_email", strings.TrimSpace(*req.AuthorEmail))
+		validator.MaxLength("author_email", *req.AuthorEmail, 254)
+	}
+	if req.AuthorURL != nil {
+		validator.URL("author_url", strings.TrimSpace(*req.AuthorURL))
+	}
+	if req.Content != nil {
+		validateContent(validator, *req.Content)
+	}
+	if req.ProjectID != nil {
+		validator.Custom("project_id", *req.ProjectID >= 0, "project_id must be a positive integer, or 0 to remove the link")
+	}
+	if req.ExperienceID != nil {
+		validator.Custom("experience_id", *req.ExperienceID >= 0, "experience_id must be a positive integer, or 0 to remove the link")
+	}
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// ApplyTo sets the fields present in the request on testimonial.
+func (req *PatchTestimonialRequest) ApplyTo(testimonial *entities.Testimonial) {
+	if req.AuthorName != nil {
+		testimonial.AuthorName = strings.TrimSpace(*req.AuthorName)
+	}
+	if req.AuthorTitle != nil {
+		testimonial.AuthorTitle = strings.TrimSpace(*req.AuthorTitle)
+	}
+	if req.AuthorCompany != nil {
+		testimonial.AuthorCompany = strings.TrimSpace(*req.AuthorCompany)
+	}
+	if req.AuthorEmail != nil {
+		testimonial.AuthorEmail = strings.TrimSpace(*req.AuthorEmail)
+	}
+	if req.AuthorURL != nil {
+		testimonial.AuthorURL = strings.TrimSpace(*req.AuthorURL)
+	}
+	if req.Content != nil {
+		testimonial.Content = strings.TrimSpace(*req.Content)
+	}
+	if req.ProjectID != nil {
+		testimonial.ProjectID = subjectID(*req.ProjectID)
+	}
+	if req.ExperienceID != nil {
+		testimonial.ExperienceID = subjectID(*req.ExperienceID)
+	}
+}
+
+func (req *RejectTestimonialRequest) Validate() error {
+	validator := validation.NewValidator()
+	validator.MaxLength("reason", req.Reason, maxRejectionReasonLength)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// apply sets the fields on testimonial.
+func (f *TestimonialFields) apply(testimonial *entities.Testimonial) {
+	testimonial.AuthorName = strings.TrimSpace(f.AuthorName)
+	testimonial.AuthorTitle = strings.TrimSpace(f.AuthorTitle)
+	testimonial.AuthorCompany = strings.TrimSpace(f.AuthorCompany)
+	testimonial.AuthorEmail = strings.TrimSpace(f.AuthorEmail)
+	testimonial.AuthorURL = strings.TrimSpace(f.AuthorURL)
+	testimonial.Content = strings.TrimSpace(f.Content)
+	testimonial.ProjectID = f.ProjectID
+	testimonial.ExperienceID = f.ExperienceID
+}
+
+func validateContent(validator *validation.Validator, content string) {
+	content = strings.TrimSpace(content)
+	validator.Required("content", content)
+	validator.Custom("content", content == "" || len([]rune(content)) >= entities.MinTestimonialContentLength,
+		"Content must be at least "+strconv.Itoa(entities.MinTestimonialContentLength)+" characters long")
+	validator.MaxLength("content", content, entities.MaxTestimonialContentLength)
+}
+
+func validateSubjectID(validator *validation.Validator, field string, id *int) {
+	if id != nil {
+		validator.Custom(field, *id > 0, field+" must be a positive integer")
+	}
+}
+
+// subjectID turns the ID of a patch into a link, where 0 removes it.
+func subjectID(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,156 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description Testimonial represents a recommendation from a colleague or a
+// @Description client. The author's email and the moderation fields are
+// @Description only returned to the admin.
+type Testimonial struct {
+	ID              int                    `json:"id"`
+	AuthorName      string                 `json:"author_name"`
+	AuthorTitle     string                 `json:"author_title"`
+	AuthorCompany   string                 `json:"author_company"`
+	AuthorEmail     string                 `json:"author_email,omitempty"`
+	AuthorURL       string                 `json:"author_url"`
+	Content         string                 `json:"content"`
+	Project         *TestimonialProject    `json:"project"`
+	Experience      *TestimonialExperience `json:"experience"`
+	Status          string                 `json:"status,omitempty" enums:"pending,approved,rejected"`
+	RejectionReason string                 `json:"rejection_reason,omitempty"`
+	ModeratedAt     *time.Time             `json:"moderated_at,omitempty"`
+	CreatedAt       string                 `json:"created_at"`
+	UpdatedAt       string                 `json:"updated_at"`
+	Position        int                    `json:"position"`
+} // @name Testimonial
+
+// @Description TestimonialProject is the project a testimonial is about
+type TestimonialProject struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+} // @name TestimonialProject
+
+// @Description TestimonialExperience is the experience a testimonial is about
+type TestimonialExperience struct {
+	ID          int    `json:"id"`
+	JobTitle    string `json:"job_title"`
+	CompanyName string `json:"company_name"`
+} // @name TestimonialExperience
+
+// @Description Response for a list of testimonials
+type TestimonialListResponse struct {
+	Testimonials []*Testimonial `json:"testimonials"`
+	Meta         *shared.Meta   `json:"meta"`
+} // @name TestimonialListResponse
+
+// @Description Response for a testimonial
+type TestimonialResponse struct {
+	Testimonial *Testimonial `json:"testimonial"`
+	Meta        *shared.Meta `json:"meta"`
+} // @name TestimonialResponse
+
+// @Description Response for a testimonial submission, which waits for
+// @Description moderation
+type TestimonialSubmissionResponse struct {
+	Status  string       `json:"status" example:"pending"`
+	Message string       `json:"message"`
+	Meta    *shared.Meta `json:"meta"`
+} // @name TestimonialSubmissionResponse
+
+func FromTestimonialEntityToResponse(testimonial *entities.Testimonial, meta *shared.Meta) *TestimonialResponse {
+	if testimonial == nil {
+		return nil
+	}
+
+	return &TestimonialResponse{
+		Testimonial: fromTestimonialEntity(testimonial),
+		Meta:        meta,
+	}
+}
+
+func FromTestimonialsEntityToResponse(testimonials []*entities.Testimonial, meta *shared.Meta) *TestimonialListResponse {
+	if testimonials == nil {
+		return nil
+	}
+
+	testimonialResponses := make([]*Testimonial, 0, len(testimonials))
+	for _, testimonial := range testimonials {
+		testimonialResponses = append(testimonialResponses, fromTestimonialEntity(testimonial))
+	}
+
+	return &TestimonialListResponse{
+		Testimonials: testimonialResponses,
+		Meta:         meta,
+	}
+}
+
+// FromPublicTestimonialEntityToResponse returns an approved testimonial as
+// visitors see it: without the author's email and the moderation fields,
+// and only linked to a published project or experience.
+func FromPublicTestimonialEntityToResponse(testimonial *entities.Testimonial, meta *shared.Meta) *TestimonialResponse {
+	if testimonial == nil {
+		return nil
+	}
+
+	return &TestimonialResponse{
+		Testimonial: fromPublicTestimonialEntity(testimonial),
+		Meta:        meta,
+	}
+}
+
+func FromPublicTestimonialsEntityToResponse(testimonials []*entities.Testimonial, meta *shared.Meta) *TestimonialListResponse {
+	if testimonials == nil {
+		return nil
+	}
+
+	testimonialResponses := make([]*Testimonial, 0, len(testimonials))
+	for _, testimonial := range testimonials {
+		testimonialResponses = append(testimonialResponses, fromPublicTestimonialEntity(testimonial))
+	}
+
+	return &TestimonialListResponse{
+		Testimonials: testimonialResponses,
+		Meta:         meta,
+	}
+}
+
+func fromPublicTestimonialEntity(testimonial *entities.Testimonial) *Testimonial {
+	response := fromTestimonialEntity(testimonial.WithPublishedSubjects())
+	response.AuthorEmail = ""
+	response.Status = ""
+	response.RejectionReason = ""
+	response.ModeratedAt = nil
+	return response
+}
+
+func fromTestimonialEntity(testimonial *entities.Testimonial) *Testimonial {
+	response := &Testimonial{
+		ID:              testimonial.TestimonialID,
+		AuthorName:      testimonial.AuthorName,
+		AuthorTitle:     testimonial.AuthorTitle,
+		AuthorCompany:   testimonial.AuthorCompany,
+		AuthorEmail:     testimonial.AuthorEmail,
+		AuthorURL:       testimonial.AuthorURL,
+		Content:         testimonial.Content,
+		Status:          testimonial.Status,
+		RejectionReason: testimonial.RejectionReason,
+		ModeratedAt:     testimonial.ModeratedAt,
+		CreatedAt:       testimonial.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       testimonial.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Position:        testimonial.Position,
+	}
+	if testimonial.Project != nil {
+		response.Project = &TestimonialProject{ID: testimonial.Project.ID, Title: testimonial.Project.Title}
+	}
+	if testimonial.Experience != nil {
+		response.Experience = &TestimonialExperience{
+			ID:          testimonial.Experience.ID,
+			JobTitle:    testimonial.Experience.Title,
+			CompanyName: testimonial.Experience.Organization,
+		}
+	}
+	return response
+}
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description TrashItem represents a soft-deleted entity waiting in the trash
+type TrashItem struct {
+	Type      string    `json:"type"`
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id"`
+	Label     string    `json:"label"`
+	DeletedAt time.Time `json:"deleted_at"`
+} // @name TrashItem
+
+// @Description Response for the content of the trash
+type TrashListResponse struct {
+	Items []*TrashItem `json:"items"`
+	Meta  *shared.Meta `json:"meta"`
+} //@name TrashListResponse
+
+func FromTrashItemsEntityToResponse(items []*entities.TrashItem, meta *shared.Meta) *TrashListResponse {
+	itemResponses := make([]*TrashItem, 0, len(items))
+
+	for _, item := range items {
+		itemResponses = append(itemResponses, &TrashItem{
+			Type:      item.Type,
+			ID:        item.ID,
+			UserID:    item.UserID,
+			Label:     item.Label,
+			DeletedAt: item.DeletedAt,
+		})
+	}
+
+	return &TrashListResponse{
+		Items: itemResponses,
+		Meta:  meta,
+	}
+}
@@ -0,0 +1,246 @@
+package dto
+
+import (
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/validation"
+	publishingDto "portfolio/dto/publishing"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxBulkVolunteerings bounds the volunteerings of one bulk request.
+const maxBulkVolunteerings = 50
+
+// VolunteeringFields holds the fields that create and update requests
+// share. Dates are YYYY-MM-DD; a volunteering without an end_date is
+// ongoing.
+type VolunteeringFields struct {
+	Role         string `json:"role" validate:"required,max=150" example:"Mentor"`
+	Organization string `json:"organization" validate:"required,max=150" example:"Code Club"`
+	Cause        string `json:"cause,omitempty" validate:"omitempty,max=100" example:"Education"`
+	StartDate    string `json:"start_date" validate:"required" example:"2022-09-01"`
+	EndDate      string `json:"end_date,omitempty" example:"2023-06-30"`
+	URL          string `json:"url,omitempty" validate:"omitempty,url" example:"https://example.com/code-club"`
+	Description  string `json:"description,omitempty" validate:"omitempty,max=2000"`
+}
+
+// @Description Request to create a volunteering
+type CreateVolunteeringRequest struct {
+	VolunteeringFields
+	publishingDto.Publishing
+} // @name CreateVolunteeringRequest
+
+// @Description Request to create multiple volunteerings in bulk
+type CreateBulkVolunteeringsRequest struct {
+	Volunteerings []CreateVolunteeringRequest `json:"volunteerings" validate:"required"`
+} // @name CreateBulkVolunteeringsRequest
+
+// @Description Request to update an existing volunteering
+type UpdateVolunteeringRequest struct {
+	VolunteeringFields
+} // @name UpdateVolunteeringRequest
+
+// @Description Request to patch an existing volunteering. An empty cause,
+// @Description end_date, url or description clears it; clearing the
+// @Description end_date makes the volunteering ongoing.
+type PatchVolunteeringRequest struct {
+	Role         *string `json:"role,omitempty" validate:"omitempty,max=150"`
+	Organization *string `json:"organization,omitempty" validate:"omitempty,max=150"`
+	Cause        *string `json:"cause,omitempty" validate:"omitempty,max=100"`
+	StartDate    *string `json:"start_date,omitempty" example:"2022-09-01"`
+	EndDate      *string `json:"end_date,omitempty" example:"2023-06-30"`
+	URL          *string `json:"url,omitempty" validate:"omitempty,url"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,max=2000"`
+} // @name PatchVolunteeringRequest
+
+// Validate adds the errors of the fields to validator.
+func (f *VolunteeringFields) Validate(validator *validation.Validator) {
+	validator.Required("role", f.Role)
+	validator.MaxLength("role", f.Role, 150)
+	validator.Required("organization", f.Organization)
+	validator.MaxLength("organization", f.Organization, 150)
+	validator.MaxLength("cause", f.Cause, 100)
+	validator.URL("url", strings.TrimSpace(f.URL))
+	validator.MaxLength("description", f.Description, 2000)
+
+	validator.Required("start_date", f.StartDate)
+	startDate, startDateOK := validateVolunteeringDate(validator, "start_date", f.StartDate)
+	endDate, endDateOK := validateVolunteeringDate(validator, "end_date", f.EndDate)
+	if startDateOK && endDateOK {
+		validator.Custom("end_date", !endDate.Before(startDate), "End date must be after start date")
+	}
+}
+
+func (req *CreateVolunteeringRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.VolunteeringFields.Validate(validator)
+	req.Publishing.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *CreateVolunteeringRequest) ToEntity(userID int) (*entities.Volunteering, error) {
+	volunteering := &entities.Volunteering{
+		UserID:     userID,
+		Publishing: req.Publishing.ToEntity(),
+	}
+	req.VolunteeringFields.apply(volunteering)
+	return volunteering, nil
+}
+
+func (req *CreateBulkVolunteeringsRequest) Validate() error {
+	if len(req.Volunteerings) == 0 {
+		return domain.NewValidationError("At least one volunteering is required", "volunteerings", nil)
+	}
+
+	if len(req.Volunteerings) > maxBulkVolunteerings {
+		return domain.NewValidationError("Cannot create more than "+strconv.Itoa(maxBulkVolunteerings)+" volunteerings at once", "volunteerings", nil)
+	}
+
+	for i, volunteering := range req.Volunteerings {
+		if err := volunteering.Validate(); err != nil {
+			return domain.NewValidationError("Volunteering "+strconv.Itoa(i+1)+": "+err.Error(), "volunteerings", &err)
+		}
+	}
+
+	return nil
+}
+
+func (req *CreateBulkVolunteeringsRequest) ToEntities(userID int) ([]*entities.Volunteering, error) {
+	volunteerings := make([]*entities.Volunteering, 0, len(req.Volunteerings))
+	for _, volunteeringReq := range req.Volunteerings {
+		volunteering, err := volunteeringReq.ToEntity(userID)
+		if err != nil {
+			return nil, err
+		}
+		volunteerings = append(volunteerings, volunteering)
+	}
+	return volunteerings, nil
+}
+
+func (req *UpdateVolunteeringRequest) Validate() error {
+	validator := validation.NewValidator()
+	req.VolunteeringFields.Validate(validator)
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+func (req *UpdateVolunteeringRequest) ToEntity(id, userID int) (*entities.Volunteering, error) {
+	volunteering := &entities.Volunteering{
+		VolunteeringID: id,
+		UserID:         userID,
+	}
+	req.VolunteeringFields.apply(volunteering)
+	return volunteering, nil
+}
+
+func (req *PatchVolunteeringRequest) Validate() error {
+	validator := validation.NewValidator()
+	if req.Role != nil {
+		validator.Required("role", *req.Role)
+		validator.MaxLength("role", *req.Role, 150)
+	}
+	if req.Organization != nil {
+		validator.Required("organization", *req.Organization)
+		validator.MaxLength("organization", *req.Organization, 150)
+	}
+	if req.Cause != nil {
+		validator.MaxLength("cause", *req.Cause, 100)
+	}
+	if req.URL != nil {
+		validator.URL("url", strings.TrimSpace(*req.URL))
+	}
+	if req.Description != nil {
+		validator.MaxLength("description", *req.Description, 2000)
+	}
+	if req.StartDate != nil {
+		validator.Required("start_date", *req.StartDate)
+		validateVolunteeringDate(validator, "start_date", *req.StartDate)
+	}
+	if req.EndDate != nil {
+		validateVolunteeringDate(validator, "end_date", *req.EndDate)
+	}
+	if validator.HasErrors() {
+		return validator.FirstError()
+	}
+	return nil
+}
+
+// ApplyTo sets the fields present in the request on volunteering. The
+// dates are checked against each other once both are known.
+func (req *PatchVolunteeringRequest) ApplyTo(volunteering *entities.Volunteering) error {
+	if req.Role != nil {
+		volunteering.Role = strings.TrimSpace(*req.Role)
+	}
+	if req.Organization != nil {
+		volunteering.Organization = strings.TrimSpace(*req.Organization)
+	}
+	if req.Cause != nil {
+		volunteering.Cause = strings.TrimSpace(*req.Cause)
+	}
+	if req.URL != nil {
+		volunteering.URL = strings.TrimSpace(*req.URL)
+	}
+	if req.Description != nil {
+		volunteering.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.StartDate != nil {
+		volunteering.StartDate = parseVolunteeringDate(*req.StartDate)
+	}
+	if req.EndDate != nil {
+		volunteering.EndDate = parseOptionalVolunteeringDate(*req.EndDate)
+	}
+
+	if volunteering.HasEndDate() && volunteering.EndDate.Before(volunteering.StartDate) {
+		return domain.NewValidationError("End date must be after start date", "end_date", nil)
+	}
+	return nil
+}
+
+// apply sets the fields on volunteering.
+func (f *VolunteeringFields) apply(volunteering *entities.Volunteering) {
+	volunteering.Role = strings.TrimSpace(f.Role)
+	volunteering.Organization = strings.TrimSpace(f.Organization)
+	volunteering.Cause = strings.TrimSpace(f.Cause)
+	volunteering.StartDate = parseVolunteeringDate(f.StartDate)
+	volunteering.EndDate = parseOptionalVolunteeringDate(f.EndDate)
+	volunteering.URL = strings.TrimSpace(f.URL)
+	volunteering.Description = strings.TrimSpace(f.Description)
+}
+
+// validateVolunteeringDate checks a YYYY-MM-DD date that is not in the
+// future, if any, and returns it with whether it is set and valid.
+func validateVolunteeringDate(validator *validation.Validator, field, value string) (time.Time, bool) {
+	if strings.TrimSpace(value) == "" {
+		return time.Time{}, false
+	}
+	date, err := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	if err != nil {
+		validator.Custom(field, false, "Date must be in YYYY-MM-DD format")
+		return time.Time{}, false
+	}
+	validator.DateNotFuture(field, date)
+	return date, true
+}
+
+// parseVolunteeringDate parses a date checked by validateVolunteeringDate.
+func parseVolunteeringDate(value string) time.Time {
+	date, _ := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	return date
+}
+
+// parseOptionalVolunteeringDate parses a date checked by
+// validateVolunteeringDate; an empty one is nil.
+func parseOptionalVolunteeringDate(value string) *time.Time {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	date := parseVolunteeringDate(value)
+	return &date
+}
@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"portfolio/domain/entities"
+	publishingDto "portfolio/dto/publishing"
+	"portfolio/shared"
+	"time"
+)
+
+// @Description Volunteering represents volunteer work in the portfolio.
+// @Description end_date is null and current is true while it is ongoing.
+type Volunteering struct {
+	ID           int     `json:"id"`
+	Role         string  `json:"role"`
+	Organization string  `json:"organization"`
+	Cause        string  `json:"cause"`
+	StartDate    string  `json:"start_date" example:"2022-09-01"`
+	EndDate      *string `json:"end_date" example:"2023-06-30"`
+	Current      bool    `json:"current"`
+	URL          string  `json:"url"`
+	Description  string  `json:"description"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+	Position     int     `json:"position"`
+	publishingDto.Publishing
+} // @name Volunteering
+
+// @Description Response for a list of volunteerings
+type VolunteeringListResponse struct {
+	Volunteerings []*Volunteering    `json:"volunteerings"`
+	Meta          *shared.Meta       `json:"meta"`
+	Errors        []*shared.APIError `json:"errors,omitempty"`
+} // @name VolunteeringListResponse
+
+// @Description Response for a volunteering
+type VolunteeringResponse struct {
+	Volunteering *Volunteering `json:"volunteering"`
+	Meta         *shared.Meta  `json:"meta"`
+} // @name VolunteeringResponse
+
+func FromVolunteeringEntityToResponse(volunteering *entities.Volunteering, meta *shared.Meta) *VolunteeringResponse {
+	if volunteering == nil {
+		return nil
+	}
+
+	return &VolunteeringResponse{
+		Volunteering: fromVolunteeringEntity(volunteering),
+		Meta:         meta,
+	}
+}
+
+func FromVolunteeringsEntityToResponse(volunteerings []*entities.Volunteering, meta *shared.Meta) *VolunteeringListResponse {
+	if volunteerings == nil {
+		return nil
+	}
+
+	volunteeringResponses := make([]*Volunteering, 0, len(volunteerings))
+	for _, volunteering := range volunteerings {
+		volunteeringResponses = append(volunteeringResponses, fromVolunteeringEntity(volunteering))
+	}
+
+	return &VolunteeringListResponse{
+		Volunteerings: volunteeringResponses,
+		Meta:          meta,
+	}
+}
+
+func FromVolunteeringsEntityForBulkToResponse(volunteerings []*entities.Volunteering, meta *shared.Meta) *VolunteeringListResponse {
+	response := FromVolunteeringsEntityToResponse(volunteerings, meta)
+	if response == nil {
+		return &VolunteeringListResponse{Volunteerings: []*Volunteering{}, Meta: meta}
+	}
+	return response
+}
+
+func fromVolunteeringEntity(volunteering *entities.Volunteering) *Volunteering {
+	response := &Volunteering{
+		ID:           volunteering.VolunteeringID,
+		Role:         volunteering.Role,
+		Organization: volunteering.Organization,
+		Cause:        volunteering.Cause,
+		StartDate:    volunteering.StartDate.Format(time.DateOnly),
+		Current:      volunteering.IsCurrent(),
+		URL:          volunteering.URL,
+		Description:  volunteering.Description,
+		CreatedAt:    volunteering.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    volunteering.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Position:     volunteering.Position,
+		Publishing:   publishingDto.FromPublishingEntity(volunteering.Publishing),
+	}
+
+	if volunteering.HasEndDate() {
+		endDate := volunteering.EndDate.Format(time.DateOnly)
+		response.EndDate = &endDate
+	}
+
+	return response
+}
@@ -0,0 +1,165 @@
+// Package conformance is the repository test suite shared by the storage
+// backends. Each backend runs it from its own tests with a Factory that
+// opens an empty store, so that the SQLite, PostgreSQL and in-memory
+// repositories are held to the same unique, not-found, soft-delete,
+// versioning, listing and transaction behaviour.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"testing"
+	"time"
+)
+
+// Repositories are the repositories of one backend, all over the same
+// store.
+type Repositories struct {
+	User           interfaces.UserRepository
+	Setting        interfaces.SettingRepository
+	Technology     interfaces.TechnologyRepository
+	Project        interfaces.ProjectRepository
+	Search         interfaces.SearchRepository
+	Certification  interfaces.CertificationRepository
+	SpokenLanguage interfaces.SpokenLanguageRepository
+	Volunteering   interfaces.VolunteeringRepository
+	Trash          interfaces.TrashRepository
+	UnitOfWork     interfaces.UnitOfWork
+}
+
+// Factory opens an empty store for one test and releases it when the test
+// ends.
+type Factory func(t *testing.T) *Repositories
+
+// Run runs the whole suite, each case on a store of its own.
+func Run(t *testing.T, newRepositories Factory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, repos *Repositories)
+	}{
+		{"UniqueViolation", testUniqueViolation},
+		{"NotFound", testNotFound},
+		{"SoftDelete", testSoftDelete},
+		{"ExpectedVersion", testExpectedVersion},
+		{"UnitOfWorkRollback", testUnitOfWorkRollback},
+		{"UnitOfWorkCommit", testUnitOfWorkCommit},
+		{"Settings", testSettings},
+		{"ListPages", testListPages},
+		{"ListErrors", testListErrors},
+		{"ListCursorScope", testListCursorScope},
+		{"Reorder", testReorder},
+		{"CertificationExpiry", testCertificationExpiry},
+		{"SpokenLanguageNames", testSpokenLanguageNames},
+		{"SpokenLanguageLevelSort", testSpokenLanguageLevelSort},
+		{"VolunteeringCurrent", testVolunteeringCurrent},
+		{"SearchVisibility", testSearchVisibility},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, newRepositories(t))
+		})
+	}
+}
+
+var published = entities.Publishing{State: entities.PublishingStatePublished}
+
+func createUser(t *testing.T, repos *Repositories, username string) int {
+	t.Helper()
+
+	now := time.Now()
+	user, err := repos.User.CreateUser(t.Context(), &entities.User{
+		Username:  username,
+		Email:     username + "@example.com",
+		Password:  "hashed",
+		Role:      entities.RoleAdmin,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser(%q) failed: %v", username, err)
+	}
+	return user.ID
+}
+
+func createTechnology(t *testing.T, repos *Repositories, ctx context.Context, userID int, name string) *entities.Technology {
+	t.Helper()
+
+	technology, err := repos.Technology.Create(ctx, &entities.Technology{
+		UserID:     userID,
+		Name:       name,
+		IconURL:    "https://example.com/" + name + ".svg",
+		Publishing: published,
+	})
+	if err != nil {
+		t.Fatalf("Create technology %q failed: %v", name, err)
+	}
+	return technology
+}
+
+func getTechnology(t *testing.T, repos *Repositories, technologyID int) *entities.Technology {
+	t.Helper()
+
+	technology, err := repos.Technology.GetByID(t.Context(), technologyID)
+	if err != nil {
+		t.Fatalf("GetByID(%d) failed: %v", technologyID, err)
+	}
+	return technology
+}
+
+func listTechnologies(t *testing.T, repos *Repositories, userID int, query *entities.ListQuery) *entities.ListPage[*entities.Technology] {
+	t.Helper()
+
+	page, err := repos.Technology.GetByUserID(t.Context(), userID, query)
+	if err != nil {
+		t.Fatalf("GetByUserID(%d, %s) failed: %v", userID, query, err)
+	}
+	return page
+}
+
+// names returns the result of name for every item, in order.
+func names[T any](items []T, name func(T) string) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, name(item))
+	}
+	return result
+}
+
+func technologyName(technology *entities.Technology) string { return technology.Name }
+
+func assertNames(t *testing.T, what string, got, want []string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s = %q, want %q", what, got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("%s = %q, want %q", what, got, want)
+		}
+	}
+}
+
+// assertCode fails unless err is a domain error with code.
+func assertCode(t *testing.T, what string, err error, code domain.ErrorCode) {
+	t.Helper()
+
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) {
+		t.Fatalf("%s returned %v, want a %s error", what, err, code)
+	}
+	if domainErr.Code != code {
+		t.Fatalf("%s returned %v, want a %s error", what, err, code)
+	}
+}
+
+// day returns midnight UTC of the day offset days from today.
+func day(offset int) time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, offset)
+}
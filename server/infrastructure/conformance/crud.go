@@ -0,0 +1,315 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+// testUniqueViolation checks that unique keys are enforced per user and
+// reported as database errors.
+func testUniqueViolation(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	alice := createUser(t, repos, "alice")
+	bob := createUser(t, repos, "bob")
+
+	_, err := repos.User.CreateUser(ctx, &entities.User{Username: "alice", Email: "other@example.com", Role: entities.RoleAdmin})
+	assertCode(t, "CreateUser with a taken username", err, domain.ErrCodeDatabase)
+
+	createTechnology(t, repos, ctx, alice, "Go")
+	_, err = repos.Technology.Create(ctx, &entities.Technology{UserID: alice, Name: "Go", IconURL: "https://example.com/go.svg", Publishing: published})
+	assertCode(t, "Create with a taken technology name", err, domain.ErrCodeDatabase)
+
+	// The key is per user.
+	createTechnology(t, repos, ctx, bob, "Go")
+
+	rust := createTechnology(t, repos, ctx, alice, "Rust")
+	rust.Name = "Go"
+	_, err = repos.Technology.Update(ctx, rust.TechnologyID, rust)
+	assertCode(t, "Update to a taken technology name", err, domain.ErrCodeDatabase)
+	if got := getTechnology(t, repos, rust.TechnologyID); got.Name != "Rust" {
+		t.Fatalf("technology renamed to %q by a rejected update", got.Name)
+	}
+}
+
+// testNotFound checks that missing rows read as nil without an error, and
+// that the trash reports them as not found.
+func testNotFound(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+
+	user, err := repos.User.GetByUsername(ctx, "nobody")
+	if err != nil || user != nil {
+		t.Fatalf("GetByUsername(missing) = %v, %v; want nil, nil", user, err)
+	}
+
+	technology, err := repos.Technology.GetByID(ctx, 404)
+	if err != nil || technology != nil {
+		t.Fatalf("GetByID(missing) = %v, %v; want nil, nil", technology, err)
+	}
+	exists, err := repos.Technology.ExistsByID(ctx, 404)
+	if err != nil || exists {
+		t.Fatalf("ExistsByID(missing) = %v, %v; want false, nil", exists, err)
+	}
+
+	updated, err := repos.Technology.Update(ctx, 404, &entities.Technology{UserID: userID, Name: "Go", IconURL: "https://example.com/go.svg"})
+	if err != nil || updated != nil {
+		t.Fatalf("Update(missing) = %v, %v; want nil, nil", updated, err)
+	}
+	if err := repos.Technology.Delete(ctx, 404); err != nil {
+		t.Fatalf("Delete(missing) failed: %v", err)
+	}
+
+	err = repos.Trash.Restore(ctx, entities.TrashTypeTechnology, 404)
+	assertCode(t, "Restore of a missing trash item", err, domain.ErrCodeNotFound)
+	err = repos.Trash.Purge(ctx, entities.TrashTypeTechnology, 404)
+	assertCode(t, "Purge of a missing trash item", err, domain.ErrCodeNotFound)
+
+	// A live row is not in the trash either.
+	live := createTechnology(t, repos, ctx, userID, "Go")
+	err = repos.Trash.Restore(ctx, entities.TrashTypeTechnology, live.TechnologyID)
+	assertCode(t, "Restore of a live row", err, domain.ErrCodeNotFound)
+
+	err = repos.Trash.Restore(ctx, "unknown", live.TechnologyID)
+	assertCode(t, "Restore of an unknown trash type", err, domain.ErrCodeValidation)
+}
+
+// testSoftDelete checks that deleted rows leave the live reads, keep their
+// unique keys in the trash, and come back when restored.
+func testSoftDelete(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+	technology := createTechnology(t, repos, ctx, userID, "Go")
+	createTechnology(t, repos, ctx, userID, "Rust")
+
+	if err := repos.Technology.Delete(ctx, technology.TechnologyID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	if got := getTechnology(t, repos, technology.TechnologyID); got != nil {
+		t.Fatalf("GetByID returned trashed technology %+v", got)
+	}
+	if exists, err := repos.Technology.ExistsByID(ctx, technology.TechnologyID); err != nil || exists {
+		t.Fatalf("ExistsByID(trashed) = %v, %v; want false, nil", exists, err)
+	}
+	page := listTechnologies(t, repos, userID, &entities.ListQuery{Size: 10})
+	assertNames(t, "live technologies", names(page.Items, technologyName), []string{"Rust"})
+	if page.Total != 1 {
+		t.Fatalf("Total = %d, want 1", page.Total)
+	}
+
+	items, err := repos.Trash.GetAll(ctx)
+	if err != nil {
+		t.Fatalf("Trash.GetAll failed: %v", err)
+	}
+	if !slices.ContainsFunc(items, func(item *entities.TrashItem) bool {
+		return item.Type == entities.TrashTypeTechnology && item.ID == technology.TechnologyID && item.UserID == userID
+	}) {
+		t.Fatalf("trash %+v lacks technology %d", items, technology.TechnologyID)
+	}
+
+	// Trashed rows keep their unique keys until purged.
+	if exists, err := repos.Technology.ExistsByNameAndUserID(ctx, "Go", userID); err != nil || !exists {
+		t.Fatalf("ExistsByNameAndUserID(trashed) = %v, %v; want true, nil", exists, err)
+	}
+	_, err = repos.Technology.Create(ctx, &entities.Technology{UserID: userID, Name: "Go", IconURL: "https://example.com/go.svg", Publishing: published})
+	assertCode(t, "Create with the name of a trashed technology", err, domain.ErrCodeDatabase)
+
+	if err := repos.Trash.Restore(ctx, entities.TrashTypeTechnology, technology.TechnologyID); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if got := getTechnology(t, repos, technology.TechnologyID); got == nil || got.Name != "Go" {
+		t.Fatalf("GetByID after restore = %+v, want Go", got)
+	}
+
+	if err := repos.Technology.Delete(ctx, technology.TechnologyID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repos.Trash.Purge(ctx, entities.TrashTypeTechnology, technology.TechnologyID); err != nil {
+		t.Fatalf("Purge failed: %v", err)
+	}
+	if exists, err := repos.Technology.ExistsByNameAndUserID(ctx, "Go", userID); err != nil || exists {
+		t.Fatalf("ExistsByNameAndUserID(purged) = %v, %v; want false, nil", exists, err)
+	}
+	createTechnology(t, repos, ctx, userID, "Go")
+
+	// Retention only purges what was deleted before the cutoff.
+	rust := page.Items[0]
+	if err := repos.Technology.Delete(ctx, rust.TechnologyID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if purged, err := repos.Trash.PurgeDeletedBefore(ctx, time.Now().Add(-time.Hour)); err != nil || purged != 0 {
+		t.Fatalf("PurgeDeletedBefore(an hour ago) = %d, %v; want 0, nil", purged, err)
+	}
+	if purged, err := repos.Trash.PurgeDeletedBefore(ctx, time.Now().Add(time.Hour)); err != nil || purged != 1 {
+		t.Fatalf("PurgeDeletedBefore(in an hour) = %d, %v; want 1, nil", purged, err)
+	}
+}
+
+// testExpectedVersion checks the optimistic concurrency of writes made under
+// domain.WithExpectedVersion.
+func testExpectedVersion(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+	technology := getTechnology(t, repos, createTechnology(t, repos, ctx, userID, "Go").TechnologyID)
+	if technology.Version != 1 {
+		t.Fatalf("Version of a new technology = %d, want 1", technology.Version)
+	}
+
+	technology.IconURL = "https://example.com/go-2.svg"
+	updated, err := repos.Technology.Update(domain.WithExpectedVersion(ctx, 1), technology.TechnologyID, technology)
+	if err != nil {
+		t.Fatalf("Update at the current version failed: %v", err)
+	}
+	if updated.Version != 2 || updated.IconURL != technology.IconURL {
+		t.Fatalf("Update returned %+v, want version 2 with the new icon", updated)
+	}
+
+	technology.IconURL = "https://example.com/go-stale.svg"
+	_, err = repos.Technology.Update(domain.WithExpectedVersion(ctx, 1), technology.TechnologyID, technology)
+	assertCode(t, "Update at a stale version", err, domain.ErrCodePreconditionFailed)
+
+	_, err = repos.Technology.Patch(domain.WithExpectedVersion(ctx, 1), technology.TechnologyID, &entities.Technology{Name: "Golang"})
+	assertCode(t, "Patch at a stale version", err, domain.ErrCodePreconditionFailed)
+
+	err = repos.Technology.Delete(domain.WithExpectedVersion(ctx, 1), technology.TechnologyID)
+	assertCode(t, "Delete at a stale version", err, domain.ErrCodePreconditionFailed)
+
+	// An If-Match without any version matches no row.
+	_, err = repos.Technology.Patch(domain.WithExpectedVersion(ctx), technology.TechnologyID, &entities.Technology{Name: "Golang"})
+	assertCode(t, "Patch without any expected version", err, domain.ErrCodePreconditionFailed)
+
+	if got := getTechnology(t, repos, technology.TechnologyID); got.Version != 2 || got.Name != "Go" || got.IconURL != updated.IconURL {
+		t.Fatalf("technology = %+v after rejected writes, want it unchanged at version 2", got)
+	}
+
+	if err := repos.Technology.Delete(domain.WithExpectedVersion(ctx, 5, 2), technology.TechnologyID); err != nil {
+		t.Fatalf("Delete with the current version in the expected list failed: %v", err)
+	}
+}
+
+var errRollback = errors.New("roll back")
+
+// testUnitOfWorkRollback checks that an error from the unit of work undoes
+// every write made in it, nested units of work included.
+func testUnitOfWorkRollback(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+	kept := createTechnology(t, repos, ctx, userID, "Go")
+	iconURL := kept.IconURL
+
+	var createdID int
+	err := repos.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		createdID = createTechnology(t, repos, ctx, userID, "Rust").TechnologyID
+
+		kept.IconURL = "https://example.com/go-2.svg"
+		if _, err := repos.Technology.Update(ctx, kept.TechnologyID, kept); err != nil {
+			return err
+		}
+
+		return repos.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := repos.Technology.Delete(ctx, kept.TechnologyID); err != nil {
+				return err
+			}
+			return errRollback
+		})
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("Do returned %v, want the error of fn", err)
+	}
+
+	if got := getTechnology(t, repos, createdID); got != nil {
+		t.Fatalf("technology %+v created in a rolled back unit of work", got)
+	}
+	got := getTechnology(t, repos, kept.TechnologyID)
+	if got == nil || got.IconURL != iconURL || got.Version != 1 {
+		t.Fatalf("technology = %+v after a rolled back update and delete, want it unchanged", got)
+	}
+
+	// The name of the rolled back row is free again.
+	createTechnology(t, repos, ctx, userID, "Rust")
+}
+
+// testUnitOfWorkCommit checks that the writes of a unit of work are visible
+// inside it and kept once it returns nil.
+func testUnitOfWorkCommit(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+
+	var createdIDs []int
+	err := repos.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		for _, name := range []string{"Go", "Rust"} {
+			createdIDs = append(createdIDs, createTechnology(t, repos, ctx, userID, name).TechnologyID)
+		}
+
+		page, err := repos.Technology.GetByUserID(ctx, userID, &entities.ListQuery{Size: 10})
+		if err != nil {
+			return err
+		}
+		if page.Total != 2 {
+			t.Errorf("Total inside the unit of work = %d, want 2", page.Total)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+
+	for _, id := range createdIDs {
+		if got := getTechnology(t, repos, id); got == nil {
+			t.Fatalf("technology %d lost after commit", id)
+		}
+	}
+}
+
+// testSettings checks that setting documents round-trip as JSON.
+func testSettings(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+
+	document, err := repos.Setting.GetNamespace(ctx, entities.SettingNamespaceSite)
+	if err != nil || document != nil {
+		t.Fatalf("GetNamespace(unset) = %s, %v; want nil, nil", document, err)
+	}
+
+	for _, value := range []map[string]any{
+		{"site_name": "Portfolio", "language": "en", "schema_version": float64(1)},
+		{"site_name": "Renamed", "language": "fr", "schema_version": float64(1)},
+	} {
+		written, err := json.Marshal(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := repos.Setting.UpsertNamespace(ctx, entities.SettingNamespaceSite, written); err != nil {
+			t.Fatalf("UpsertNamespace failed: %v", err)
+		}
+
+		document, err := repos.Setting.GetNamespace(ctx, entities.SettingNamespaceSite)
+		if err != nil {
+			t.Fatalf("GetNamespace failed: %v", err)
+		}
+		var read map[string]any
+		if err := json.Unmarshal(document, &read); err != nil {
+			t.Fatalf("GetNamespace returned invalid JSON %s: %v", document, err)
+		}
+		if !reflect.DeepEqual(read, value) {
+			t.Fatalf("GetNamespace = %v, want %v", read, value)
+		}
+	}
+
+	if document, err := repos.Setting.GetNamespace(ctx, entities.SettingNamespaceSEO); err != nil || document != nil {
+		t.Fatalf("GetNamespace(other namespace) = %s, %v; want nil, nil", document, err)
+	}
+
+	if err := repos.Setting.Delete(ctx); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if document, err := repos.Setting.GetNamespace(ctx, entities.SettingNamespaceSite); err != nil || document != nil {
+		t.Fatalf("GetNamespace after Delete = %s, %v; want nil, nil", document, err)
+	}
+}
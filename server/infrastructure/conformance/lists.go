@@ -0,0 +1,396 @@
+package conformance
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"testing"
+	"time"
+)
+
+// testListPages checks sorting, filtering and both directions of cursor
+// paging.
+func testListPages(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+	other := createUser(t, repos, "bob")
+
+	ids := map[string]int{}
+	for _, name := range []string{"Delta", "alpha", "Charlie", "bravo", "Echo"} {
+		ids[name] = createTechnology(t, repos, ctx, userID, name).TechnologyID
+	}
+	createTechnology(t, repos, ctx, other, "Foxtrot")
+	if _, err := repos.Technology.Create(ctx, &entities.Technology{
+		UserID: userID, Name: "Golf", IconURL: "https://example.com/golf.svg",
+		Publishing: entities.Publishing{State: entities.PublishingStateDraft},
+	}); err != nil {
+		t.Fatalf("Create draft technology failed: %v", err)
+	}
+	publishedOnly := map[string]string{"state": entities.PublishingStatePublished}
+	byName := []entities.SortField{{Name: "name"}}
+
+	// Names sort ignoring case.
+	first := listTechnologies(t, repos, userID, &entities.ListQuery{Size: 2, Sort: byName, Filters: publishedOnly})
+	assertNames(t, "first page", names(first.Items, technologyName), []string{"alpha", "bravo"})
+	if first.Total != 5 || first.NextAfter != ids["bravo"] || first.PrevBefore != 0 {
+		t.Fatalf("first page total %d, next %d, prev %d; want 5, %d, 0", first.Total, first.NextAfter, first.PrevBefore, ids["bravo"])
+	}
+
+	second := listTechnologies(t, repos, userID, &entities.ListQuery{Size: 2, After: first.NextAfter, Sort: byName, Filters: publishedOnly})
+	assertNames(t, "second page", names(second.Items, technologyName), []string{"Charlie", "Delta"})
+	if second.Total != 5 || second.NextAfter != ids["Delta"] || second.PrevBefore != ids["Charlie"] {
+		t.Fatalf("second page total %d, next %d, prev %d; want 5, %d, %d", second.Total, second.NextAfter, second.PrevBefore, ids["Delta"], ids["Charlie"])
+	}
+
+	last := listTechnologies(t, repos, userID, &entities.ListQuery{Size: 2, After: second.NextAfter, Sort: byName, Filters: publishedOnly})
+	assertNames(t, "last page", names(last.Items, technologyName), []string{"Echo"})
+	if last.NextAfter != 0 || last.PrevBefore != ids["Echo"] {
+		t.Fatalf("last page next %d, prev %d; want 0, %d", last.NextAfter, last.PrevBefore, ids["Echo"])
+	}
+
+	back := listTechnologies(t, repos, userID, &entities.ListQuery{Size: 2, Before: second.PrevBefore, Sort: byName, Filters: publishedOnly})
+	assertNames(t, "page before the second", names(back.Items, technologyName), []string{"alpha", "bravo"})
+	if back.NextAfter != ids["bravo"] || back.PrevBefore != 0 {
+		t.Fatalf("page before the second next %d, prev %d; want %d, 0", back.NextAfter, back.PrevBefore, ids["bravo"])
+	}
+
+	descending := listTechnologies(t, repos, userID, &entities.ListQuery{Size: 3, Sort: []entities.SortField{{Name: "name", Descending: true}}})
+	assertNames(t, "descending page", names(descending.Items, technologyName), []string{"Golf", "Echo", "Delta"})
+	if descending.Total != 6 {
+		t.Fatalf("Total without filters = %d, want 6", descending.Total)
+	}
+
+	// New rows are appended to the manual order.
+	byPosition := listTechnologies(t, repos, userID, &entities.ListQuery{Size: 10, Sort: []entities.SortField{{Name: "position"}}})
+	assertNames(t, "page by position", names(byPosition.Items, technologyName), []string{"Delta", "alpha", "Charlie", "bravo", "Echo", "Golf"})
+
+	filtered := listTechnologies(t, repos, userID, &entities.ListQuery{Size: 10, Filters: map[string]string{"name": "CHARLIE"}})
+	assertNames(t, "page filtered by name", names(filtered.Items, technologyName), []string{"Charlie"})
+	if filtered.Total != 1 {
+		t.Fatalf("Total filtered by name = %d, want 1", filtered.Total)
+	}
+
+	drafts := listTechnologies(t, repos, userID, &entities.ListQuery{Size: 10, Filters: map[string]string{"state": entities.PublishingStateDraft}})
+	assertNames(t, "page of drafts", names(drafts.Items, technologyName), []string{"Golf"})
+
+	empty := listTechnologies(t, repos, createUser(t, repos, "carol"), &entities.ListQuery{Size: 10})
+	if empty.Items == nil || len(empty.Items) != 0 || empty.Total != 0 {
+		t.Fatalf("page of a user without technologies = %+v, want an empty page", empty)
+	}
+}
+
+// testListErrors checks that unknown sorts and filters, and cursors that are
+// missing or out of scope, are rejected as validation errors.
+func testListErrors(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+	createTechnology(t, repos, ctx, userID, "Go")
+
+	_, err := repos.Technology.GetByUserID(ctx, userID, &entities.ListQuery{Size: 10, Sort: []entities.SortField{{Name: "icon_url"}}})
+	assertCode(t, "GetByUserID sorted by an unknown field", err, domain.ErrCodeValidation)
+
+	_, err = repos.Technology.GetByUserID(ctx, userID, &entities.ListQuery{Size: 10, Filters: map[string]string{"icon_url": "x"}})
+	assertCode(t, "GetByUserID filtered by an unknown field", err, domain.ErrCodeValidation)
+
+	_, err = repos.Technology.GetByUserID(ctx, userID, &entities.ListQuery{Size: 10, After: 404})
+	assertCode(t, "GetByUserID after a missing cursor", err, domain.ErrCodeValidation)
+
+	foreign := createTechnology(t, repos, ctx, createUser(t, repos, "bob"), "Rust")
+	_, err = repos.Technology.GetByUserID(ctx, userID, &entities.ListQuery{Size: 10, After: foreign.TechnologyID})
+	assertCode(t, "GetByUserID after another user's cursor", err, domain.ErrCodeValidation)
+
+	trashed := createTechnology(t, repos, ctx, userID, "Zig")
+	if err := repos.Technology.Delete(ctx, trashed.TechnologyID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	_, err = repos.Technology.GetByUserID(ctx, userID, &entities.ListQuery{Size: 10, Before: trashed.TechnologyID})
+	assertCode(t, "GetByUserID before a trashed cursor", err, domain.ErrCodeValidation)
+}
+
+// testListCursorScope checks that a cursor is accepted while its row is in
+// the list's scope, even when the filters leave it out, and rejected once it
+// is not, for the scopes of the project lists too.
+func testListCursorScope(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+
+	for _, name := range []string{"alpha", "bravo", "delta"} {
+		createTechnology(t, repos, ctx, userID, name)
+	}
+	draft, err := repos.Technology.Create(ctx, &entities.Technology{
+		UserID: userID, Name: "charlie", IconURL: "https://example.com/charlie.svg",
+		Publishing: entities.Publishing{State: entities.PublishingStateDraft},
+	})
+	if err != nil {
+		t.Fatalf("Create draft technology failed: %v", err)
+	}
+	page := listTechnologies(t, repos, userID, &entities.ListQuery{
+		Size:    10,
+		After:   draft.TechnologyID,
+		Sort:    []entities.SortField{{Name: "name"}},
+		Filters: map[string]string{"state": entities.PublishingStatePublished},
+	})
+	assertNames(t, "published technologies after a draft cursor", names(page.Items, technologyName), []string{"delta"})
+
+	createProject := func(userID int, slug string) *entities.Project {
+		t.Helper()
+
+		project, err := repos.Project.Create(ctx, &entities.Project{
+			UserID: userID, Title: slug, Slug: slug, Description: slug, Status: "active", Publishing: published,
+		})
+		if err != nil {
+			t.Fatalf("Create project %q failed: %v", slug, err)
+		}
+		return project
+	}
+	linked := createProject(userID, "linked")
+	unlinked := createProject(userID, "unlinked")
+	foreign := createProject(createUser(t, repos, "bob"), "foreign")
+	technology := createTechnology(t, repos, ctx, userID, "Go")
+	if err := repos.Project.SetTechnologies(ctx, linked.ProjectID, []*entities.Technology{technology}); err != nil {
+		t.Fatalf("SetTechnologies failed: %v", err)
+	}
+
+	if _, err := repos.Project.GetAll(ctx, userID, &entities.ListQuery{Size: 10, After: unlinked.ProjectID}); err != nil {
+		t.Fatalf("GetAll after an own project failed: %v", err)
+	}
+	_, err = repos.Project.GetAll(ctx, userID, &entities.ListQuery{Size: 10, After: foreign.ProjectID})
+	assertCode(t, "GetAll after another user's project", err, domain.ErrCodeValidation)
+
+	if _, err := repos.Project.GetByTechnologyID(ctx, userID, technology.TechnologyID, &entities.ListQuery{Size: 10, Before: linked.ProjectID}); err != nil {
+		t.Fatalf("GetByTechnologyID before a linked project failed: %v", err)
+	}
+	_, err = repos.Project.GetByTechnologyID(ctx, userID, technology.TechnologyID, &entities.ListQuery{Size: 10, Before: unlinked.ProjectID})
+	assertCode(t, "GetByTechnologyID before an unlinked project", err, domain.ErrCodeValidation)
+
+	if err := repos.Project.Delete(ctx, unlinked.ProjectID); err != nil {
+		t.Fatalf("Delete project failed: %v", err)
+	}
+	_, err = repos.Project.GetAll(ctx, userID, &entities.ListQuery{Size: 10, After: unlinked.ProjectID})
+	assertCode(t, "GetAll after a trashed project", err, domain.ErrCodeValidation)
+}
+
+// testReorder checks that Reorder sets the manual order and only accepts
+// every live row of the user exactly once.
+func testReorder(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+	other := createUser(t, repos, "bob")
+
+	var ids []int
+	for _, name := range []string{"Go", "Rust", "Zig"} {
+		ids = append(ids, createTechnology(t, repos, ctx, userID, name).TechnologyID)
+	}
+	foreign := createTechnology(t, repos, ctx, other, "Go").TechnologyID
+
+	byPosition := &entities.ListQuery{Size: 10, Sort: []entities.SortField{{Name: "position"}}}
+	reorder := func(ids []int) error {
+		return repos.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+			return repos.Technology.Reorder(ctx, userID, ids)
+		})
+	}
+
+	if err := reorder([]int{ids[2], ids[0], ids[1]}); err != nil {
+		t.Fatalf("Reorder failed: %v", err)
+	}
+	page := listTechnologies(t, repos, userID, byPosition)
+	assertNames(t, "reordered technologies", names(page.Items, technologyName), []string{"Zig", "Go", "Rust"})
+
+	for _, invalid := range [][]int{
+		{ids[0], ids[1]},
+		{ids[0], ids[1], ids[1]},
+		{ids[0], ids[1], ids[2], foreign},
+		{ids[0], ids[1], foreign},
+	} {
+		assertCode(t, "Reorder with invalid IDs", reorder(invalid), domain.ErrCodeValidation)
+	}
+	page = listTechnologies(t, repos, userID, byPosition)
+	assertNames(t, "technologies after rejected reorders", names(page.Items, technologyName), []string{"Zig", "Go", "Rust"})
+
+	// Trashed rows are out of the order.
+	if err := repos.Technology.Delete(ctx, ids[1]); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := reorder([]int{ids[0], ids[2]}); err != nil {
+		t.Fatalf("Reorder without the trashed technology failed: %v", err)
+	}
+	page = listTechnologies(t, repos, userID, byPosition)
+	assertNames(t, "technologies reordered after a delete", names(page.Items, technologyName), []string{"Go", "Zig"})
+}
+
+// testCertificationExpiry checks the expiry filter and sort, which compare
+// dates with the current one.
+func testCertificationExpiry(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+
+	expiries := []struct {
+		name   string
+		expiry *time.Time
+	}{
+		{"Lapsed", ptr(day(-10))},
+		{"Expiring today", ptr(day(0))},
+		{"Expiring soon", ptr(day(30))},
+		{"Renewed", ptr(day(entities.CertificationExpiryWarningDays + 30))},
+		{"Lifetime", nil},
+	}
+	for _, expiry := range expiries {
+		if _, err := repos.Certification.Create(ctx, &entities.Certification{
+			UserID:     userID,
+			Name:       expiry.name,
+			Issuer:     "Example",
+			IssueDate:  day(-400),
+			ExpiryDate: expiry.expiry,
+			Publishing: published,
+		}); err != nil {
+			t.Fatalf("Create certification %q failed: %v", expiry.name, err)
+		}
+	}
+
+	certificationName := func(certification *entities.Certification) string { return certification.Name }
+	list := func(query *entities.ListQuery) []string {
+		t.Helper()
+		page, err := repos.Certification.GetByUserID(ctx, userID, query)
+		if err != nil {
+			t.Fatalf("GetByUserID(%s) failed: %v", query, err)
+		}
+		return names(page.Items, certificationName)
+	}
+	byName := []entities.SortField{{Name: "name"}}
+
+	for status, want := range map[string][]string{
+		entities.CertificationExpiryExpired:      {"Lapsed"},
+		entities.CertificationExpiryExpiringSoon: {"Expiring soon", "Expiring today"},
+		entities.CertificationExpiryValid:        {"Lifetime", "Renewed"},
+	} {
+		got := list(&entities.ListQuery{Size: 10, Sort: byName, Filters: map[string]string{"expiry": status}})
+		assertNames(t, status+" certifications", got, want)
+	}
+
+	// Certifications that never expire sort last.
+	got := list(&entities.ListQuery{Size: 10, Sort: []entities.SortField{{Name: "expiry_date"}}})
+	assertNames(t, "certifications by expiry date", got, []string{"Lapsed", "Expiring today", "Expiring soon", "Renewed", "Lifetime"})
+}
+
+// testSpokenLanguageNames checks that spoken language names are unique per
+// user ignoring case, trashed ones included.
+func testSpokenLanguageNames(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+	other := createUser(t, repos, "bob")
+
+	create := func(userID int, name string) (*entities.SpokenLanguage, error) {
+		return repos.SpokenLanguage.Create(ctx, &entities.SpokenLanguage{
+			UserID: userID, Name: name, Level: entities.SpokenLanguageLevelB2, Publishing: published,
+		})
+	}
+
+	english, err := create(userID, "English")
+	if err != nil {
+		t.Fatalf("Create spoken language failed: %v", err)
+	}
+	_, err = create(userID, "ENGLISH")
+	assertCode(t, "Create with a taken spoken language name", err, domain.ErrCodeDatabase)
+	if _, err := create(other, "english"); err != nil {
+		t.Fatalf("Create with a name taken by another user failed: %v", err)
+	}
+
+	exists := func(name string, exceptID int) bool {
+		t.Helper()
+		exists, err := repos.SpokenLanguage.ExistsByName(ctx, userID, name, exceptID)
+		if err != nil {
+			t.Fatalf("ExistsByName failed: %v", err)
+		}
+		return exists
+	}
+	if !exists("english", 0) {
+		t.Fatal("ExistsByName ignores a name differing in case")
+	}
+	if exists("English", english.SpokenLanguageID) {
+		t.Fatal("ExistsByName counts the excepted spoken language")
+	}
+	if exists("German", 0) {
+		t.Fatal("ExistsByName counts a missing name")
+	}
+
+	if err := repos.SpokenLanguage.Delete(ctx, english.SpokenLanguageID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if !exists("English", 0) {
+		t.Fatal("ExistsByName ignores a trashed spoken language")
+	}
+	_, err = create(userID, "english")
+	assertCode(t, "Create with the name of a trashed spoken language", err, domain.ErrCodeDatabase)
+}
+
+// testSpokenLanguageLevelSort checks that levels sort from A1 to native
+// rather than alphabetically.
+func testSpokenLanguageLevelSort(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+
+	for name, level := range map[string]string{
+		"English": entities.SpokenLanguageLevelNative,
+		"German":  entities.SpokenLanguageLevelB2,
+		"French":  entities.SpokenLanguageLevelA2,
+		"Spanish": entities.SpokenLanguageLevelC1,
+	} {
+		if _, err := repos.SpokenLanguage.Create(ctx, &entities.SpokenLanguage{
+			UserID: userID, Name: name, Level: level, Publishing: published,
+		}); err != nil {
+			t.Fatalf("Create spoken language %q failed: %v", name, err)
+		}
+	}
+
+	page, err := repos.SpokenLanguage.GetByUserID(ctx, userID, &entities.ListQuery{Size: 10, Sort: []entities.SortField{{Name: "level", Descending: true}}})
+	if err != nil {
+		t.Fatalf("GetByUserID failed: %v", err)
+	}
+	got := names(page.Items, func(language *entities.SpokenLanguage) string { return language.Name })
+	assertNames(t, "spoken languages by level", got, []string{"English", "Spanish", "German", "French"})
+
+	page, err = repos.SpokenLanguage.GetByUserID(ctx, userID, &entities.ListQuery{Size: 10, Filters: map[string]string{"level": entities.SpokenLanguageLevelB2}})
+	if err != nil {
+		t.Fatalf("GetByUserID failed: %v", err)
+	}
+	got = names(page.Items, func(language *entities.SpokenLanguage) string { return language.Name })
+	assertNames(t, "spoken languages at B2", got, []string{"German"})
+}
+
+// testVolunteeringCurrent checks that the current filter matches the
+// volunteerings without an end date, and that end dates round-trip.
+func testVolunteeringCurrent(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "alice")
+
+	ended := day(-100)
+	for role, endDate := range map[string]*time.Time{"Mentor": nil, "Maintainer": &ended} {
+		if _, err := repos.Volunteering.Create(ctx, &entities.Volunteering{
+			UserID: userID, Role: role, Organization: "Example", StartDate: day(-1000), EndDate: endDate, Publishing: published,
+		}); err != nil {
+			t.Fatalf("Create volunteering %q failed: %v", role, err)
+		}
+	}
+
+	for current, want := range map[string]string{"true": "Mentor", "false": "Maintainer"} {
+		page, err := repos.Volunteering.GetByUserID(ctx, userID, &entities.ListQuery{Size: 10, Filters: map[string]string{"current": current}})
+		if err != nil {
+			t.Fatalf("GetByUserID failed: %v", err)
+		}
+		if len(page.Items) != 1 || page.Items[0].Role != want {
+			t.Fatalf("current=%s volunteerings = %q, want %q", current, names(page.Items, func(v *entities.Volunteering) string { return v.Role }), want)
+		}
+
+		volunteering := page.Items[0]
+		if current == "false" && (volunteering.EndDate == nil || !volunteering.EndDate.Equal(ended)) {
+			t.Fatalf("EndDate = %v, want %v", volunteering.EndDate, ended)
+		}
+		if !volunteering.StartDate.Equal(day(-1000)) {
+			t.Fatalf("StartDate = %v, want %v", volunteering.StartDate, day(-1000))
+		}
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,63 @@
+package conformance
+
+import (
+	"portfolio/domain/entities"
+	"slices"
+	"testing"
+)
+
+func testSearchVisibility(t *testing.T, repos *Repositories) {
+	ctx := t.Context()
+	userID := createUser(t, repos, "admin")
+
+	otherID := createUser(t, repos, "other")
+
+	createProject := func(project *entities.Project) *entities.Project {
+		t.Helper()
+
+		project.Description = project.Title + " description"
+		project.ShortDescription = project.Title
+		created, err := repos.Project.Create(ctx, project)
+		if err != nil {
+			t.Fatalf("Create project %q failed: %v", project.Title, err)
+		}
+		return created
+	}
+	for _, project := range []*entities.Project{
+		{Title: "Orbit Live", Slug: "orbit-live", Status: "active", Publishing: published},
+		{Title: "Orbit Paused", Slug: "orbit-paused", Status: "inactive", Publishing: published},
+		{Title: "Orbit Archive", Slug: "orbit-archive", Status: "archived", Publishing: published},
+		{Title: "Orbit Draft", Slug: "orbit-draft", Status: "active"},
+	} {
+		project.UserID = userID
+		createProject(project)
+	}
+
+	// Trashed projects and those of other users are never found.
+	trashed := createProject(&entities.Project{UserID: userID, Title: "Orbit Trashed", Slug: "orbit-trashed", Status: "active", Publishing: published})
+	if err := repos.Project.Delete(ctx, trashed.ProjectID); err != nil {
+		t.Fatalf("Delete project failed: %v", err)
+	}
+	createProject(&entities.Project{UserID: otherID, Title: "Orbit Elsewhere", Slug: "orbit-elsewhere", Status: "active", Publishing: published})
+
+	search := func(includeHidden bool) []string {
+		t.Helper()
+
+		hits, err := repos.Search.Search(ctx, entities.SearchQuery{
+			Terms:         []string{"orbit"},
+			UserID:        userID,
+			IncludeHidden: includeHidden,
+			Limit:         10,
+		})
+		if err != nil {
+			t.Fatalf("Search(includeHidden=%v) failed: %v", includeHidden, err)
+		}
+		titles := names(hits, func(hit *entities.SearchHit) string { return hit.Title })
+		slices.Sort(titles)
+		return titles
+	}
+
+	assertNames(t, "public search", search(false), []string{"Orbit Live"})
+	assertNames(t, "admin search", search(true),
+		[]string{"Orbit Archive", "Orbit Draft", "Orbit Live", "Orbit Paused"})
+}
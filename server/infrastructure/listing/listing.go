@@ -0,0 +1,231 @@
+package listing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/infrastructure/transaction"
+	"strconv"
+	"strings"
+)
+
+// Table describes a list to the SQL builder. Everything in it is trusted SQL
+// written by the repositories; filter values and cursors, which come from
+// the request, are always bound as arguments. Placeholders are written as ?
+// and rewritten for the dialect.
+type Table struct {
+	Name     string
+	IDColumn string
+	// Columns is the select list, in the order the scan function reads it.
+	Columns string
+	// Scope restricts the list, e.g. to the live rows of one user. Its
+	// placeholders are bound to the scope arguments of Fetch.
+	Scope string
+	// Sorts maps API sort names to expressions, which must never be NULL.
+	Sorts map[string]string
+	// Filters maps API filter names to conditions; every placeholder in a
+	// condition is bound to the filter value.
+	Filters map[string]string
+	// Position is the column of the manual display order, for the
+	// collections that can be reordered.
+	Position string
+}
+
+// Dialect writes the placeholder of the nth argument, counting from 1.
+type Dialect func(n int) string
+
+var (
+	SQLite   Dialect = func(int) string { return "?" }
+	Postgres Dialect = func(n int) string { return "$" + strconv.Itoa(n) }
+)
+
+type orderField struct {
+	expr       string
+	descending bool
+}
+
+// Fetch reads the page of table selected by query. Pages are cut with
+// keyset conditions against the cursor row rather than offsets, so they stay
+// stable while items are added or removed.
+func Fetch[T any](ctx context.Context, executor transaction.Executor, dialect Dialect, table Table, scopeArgs []any,
+	query *entities.ListQuery, scan func(*sql.Rows) (T, error), id func(T) int) (*entities.ListPage[T], error) {
+	where := []string{table.Scope}
+	args := append([]any(nil), scopeArgs...)
+
+	for name, value := range query.Filters {
+		condition, ok := table.Filters[name]
+		if !ok {
+			return nil, domain.NewValidationError("Unknown filter "+name, "filter["+name+"]", nil)
+		}
+		where = append(where, condition)
+		for range strings.Count(condition, "?") {
+			args = append(args, value)
+		}
+	}
+
+	var total int
+	countQuery := "SELECT COUNT(*) FROM " + table.Name + " WHERE " + strings.Join(where, " AND ")
+	if err := executor.QueryRowContext(ctx, bind(countQuery, dialect), args...).Scan(&total); err != nil {
+		return nil, domain.NewDatabaseError(table.Name+" count", err)
+	}
+
+	order := make([]orderField, 0, len(query.Sort)+1)
+	for _, field := range query.Sort {
+		expr, ok := table.Sorts[field.Name]
+		if !ok {
+			return nil, domain.NewValidationError("Unknown sort field "+field.Name, "sort", nil)
+		}
+		order = append(order, orderField{expr: expr, descending: field.Descending})
+	}
+	order = append(order, orderField{expr: table.IDColumn})
+
+	// A page before the cursor is read backwards from it, then reversed.
+	if query.Before != 0 {
+		for i := range order {
+			order[i].descending = !order[i].descending
+		}
+	}
+
+	if cursor := max(query.After, query.Before); cursor != 0 {
+		// The cursor must be in scope, or it could point at another user's
+		// row or at a trashed one.
+		var exists int
+		existsQuery := "SELECT COUNT(*) FROM " + table.Name + " WHERE " + table.Scope + " AND " + table.IDColumn + " = ?"
+		existsArgs := append(append([]any(nil), scopeArgs...), cursor)
+		if err := executor.QueryRowContext(ctx, bind(existsQuery, dialect), existsArgs...).Scan(&exists); err != nil {
+			return nil, domain.NewDatabaseError(table.Name+" cursor lookup", err)
+		}
+		if exists == 0 {
+			return nil, domain.NewValidationError("The page cursor no longer points to an item", "page", nil)
+		}
+
+		condition, cursorArgs := keysetCondition(table, order, cursor)
+		where = append(where, condition)
+		args = append(args, cursorArgs...)
+	}
+
+	orderBy := make([]string, 0, len(order))
+	for _, field := range order {
+		if field.descending {
+			orderBy = append(orderBy, field.expr+" DESC")
+		} else {
+			orderBy = append(orderBy, field.expr+" ASC")
+		}
+	}
+
+	pageQuery := fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT ?",
+		table.Columns, table.Name, strings.Join(where, " AND "), strings.Join(orderBy, ", "))
+	// One row more than the page size tells whether another page follows.
+	args = append(args, query.Size+1)
+
+	rows, err := executor.QueryContext(ctx, bind(pageQuery, dialect), args...)
+	if err != nil {
+		return nil, domain.NewDatabaseError(table.Name+" list retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var items []T
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, domain.NewDatabaseError(table.Name+" list scanning", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError(table.Name+" list iteration", err)
+	}
+
+	return Paginate(items, id, query, total), nil
+}
+
+// keysetCondition matches the rows that come after the cursor row in order:
+// those greater on the first field, or equal on it and greater on the
+// second, and so on. The last field is the ID, which breaks every tie.
+func keysetCondition(table Table, order []orderField, cursor int) (string, []any) {
+	var alternatives []string
+	var args []any
+
+	for i, field := range order {
+		var terms []string
+		for _, previous := range order[:i] {
+			terms = append(terms, previous.expr+" = "+cursorValue(table, previous))
+			args = append(args, cursor)
+		}
+
+		comparison := " > "
+		if field.descending {
+			comparison = " < "
+		}
+		terms = append(terms, field.expr+comparison+cursorValue(table, field))
+		args = append(args, cursor)
+
+		alternatives = append(alternatives, "("+strings.Join(terms, " AND ")+")")
+	}
+
+	return "(" + strings.Join(alternatives, " OR ") + ")", args
+}
+
+// cursorValue reads field from the cursor row, whose ID is bound to the
+// placeholder.
+func cursorValue(table Table, field orderField) string {
+	if field.expr == table.IDColumn {
+		return "?"
+	}
+	return "(SELECT " + field.expr + " FROM " + table.Name + " WHERE " + table.IDColumn + " = ?)"
+}
+
+// Paginate turns the rows read for query, in reading order and with at most
+// one row more than the page size, into a page.
+func Paginate[T any](items []T, id func(T) int, query *entities.ListQuery, total int) *entities.ListPage[T] {
+	hasMore := len(items) > query.Size
+	if hasMore {
+		items = items[:query.Size]
+	}
+
+	page := &entities.ListPage[T]{Items: items, Total: total}
+	if page.Items == nil {
+		page.Items = []T{}
+	}
+	if len(items) == 0 {
+		return page
+	}
+
+	if query.Before != 0 {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+		page.NextAfter = id(items[len(items)-1])
+		if hasMore {
+			page.PrevBefore = id(items[0])
+		}
+		return page
+	}
+
+	if hasMore {
+		page.NextAfter = id(items[len(items)-1])
+	}
+	if query.After != 0 {
+		page.PrevBefore = id(items[0])
+	}
+	return page
+}
+
+// bind rewrites the ? placeholders of query for dialect.
+func bind(query string, dialect Dialect) string {
+	var builder strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			builder.WriteString(dialect(n))
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
@@ -0,0 +1,65 @@
+package listing
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/infrastructure/transaction"
+)
+
+// NextPosition is the position after the last row in the scope of table,
+// where a new row is appended.
+func NextPosition(ctx context.Context, executor transaction.Executor, dialect Dialect, table Table, scopeArgs []any) (int, error) {
+	query := "SELECT COALESCE(MAX(" + table.Position + ") + 1, 0) FROM " + table.Name + " WHERE " + table.Scope
+
+	var position int
+	if err := executor.QueryRowContext(ctx, bind(query, dialect), scopeArgs...).Scan(&position); err != nil {
+		return 0, domain.NewDatabaseError(table.Name+" position lookup", err)
+	}
+	return position, nil
+}
+
+// Reorder gives the rows in the scope of table the positions of their IDs in
+// ids, which must list every one of them exactly once. Run it in a unit of
+// work so that the order changes all at once.
+func Reorder(ctx context.Context, executor transaction.Executor, dialect Dialect, table Table, scopeArgs []any, ids []int) error {
+	query := "SELECT " + table.IDColumn + " FROM " + table.Name + " WHERE " + table.Scope
+	rows, err := executor.QueryContext(ctx, bind(query, dialect), scopeArgs...)
+	if err != nil {
+		return domain.NewDatabaseError(table.Name+" reorder lookup", err)
+	}
+
+	unlisted := map[int]bool{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return domain.NewDatabaseError(table.Name+" reorder scanning", err)
+		}
+		unlisted[id] = true
+	}
+	if err := rows.Close(); err != nil {
+		return domain.NewDatabaseError(table.Name+" reorder lookup", err)
+	}
+
+	if len(ids) != len(unlisted) {
+		return reorderError(table)
+	}
+	for _, id := range ids {
+		if !unlisted[id] {
+			return reorderError(table)
+		}
+		delete(unlisted, id)
+	}
+
+	update := bind("UPDATE "+table.Name+" SET "+table.Position+" = ? WHERE "+table.IDColumn+" = ?", dialect)
+	for position, id := range ids {
+		if _, err := executor.ExecContext(ctx, update, position, id); err != nil {
+			return domain.NewDatabaseError(table.Name+" reorder", err)
+		}
+	}
+	return nil
+}
+
+func reorderError(table Table) error {
+	return domain.NewValidationError("IDs must list every one of the "+table.Name+" exactly once", "ids", nil)
+}
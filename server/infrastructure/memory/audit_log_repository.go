@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"time"
+)
+
+type auditLogRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewAuditLogRepository(store *Store, logger *logger.Logger) interfaces.AuditLogRepository {
+	return &auditLogRepository{store: store, logger: logger}
+}
+
+func (repo *auditLogRepository) Create(ctx context.Context, auditLog *entities.AuditLog) (*entities.AuditLog, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	auditLog.AuditLogID = repo.store.nextID("audit_logs")
+	auditLog.CreatedAt = time.Now()
+
+	stored := *auditLog
+	repo.store.auditLogs = append(repo.store.auditLogs, &stored)
+
+	return auditLog, nil
+}
+
+func (repo *auditLogRepository) List(ctx context.Context, filter *entities.AuditLogFilter) ([]*entities.AuditLog, int, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var matches []*entities.AuditLog
+	for i := len(repo.store.auditLogs) - 1; i >= 0; i-- {
+		auditLog := repo.store.auditLogs[i]
+		if matchesAuditLogFilter(auditLog, filter) {
+			found := *auditLog
+			matches = append(matches, &found)
+		}
+	}
+
+	total := len(matches)
+	if filter.Limit > 0 {
+		start := min(filter.Offset, total)
+		end := min(start+filter.Limit, total)
+		matches = matches[start:end]
+	}
+
+	return matches, total, nil
+}
+
+func matchesAuditLogFilter(auditLog *entities.AuditLog, filter *entities.AuditLogFilter) bool {
+	if filter.UserID > 0 && (auditLog.UserID == nil || *auditLog.UserID != filter.UserID) {
+		return false
+	}
+	if filter.Action != "" && auditLog.Action != filter.Action {
+		return false
+	}
+	if filter.ResourceType != "" && auditLog.ResourceType != filter.ResourceType {
+		return false
+	}
+	if filter.ResourceID != "" && auditLog.ResourceID != filter.ResourceID {
+		return false
+	}
+	if filter.Since != nil && auditLog.CreatedAt.Before(*filter.Since) {
+		return false
+	}
+	if filter.Until != nil && !auditLog.CreatedAt.Before(*filter.Until) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"strings"
+	"time"
+)
+
+type awardRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewAwardRepository(store *Store, logger *logger.Logger) interfaces.AwardRepository {
+	return &awardRepository{store: store, logger: logger}
+}
+
+func (repo *awardRepository) Create(ctx context.Context, award *entities.Award) (*entities.Award, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.store.checkUser(award.UserID); err != nil {
+		repo.logger.Error("Failed to create award: %v", err)
+		return nil, domain.NewDatabaseError("create award", err)
+	}
+
+	now := time.Now()
+	award.AwardID = repo.store.nextID("awards")
+	award.Position = nextPosition(repo.store.awards, awardPlace, award.UserID)
+	award.CreatedAt = now
+	award.UpdatedAt = now
+	award.Version = 1
+
+	stored := *award
+	repo.store.awards[award.AwardID] = &stored
+
+	created := *award
+	return &created, nil
+}
+
+func (repo *awardRepository) GetByID(ctx context.Context, awardID int) (*entities.Award, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	award, ok := repo.store.awards[awardID]
+	if !ok {
+		return nil, nil
+	}
+
+	found := *award
+	return &found, nil
+}
+
+var awardListSpec = listSpec[*entities.Award]{
+	id: func(award *entities.Award) int { return award.AwardID },
+	sorts: map[string]func(a, b *entities.Award) int{
+		"position":   func(a, b *entities.Award) int { return cmp.Compare(a.Position, b.Position) },
+		"date":       func(a, b *entities.Award) int { return a.Date.Compare(b.Date) },
+		"title":      func(a, b *entities.Award) int { return compareFolded(a.Title, b.Title) },
+		"issuer":     func(a, b *entities.Award) int { return compareFolded(a.Issuer, b.Issuer) },
+		"created_at": func(a, b *entities.Award) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	},
+	filters: map[string]func(award *entities.Award, value string) bool{
+		"state":  func(award *entities.Award, value string) bool { return award.State == value },
+		"issuer": func(award *entities.Award, value string) bool { return strings.EqualFold(award.Issuer, value) },
+	},
+}
+
+func (repo *awardRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Award], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var awards []*entities.Award
+	for _, award := range repo.store.awards {
+		if award.UserID == userID {
+			found := *award
+			awards = append(awards, &found)
+		}
+	}
+
+	return listPage(awardListSpec, awards, query)
+}
+
+func (repo *awardRepository) Update(ctx context.Context, awardID int, award *entities.Award) (*entities.Award, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.awards[awardID]; ok {
+		if err := checkVersion(ctx, "Award", awardID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		stored.Title = award.Title
+		stored.Issuer = award.Issuer
+		stored.Date = award.Date
+		stored.URL = award.URL
+		stored.Description = award.Description
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, awardID)
+}
+
+func (repo *awardRepository) Delete(ctx context.Context, awardID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.awards[awardID]; ok {
+		if err := checkVersion(ctx, "Award", awardID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, entities.TrashTypeAward, repo.store.awards, awardID)
+	return nil
+}
+
+func (repo *awardRepository) Reorder(ctx context.Context, userID int, awardIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("awards", repo.store.awards, awardPlace, userID, awardIDs)
+}
+
+func awardPlace(award *entities.Award) (int, *int) {
+	return award.UserID, &award.Position
+}
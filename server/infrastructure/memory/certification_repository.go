@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"strings"
+	"time"
+)
+
+type certificationRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewCertificationRepository(store *Store, logger *logger.Logger) interfaces.CertificationRepository {
+	return &certificationRepository{store: store, logger: logger}
+}
+
+func (repo *certificationRepository) Create(ctx context.Context, certification *entities.Certification) (*entities.Certification, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.checkConstraints(0, certification); err != nil {
+		repo.logger.Error("Failed to create certification: %v", err)
+		return nil, domain.NewDatabaseError("create certification", err)
+	}
+
+	now := time.Now()
+	certification.CertificationID = repo.store.nextID("certifications")
+	certification.Position = nextPosition(repo.store.certifications, certificationPlace, certification.UserID)
+	certification.CreatedAt = now
+	certification.UpdatedAt = now
+	certification.Version = 1
+
+	repo.store.certifications[certification.CertificationID] = copyCertification(certification)
+
+	return copyCertification(certification), nil
+}
+
+func (repo *certificationRepository) GetByID(ctx context.Context, certificationID int) (*entities.Certification, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	certification, ok := repo.store.certifications[certificationID]
+	if !ok {
+		return nil, nil
+	}
+
+	return copyCertification(certification), nil
+}
+
+// neverExpires sorts the certifications without an expiry date last, like
+// the far future date the SQL backends use for them.
+var neverExpires = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+func certificationExpirySortKey(certification *entities.Certification) time.Time {
+	if !certification.HasExpiryDate() {
+		return neverExpires
+	}
+	return *certification.ExpiryDate
+}
+
+var certificationListSpec = listSpec[*entities.Certification]{
+	id: func(certification *entities.Certification) int { return certification.CertificationID },
+	sorts: map[string]func(a, b *entities.Certification) int{
+		"position":   func(a, b *entities.Certification) int { return cmp.Compare(a.Position, b.Position) },
+		"issue_date": func(a, b *entities.Certification) int { return a.IssueDate.Compare(b.IssueDate) },
+		"expiry_date": func(a, b *entities.Certification) int {
+			return certificationExpirySortKey(a).Compare(certificationExpirySortKey(b))
+		},
+		"name":       func(a, b *entities.Certification) int { return compareFolded(a.Name, b.Name) },
+		"issuer":     func(a, b *entities.Certification) int { return compareFolded(a.Issuer, b.Issuer) },
+		"created_at": func(a, b *entities.Certification) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	},
+	filters: map[string]func(certification *entities.Certification, value string) bool{
+		"state": func(certification *entities.Certification, value string) bool { return certification.State == value },
+		"issuer": func(certification *entities.Certification, value string) bool {
+			return strings.EqualFold(certification.Issuer, value)
+		},
+		"expiry": func(certification *entities.Certification, value string) bool {
+			return certification.ExpiryStatus(time.Now().UTC()) == value
+		},
+	},
+}
+
+func (repo *certificationRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Certification], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var certifications []*entities.Certification
+	for _, certification := range repo.store.certifications {
+		if certification.UserID == userID {
+			certifications = append(certifications, copyCertification(certification))
+		}
+	}
+
+	return listPage(certificationListSpec, certifications, query)
+}
+
+func (repo *certificationRepository) Update(ctx context.Context, certificationID int, certification *entities.Certification) (*entities.Certification, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.certifications[certificationID]; ok {
+		if err := checkVersion(ctx, "Certification", certificationID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		checked := *certification
+		checked.UserID = stored.UserID
+		if err := repo.checkConstraints(certificationID, &checked); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to update certification: %v", err)
+			return nil, domain.NewDatabaseError("update certification", err)
+		}
+		stored.Name = certification.Name
+		stored.Issuer = certification.Issuer
+		stored.CredentialID = certification.CredentialID
+		stored.VerificationURL = certification.VerificationURL
+		stored.IssueDate = certification.IssueDate
+		stored.ExpiryDate = copyTime(certification.ExpiryDate)
+		stored.Description = certification.Description
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, certificationID)
+}
+
+func (repo *certificationRepository) Delete(ctx context.Context, certificationID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.certifications[certificationID]; ok {
+		if err := checkVersion(ctx, "Certification", certificationID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, entities.TrashTypeCertification, repo.store.certifications, certificationID)
+	return nil
+}
+
+func (repo *certificationRepository) ExistsByCredential(ctx context.Context, userID int, issuer, credentialID string, exceptCertificationID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	return repo.hasCredential(userID, issuer, credentialID, exceptCertificationID), nil
+}
+
+func (repo *certificationRepository) Reorder(ctx context.Context, userID int, certificationIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("certifications", repo.store.certifications, certificationPlace, userID, certificationIDs)
+}
+
+// checkConstraints mirrors the partial unique index on (user_id,
+// certification_issuer, certification_credential_id) and the user foreign
+// key; callers must hold the write lock.
+func (repo *certificationRepository) checkConstraints(certificationID int, certification *entities.Certification) error {
+	if err := repo.store.checkUser(certification.UserID); err != nil {
+		return err
+	}
+	if certification.CredentialID != "" &&
+		repo.hasCredential(certification.UserID, certification.Issuer, certification.CredentialID, certificationID) {
+		return uniqueConstraintError("certifications.user_id, certifications.certification_issuer, certifications.certification_credential_id")
+	}
+	return nil
+}
+
+// hasCredential looks through the live and the trashed certifications;
+// callers must hold a lock.
+func (repo *certificationRepository) hasCredential(userID int, issuer, credentialID string, exceptCertificationID int) bool {
+	for id, certification := range withTrashed(repo.store, entities.TrashTypeCertification, repo.store.certifications) {
+		if id != exceptCertificationID && certification.UserID == userID &&
+			certification.Issuer == issuer && certification.CredentialID == credentialID {
+			return true
+		}
+	}
+	return false
+}
+
+func copyCertification(certification *entities.Certification) *entities.Certification {
+	copied := *certification
+	copied.ExpiryDate = copyTime(certification.ExpiryDate)
+	return &copied
+}
+
+func certificationPlace(certification *entities.Certification) (int, *int) {
+	return certification.UserID, &certification.Position
+}
@@ -0,0 +1,29 @@
+package memory_test
+
+import (
+	"io"
+	"portfolio/infrastructure/conformance"
+	"portfolio/infrastructure/memory"
+	"portfolio/logger"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) *conformance.Repositories {
+		logger := logger.NewWriterLogger(io.Discard)
+		store := memory.NewStore()
+
+		return &conformance.Repositories{
+			User:           memory.NewUserRepository(store, logger),
+			Setting:        memory.NewSettingRepository(store, logger, "portfolio"),
+			Technology:     memory.NewTechnologyRepository(store, logger),
+			Project:        memory.NewProjectRepository(store, logger),
+			Search:         memory.NewSearchRepository(store, logger),
+			Certification:  memory.NewCertificationRepository(store, logger),
+			SpokenLanguage: memory.NewSpokenLanguageRepository(store, logger),
+			Volunteering:   memory.NewVolunteeringRepository(store, logger),
+			Trash:          memory.NewTrashRepository(store, logger),
+			UnitOfWork:     memory.NewUnitOfWork(store, logger),
+		}
+	})
+}
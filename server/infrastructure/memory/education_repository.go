@@ -0,0 +1,260 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"sort"
+	"strings"
+	"time"
+)
+
+type educationRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewEducationRepository(store *Store, logger *logger.Logger) interfaces.EducationRepository {
+	return &educationRepository{store: store, logger: logger}
+}
+
+func (repo *educationRepository) Create(ctx context.Context, education *entities.Education) (*entities.Education, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.checkConstraints(0, education.Degree, education.Institution, education.UserID); err != nil {
+		repo.logger.Error("Failed to create education: %v", err)
+		return nil, domain.NewDatabaseError("create education", err)
+	}
+
+	education.EducationID = repo.store.nextID("educations")
+	education.Position = nextPosition(repo.store.educations, educationPlace, education.UserID)
+	education.CreatedAt = time.Now()
+	education.UpdatedAt = time.Now()
+	education.Version = 1
+
+	repo.store.educations[education.EducationID] = copyEducation(education)
+
+	return education, nil
+}
+
+func (repo *educationRepository) GetByID(ctx context.Context, educationID int) (*entities.Education, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	education, ok := repo.store.educations[educationID]
+	if !ok {
+		return nil, nil
+	}
+
+	return copyEducation(education), nil
+}
+
+var educationListSpec = listSpec[*entities.Education]{
+	id: func(education *entities.Education) int { return education.EducationID },
+	sorts: map[string]func(a, b *entities.Education) int{
+		"position":    func(a, b *entities.Education) int { return cmp.Compare(a.Position, b.Position) },
+		"start_date":  func(a, b *entities.Education) int { return a.StartDate.Compare(b.StartDate) },
+		"degree":      func(a, b *entities.Education) int { return compareFolded(a.Degree, b.Degree) },
+		"institution": func(a, b *entities.Education) int { return compareFolded(a.Institution, b.Institution) },
+		"created_at":  func(a, b *entities.Education) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	},
+	filters: map[string]func(education *entities.Education, value string) bool{
+		"state": func(education *entities.Education, value string) bool { return education.State == value },
+		"institution": func(education *entities.Education, value string) bool {
+			return strings.EqualFold(education.Institution, value)
+		},
+	},
+}
+
+func (repo *educationRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Education], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var educations []*entities.Education
+	for _, education := range repo.store.educations {
+		if education.UserID == userID {
+			educations = append(educations, copyEducation(education))
+		}
+	}
+
+	return listPage(educationListSpec, educations, query)
+}
+
+func (repo *educationRepository) Update(ctx context.Context, educationID int, education *entities.Education) (*entities.Education, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.educations[educationID]; ok {
+		if err := checkVersion(ctx, "Education", educationID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		if err := repo.checkConstraints(educationID, education.Degree, education.Institution, stored.UserID); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to update education: %v", err)
+			return nil, domain.NewDatabaseError("update education", err)
+		}
+		stored.Degree = education.Degree
+		stored.Institution = education.Institution
+		stored.StartDate = education.StartDate
+		stored.EndDate = copyTime(education.EndDate)
+		stored.Description = education.Description
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, educationID)
+}
+
+func (repo *educationRepository) Patch(ctx context.Context, educationID int, education *entities.Education) (*entities.Education, error) {
+	hasEndDate := education.EndDate != nil && !education.EndDate.IsZero()
+	if education.Degree == "" && education.Institution == "" && education.StartDate.IsZero() &&
+		!hasEndDate && education.Description == "" {
+		return education, nil
+	}
+
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.educations[educationID]; ok {
+		if err := checkVersion(ctx, "Education", educationID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		degree, institution := stored.Degree, stored.Institution
+		if education.Degree != "" {
+			degree = education.Degree
+		}
+		if education.Institution != "" {
+			institution = education.Institution
+		}
+		if err := repo.checkConstraints(educationID, degree, institution, stored.UserID); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to patch education: %v", err)
+			return nil, fmt.Errorf("unable to patch education: %w", err)
+		}
+		stored.Degree = degree
+		stored.Institution = institution
+		if !education.StartDate.IsZero() {
+			stored.StartDate = education.StartDate
+		}
+		if hasEndDate {
+			stored.EndDate = copyTime(education.EndDate)
+		}
+		if education.Description != "" {
+			stored.Description = education.Description
+		}
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, educationID)
+}
+
+func (repo *educationRepository) Delete(ctx context.Context, educationID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.educations[educationID]; ok {
+		if err := checkVersion(ctx, "Education", educationID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, "educations", repo.store.educations, educationID)
+	return nil
+}
+
+func (repo *educationRepository) ExistsByID(ctx context.Context, educationID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	_, ok := repo.store.educations[educationID]
+	return ok, nil
+}
+
+func (repo *educationRepository) ExistsByDegreeInstitutionAndUserID(ctx context.Context, degree, institution string, userID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for _, education := range withTrashed(repo.store, "educations", repo.store.educations) {
+		if education.Degree == degree && education.Institution == institution && education.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (repo *educationRepository) GetCurrentEducations(ctx context.Context, userID int) ([]*entities.Education, error) {
+	return repo.list(func(education *entities.Education) bool {
+		return education.UserID == userID && (education.EndDate == nil || education.EndDate.IsZero())
+	}), nil
+}
+
+func (repo *educationRepository) GetAll(ctx context.Context) ([]*entities.Education, error) {
+	return repo.list(func(*entities.Education) bool { return true }), nil
+}
+
+// list returns copies of the matching educations, most recent start first.
+func (repo *educationRepository) list(match func(*entities.Education) bool) []*entities.Education {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var educations []*entities.Education
+	for _, education := range repo.store.educations {
+		if match(education) {
+			educations = append(educations, copyEducation(education))
+		}
+	}
+
+	sort.Slice(educations, func(i, j int) bool {
+		if educations[i].StartDate.Equal(educations[j].StartDate) {
+			return educations[i].EducationID < educations[j].EducationID
+		}
+		return educations[i].StartDate.After(educations[j].StartDate)
+	})
+
+	return educations
+}
+
+// checkConstraints mirrors UNIQUE(education_degree, education_institution,
+// user_id) and the user foreign key; callers must hold the write lock.
+func (repo *educationRepository) checkConstraints(educationID int, degree, institution string, userID int) error {
+	if err := repo.store.checkUser(userID); err != nil {
+		return err
+	}
+	for id, education := range withTrashed(repo.store, "educations", repo.store.educations) {
+		if id != educationID && education.Degree == degree && education.Institution == institution && education.UserID == userID {
+			return uniqueConstraintError("educations.education_degree, educations.education_institution, educations.user_id")
+		}
+	}
+	return nil
+}
+
+func copyEducation(education *entities.Education) *entities.Education {
+	copied := *education
+	copied.EndDate = copyTime(education.EndDate)
+	return &copied
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
+
+func (repo *educationRepository) Reorder(ctx context.Context, userID int, educationIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("educations", repo.store.educations, educationPlace, userID, educationIDs)
+}
+
+func educationPlace(education *entities.Education) (int, *int) {
+	return education.UserID, &education.Position
+}
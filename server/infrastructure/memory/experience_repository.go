@@ -0,0 +1,296 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+type experienceRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewExperienceRepository(store *Store, logger *logger.Logger) interfaces.ExperienceRepository {
+	return &experienceRepository{store: store, logger: logger}
+}
+
+func (repo *experienceRepository) Create(ctx context.Context, experience *entities.Experience) (*entities.Experience, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.store.checkUser(experience.UserID); err != nil {
+		repo.logger.Error("Failed to create experience: %v", err)
+		return nil, domain.NewDatabaseError("create experience", err)
+	}
+
+	experience.ExperienceID = repo.store.nextID("experiences")
+	experience.Position = nextPosition(repo.store.experiences, experiencePlace, experience.UserID)
+	experience.CreatedAt = time.Now()
+	experience.UpdatedAt = time.Now()
+	experience.Version = 1
+
+	stored := *experience
+	stored.Achievements = nil
+	stored.Technologies = nil
+	repo.store.experiences[experience.ExperienceID] = &stored
+
+	return experience, nil
+}
+
+func (repo *experienceRepository) GetByID(ctx context.Context, experienceID int) (*entities.Experience, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	experience, ok := repo.store.experiences[experienceID]
+	if !ok {
+		return nil, nil
+	}
+
+	return repo.withDetails(experience), nil
+}
+
+var experienceListSpec = listSpec[*entities.Experience]{
+	id: func(experience *entities.Experience) int { return experience.ExperienceID },
+	sorts: map[string]func(a, b *entities.Experience) int{
+		"position":     func(a, b *entities.Experience) int { return cmp.Compare(a.Position, b.Position) },
+		"start_date":   func(a, b *entities.Experience) int { return a.StartDate.Compare(b.StartDate) },
+		"job_title":    func(a, b *entities.Experience) int { return compareFolded(a.JobTitle, b.JobTitle) },
+		"company_name": func(a, b *entities.Experience) int { return compareFolded(a.CompanyName, b.CompanyName) },
+		"created_at":   func(a, b *entities.Experience) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	},
+	filters: map[string]func(experience *entities.Experience, value string) bool{
+		"state": func(experience *entities.Experience, value string) bool { return experience.State == value },
+		"company_name": func(experience *entities.Experience, value string) bool {
+			return strings.EqualFold(experience.CompanyName, value)
+		},
+		"employment_type": func(experience *entities.Experience, value string) bool {
+			return experience.EmploymentType == value
+		},
+		"remote": func(experience *entities.Experience, value string) bool {
+			return experience.Remote == (value == "true")
+		},
+		"technology": func(experience *entities.Experience, value string) bool {
+			return hasTechnology(experience.Technologies, value)
+		},
+	},
+}
+
+func (repo *experienceRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Experience], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var experiences []*entities.Experience
+	latest := make(map[string]time.Time)
+	for _, experience := range repo.store.experiences {
+		if experience.UserID == userID {
+			experiences = append(experiences, repo.withDetails(experience))
+			if key := experience.CompanyKey(); experience.StartDate.After(latest[key]) {
+				latest[key] = experience.StartDate
+			}
+		}
+	}
+
+	// The company sort depends on the other positions at the company, so it
+	// is added per call rather than to experienceListSpec.
+	spec := experienceListSpec
+	spec.sorts = maps.Clone(spec.sorts)
+	spec.sorts["company"] = func(a, b *entities.Experience) int {
+		return cmp.Or(latest[a.CompanyKey()].Compare(latest[b.CompanyKey()]), strings.Compare(a.CompanyKey(), b.CompanyKey()))
+	}
+
+	return listPage(spec, experiences, query)
+}
+
+func (repo *experienceRepository) Update(ctx context.Context, experienceID int, experience *entities.Experience) (*entities.Experience, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.experiences[experienceID]; ok {
+		if err := checkVersion(ctx, "Experience", experienceID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		stored.CompanyName = experience.CompanyName
+		stored.JobTitle = experience.JobTitle
+		stored.StartDate = experience.StartDate
+		stored.EndDate = experience.EndDate
+		stored.Description = experience.Description
+		stored.EmploymentType = experience.EmploymentType
+		stored.Location = experience.Location
+		stored.Remote = experience.Remote
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, experienceID)
+}
+
+func (repo *experienceRepository) Patch(ctx context.Context, experienceID int, experience *entities.Experience) (*entities.Experience, error) {
+	if experience.CompanyName == "" && experience.JobTitle == "" && experience.StartDate.IsZero() &&
+		experience.EndDate.IsZero() && experience.Description == "" && experience.EmploymentType == "" {
+		return experience, nil
+	}
+
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.experiences[experienceID]; ok {
+		if err := checkVersion(ctx, "Experience", experienceID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		if experience.JobTitle != "" {
+			stored.JobTitle = experience.JobTitle
+		}
+		if experience.CompanyName != "" {
+			stored.CompanyName = experience.CompanyName
+		}
+		if !experience.StartDate.IsZero() {
+			stored.StartDate = experience.StartDate
+		}
+		if !experience.EndDate.IsZero() {
+			stored.EndDate = experience.EndDate
+		}
+		if experience.Description != "" {
+			stored.Description = experience.Description
+		}
+		if experience.EmploymentType != "" {
+			stored.EmploymentType = experience.EmploymentType
+		}
+		stored.Location = experience.Location
+		stored.Remote = experience.Remote
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, experienceID)
+}
+
+func (repo *experienceRepository) Delete(ctx context.Context, experienceID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.experiences[experienceID]; ok {
+		if err := checkVersion(ctx, "Experience", experienceID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, "experiences", repo.store.experiences, experienceID)
+	return nil
+}
+
+func (repo *experienceRepository) ExistsByID(ctx context.Context, experienceID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	_, ok := repo.store.experiences[experienceID]
+	return ok, nil
+}
+
+func (repo *experienceRepository) GetCurrentExperiences(ctx context.Context, userID int) ([]*entities.Experience, error) {
+	return repo.list(func(experience *entities.Experience) bool {
+		return experience.UserID == userID && experience.EndDate.IsZero()
+	}), nil
+}
+
+func (repo *experienceRepository) GetAll(ctx context.Context) ([]*entities.Experience, error) {
+	return repo.list(func(*entities.Experience) bool { return true }), nil
+}
+
+// list returns copies of the matching experiences, most recent start first.
+func (repo *experienceRepository) list(match func(*entities.Experience) bool) []*entities.Experience {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var experiences []*entities.Experience
+	for _, experience := range repo.store.experiences {
+		if match(experience) {
+			experiences = append(experiences, repo.withDetails(experience))
+		}
+	}
+
+	sort.Slice(experiences, func(i, j int) bool {
+		if experiences[i].StartDate.Equal(experiences[j].StartDate) {
+			return experiences[i].ExperienceID < experiences[j].ExperienceID
+		}
+		return experiences[i].StartDate.After(experiences[j].StartDate)
+	})
+
+	return experiences
+}
+
+func (repo *experienceRepository) Reorder(ctx context.Context, userID int, experienceIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("experiences", repo.store.experiences, experiencePlace, userID, experienceIDs)
+}
+
+func experiencePlace(experience *entities.Experience) (int, *int) {
+	return experience.UserID, &experience.Position
+}
+
+func (repo *experienceRepository) SetAchievements(ctx context.Context, experienceID int, achievements []string) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	stored, ok := findRow(repo.store.experiences, repo.store.trash[entities.TrashTypeExperience], experienceID)
+	if !ok {
+		return domain.NewDatabaseError("experience achievements update", fmt.Errorf("FOREIGN KEY constraint failed"))
+	}
+
+	// The slice is replaced rather than updated in place, as snapshots
+	// share it.
+	stored.Achievements = slices.Clone(achievements)
+	return nil
+}
+
+func (repo *experienceRepository) SetTechnologies(ctx context.Context, experienceID int, technologies []*entities.Technology) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if _, ok := findRow(repo.store.experiences, repo.store.trash[entities.TrashTypeExperience], experienceID); !ok {
+		return domain.NewDatabaseError("experience technologies update", fmt.Errorf("FOREIGN KEY constraint failed"))
+	}
+
+	technologyIDs := make([]int, 0, len(technologies))
+	for _, technology := range technologies {
+		if _, ok := findRow(repo.store.technologies, repo.store.trash[entities.TrashTypeTechnology], technology.TechnologyID); !ok {
+			return domain.NewDatabaseError("experience technologies update", fmt.Errorf("FOREIGN KEY constraint failed"))
+		}
+		if slices.Contains(technologyIDs, technology.TechnologyID) {
+			return domain.NewDatabaseError("experience technologies update", uniqueConstraintError("experience_technologies.experience_id, experience_technologies.technology_id"))
+		}
+		technologyIDs = append(technologyIDs, technology.TechnologyID)
+	}
+
+	repo.store.experienceTechnologies[experienceID] = technologyIDs
+	return nil
+}
+
+// withDetails copies a stored experience and fills in its achievements and
+// live linked technologies; callers must hold a lock.
+func (repo *experienceRepository) withDetails(experience *entities.Experience) *entities.Experience {
+	found := *experience
+	found.Achievements = slices.Clone(experience.Achievements)
+	if found.Achievements == nil {
+		found.Achievements = []string{}
+	}
+	found.Technologies = []*entities.Technology{}
+	for _, technologyID := range repo.store.experienceTechnologies[experience.ExperienceID] {
+		if technology, ok := repo.store.technologies[technologyID]; ok {
+			linked := *technology
+			found.Technologies = append(found.Technologies, &linked)
+		}
+	}
+	return &found
+}
@@ -0,0 +1,142 @@
+package memory
+
+import (
+	"cmp"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/infrastructure/listing"
+	"slices"
+	"strings"
+)
+
+// listSpec is the memory counterpart of listing.Table: how to sort and
+// filter the rows of a list by their API names.
+type listSpec[T any] struct {
+	id      func(T) int
+	sorts   map[string]func(a, b T) int
+	filters map[string]func(row T, value string) bool
+}
+
+// listPage cuts the page selected by query out of rows, which hold every
+// row in scope. Like the SQL backends, it only accepts a cursor in scope,
+// whether or not the filters keep it.
+func listPage[T any](spec listSpec[T], rows []T, query *entities.ListQuery) (*entities.ListPage[T], error) {
+	cursor := max(query.After, query.Before)
+	pivotIndex := slices.IndexFunc(rows, func(row T) bool { return spec.id(row) == cursor })
+	var pivot T
+	if pivotIndex >= 0 {
+		pivot = rows[pivotIndex]
+	}
+
+	for name, value := range query.Filters {
+		filter, ok := spec.filters[name]
+		if !ok {
+			return nil, domain.NewValidationError("Unknown filter "+name, "filter["+name+"]", nil)
+		}
+		rows = slices.DeleteFunc(rows, func(row T) bool { return !filter(row, value) })
+	}
+
+	for _, field := range query.Sort {
+		if _, ok := spec.sorts[field.Name]; !ok {
+			return nil, domain.NewValidationError("Unknown sort field "+field.Name, "sort", nil)
+		}
+	}
+	compare := func(a, b T) int {
+		for _, field := range query.Sort {
+			if order := spec.sorts[field.Name](a, b); order != 0 {
+				if field.Descending {
+					return -order
+				}
+				return order
+			}
+		}
+		return cmp.Compare(spec.id(a), spec.id(b))
+	}
+	slices.SortFunc(rows, compare)
+
+	total := len(rows)
+	if cursor != 0 {
+		if pivotIndex < 0 {
+			return nil, domain.NewValidationError("The page cursor no longer points to an item", "page", nil)
+		}
+		if query.After != 0 {
+			rows = slices.DeleteFunc(rows, func(row T) bool { return compare(row, pivot) <= 0 })
+		} else {
+			rows = slices.DeleteFunc(rows, func(row T) bool { return compare(row, pivot) >= 0 })
+		}
+	}
+
+	// Read like the SQL backends do: backwards from a before cursor, and one
+	// row more than the page size.
+	if query.Before != 0 {
+		slices.Reverse(rows)
+	}
+	if len(rows) > query.Size+1 {
+		rows = rows[:query.Size+1]
+	}
+
+	return listing.Paginate(rows, spec.id, query, total), nil
+}
+
+// findRow looks a row up among the live and the trashed rows of a table.
+func findRow[T any](live map[int]*T, trash map[int]*trashedRow, id int) (*T, bool) {
+	if row, ok := live[id]; ok {
+		return row, true
+	}
+	if trashed, ok := trash[id]; ok {
+		row, ok := trashed.row.(*T)
+		return row, ok
+	}
+	return nil, false
+}
+
+func compareFolded(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// placeFunc locates a row in its owner's display order: the owner's ID and
+// the row's position.
+type placeFunc[T any] func(row *T) (userID int, position *int)
+
+// nextPosition mirrors listing.NextPosition over the live rows of one user;
+// callers must hold the lock.
+func nextPosition[T any](live map[int]*T, place placeFunc[T], userID int) int {
+	next := 0
+	for _, row := range live {
+		if owner, position := place(row); owner == userID {
+			next = max(next, *position+1)
+		}
+	}
+	return next
+}
+
+// reorder mirrors listing.Reorder over the live rows of one user; callers
+// must hold the write lock.
+func reorder[T any](table string, live map[int]*T, place placeFunc[T], userID int, ids []int) error {
+	unlisted := map[int]bool{}
+	for id, row := range live {
+		if owner, _ := place(row); owner == userID {
+			unlisted[id] = true
+		}
+	}
+
+	if len(ids) != len(unlisted) {
+		return reorderError(table)
+	}
+	for _, id := range ids {
+		if !unlisted[id] {
+			return reorderError(table)
+		}
+		delete(unlisted, id)
+	}
+
+	for i, id := range ids {
+		_, position := place(live[id])
+		*position = i
+	}
+	return nil
+}
+
+func reorderError(table string) error {
+	return domain.NewValidationError("IDs must list every one of the "+table+" exactly once", "ids", nil)
+}
@@ -0,0 +1,215 @@
+package memory
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/domain/utils"
+	"portfolio/logger"
+	"time"
+)
+
+type personalInfoRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewPersonalInfoRepository(store *Store, logger *logger.Logger) interfaces.PersonalInfoRepository {
+	return &personalInfoRepository{store: store, logger: logger}
+}
+
+func (repo *personalInfoRepository) Get(ctx context.Context) (*entities.PersonalInfo, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var first *entities.PersonalInfo
+	for _, info := range repo.store.personalInfos {
+		if first == nil || info.PersonalInfoID < first.PersonalInfoID {
+			first = info
+		}
+	}
+	if first == nil {
+		return nil, nil
+	}
+
+	return copyPersonalInfo(first), nil
+}
+
+func (repo *personalInfoRepository) GetByID(ctx context.Context, personalInfoID int) (*entities.PersonalInfo, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	info, ok := repo.store.personalInfos[personalInfoID]
+	if !ok {
+		return nil, nil
+	}
+
+	return copyPersonalInfo(info), nil
+}
+
+func (repo *personalInfoRepository) Create(ctx context.Context, personalInfo *entities.PersonalInfo) (*entities.PersonalInfo, error) {
+	repo.store.lock(ctx)
+
+	if err := repo.checkConstraints(0, personalInfo.UserID); err != nil {
+		repo.store.unlock(ctx)
+		repo.logger.Error("Failed to create personalinfo: %v", err)
+		return nil, domain.NewDatabaseError("create personal information", err)
+	}
+
+	now := time.Now()
+	stored := copyPersonalInfo(personalInfo)
+	stored.PersonalInfoID = repo.store.nextID("personal_infos")
+	stored.CreatedAt = now
+	stored.UpdatedAt = now
+	stored.Version = 1
+	repo.store.personalInfos[stored.PersonalInfoID] = stored
+	repo.store.unlock(ctx)
+
+	return repo.Get(ctx)
+}
+
+func (repo *personalInfoRepository) Update(ctx context.Context, personalInfoId int, personalInfo *entities.PersonalInfo) (*entities.PersonalInfo, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.personalInfos[personalInfoId]; ok {
+		if err := checkVersion(ctx, "Personal info", personalInfoId, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		replaced := copyPersonalInfo(personalInfo)
+		replaced.PersonalInfoID = stored.PersonalInfoID
+		replaced.UserID = stored.UserID
+		replaced.CreatedAt = stored.CreatedAt
+		replaced.UpdatedAt = stored.UpdatedAt
+		replaced.Version = stored.Version + 1
+		repo.store.personalInfos[personalInfoId] = replaced
+	}
+	repo.store.unlock(ctx)
+
+	return repo.Get(ctx)
+}
+
+func (repo *personalInfoRepository) Patch(ctx context.Context, personalInfoId int, personalInfo *entities.PersonalInfo) (*entities.PersonalInfo, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.personalInfos[personalInfoId]; ok {
+		if err := checkVersion(ctx, "Personal info", personalInfoId, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		patchString(&stored.FirstName, personalInfo.FirstName)
+		patchString(&stored.LastName, personalInfo.LastName)
+		patchString(&stored.ProfessionalTitle, personalInfo.ProfessionalTitle)
+		patchString(&stored.Intro, personalInfo.Intro)
+		if personalInfo.AboutMe != nil {
+			aboutMe := *personalInfo.AboutMe
+			stored.AboutMe = &aboutMe
+		}
+		patchString(&stored.Location, personalInfo.Location)
+		patchString(&stored.ResumeURL, personalInfo.ResumeURL)
+		patchString(&stored.WebsiteURL, personalInfo.WebsiteURL)
+		patchString(&stored.LinkedinURL, personalInfo.LinkedinURL)
+		patchString(&stored.GithubURL, personalInfo.GithubURL)
+		patchString(&stored.XURL, personalInfo.XURL)
+		if personalInfo.DateOfBirth != nil {
+			dateOfBirth := *personalInfo.DateOfBirth
+			stored.DateOfBirth = &dateOfBirth
+		}
+		patchString(&stored.PhoneNumber, personalInfo.PhoneNumber)
+		patchString(&stored.Interests, personalInfo.Interests)
+		patchString(&stored.ProfilePicture, personalInfo.ProfilePicture)
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.Get(ctx)
+}
+
+func (repo *personalInfoRepository) Delete(ctx context.Context, personalInfoId int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.personalInfos[personalInfoId]; ok {
+		if err := checkVersion(ctx, "Personal info", personalInfoId, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, "personal_infos", repo.store.personalInfos, personalInfoId)
+	return nil
+}
+
+func (repo *personalInfoRepository) GetByUserID(ctx context.Context, userID int) (*entities.PersonalInfo, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for _, info := range repo.store.personalInfos {
+		if info.UserID == userID {
+			return copyPersonalInfo(info), nil
+		}
+	}
+	return nil, nil
+}
+
+func (repo *personalInfoRepository) ExistsByUserID(ctx context.Context, userID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for _, info := range withTrashed(repo.store, "personal_infos", repo.store.personalInfos) {
+		if info.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (repo *personalInfoRepository) GetUserByID(ctx context.Context, userID int) (*entities.User, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	user, ok := repo.store.users[userID]
+	if !ok {
+		return nil, nil
+	}
+
+	found := *user
+	found.Password = ""
+	found.LastLogin = time.Time{}
+	return &found, nil
+}
+
+// checkConstraints mirrors UNIQUE(user_id) and the user foreign key; callers
+// must hold the write lock.
+func (repo *personalInfoRepository) checkConstraints(personalInfoID int, userID int) error {
+	if err := repo.store.checkUser(userID); err != nil {
+		return err
+	}
+	for id, info := range withTrashed(repo.store, "personal_infos", repo.store.personalInfos) {
+		if id != personalInfoID && info.UserID == userID {
+			return uniqueConstraintError("personal_infos.user_id")
+		}
+	}
+	return nil
+}
+
+// copyPersonalInfo detaches the pointer fields and, like the SQL backends,
+// always returns a date of birth.
+func copyPersonalInfo(info *entities.PersonalInfo) *entities.PersonalInfo {
+	copied := *info
+	if info.AboutMe != nil {
+		aboutMe := *info.AboutMe
+		copied.AboutMe = &aboutMe
+	}
+	dateOfBirth := utils.NewDate(time.Time{})
+	if info.DateOfBirth != nil {
+		dateOfBirth = *info.DateOfBirth
+	}
+	copied.DateOfBirth = &dateOfBirth
+	return &copied
+}
+
+func patchString(target *string, value string) {
+	if value != "" {
+		*target = value
+	}
+}
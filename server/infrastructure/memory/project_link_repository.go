@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"slices"
+	"time"
+)
+
+type projectLinkRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewProjectLinkRepository(store *Store, logger *logger.Logger) interfaces.ProjectLinkRepository {
+	return &projectLinkRepository{store: store, logger: logger}
+}
+
+func (repo *projectLinkRepository) GetByID(ctx context.Context, linkID int) (*entities.ProjectLink, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	link, ok := repo.store.projectLinks[linkID]
+	if !ok {
+		return nil, domain.NewNotFoundError("Project link", fmt.Sprint(linkID))
+	}
+
+	found := *link
+	return &found, nil
+}
+
+func (repo *projectLinkRepository) GetByProjectID(ctx context.Context, projectID int) ([]*entities.ProjectLink, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	return projectLinks(repo.store, projectID), nil
+}
+
+func (repo *projectLinkRepository) Create(ctx context.Context, link *entities.ProjectLink) (*entities.ProjectLink, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if _, ok := findRow(repo.store.projects, repo.store.trash[entities.TrashTypeProject], link.ProjectID); !ok {
+		return nil, domain.NewDatabaseError("project link creation", fmt.Errorf("FOREIGN KEY constraint failed"))
+	}
+
+	now := time.Now()
+	stored := *link
+	stored.ProjectLinkID = repo.store.nextID("project_links")
+	stored.Position = 0
+	for _, item := range repo.store.projectLinks {
+		if item.ProjectID == link.ProjectID && item.Position >= stored.Position {
+			stored.Position = item.Position + 1
+		}
+	}
+	stored.CreatedAt = now
+	stored.UpdatedAt = now
+	repo.store.projectLinks[stored.ProjectLinkID] = &stored
+	repo.takePrimary(&stored)
+
+	found := stored
+	return &found, nil
+}
+
+func (repo *projectLinkRepository) Update(ctx context.Context, linkID int, link *entities.ProjectLink) (*entities.ProjectLink, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	stored, ok := repo.store.projectLinks[linkID]
+	if !ok {
+		return nil, domain.NewNotFoundError("Project link", fmt.Sprint(linkID))
+	}
+
+	stored.Type = link.Type
+	stored.URL = link.URL
+	stored.Label = link.Label
+	stored.IsPrimary = link.IsPrimary
+	stored.UpdatedAt = time.Now()
+	repo.takePrimary(stored)
+
+	found := *stored
+	return &found, nil
+}
+
+func (repo *projectLinkRepository) Delete(ctx context.Context, linkID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if _, ok := repo.store.projectLinks[linkID]; !ok {
+		return domain.NewNotFoundError("Project link", fmt.Sprint(linkID))
+	}
+
+	delete(repo.store.projectLinks, linkID)
+	return nil
+}
+
+func (repo *projectLinkRepository) Reorder(ctx context.Context, projectID int, linkIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	for position, linkID := range linkIDs {
+		if stored, ok := repo.store.projectLinks[linkID]; ok && stored.ProjectID == projectID {
+			stored.Position = position
+		}
+	}
+	return nil
+}
+
+// takePrimary clears the primary flag of the project's other links once
+// link is primary; callers must hold the write lock.
+func (repo *projectLinkRepository) takePrimary(link *entities.ProjectLink) {
+	if !link.IsPrimary {
+		return
+	}
+	for id, item := range repo.store.projectLinks {
+		if item.ProjectID == link.ProjectID && id != link.ProjectLinkID {
+			item.IsPrimary = false
+		}
+	}
+}
+
+// projectLinks copies the links of a project, by position; callers must
+// hold a lock.
+func projectLinks(store *Store, projectID int) []*entities.ProjectLink {
+	links := []*entities.ProjectLink{}
+	for _, item := range store.projectLinks {
+		if item.ProjectID == projectID {
+			found := *item
+			links = append(links, &found)
+		}
+	}
+	slices.SortFunc(links, func(a, b *entities.ProjectLink) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.ProjectLinkID, b.ProjectLinkID))
+	})
+	return links
+}
@@ -0,0 +1,142 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"slices"
+	"time"
+)
+
+type projectMediaRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewProjectMediaRepository(store *Store, logger *logger.Logger) interfaces.ProjectMediaRepository {
+	return &projectMediaRepository{store: store, logger: logger}
+}
+
+func (repo *projectMediaRepository) GetByID(ctx context.Context, mediaID int) (*entities.ProjectMedia, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	media, ok := repo.store.projectMedia[mediaID]
+	if !ok {
+		return nil, domain.NewNotFoundError("Project media", fmt.Sprint(mediaID))
+	}
+
+	found := *media
+	return &found, nil
+}
+
+func (repo *projectMediaRepository) GetByProjectID(ctx context.Context, projectID int) ([]*entities.ProjectMedia, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	return projectMedia(repo.store, projectID), nil
+}
+
+func (repo *projectMediaRepository) Create(ctx context.Context, media *entities.ProjectMedia) (*entities.ProjectMedia, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if _, ok := findRow(repo.store.projects, repo.store.trash[entities.TrashTypeProject], media.ProjectID); !ok {
+		return nil, domain.NewDatabaseError("project media creation", fmt.Errorf("FOREIGN KEY constraint failed"))
+	}
+
+	now := time.Now()
+	stored := *media
+	stored.ProjectMediaID = repo.store.nextID("project_media")
+	stored.Position = 0
+	for _, item := range repo.store.projectMedia {
+		if item.ProjectID == media.ProjectID && item.Position >= stored.Position {
+			stored.Position = item.Position + 1
+		}
+	}
+	stored.CreatedAt = now
+	stored.UpdatedAt = now
+	repo.store.projectMedia[stored.ProjectMediaID] = &stored
+	repo.takePrimary(&stored)
+
+	found := stored
+	return &found, nil
+}
+
+func (repo *projectMediaRepository) Update(ctx context.Context, mediaID int, media *entities.ProjectMedia) (*entities.ProjectMedia, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	stored, ok := repo.store.projectMedia[mediaID]
+	if !ok {
+		return nil, domain.NewNotFoundError("Project media", fmt.Sprint(mediaID))
+	}
+
+	stored.Type = media.Type
+	stored.URL = media.URL
+	stored.Caption = media.Caption
+	stored.AltText = media.AltText
+	stored.IsPrimary = media.IsPrimary
+	stored.UpdatedAt = time.Now()
+	repo.takePrimary(stored)
+
+	found := *stored
+	return &found, nil
+}
+
+func (repo *projectMediaRepository) Delete(ctx context.Context, mediaID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if _, ok := repo.store.projectMedia[mediaID]; !ok {
+		return domain.NewNotFoundError("Project media", fmt.Sprint(mediaID))
+	}
+
+	delete(repo.store.projectMedia, mediaID)
+	return nil
+}
+
+func (repo *projectMediaRepository) Reorder(ctx context.Context, projectID int, mediaIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	for position, mediaID := range mediaIDs {
+		if stored, ok := repo.store.projectMedia[mediaID]; ok && stored.ProjectID == projectID {
+			stored.Position = position
+		}
+	}
+	return nil
+}
+
+// takePrimary clears the primary flag of the project's other items once
+// media is primary; callers must hold the write lock.
+func (repo *projectMediaRepository) takePrimary(media *entities.ProjectMedia) {
+	if !media.IsPrimary {
+		return
+	}
+	for id, item := range repo.store.projectMedia {
+		if item.ProjectID == media.ProjectID && id != media.ProjectMediaID {
+			item.IsPrimary = false
+		}
+	}
+}
+
+// projectMedia copies the gallery of a project, by position; callers must
+// hold a lock.
+func projectMedia(store *Store, projectID int) []*entities.ProjectMedia {
+	media := []*entities.ProjectMedia{}
+	for _, item := range store.projectMedia {
+		if item.ProjectID == projectID {
+			found := *item
+			media = append(media, &found)
+		}
+	}
+	slices.SortFunc(media, func(a, b *entities.ProjectMedia) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.ProjectMediaID, b.ProjectMediaID))
+	})
+	return media
+}
@@ -0,0 +1,353 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type projectRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewProjectRepository(store *Store, logger *logger.Logger) interfaces.ProjectRepository {
+	return &projectRepository{store: store, logger: logger}
+}
+
+var projectListSpec = listSpec[*entities.Project]{
+	id: func(project *entities.Project) int { return project.ProjectID },
+	sorts: map[string]func(a, b *entities.Project) int{
+		"position":   func(a, b *entities.Project) int { return cmp.Compare(a.Position, b.Position) },
+		"title":      func(a, b *entities.Project) int { return compareFolded(a.Title, b.Title) },
+		"status":     func(a, b *entities.Project) int { return strings.Compare(a.Status, b.Status) },
+		"created_at": func(a, b *entities.Project) int { return a.CreatedAt.Compare(b.CreatedAt) },
+		"updated_at": func(a, b *entities.Project) int { return a.UpdatedAt.Compare(b.UpdatedAt) },
+	},
+	filters: map[string]func(project *entities.Project, value string) bool{
+		"state":  func(project *entities.Project, value string) bool { return project.State == value },
+		"status": func(project *entities.Project, value string) bool { return project.Status == value },
+		"featured": func(project *entities.Project, value string) bool {
+			return strconv.FormatBool(project.Featured) == value
+		},
+		"technology": func(project *entities.Project, value string) bool {
+			return hasTechnology(project.LinkedTechnologies, value)
+		},
+	},
+}
+
+func (repo *projectRepository) GetAll(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Project], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var projects []*entities.Project
+	for _, project := range repo.store.projects {
+		if project.UserID == userID {
+			projects = append(projects, repo.withTechnologies(project))
+		}
+	}
+
+	return listPage(projectListSpec, projects, query)
+}
+
+func (repo *projectRepository) GetByTechnologyID(ctx context.Context, userID, technologyID int, query *entities.ListQuery) (*entities.ListPage[*entities.Project], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var projects []*entities.Project
+	for _, project := range repo.store.projects {
+		if project.UserID == userID && slices.Contains(repo.store.projectTechnologies[project.ProjectID], technologyID) {
+			projects = append(projects, repo.withTechnologies(project))
+		}
+	}
+
+	return listPage(projectListSpec, projects, query)
+}
+
+func (repo *projectRepository) GetByID(ctx context.Context, projectID int) (*entities.Project, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	project, ok := repo.store.projects[projectID]
+	if !ok {
+		return nil, domain.NewNotFoundError("Project", fmt.Sprint(projectID))
+	}
+
+	return repo.withTechnologies(project), nil
+}
+
+func (repo *projectRepository) Create(ctx context.Context, project *entities.Project) (*entities.Project, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.checkConstraints(0, project.Title, project.Slug, project.UserID); err != nil {
+		repo.logger.Error("Failed to create project: %v", err)
+		return nil, domain.NewDatabaseError("project creation", err)
+	}
+
+	now := time.Now()
+	project.ProjectID = repo.store.nextID("projects")
+	project.Position = nextPosition(repo.store.projects, projectPlace, project.UserID)
+	project.CreatedAt = now
+	project.UpdatedAt = now
+	project.Version = 1
+
+	stored := *project
+	stored.LinkedTechnologies = nil
+	stored.SetMedia(nil)
+	stored.SetLinks(nil)
+	repo.store.projects[project.ProjectID] = &stored
+
+	return project, nil
+}
+
+func (repo *projectRepository) Update(ctx context.Context, projectID int, project *entities.Project) (*entities.Project, error) {
+	repo.store.lock(ctx)
+
+	stored, ok := repo.store.projects[projectID]
+	if !ok {
+		repo.store.unlock(ctx)
+		return nil, domain.NewNotFoundError("Project", fmt.Sprint(project.ProjectID))
+	}
+
+	if err := checkVersion(ctx, "Project", projectID, stored.Version); err != nil {
+		repo.store.unlock(ctx)
+		return nil, err
+	}
+
+	if err := repo.checkConstraints(projectID, project.Title, project.Slug, stored.UserID); err != nil {
+		repo.store.unlock(ctx)
+		repo.logger.Error("Failed to update project: %v", err)
+		return nil, domain.NewDatabaseError("project update", err)
+	}
+
+	now := time.Now()
+	stored.Title = project.Title
+	stored.Description = project.Description
+	stored.ShortDescription = project.ShortDescription
+	stored.Technologies = project.Technologies
+	stored.Status = project.Status
+	stored.Slug = project.Slug
+	stored.Featured = project.Featured
+	stored.UpdatedAt = now
+	stored.Version++
+	repo.store.unlock(ctx)
+
+	project.UpdatedAt = now
+	return repo.GetByID(ctx, projectID)
+}
+
+func (repo *projectRepository) Patch(ctx context.Context, projectID int, project *entities.Project) (*entities.Project, error) {
+	if project.Title == "" && project.Description == "" && project.ShortDescription == "" &&
+		project.Technologies == "" && project.Status == "" && project.Slug == "" {
+		return project, nil
+	}
+
+	repo.store.lock(ctx)
+
+	stored, ok := repo.store.projects[projectID]
+	if ok {
+		if err := checkVersion(ctx, "Project", projectID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+
+		title := stored.Title
+		if project.Title != "" {
+			title = project.Title
+		}
+		slug := stored.Slug
+		if project.Slug != "" {
+			slug = project.Slug
+		}
+		if err := repo.checkConstraints(projectID, title, slug, stored.UserID); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to patch project: %v", err)
+			return nil, fmt.Errorf("unable to patch project: %w", err)
+		}
+
+		if project.Title != "" {
+			stored.Title = project.Title
+		}
+		if project.Description != "" {
+			stored.Description = project.Description
+		}
+		if project.ShortDescription != "" {
+			stored.ShortDescription = project.ShortDescription
+		}
+		if project.Technologies != "" {
+			stored.Technologies = project.Technologies
+		}
+		if project.Status != "" {
+			stored.Status = project.Status
+		}
+		stored.Slug = slug
+		stored.Featured = project.Featured
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, projectID)
+}
+
+func (repo *projectRepository) Delete(ctx context.Context, projectID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if _, ok := repo.store.projects[projectID]; !ok {
+		return domain.NewNotFoundError("Project", fmt.Sprint(projectID))
+	}
+
+	if stored, ok := repo.store.projects[projectID]; ok {
+		if err := checkVersion(ctx, "Project", projectID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, "projects", repo.store.projects, projectID)
+	return nil
+}
+
+// checkConstraints mirrors UNIQUE(project_title, user_id), UNIQUE(user_id,
+// project_slug) and the user foreign key; callers must hold the write lock.
+func (repo *projectRepository) checkConstraints(projectID int, title, slug string, userID int) error {
+	if err := repo.store.checkUser(userID); err != nil {
+		return err
+	}
+	for id, project := range withTrashed(repo.store, "projects", repo.store.projects) {
+		if id != projectID && project.Title == title && project.UserID == userID {
+			return uniqueConstraintError("projects.project_title, projects.user_id")
+		}
+		if id != projectID && project.Slug == slug && project.UserID == userID {
+			return uniqueConstraintError("projects.user_id, projects.project_slug")
+		}
+	}
+	return nil
+}
+
+func (repo *projectRepository) SetTechnologies(ctx context.Context, projectID int, technologies []*entities.Technology) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	stored, ok := repo.store.projects[projectID]
+	if !ok {
+		stored, ok = findRow(repo.store.projects, repo.store.trash[entities.TrashTypeProject], projectID)
+	}
+	if !ok {
+		return domain.NewDatabaseError("project technologies update", fmt.Errorf("FOREIGN KEY constraint failed"))
+	}
+
+	technologyIDs := make([]int, 0, len(technologies))
+	for _, technology := range technologies {
+		if _, ok := findRow(repo.store.technologies, repo.store.trash[entities.TrashTypeTechnology], technology.TechnologyID); !ok {
+			return domain.NewDatabaseError("project technologies update", fmt.Errorf("FOREIGN KEY constraint failed"))
+		}
+		if slices.Contains(technologyIDs, technology.TechnologyID) {
+			return domain.NewDatabaseError("project technologies update", uniqueConstraintError("project_technologies.project_id, project_technologies.technology_id"))
+		}
+		technologyIDs = append(technologyIDs, technology.TechnologyID)
+	}
+
+	repo.store.projectTechnologies[projectID] = technologyIDs
+	stored.Technologies = entities.JoinTechnologyNames(technologies)
+	return nil
+}
+
+// withTechnologies copies a stored project and fills in its live linked
+// technologies, media and links; callers must hold a lock.
+func (repo *projectRepository) withTechnologies(project *entities.Project) *entities.Project {
+	found := *project
+	found.LinkedTechnologies = []*entities.Technology{}
+	for _, technologyID := range repo.store.projectTechnologies[project.ProjectID] {
+		if technology, ok := repo.store.technologies[technologyID]; ok {
+			linked := *technology
+			found.LinkedTechnologies = append(found.LinkedTechnologies, &linked)
+		}
+	}
+	found.SetMedia(projectMedia(repo.store, project.ProjectID))
+	found.SetLinks(projectLinks(repo.store, project.ProjectID))
+	return &found
+}
+
+// hasTechnology reports whether one of the technologies has the name,
+// ignoring case like the SQL backends.
+func hasTechnology(technologies []*entities.Technology, name string) bool {
+	for _, technology := range technologies {
+		if strings.EqualFold(technology.Name, strings.TrimSpace(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (repo *projectRepository) Reorder(ctx context.Context, userID int, projectIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("projects", repo.store.projects, projectPlace, userID, projectIDs)
+}
+
+func projectPlace(project *entities.Project) (int, *int) {
+	return project.UserID, &project.Position
+}
+
+func (repo *projectRepository) GetBySlug(ctx context.Context, userID int, slug string) (*entities.Project, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for _, project := range repo.store.projects {
+		if project.UserID == userID && project.Slug == slug {
+			return repo.withTechnologies(project), nil
+		}
+	}
+	for _, former := range repo.store.projectSlugs {
+		if former.userID == userID && former.slug == slug {
+			if project, ok := repo.store.projects[former.projectID]; ok {
+				return repo.withTechnologies(project), nil
+			}
+		}
+	}
+	return nil, domain.NewNotFoundError("Project", slug)
+}
+
+func (repo *projectRepository) SlugExists(ctx context.Context, userID int, slug string, exceptProjectID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for id, project := range withTrashed(repo.store, "projects", repo.store.projects) {
+		if id != exceptProjectID && project.UserID == userID && project.Slug == slug {
+			return true, nil
+		}
+	}
+	for _, former := range repo.store.projectSlugs {
+		if former.projectID != exceptProjectID && former.userID == userID && former.slug == slug {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (repo *projectRepository) RetireSlug(ctx context.Context, project *entities.Project, oldSlug string) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	maps.DeleteFunc(repo.store.projectSlugs, func(_ int, former *projectSlug) bool {
+		return former.userID == project.UserID && (former.slug == project.Slug || former.slug == oldSlug)
+	})
+	repo.store.projectSlugs[repo.store.nextID("project_slugs")] = &projectSlug{
+		projectID: project.ProjectID,
+		userID:    project.UserID,
+		slug:      oldSlug,
+	}
+	return nil
+}
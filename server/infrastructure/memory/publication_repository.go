@@ -0,0 +1,163 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"slices"
+	"strings"
+	"time"
+)
+
+type publicationRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewPublicationRepository(store *Store, logger *logger.Logger) interfaces.PublicationRepository {
+	return &publicationRepository{store: store, logger: logger}
+}
+
+func (repo *publicationRepository) Create(ctx context.Context, publication *entities.Publication) (*entities.Publication, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.store.checkUser(publication.UserID); err != nil {
+		repo.logger.Error("Failed to create publication: %v", err)
+		return nil, domain.NewDatabaseError("create publication", err)
+	}
+
+	now := time.Now()
+	publication.PublicationID = repo.store.nextID("publications")
+	publication.Position = nextPosition(repo.store.publications, publicationPlace, publication.UserID)
+	publication.CreatedAt = now
+	publication.UpdatedAt = now
+	publication.Version = 1
+
+	stored := *publication
+	stored.CoAuthors = nil
+	repo.store.publications[publication.PublicationID] = &stored
+
+	return copyPublication(&stored), nil
+}
+
+func (repo *publicationRepository) GetByID(ctx context.Context, publicationID int) (*entities.Publication, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	publication, ok := repo.store.publications[publicationID]
+	if !ok {
+		return nil, nil
+	}
+
+	return copyPublication(publication), nil
+}
+
+var publicationListSpec = listSpec[*entities.Publication]{
+	id: func(publication *entities.Publication) int { return publication.PublicationID },
+	sorts: map[string]func(a, b *entities.Publication) int{
+		"position":   func(a, b *entities.Publication) int { return cmp.Compare(a.Position, b.Position) },
+		"date":       func(a, b *entities.Publication) int { return a.Date.Compare(b.Date) },
+		"title":      func(a, b *entities.Publication) int { return compareFolded(a.Title, b.Title) },
+		"type":       func(a, b *entities.Publication) int { return cmp.Compare(a.Type, b.Type) },
+		"created_at": func(a, b *entities.Publication) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	},
+	filters: map[string]func(publication *entities.Publication, value string) bool{
+		"state": func(publication *entities.Publication, value string) bool { return publication.State == value },
+		"type":  func(publication *entities.Publication, value string) bool { return publication.Type == value },
+		"venue": func(publication *entities.Publication, value string) bool {
+			return strings.EqualFold(publication.Venue, value)
+		},
+	},
+}
+
+func (repo *publicationRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Publication], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var publications []*entities.Publication
+	for _, publication := range repo.store.publications {
+		if publication.UserID == userID {
+			publications = append(publications, copyPublication(publication))
+		}
+	}
+
+	return listPage(publicationListSpec, publications, query)
+}
+
+func (repo *publicationRepository) Update(ctx context.Context, publicationID int, publication *entities.Publication) (*entities.Publication, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.publications[publicationID]; ok {
+		if err := checkVersion(ctx, "Publication", publicationID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		stored.Title = publication.Title
+		stored.Type = publication.Type
+		stored.Venue = publication.Venue
+		stored.Date = publication.Date
+		stored.URL = publication.URL
+		stored.SlidesURL = publication.SlidesURL
+		stored.VideoURL = publication.VideoURL
+		stored.Description = publication.Description
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, publicationID)
+}
+
+func (repo *publicationRepository) Delete(ctx context.Context, publicationID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.publications[publicationID]; ok {
+		if err := checkVersion(ctx, "Publication", publicationID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, entities.TrashTypePublication, repo.store.publications, publicationID)
+	return nil
+}
+
+func (repo *publicationRepository) Reorder(ctx context.Context, userID int, publicationIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("publications", repo.store.publications, publicationPlace, userID, publicationIDs)
+}
+
+func (repo *publicationRepository) SetCoAuthors(ctx context.Context, publicationID int, coAuthors []string) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	stored, ok := findRow(repo.store.publications, repo.store.trash[entities.TrashTypePublication], publicationID)
+	if !ok {
+		return domain.NewDatabaseError("publication co-authors update", fmt.Errorf("FOREIGN KEY constraint failed"))
+	}
+
+	// The slice is replaced rather than updated in place, as snapshots
+	// share it.
+	stored.CoAuthors = slices.Clone(coAuthors)
+	return nil
+}
+
+func copyPublication(publication *entities.Publication) *entities.Publication {
+	copied := *publication
+	copied.CoAuthors = slices.Clone(publication.CoAuthors)
+	if copied.CoAuthors == nil {
+		copied.CoAuthors = []string{}
+	}
+	return &copied
+}
+
+func publicationPlace(publication *entities.Publication) (int, *int) {
+	return publication.UserID, &publication.Position
+}
@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"sort"
+	"time"
+)
+
+type publishingRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewPublishingRepository(store *Store, logger *logger.Logger) interfaces.PublishingRepository {
+	return &publishingRepository{store: store, logger: logger}
+}
+
+// publishedRow points into a live row with a publishing state.
+type publishedRow struct {
+	item       entities.PublishingItem
+	version    *int
+	updatedAt  *time.Time
+	publishing *entities.Publishing
+}
+
+// rows returns the live rows of itemType, by ID; callers must hold a lock.
+func (repo *publishingRepository) rows(itemType string) map[int]publishedRow {
+	rows := make(map[int]publishedRow)
+	add := func(id, userID int, label string, version *int, updatedAt *time.Time, publishing *entities.Publishing) {
+		rows[id] = publishedRow{
+			item:       entities.PublishingItem{Type: itemType, ID: id, UserID: userID, Label: label, Version: *version, Publishing: *publishing},
+			version:    version,
+			updatedAt:  updatedAt,
+			publishing: publishing,
+		}
+	}
+
+	switch itemType {
+	case entities.TrashTypeProject:
+		for id, row := range repo.store.projects {
+			add(id, row.UserID, row.Title, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	case entities.TrashTypeSkill:
+		for id, row := range repo.store.skills {
+			add(id, row.UserID, row.Name, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	case entities.TrashTypeExperience:
+		for id, row := range repo.store.experiences {
+			add(id, row.UserID, row.JobTitle+" at "+row.CompanyName, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	case entities.TrashTypeEducation:
+		for id, row := range repo.store.educations {
+			add(id, row.UserID, row.Degree+", "+row.Institution, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	case entities.TrashTypeCertification:
+		for id, row := range repo.store.certifications {
+			add(id, row.UserID, row.Name+" by "+row.Issuer, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	case entities.TrashTypePublication:
+		for id, row := range repo.store.publications {
+			add(id, row.UserID, row.Title, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	case entities.TrashTypeSpokenLanguage:
+		for id, row := range repo.store.spokenLanguages {
+			add(id, row.UserID, row.Name, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	case entities.TrashTypeAward:
+		for id, row := range repo.store.awards {
+			add(id, row.UserID, row.Title+" by "+row.Issuer, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	case entities.TrashTypeVolunteering:
+		for id, row := range repo.store.volunteerings {
+			add(id, row.UserID, row.Role+" at "+row.Organization, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	case entities.TrashTypeTechnology:
+		for id, row := range repo.store.technologies {
+			add(id, row.UserID, row.Name, &row.Version, &row.UpdatedAt, &row.Publishing)
+		}
+	}
+	return rows
+}
+
+func (repo *publishingRepository) GetAll(ctx context.Context) ([]*entities.PublishingItem, error) {
+	return repo.find(func(entities.PublishingItem) bool { return true }), nil
+}
+
+func (repo *publishingRepository) Get(ctx context.Context, itemType string, id int) (*entities.PublishingItem, error) {
+	if !entities.IsPublishingType(itemType) {
+		return nil, domain.NewValidationError("Unknown publishing item type", "type", nil)
+	}
+
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	row, ok := repo.rows(itemType)[id]
+	if !ok {
+		return nil, domain.NewNotFoundError("Publishing item", fmt.Sprintf("%s/%d", itemType, id))
+	}
+	item := row.item
+	return &item, nil
+}
+
+func (repo *publishingRepository) GetDue(ctx context.Context, now time.Time) ([]*entities.PublishingItem, error) {
+	return repo.find(func(item entities.PublishingItem) bool { return item.Due(now) != "" }), nil
+}
+
+func (repo *publishingRepository) Set(ctx context.Context, itemType string, id int, publishing entities.Publishing) error {
+	if !entities.IsPublishingType(itemType) {
+		return domain.NewValidationError("Unknown publishing item type", "type", nil)
+	}
+
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	row, ok := repo.rows(itemType)[id]
+	if !ok {
+		return nil
+	}
+	if err := checkVersion(ctx, "Publishing item", id, *row.version); err != nil {
+		return err
+	}
+
+	*row.publishing = publishing
+	*row.updatedAt = time.Now()
+	*row.version++
+	return nil
+}
+
+// find returns the live items that match, by type and ID.
+func (repo *publishingRepository) find(match func(entities.PublishingItem) bool) []*entities.PublishingItem {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var items []*entities.PublishingItem
+	for _, itemType := range entities.PublishingTypes {
+		var found []*entities.PublishingItem
+		for _, row := range repo.rows(itemType) {
+			if match(row.item) {
+				item := row.item
+				found = append(found, &item)
+			}
+		}
+		sort.Slice(found, func(i, j int) bool { return found[i].ID < found[j].ID })
+		items = append(items, found...)
+	}
+	return items
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"time"
+)
+
+type revisionRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewRevisionRepository(store *Store, logger *logger.Logger) interfaces.RevisionRepository {
+	return &revisionRepository{store: store, logger: logger}
+}
+
+func (repo *revisionRepository) Create(ctx context.Context, revision *entities.Revision) (*entities.Revision, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	revision.RevisionID = repo.store.nextID("revisions")
+	revision.CreatedAt = time.Now()
+
+	stored := *revision
+	repo.store.revisions = append(repo.store.revisions, &stored)
+
+	return revision, nil
+}
+
+func (repo *revisionRepository) GetByID(ctx context.Context, revisionID int) (*entities.Revision, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for _, revision := range repo.store.revisions {
+		if revision.RevisionID == revisionID {
+			found := *revision
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetByEntity returns the entity's revisions newest first; the store keeps
+// them in insertion order.
+func (repo *revisionRepository) GetByEntity(ctx context.Context, entityType string, entityID int) ([]*entities.Revision, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var revisions []*entities.Revision
+	for i := len(repo.store.revisions) - 1; i >= 0; i-- {
+		revision := repo.store.revisions[i]
+		if revision.EntityType == entityType && revision.EntityID == entityID {
+			found := *revision
+			revisions = append(revisions, &found)
+		}
+	}
+	return revisions, nil
+}
@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+)
+
+type revokedTokenRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewRevokedTokenRepository(store *Store, logger *logger.Logger) interfaces.RevokedTokenRepository {
+	return &revokedTokenRepository{store: store, logger: logger}
+}
+
+func (repo *revokedTokenRepository) RevokedToken(ctx context.Context, userID int, token string) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.store.checkUser(userID); err != nil {
+		repo.logger.Error("failed to revoke token for user %d: %v", userID, err)
+		return err
+	}
+
+	repo.store.revokedTokens = append(repo.store.revokedTokens, revokedToken{userID: userID, token: token})
+	return nil
+}
+
+func (repo *revokedTokenRepository) IsTokenRevoked(ctx context.Context, userID int, token string) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for _, revoked := range repo.store.revokedTokens {
+		if revoked.userID == userID && revoked.token == token {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,207 @@
+package memory
+
+import (
+	"context"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// snippetWords is the number of words a snippet shows around the first match.
+const snippetWords = 16
+
+// searchField is one searchable column of a row and its weight in the score.
+type searchField struct {
+	text   string
+	weight float64
+}
+
+type searchRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewSearchRepository(store *Store, logger *logger.Logger) interfaces.SearchRepository {
+	return &searchRepository{store: store, logger: logger}
+}
+
+// Search scans the live rows: a row matches when every term is a prefix of a
+// word in one of its fields. There is no index and no stemming; this backend
+// only has to behave like the SQL ones on small demo data.
+func (repo *searchRepository) Search(ctx context.Context, query entities.SearchQuery) ([]*entities.SearchHit, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var hits []*entities.SearchHit
+	for _, itemType := range entities.SearchTypes {
+		var typeHits []*entities.SearchHit
+		match := func(id, userID int, title string, fields ...searchField) {
+			if userID != query.UserID {
+				return
+			}
+			if hit := matchRow(query.Terms, fields); hit != nil {
+				hit.Type, hit.ID, hit.Title = itemType, id, title
+				typeHits = append(typeHits, hit)
+			}
+		}
+
+		switch itemType {
+		case entities.SearchTypeProject:
+			for id, project := range repo.store.projects {
+				if !(project.IsPublished() && project.IsActive()) && !query.IncludeHidden {
+					continue
+				}
+				match(id, project.UserID, project.Title,
+					searchField{project.Title, 10}, searchField{project.ShortDescription, 4},
+					searchField{project.Description, 1}, searchField{project.Technologies, 2})
+			}
+		case entities.SearchTypeSkill:
+			for id, skill := range repo.store.skills {
+				if !skill.IsPublished() && !query.IncludeHidden {
+					continue
+				}
+				match(id, skill.UserID, skill.Name, searchField{skill.Name, 1})
+			}
+		case entities.SearchTypeExperience:
+			for id, experience := range repo.store.experiences {
+				if !experience.IsPublished() && !query.IncludeHidden {
+					continue
+				}
+				match(id, experience.UserID, experience.JobTitle+" at "+experience.CompanyName,
+					searchField{experience.JobTitle, 10}, searchField{experience.CompanyName, 5},
+					searchField{experience.Description, 1})
+			}
+		case entities.SearchTypeEducation:
+			for id, education := range repo.store.educations {
+				if !education.IsPublished() && !query.IncludeHidden {
+					continue
+				}
+				match(id, education.UserID, education.Degree+", "+education.Institution,
+					searchField{education.Degree, 10}, searchField{education.Institution, 5},
+					searchField{education.Description, 1})
+			}
+		case entities.SearchTypePersonalInfo:
+			for id, info := range repo.store.personalInfos {
+				aboutMe := ""
+				if info.AboutMe != nil {
+					aboutMe = *info.AboutMe
+				}
+				match(id, info.UserID, info.FirstName+" "+info.LastName,
+					searchField{info.FirstName, 10}, searchField{info.LastName, 10},
+					searchField{info.ProfessionalTitle, 5}, searchField{info.Intro, 2},
+					searchField{aboutMe, 1}, searchField{info.Location, 2}, searchField{info.Interests, 2})
+			}
+		}
+
+		sort.SliceStable(typeHits, func(i, j int) bool {
+			if typeHits[i].Score == typeHits[j].Score {
+				return typeHits[i].ID < typeHits[j].ID
+			}
+			return typeHits[i].Score > typeHits[j].Score
+		})
+		if len(typeHits) > query.Limit {
+			typeHits = typeHits[:query.Limit]
+		}
+		hits = append(hits, typeHits...)
+	}
+
+	return hits, nil
+}
+
+// matchRow returns a hit with its score and snippet, or nil when a term is
+// missing from every field. The snippet is cut from the field with the most
+// matching words.
+func matchRow(terms []string, fields []searchField) *entities.SearchHit {
+	found := make(map[string]bool, len(terms))
+	hit := &entities.SearchHit{}
+	bestMatches := 0
+
+	for _, field := range fields {
+		words := wordSpans(field.text)
+		matched := make([]bool, len(words))
+		matches := 0
+		for i, word := range words {
+			lowered := strings.ToLower(field.text[word[0]:word[1]])
+			for _, term := range terms {
+				if strings.HasPrefix(lowered, term) {
+					found[term] = true
+					matched[i] = true
+				}
+			}
+			if matched[i] {
+				matches++
+			}
+		}
+
+		hit.Score += float64(matches) * field.weight
+		if matches > bestMatches {
+			bestMatches = matches
+			hit.Snippet = snippet(field.text, words, matched)
+		}
+	}
+
+	if len(found) < len(terms) {
+		return nil
+	}
+	return hit
+}
+
+// wordSpans returns the byte offsets of the runs of letters and digits of text.
+func wordSpans(text string) [][2]int {
+	var spans [][2]int
+	start := -1
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWord && start < 0 {
+			start = i
+		} else if !isWord && start >= 0 {
+			spans = append(spans, [2]int{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(text)})
+	}
+	return spans
+}
+
+// snippet shows up to snippetWords words of text starting near the first
+// matched word, with the matched words marked.
+func snippet(text string, words [][2]int, matched []bool) string {
+	first := 0
+	for i := range matched {
+		if matched[i] {
+			first = i
+			break
+		}
+	}
+
+	from := max(0, first-snippetWords/4)
+	to := min(len(words), from+snippetWords)
+
+	var builder strings.Builder
+	if from > 0 {
+		builder.WriteString("…")
+	}
+	position := words[from][0]
+	for i := from; i < to; i++ {
+		builder.WriteString(text[position:words[i][0]])
+		if matched[i] {
+			builder.WriteString(entities.SearchHighlightStart)
+			builder.WriteString(text[words[i][0]:words[i][1]])
+			builder.WriteString(entities.SearchHighlightEnd)
+		} else {
+			builder.WriteString(text[words[i][0]:words[i][1]])
+		}
+		position = words[i][1]
+	}
+	if to < len(words) {
+		builder.WriteString("…")
+	} else {
+		builder.WriteString(text[position:])
+	}
+	return builder.String()
+}
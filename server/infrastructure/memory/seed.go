@@ -0,0 +1,472 @@
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"portfolio/domain/entities"
+	"portfolio/domain/utils"
+	"strings"
+	"time"
+)
+
+// SeedDemo resets the store and fills it with a sample portfolio owned by
+// username. hashedPassword must already be hashed with the admin salt. The
+// reset and the seed happen under one lock, so that concurrent requests
+// never see the store empty or half seeded.
+func (s *Store) SeedDemo(settingKey, username, hashedPassword string) {
+	s.unitMu.Lock()
+	defer s.unitMu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reset()
+
+	now := time.Now()
+	date := func(year int, month time.Month) time.Time {
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	userID := s.nextID("users")
+	s.users[userID] = &entities.User{
+		ID:        userID,
+		Username:  username,
+		Password:  hashedPassword,
+		Email:     username + "@localhost",
+		Role:      entities.RoleAdmin,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	s.seedSetting(settingKey, entities.SettingNamespaceSite, entities.SiteSettings{
+		SchemaVersion:    entities.SiteSettingsSchemaVersion,
+		PortfolioOwnerID: userID,
+		SiteName:         "Demo Portfolio",
+		SiteDescription:  "A sandbox portfolio that resets itself periodically",
+		Language:         "en",
+		UpdatedAt:        now,
+	})
+	s.seedSetting(settingKey, entities.SettingNamespaceDisplay, entities.DisplaySettings{
+		SchemaVersion: entities.DisplaySettingsSchemaVersion,
+		ShowProjects:  true,
+		Theme:         "light",
+		UpdatedAt:     now,
+	})
+	s.seedSetting(settingKey, entities.SettingNamespaceSEO, entities.SEOSettings{
+		SchemaVersion:   entities.SEOSettingsSchemaVersion,
+		MetaTitle:       "Demo Portfolio",
+		MetaDescription: "A sandbox portfolio that resets itself periodically",
+		Keywords:        []string{"portfolio", "demo"},
+		UpdatedAt:       now,
+	})
+	s.seedSetting(settingKey, entities.SettingNamespaceContact, entities.ContactSettings{
+		SchemaVersion: entities.ContactSettingsSchemaVersion,
+		ContactEmail:  "demo@example.com",
+		UpdatedAt:     now,
+	})
+
+	aboutMe := "I build reliable web backends and the small tools that keep them running."
+	dateOfBirth := utils.NewDate(time.Date(1990, time.June, 15, 0, 0, 0, 0, time.UTC))
+	personalInfoID := s.nextID("personal_infos")
+	s.personalInfos[personalInfoID] = &entities.PersonalInfo{
+		PersonalInfoID:    personalInfoID,
+		UserID:            userID,
+		FirstName:         "Alex",
+		LastName:          "Demo",
+		ProfessionalTitle: "Backend Engineer",
+		Intro:             "Hi, this is a demo portfolio.",
+		AboutMe:           &aboutMe,
+		Location:          "Paris, France",
+		WebsiteURL:        "https://example.com",
+		GithubURL:         "https://github.com/example",
+		DateOfBirth:       &dateOfBirth,
+		Interests:         "Distributed systems, climbing, photography",
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		Version:           1,
+	}
+
+	published := entities.Publishing{State: entities.PublishingStatePublished}
+
+	technologyIDs := make(map[string]int)
+	for position, name := range []string{"Go", "PostgreSQL", "SQLite"} {
+		technologyID := s.nextID("technologies")
+		technologyIDs[name] = technologyID
+		s.technologies[technologyID] = &entities.Technology{
+			TechnologyID: technologyID,
+			UserID:       userID,
+			Name:         name,
+			IconURL:      "https://example.com/icons/" + name + ".svg",
+			CreatedAt:    now,
+			UpdatedAt:    now,
+			Version:      1,
+			Position:     position,
+			Publishing:   published,
+		}
+	}
+
+	projects := []entities.Project{
+		{
+			Title:            "Portfolio API",
+			Slug:             "portfolio-api",
+			Description:      "The REST API serving this very portfolio.",
+			ShortDescription: "Go REST API",
+			Technologies:     "Go, SQLite",
+			Status:           "active",
+			Featured:         true,
+			Publishing:       published,
+		},
+		{
+			Title:            "Log Shipper",
+			Slug:             "log-shipper",
+			Description:      "A tiny agent that tails files and forwards them to a central store.",
+			ShortDescription: "Log forwarding agent",
+			Technologies:     "Go",
+			Status:           "archived",
+			Publishing:       entities.Publishing{State: entities.PublishingStateArchived},
+		},
+	}
+	links := map[string][]entities.ProjectLink{
+		"Portfolio API": {
+			{Type: entities.ProjectLinkTypeGithub, URL: "https://github.com/example/portfolio", Label: "GitHub", IsPrimary: true},
+			{Type: entities.ProjectLinkTypeDocs, URL: "https://example.com/portfolio/docs", Label: "API docs"},
+		},
+	}
+	projectIDs := make(map[string]int)
+	for i := range projects {
+		project := projects[i]
+		project.ProjectID = s.nextID("projects")
+		projectIDs[project.Title] = project.ProjectID
+		project.UserID = userID
+		project.CreatedAt = now.Add(-time.Duration(i) * time.Hour)
+		project.UpdatedAt = project.CreatedAt
+		project.Version = 1
+		project.Position = i
+		s.projects[project.ProjectID] = &project
+		for _, name := range strings.Split(project.Technologies, ", ") {
+			s.projectTechnologies[project.ProjectID] = append(s.projectTechnologies[project.ProjectID], technologyIDs[name])
+		}
+		for position, link := range links[project.Title] {
+			link.ProjectLinkID = s.nextID("project_links")
+			link.ProjectID = project.ProjectID
+			link.Position = position
+			link.CreatedAt = now
+			link.UpdatedAt = now
+			s.projectLinks[link.ProjectLinkID] = &link
+		}
+	}
+
+	categoryIDs := map[string]int{}
+	for i, name := range []string{"Languages", "Tooling"} {
+		categoryID := s.nextID("skill_categories")
+		s.skillCategories[categoryID] = &entities.SkillCategory{
+			SkillCategoryID: categoryID,
+			UserID:          userID,
+			Name:            name,
+			Position:        i,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		}
+		categoryIDs[name] = categoryID
+	}
+	skills := []struct {
+		name     string
+		category string
+		years    int
+	}{
+		{"Go", "Languages", 7},
+		{"SQL", "Languages", 9},
+		{"Docker", "Tooling", 6},
+	}
+	for i, item := range skills {
+		skillID := s.nextID("skills")
+		categoryID := categoryIDs[item.category]
+		years := item.years
+		s.skills[skillID] = &entities.Skill{
+			SkillID:           skillID,
+			UserID:            userID,
+			Name:              item.name,
+			Level:             5 - i,
+			CategoryID:        &categoryID,
+			YearsOfExperience: &years,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+			Version:           1,
+			Position:          i,
+			Publishing:        published,
+		}
+	}
+
+	experiences := []entities.Experience{
+		{
+			JobTitle:       "Senior Backend Engineer",
+			CompanyName:    "Example Corp",
+			StartDate:      date(2023, time.April),
+			Description:    "Leading the platform team behind the internal APIs.",
+			EmploymentType: entities.EmploymentTypeFullTime,
+			Location:       "Paris, France",
+			Remote:         true,
+			Achievements:   []string{"Moved the public APIs to cursor pagination", "Mentored three engineers"},
+		},
+		{
+			JobTitle:       "Backend Engineer",
+			CompanyName:    "Example Corp",
+			StartDate:      date(2021, time.March),
+			EndDate:        date(2023, time.March),
+			Description:    "Designing and operating internal APIs.",
+			EmploymentType: entities.EmploymentTypeFullTime,
+			Location:       "Paris, France",
+			Achievements:   []string{"Cut p99 latency of the billing API by 40%"},
+		},
+		{
+			JobTitle:       "Software Developer",
+			CompanyName:    "Sample Studio",
+			StartDate:      date(2016, time.September),
+			EndDate:        date(2021, time.February),
+			Description:    "Full-stack work on client projects.",
+			EmploymentType: entities.EmploymentTypeContract,
+			Location:       "Lyon, France",
+		},
+	}
+	experienceTechnologies := map[string][]string{
+		"Senior Backend Engineer": {"Go", "PostgreSQL"},
+		"Backend Engineer":        {"Go"},
+	}
+	experienceIDs := make(map[string]int)
+	for i := range experiences {
+		experience := experiences[i]
+		experience.ExperienceID = s.nextID("experiences")
+		experienceIDs[experience.JobTitle] = experience.ExperienceID
+		experience.UserID = userID
+		experience.CreatedAt = now
+		experience.UpdatedAt = now
+		experience.Version = 1
+		experience.Position = i
+		experience.Publishing = published
+		s.experiences[experience.ExperienceID] = &experience
+		for _, name := range experienceTechnologies[experience.JobTitle] {
+			s.experienceTechnologies[experience.ExperienceID] = append(s.experienceTechnologies[experience.ExperienceID], technologyIDs[name])
+		}
+	}
+
+	graduation := date(2016, time.June)
+	educationID := s.nextID("educations")
+	s.educations[educationID] = &entities.Education{
+		EducationID: educationID,
+		UserID:      userID,
+		Degree:      "MSc Computer Science",
+		Institution: "Example University",
+		StartDate:   date(2014, time.September),
+		EndDate:     &graduation,
+		Description: "Specialised in distributed systems.",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Version:     1,
+		Publishing:  published,
+	}
+
+	// One certification of each expiry status: expired, expiring soon and
+	// never expiring.
+	lapsed := date(2024, time.March)
+	renewal := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 60)
+	certifications := []entities.Certification{
+		{
+			Name:            "AWS Certified Solutions Architect - Associate",
+			Issuer:          "Amazon Web Services",
+			CredentialID:    "AWS-SAA-000001",
+			VerificationURL: "https://example.com/verify/AWS-SAA-000001",
+			IssueDate:       date(2021, time.March),
+			ExpiryDate:      &lapsed,
+		},
+		{
+			Name:            "Certified Kubernetes Administrator",
+			Issuer:          "The Linux Foundation",
+			CredentialID:    "LF-CKA-000002",
+			VerificationURL: "https://example.com/verify/LF-CKA-000002",
+			IssueDate:       renewal.AddDate(-3, 0, 0),
+			ExpiryDate:      &renewal,
+			Description:     "Cluster installation, networking, storage and troubleshooting.",
+		},
+		{
+			Name:      "Professional Scrum Master I",
+			Issuer:    "Scrum.org",
+			IssueDate: date(2019, time.November),
+		},
+	}
+	for i := range certifications {
+		certification := certifications[i]
+		certification.CertificationID = s.nextID("certifications")
+		certification.UserID = userID
+		certification.CreatedAt = now
+		certification.UpdatedAt = now
+		certification.Version = 1
+		certification.Position = i
+		certification.Publishing = published
+		s.certifications[certification.CertificationID] = &certification
+	}
+
+	publications := []entities.Publication{
+		{
+			Title:     "Zero-downtime schema migrations",
+			Type:      entities.PublicationTypeTalk,
+			Venue:     "Example Go Conference",
+			Date:      date(2024, time.June),
+			URL:       "https://example.com/talks/zero-downtime-migrations",
+			SlidesURL: "https://example.com/slides/zero-downtime-migrations.pdf",
+			VideoURL:  "https://example.com/videos/zero-downtime-migrations",
+		},
+		{
+			Title:       "Cursor pagination for public APIs",
+			Type:        entities.PublicationTypeArticle,
+			Venue:       "Example Engineering Blog",
+			Date:        date(2023, time.February),
+			URL:         "https://example.com/blog/cursor-pagination",
+			Description: "Why offsets break under concurrent writes, and how cursors fix it.",
+			CoAuthors:   []string{"Sam Example"},
+		},
+		{
+			Title: "Context-aware retries in a Go HTTP client",
+			Type:  entities.PublicationTypeOpenSource,
+			Venue: "github.com/example/httpclient",
+			Date:  date(2022, time.September),
+			URL:   "https://example.com/example/httpclient/pull/42",
+		},
+	}
+	for i := range publications {
+		publication := publications[i]
+		publication.PublicationID = s.nextID("publications")
+		publication.UserID = userID
+		publication.CreatedAt = now
+		publication.UpdatedAt = now
+		publication.Version = 1
+		publication.Position = i
+		publication.Publishing = published
+		s.publications[publication.PublicationID] = &publication
+	}
+
+	spokenLanguages := []entities.SpokenLanguage{
+		{Name: "English", Level: entities.SpokenLanguageLevelNative},
+		{Name: "German", Level: entities.SpokenLanguageLevelB2, Description: "Professional working proficiency."},
+		{Name: "French", Level: entities.SpokenLanguageLevelA2},
+	}
+	for i := range spokenLanguages {
+		spokenLanguage := spokenLanguages[i]
+		spokenLanguage.SpokenLanguageID = s.nextID("spoken_languages")
+		spokenLanguage.UserID = userID
+		spokenLanguage.CreatedAt = now
+		spokenLanguage.UpdatedAt = now
+		spokenLanguage.Version = 1
+		spokenLanguage.Position = i
+		spokenLanguage.Publishing = published
+		s.spokenLanguages[spokenLanguage.SpokenLanguageID] = &spokenLanguage
+	}
+
+	awards := []entities.Award{
+		{
+			Title:       "Best Developer Tool",
+			Issuer:      "Example Hackathon",
+			Date:        date(2023, time.October),
+			URL:         "https://example.com/hackathon/2023/winners",
+			Description: "A command-line profiler for slow SQL queries, built in 48 hours.",
+		},
+		{
+			Title:  "Dean's List",
+			Issuer: "Example University",
+			Date:   date(2017, time.June),
+		},
+	}
+	for i := range awards {
+		award := awards[i]
+		award.AwardID = s.nextID("awards")
+		award.UserID = userID
+		award.CreatedAt = now
+		award.UpdatedAt = now
+		award.Version = 1
+		award.Position = i
+		award.Publishing = published
+		s.awards[award.AwardID] = &award
+	}
+
+	// One ongoing volunteering and one that has ended.
+	foodBankEnd := date(2021, time.December)
+	volunteerings := []entities.Volunteering{
+		{
+			Role:         "Mentor",
+			Organization: "Example Code Club",
+			Cause:        "Education",
+			StartDate:    date(2022, time.January),
+			URL:          "https://example.com/code-club",
+			Description:  "Weekly programming sessions for secondary school students.",
+		},
+		{
+			Role:         "Website Maintainer",
+			Organization: "Example Food Bank",
+			Cause:        "Poverty Alleviation",
+			StartDate:    date(2019, time.April),
+			EndDate:      &foodBankEnd,
+		},
+	}
+	for i := range volunteerings {
+		volunteering := volunteerings[i]
+		volunteering.VolunteeringID = s.nextID("volunteerings")
+		volunteering.UserID = userID
+		volunteering.CreatedAt = now
+		volunteering.UpdatedAt = now
+		volunteering.Version = 1
+		volunteering.Position = i
+		volunteering.Publishing = published
+		s.volunteerings[volunteering.VolunteeringID] = &volunteering
+	}
+
+	// Two approved testimonials and one waiting in the moderation queue.
+	portfolioAPI := projectIDs["Portfolio API"]
+	backendEngineer := experienceIDs["Backend Engineer"]
+	testimonials := []entities.Testimonial{
+		{
+			AuthorName:    "Sam Example",
+			AuthorTitle:   "Engineering Manager",
+			AuthorCompany: "Example Corp",
+			AuthorEmail:   "sam@example.com",
+			Content:       "A dependable engineer who made our billing API both faster and easier to operate.",
+			Status:        entities.TestimonialStatusApproved,
+			ModeratedAt:   &now,
+			ExperienceID:  &backendEngineer,
+		},
+		{
+			AuthorName:  "Alex Sample",
+			AuthorTitle: "Open-source contributor",
+			AuthorEmail: "alex@example.com",
+			AuthorURL:   "https://example.com/alex",
+			Content:     "The Portfolio API is a clean, well documented codebase that is a pleasure to build on.",
+			Status:      entities.TestimonialStatusApproved,
+			ModeratedAt: &now,
+			ProjectID:   &portfolioAPI,
+		},
+		{
+			AuthorName:    "Jordan Demo",
+			AuthorCompany: "Sample Studio",
+			AuthorEmail:   "jordan@example.com",
+			Content:       "Great to work with on client projects, always on time and happy to help.",
+			Status:        entities.TestimonialStatusPending,
+		},
+	}
+	for i := range testimonials {
+		testimonial := testimonials[i]
+		testimonial.TestimonialID = s.nextID("testimonials")
+		testimonial.UserID = userID
+		testimonial.CreatedAt = now
+		testimonial.UpdatedAt = now
+		testimonial.Version = 1
+		testimonial.Position = i
+		s.testimonials[testimonial.TestimonialID] = &testimonial
+	}
+}
+
+// seedSetting stores one settings namespace; callers hold s.mu.
+func (s *Store) seedSetting(settingKey string, namespace entities.SettingNamespace, value any) {
+	document, err := json.Marshal(value)
+	if err != nil {
+		panic(fmt.Sprintf("Error marshaling seeded %s settings: %v", namespace, err))
+	}
+	s.settings[namespaceKey(settingKey, namespace)] = document
+}
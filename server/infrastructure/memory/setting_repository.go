@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+)
+
+type settingRepository struct {
+	settingKey string
+	store      *Store
+	logger     *logger.Logger
+}
+
+func NewSettingRepository(store *Store, logger *logger.Logger, settingKey string) interfaces.SettingRepository {
+	return &settingRepository{
+		store:      store,
+		logger:     logger,
+		settingKey: settingKey,
+	}
+}
+
+func (repo *settingRepository) UpsertNamespace(ctx context.Context, namespace entities.SettingNamespace, document []byte) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	repo.store.settings[namespaceKey(repo.settingKey, namespace)] = append([]byte(nil), document...)
+	return nil
+}
+
+func (repo *settingRepository) GetNamespace(ctx context.Context, namespace entities.SettingNamespace) ([]byte, error) {
+	return repo.getDocument(namespaceKey(repo.settingKey, namespace)), nil
+}
+
+func (repo *settingRepository) GetLegacy(ctx context.Context) ([]byte, error) {
+	return repo.getDocument(repo.settingKey), nil
+}
+
+func (repo *settingRepository) getDocument(key string) []byte {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	document, ok := repo.store.settings[key]
+	if !ok {
+		return nil
+	}
+	return append([]byte(nil), document...)
+}
+
+func (repo *settingRepository) Delete(ctx context.Context) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	delete(repo.store.settings, repo.settingKey)
+	for _, namespace := range entities.SettingNamespaces {
+		delete(repo.store.settings, namespaceKey(repo.settingKey, namespace))
+	}
+	return nil
+}
+
+func namespaceKey(settingKey string, namespace entities.SettingNamespace) string {
+	return settingKey + "." + string(namespace)
+}
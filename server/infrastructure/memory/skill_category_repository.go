@@ -0,0 +1,150 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"slices"
+	"time"
+)
+
+type skillCategoryRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewSkillCategoryRepository(store *Store, logger *logger.Logger) interfaces.SkillCategoryRepository {
+	return &skillCategoryRepository{store: store, logger: logger}
+}
+
+func (repo *skillCategoryRepository) GetByID(ctx context.Context, categoryID int) (*entities.SkillCategory, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	category, ok := repo.store.skillCategories[categoryID]
+	if !ok {
+		return nil, domain.NewNotFoundError("Skill category", fmt.Sprint(categoryID))
+	}
+
+	found := *category
+	return &found, nil
+}
+
+func (repo *skillCategoryRepository) GetByUserID(ctx context.Context, userID int) ([]*entities.SkillCategory, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	categories := []*entities.SkillCategory{}
+	for _, category := range repo.store.skillCategories {
+		if category.UserID == userID {
+			found := *category
+			categories = append(categories, &found)
+		}
+	}
+	slices.SortFunc(categories, func(a, b *entities.SkillCategory) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.SkillCategoryID, b.SkillCategoryID))
+	})
+	return categories, nil
+}
+
+func (repo *skillCategoryRepository) Create(ctx context.Context, category *entities.SkillCategory) (*entities.SkillCategory, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.checkConstraints(0, category.Name, category.UserID); err != nil {
+		repo.logger.Error("Failed to create skill category: %v", err)
+		return nil, domain.NewDatabaseError("skill category creation", err)
+	}
+
+	now := time.Now()
+	stored := *category
+	stored.SkillCategoryID = repo.store.nextID("skill_categories")
+	stored.Position = nextPosition(repo.store.skillCategories, skillCategoryPlace, category.UserID)
+	stored.CreatedAt = now
+	stored.UpdatedAt = now
+	repo.store.skillCategories[stored.SkillCategoryID] = &stored
+
+	found := stored
+	return &found, nil
+}
+
+func (repo *skillCategoryRepository) Update(ctx context.Context, categoryID int, category *entities.SkillCategory) (*entities.SkillCategory, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	stored, ok := repo.store.skillCategories[categoryID]
+	if !ok {
+		return nil, domain.NewNotFoundError("Skill category", fmt.Sprint(categoryID))
+	}
+	if err := repo.checkConstraints(categoryID, category.Name, stored.UserID); err != nil {
+		repo.logger.Error("Failed to update skill category: %v", err)
+		return nil, domain.NewDatabaseError("skill category update", err)
+	}
+
+	stored.Name = category.Name
+	stored.UpdatedAt = time.Now()
+
+	found := *stored
+	return &found, nil
+}
+
+// Delete removes the category and, like the ON DELETE SET NULL of
+// skills.skill_category_id, takes its skills out of it, trashed ones
+// included.
+func (repo *skillCategoryRepository) Delete(ctx context.Context, categoryID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if _, ok := repo.store.skillCategories[categoryID]; !ok {
+		return domain.NewNotFoundError("Skill category", fmt.Sprint(categoryID))
+	}
+
+	delete(repo.store.skillCategories, categoryID)
+	for _, skill := range withTrashed(repo.store, "skills", repo.store.skills) {
+		if skill.CategoryID != nil && *skill.CategoryID == categoryID {
+			skill.CategoryID = nil
+		}
+	}
+	return nil
+}
+
+func (repo *skillCategoryRepository) ExistsByNameAndUserID(ctx context.Context, name string, userID int, exceptCategoryID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for id, category := range repo.store.skillCategories {
+		if id != exceptCategoryID && category.Name == name && category.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (repo *skillCategoryRepository) Reorder(ctx context.Context, userID int, categoryIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("skill_categories", repo.store.skillCategories, skillCategoryPlace, userID, categoryIDs)
+}
+
+// checkConstraints mirrors UNIQUE(user_id, skill_category_name) and the user
+// foreign key; callers must hold the write lock.
+func (repo *skillCategoryRepository) checkConstraints(categoryID int, name string, userID int) error {
+	if err := repo.store.checkUser(userID); err != nil {
+		return err
+	}
+	for id, category := range repo.store.skillCategories {
+		if id != categoryID && category.Name == name && category.UserID == userID {
+			return uniqueConstraintError("skill_categories.user_id, skill_categories.skill_category_name")
+		}
+	}
+	return nil
+}
+
+func skillCategoryPlace(category *entities.SkillCategory) (int, *int) {
+	return category.UserID, &category.Position
+}
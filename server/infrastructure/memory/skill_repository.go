@@ -0,0 +1,347 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type skillRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewSkillRepository(store *Store, logger *logger.Logger) interfaces.SkillRepository {
+	return &skillRepository{store: store, logger: logger}
+}
+
+func (repo *skillRepository) Create(ctx context.Context, skill *entities.Skill) (*entities.Skill, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.checkConstraints(0, skill); err != nil {
+		repo.logger.Error("Failed to create skill: %v", err)
+		return nil, domain.NewDatabaseError("create skill", err)
+	}
+
+	skill.SkillID = repo.store.nextID("skills")
+	skill.Position = nextPosition(repo.store.skills, skillPlace, skill.UserID)
+	skill.CreatedAt = time.Now()
+	skill.UpdatedAt = time.Now()
+	skill.Version = 1
+
+	stored := *skill
+	stored.Projects, stored.Experiences = nil, nil
+	repo.store.skills[skill.SkillID] = &stored
+
+	return skill, nil
+}
+
+func (repo *skillRepository) GetByID(ctx context.Context, skillID int) (*entities.Skill, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	skill, ok := repo.store.skills[skillID]
+	if !ok {
+		return nil, nil
+	}
+
+	return repo.withEvidence(skill), nil
+}
+
+// skillListSpec sorts by category through the store's categories; callers
+// must hold a lock while the list is cut.
+func (repo *skillRepository) skillListSpec() listSpec[*entities.Skill] {
+	categoryPosition := func(skill *entities.Skill) int {
+		if skill.CategoryID != nil {
+			if category, ok := repo.store.skillCategories[*skill.CategoryID]; ok {
+				return category.Position
+			}
+		}
+		return math.MaxInt32
+	}
+	yearsOfExperience := func(skill *entities.Skill) int {
+		if skill.YearsOfExperience == nil {
+			return -1
+		}
+		return *skill.YearsOfExperience
+	}
+
+	return listSpec[*entities.Skill]{
+		id: func(skill *entities.Skill) int { return skill.SkillID },
+		sorts: map[string]func(a, b *entities.Skill) int{
+			"position": func(a, b *entities.Skill) int { return cmp.Compare(a.Position, b.Position) },
+			"name":     func(a, b *entities.Skill) int { return compareFolded(a.Name, b.Name) },
+			"level":    func(a, b *entities.Skill) int { return cmp.Compare(a.Level, b.Level) },
+			"category": func(a, b *entities.Skill) int { return cmp.Compare(categoryPosition(a), categoryPosition(b)) },
+			"years_of_experience": func(a, b *entities.Skill) int {
+				return cmp.Compare(yearsOfExperience(a), yearsOfExperience(b))
+			},
+			"created_at": func(a, b *entities.Skill) int { return a.CreatedAt.Compare(b.CreatedAt) },
+		},
+		filters: map[string]func(skill *entities.Skill, value string) bool{
+			"state": func(skill *entities.Skill, value string) bool { return skill.State == value },
+			"level": func(skill *entities.Skill, value string) bool { return strconv.Itoa(skill.Level) == value },
+			"category": func(skill *entities.Skill, value string) bool {
+				return skill.CategoryID != nil && strconv.Itoa(*skill.CategoryID) == value
+			},
+		},
+	}
+}
+
+func (repo *skillRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Skill], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var skills []*entities.Skill
+	for _, skill := range repo.store.skills {
+		if skill.UserID == userID {
+			skills = append(skills, repo.withEvidence(skill))
+		}
+	}
+
+	return listPage(repo.skillListSpec(), skills, query)
+}
+
+func (repo *skillRepository) Update(ctx context.Context, skillID int, skill *entities.Skill) (*entities.Skill, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.skills[skillID]; ok {
+		if err := checkVersion(ctx, "Skill", skillID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		updated := *skill
+		updated.UserID = stored.UserID
+		if err := repo.checkConstraints(skillID, &updated); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to update skill: %v", err)
+			return nil, domain.NewDatabaseError("update skill", err)
+		}
+		stored.Name = skill.Name
+		stored.Level = skill.Level
+		stored.CategoryID = skill.CategoryID
+		stored.YearsOfExperience = skill.YearsOfExperience
+		stored.LastUsedAt = skill.LastUsedAt
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, skillID)
+}
+
+func (repo *skillRepository) Patch(ctx context.Context, skillID int, skill *entities.Skill) (*entities.Skill, error) {
+	if skill.Name == "" && skill.Level <= 0 {
+		return skill, nil
+	}
+
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.skills[skillID]; ok {
+		if err := checkVersion(ctx, "Skill", skillID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		// The nullable fields are always written, as in the SQL backends.
+		patched := *stored
+		if skill.Name != "" {
+			patched.Name = skill.Name
+		}
+		if skill.Level > 0 {
+			patched.Level = skill.Level
+		}
+		patched.CategoryID = skill.CategoryID
+		patched.YearsOfExperience = skill.YearsOfExperience
+		patched.LastUsedAt = skill.LastUsedAt
+		if err := repo.checkConstraints(skillID, &patched); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to patch skill: %v", err)
+			return nil, fmt.Errorf("unable to patch skill: %w", err)
+		}
+		stored.Name = patched.Name
+		stored.Level = patched.Level
+		stored.CategoryID = patched.CategoryID
+		stored.YearsOfExperience = patched.YearsOfExperience
+		stored.LastUsedAt = patched.LastUsedAt
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, skillID)
+}
+
+func (repo *skillRepository) Delete(ctx context.Context, skillID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.skills[skillID]; ok {
+		if err := checkVersion(ctx, "Skill", skillID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, "skills", repo.store.skills, skillID)
+	return nil
+}
+
+func (repo *skillRepository) ExistsByID(ctx context.Context, skillID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	_, ok := repo.store.skills[skillID]
+	return ok, nil
+}
+
+func (repo *skillRepository) ExistsByNameAndUserID(ctx context.Context, name string, userID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for _, skill := range withTrashed(repo.store, "skills", repo.store.skills) {
+		if skill.Name == name && skill.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (repo *skillRepository) GetAll(ctx context.Context) ([]*entities.Skill, error) {
+	return repo.list(func(*entities.Skill) bool { return true }), nil
+}
+
+// list returns copies of the matching skills ordered by name.
+func (repo *skillRepository) list(match func(*entities.Skill) bool) []*entities.Skill {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var skills []*entities.Skill
+	for _, skill := range repo.store.skills {
+		if match(skill) {
+			skills = append(skills, repo.withEvidence(skill))
+		}
+	}
+
+	sort.Slice(skills, func(i, j int) bool {
+		if skills[i].Name == skills[j].Name {
+			return skills[i].SkillID < skills[j].SkillID
+		}
+		return skills[i].Name < skills[j].Name
+	})
+
+	return skills
+}
+
+// checkConstraints mirrors UNIQUE(skill_name, user_id), the skill_level and
+// skill_years_of_experience CHECKs and the user and category foreign keys;
+// callers must hold the write lock.
+func (repo *skillRepository) checkConstraints(skillID int, skill *entities.Skill) error {
+	if err := repo.store.checkUser(skill.UserID); err != nil {
+		return err
+	}
+	if skill.CategoryID != nil {
+		if _, ok := repo.store.skillCategories[*skill.CategoryID]; !ok {
+			return fmt.Errorf("FOREIGN KEY constraint failed")
+		}
+	}
+	if skill.Level < 1 || skill.Level > 5 {
+		return fmt.Errorf("CHECK constraint failed: skill_level BETWEEN 1 AND 5")
+	}
+	if !skill.HasValidYearsOfExperience() {
+		return fmt.Errorf("CHECK constraint failed: skill_years_of_experience BETWEEN 0 AND 80")
+	}
+	for id, stored := range withTrashed(repo.store, "skills", repo.store.skills) {
+		if id != skillID && stored.Name == skill.Name && stored.UserID == skill.UserID {
+			return uniqueConstraintError("skills.skill_name, skills.user_id")
+		}
+	}
+	return nil
+}
+
+func (repo *skillRepository) SetProjects(ctx context.Context, skillID int, projectIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return setSkillEvidence(repo.store, repo.store.skillProjects, "skill_projects", "project_id", skillID, projectIDs, func(id int) bool {
+		_, ok := findRow(repo.store.projects, repo.store.trash[entities.TrashTypeProject], id)
+		return ok
+	})
+}
+
+func (repo *skillRepository) SetExperiences(ctx context.Context, skillID int, experienceIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return setSkillEvidence(repo.store, repo.store.skillExperiences, "skill_experiences", "experience_id", skillID, experienceIDs, func(id int) bool {
+		_, ok := findRow(repo.store.experiences, repo.store.trash[entities.TrashTypeExperience], id)
+		return ok
+	})
+}
+
+// setSkillEvidence replaces the links of the skill in a join table, checking
+// its foreign keys and primary key; callers must hold the write lock.
+func setSkillEvidence(store *Store, links map[int][]int, table, column string, skillID int, ids []int, exists func(id int) bool) error {
+	if _, ok := findRow(store.skills, store.trash[entities.TrashTypeSkill], skillID); !ok {
+		return domain.NewDatabaseError(table+" update", fmt.Errorf("FOREIGN KEY constraint failed"))
+	}
+
+	linked := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !exists(id) {
+			return domain.NewDatabaseError(table+" update", fmt.Errorf("FOREIGN KEY constraint failed"))
+		}
+		if slices.Contains(linked, id) {
+			return domain.NewDatabaseError(table+" update", uniqueConstraintError(table+".skill_id, "+table+"."+column))
+		}
+		linked = append(linked, id)
+	}
+
+	links[skillID] = linked
+	return nil
+}
+
+// withEvidence copies a stored skill and fills in its live linked projects
+// and experiences; callers must hold a lock.
+func (repo *skillRepository) withEvidence(skill *entities.Skill) *entities.Skill {
+	found := *skill
+	found.Projects = []*entities.SkillEvidence{}
+	for _, projectID := range repo.store.skillProjects[skill.SkillID] {
+		if project, ok := repo.store.projects[projectID]; ok {
+			found.Projects = append(found.Projects, &entities.SkillEvidence{
+				ID:         project.ProjectID,
+				Title:      project.Title,
+				Publishing: project.Publishing,
+			})
+		}
+	}
+	found.Experiences = []*entities.SkillEvidence{}
+	for _, experienceID := range repo.store.skillExperiences[skill.SkillID] {
+		if experience, ok := repo.store.experiences[experienceID]; ok {
+			found.Experiences = append(found.Experiences, &entities.SkillEvidence{
+				ID:           experience.ExperienceID,
+				Title:        experience.JobTitle,
+				Organization: experience.CompanyName,
+				Publishing:   experience.Publishing,
+			})
+		}
+	}
+	return &found
+}
+
+func (repo *skillRepository) Reorder(ctx context.Context, userID int, skillIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("skills", repo.store.skills, skillPlace, userID, skillIDs)
+}
+
+func skillPlace(skill *entities.Skill) (int, *int) {
+	return skill.UserID, &skill.Position
+}
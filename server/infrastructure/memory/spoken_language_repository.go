@@ -0,0 +1,168 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"strings"
+	"time"
+)
+
+type spokenLanguageRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewSpokenLanguageRepository(store *Store, logger *logger.Logger) interfaces.SpokenLanguageRepository {
+	return &spokenLanguageRepository{store: store, logger: logger}
+}
+
+func (repo *spokenLanguageRepository) Create(ctx context.Context, spokenLanguage *entities.SpokenLanguage) (*entities.SpokenLanguage, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.checkConstraints(0, spokenLanguage); err != nil {
+		repo.logger.Error("Failed to create spoken language: %v", err)
+		return nil, domain.NewDatabaseError("create spoken language", err)
+	}
+
+	now := time.Now()
+	spokenLanguage.SpokenLanguageID = repo.store.nextID("spoken_languages")
+	spokenLanguage.Position = nextPosition(repo.store.spokenLanguages, spokenLanguagePlace, spokenLanguage.UserID)
+	spokenLanguage.CreatedAt = now
+	spokenLanguage.UpdatedAt = now
+	spokenLanguage.Version = 1
+
+	stored := *spokenLanguage
+	repo.store.spokenLanguages[spokenLanguage.SpokenLanguageID] = &stored
+
+	created := *spokenLanguage
+	return &created, nil
+}
+
+func (repo *spokenLanguageRepository) GetByID(ctx context.Context, spokenLanguageID int) (*entities.SpokenLanguage, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	spokenLanguage, ok := repo.store.spokenLanguages[spokenLanguageID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *spokenLanguage
+	return &copied, nil
+}
+
+var spokenLanguageListSpec = listSpec[*entities.SpokenLanguage]{
+	id: func(spokenLanguage *entities.SpokenLanguage) int { return spokenLanguage.SpokenLanguageID },
+	sorts: map[string]func(a, b *entities.SpokenLanguage) int{
+		"position":   func(a, b *entities.SpokenLanguage) int { return cmp.Compare(a.Position, b.Position) },
+		"name":       func(a, b *entities.SpokenLanguage) int { return compareFolded(a.Name, b.Name) },
+		"level":      func(a, b *entities.SpokenLanguage) int { return cmp.Compare(a.LevelRank(), b.LevelRank()) },
+		"created_at": func(a, b *entities.SpokenLanguage) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	},
+	filters: map[string]func(spokenLanguage *entities.SpokenLanguage, value string) bool{
+		"state": func(spokenLanguage *entities.SpokenLanguage, value string) bool { return spokenLanguage.State == value },
+		"level": func(spokenLanguage *entities.SpokenLanguage, value string) bool { return spokenLanguage.Level == value },
+	},
+}
+
+func (repo *spokenLanguageRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.SpokenLanguage], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var spokenLanguages []*entities.SpokenLanguage
+	for _, spokenLanguage := range repo.store.spokenLanguages {
+		if spokenLanguage.UserID == userID {
+			copied := *spokenLanguage
+			spokenLanguages = append(spokenLanguages, &copied)
+		}
+	}
+
+	return listPage(spokenLanguageListSpec, spokenLanguages, query)
+}
+
+func (repo *spokenLanguageRepository) Update(ctx context.Context, spokenLanguageID int, spokenLanguage *entities.SpokenLanguage) (*entities.SpokenLanguage, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.spokenLanguages[spokenLanguageID]; ok {
+		if err := checkVersion(ctx, "Spoken language", spokenLanguageID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		checked := *spokenLanguage
+		checked.UserID = stored.UserID
+		if err := repo.checkConstraints(spokenLanguageID, &checked); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to update spoken language: %v", err)
+			return nil, domain.NewDatabaseError("update spoken language", err)
+		}
+		stored.Name = spokenLanguage.Name
+		stored.Level = spokenLanguage.Level
+		stored.Description = spokenLanguage.Description
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, spokenLanguageID)
+}
+
+func (repo *spokenLanguageRepository) Delete(ctx context.Context, spokenLanguageID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.spokenLanguages[spokenLanguageID]; ok {
+		if err := checkVersion(ctx, "Spoken language", spokenLanguageID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, entities.TrashTypeSpokenLanguage, repo.store.spokenLanguages, spokenLanguageID)
+	return nil
+}
+
+func (repo *spokenLanguageRepository) ExistsByName(ctx context.Context, userID int, name string, exceptSpokenLanguageID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	return repo.hasName(userID, name, exceptSpokenLanguageID), nil
+}
+
+func (repo *spokenLanguageRepository) Reorder(ctx context.Context, userID int, spokenLanguageIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("spoken_languages", repo.store.spokenLanguages, spokenLanguagePlace, userID, spokenLanguageIDs)
+}
+
+// checkConstraints mirrors the unique index on (user_id,
+// lower(spoken_language_name)) and the user foreign key; callers must hold
+// the write lock.
+func (repo *spokenLanguageRepository) checkConstraints(spokenLanguageID int, spokenLanguage *entities.SpokenLanguage) error {
+	if err := repo.store.checkUser(spokenLanguage.UserID); err != nil {
+		return err
+	}
+	if repo.hasName(spokenLanguage.UserID, spokenLanguage.Name, spokenLanguageID) {
+		return uniqueConstraintError("spoken_languages.user_id, spoken_languages.spoken_language_name")
+	}
+	return nil
+}
+
+// hasName looks through the live and the trashed spoken languages; callers
+// must hold a lock.
+func (repo *spokenLanguageRepository) hasName(userID int, name string, exceptSpokenLanguageID int) bool {
+	for id, spokenLanguage := range withTrashed(repo.store, entities.TrashTypeSpokenLanguage, repo.store.spokenLanguages) {
+		if id != exceptSpokenLanguageID && spokenLanguage.UserID == userID && strings.EqualFold(spokenLanguage.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func spokenLanguagePlace(spokenLanguage *entities.SpokenLanguage) (int, *int) {
+	return spokenLanguage.UserID, &spokenLanguage.Position
+}
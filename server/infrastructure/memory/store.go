@@ -0,0 +1,359 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type trashedRow struct {
+	row       any
+	deletedAt time.Time
+}
+
+// projectSlug is a row of project_slugs: a former slug of a project.
+type projectSlug struct {
+	projectID int
+	userID    int
+	slug      string
+}
+
+type revokedToken struct {
+	userID int
+	token  string
+}
+
+// Store holds every table of the in-memory backend behind a single lock, so
+// the repositories can enforce the same unique and foreign key constraints as
+// the SQL schema.
+type Store struct {
+	mu              sync.RWMutex
+	unitMu          sync.Mutex
+	settings        map[string][]byte
+	users           map[int]*entities.User
+	revokedTokens   []revokedToken
+	personalInfos   map[int]*entities.PersonalInfo
+	projects        map[int]*entities.Project
+	skills          map[int]*entities.Skill
+	experiences     map[int]*entities.Experience
+	educations      map[int]*entities.Education
+	certifications  map[int]*entities.Certification
+	publications    map[int]*entities.Publication
+	spokenLanguages map[int]*entities.SpokenLanguage
+	awards          map[int]*entities.Award
+	volunteerings   map[int]*entities.Volunteering
+	testimonials    map[int]*entities.Testimonial
+	technologies    map[int]*entities.Technology
+	// projectTechnologies maps project IDs to their linked technology IDs,
+	// in order, like the project_technologies table.
+	projectTechnologies map[int][]int
+	projectMedia        map[int]*entities.ProjectMedia
+	projectLinks        map[int]*entities.ProjectLink
+	projectSlugs        map[int]*projectSlug
+	skillCategories     map[int]*entities.SkillCategory
+	// skillProjects and skillExperiences map skill IDs to the IDs of the
+	// projects and experiences linked to them as evidence, in order, like
+	// the skill_projects and skill_experiences tables.
+	skillProjects    map[int][]int
+	skillExperiences map[int][]int
+	// experienceTechnologies maps experience IDs to their linked technology
+	// IDs, in order, like the experience_technologies table.
+	experienceTechnologies map[int][]int
+	trash                  map[string]map[int]*trashedRow
+	revisions              []*entities.Revision
+	auditLogs              []*entities.AuditLog
+	sequences              map[string]int
+}
+
+func NewStore() *Store {
+	store := &Store{}
+	store.reset()
+	return store
+}
+
+// Reset drops every row and restarts the ID sequences. It waits for the
+// running unit of work, if any, which would otherwise restore its snapshot
+// over the empty store when it rolls back.
+func (s *Store) Reset() {
+	s.unitMu.Lock()
+	defer s.unitMu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reset()
+}
+
+// lock takes the write lock. Outside of a unit of work it first waits for the
+// running one, if any: a rollback restores the snapshot taken when the unit of
+// work began, which would erase a write made in the meantime.
+func (s *Store) lock(ctx context.Context) {
+	if ctx.Value(unitOfWorkKey{}) == nil {
+		s.unitMu.Lock()
+	}
+	s.mu.Lock()
+}
+
+// unlock releases the locks taken by lock with the same context.
+func (s *Store) unlock(ctx context.Context) {
+	s.mu.Unlock()
+	if ctx.Value(unitOfWorkKey{}) == nil {
+		s.unitMu.Unlock()
+	}
+}
+
+// reset drops every row; callers must hold both locks.
+func (s *Store) reset() {
+	s.settings = make(map[string][]byte)
+	s.users = make(map[int]*entities.User)
+	s.revokedTokens = nil
+	s.personalInfos = make(map[int]*entities.PersonalInfo)
+	s.projects = make(map[int]*entities.Project)
+	s.skills = make(map[int]*entities.Skill)
+	s.experiences = make(map[int]*entities.Experience)
+	s.educations = make(map[int]*entities.Education)
+	s.certifications = make(map[int]*entities.Certification)
+	s.publications = make(map[int]*entities.Publication)
+	s.spokenLanguages = make(map[int]*entities.SpokenLanguage)
+	s.awards = make(map[int]*entities.Award)
+	s.volunteerings = make(map[int]*entities.Volunteering)
+	s.testimonials = make(map[int]*entities.Testimonial)
+	s.technologies = make(map[int]*entities.Technology)
+	s.projectTechnologies = make(map[int][]int)
+	s.projectMedia = make(map[int]*entities.ProjectMedia)
+	s.projectLinks = make(map[int]*entities.ProjectLink)
+	s.projectSlugs = make(map[int]*projectSlug)
+	s.skillCategories = make(map[int]*entities.SkillCategory)
+	s.skillProjects = make(map[int][]int)
+	s.skillExperiences = make(map[int][]int)
+	s.experienceTechnologies = make(map[int][]int)
+	s.trash = make(map[string]map[int]*trashedRow)
+	s.revisions = nil
+	s.auditLogs = nil
+	s.sequences = make(map[string]int)
+}
+
+// snapshot copies every table so a unit of work can be rolled back; callers
+// must hold a lock. Rows are copied too, as the repositories update them in
+// place.
+func (s *Store) snapshot() *Store {
+	trash := make(map[string]map[int]*trashedRow, len(s.trash))
+	for table, rows := range s.trash {
+		trash[table] = cloneRows(rows)
+	}
+
+	sequences := make(map[string]int, len(s.sequences))
+	for table, id := range s.sequences {
+		sequences[table] = id
+	}
+
+	projectTechnologies := cloneLinks(s.projectTechnologies)
+
+	settings := make(map[string][]byte, len(s.settings))
+	for key, setting := range s.settings {
+		settings[key] = setting
+	}
+
+	return &Store{
+		settings:               settings,
+		users:                  cloneRows(s.users),
+		revokedTokens:          append([]revokedToken(nil), s.revokedTokens...),
+		personalInfos:          cloneRows(s.personalInfos),
+		projects:               cloneRows(s.projects),
+		skills:                 cloneRows(s.skills),
+		experiences:            cloneRows(s.experiences),
+		educations:             cloneRows(s.educations),
+		certifications:         cloneRows(s.certifications),
+		publications:           cloneRows(s.publications),
+		spokenLanguages:        cloneRows(s.spokenLanguages),
+		awards:                 cloneRows(s.awards),
+		volunteerings:          cloneRows(s.volunteerings),
+		testimonials:           cloneRows(s.testimonials),
+		technologies:           cloneRows(s.technologies),
+		projectTechnologies:    projectTechnologies,
+		projectMedia:           cloneRows(s.projectMedia),
+		projectLinks:           cloneRows(s.projectLinks),
+		projectSlugs:           cloneRows(s.projectSlugs),
+		skillCategories:        cloneRows(s.skillCategories),
+		skillProjects:          cloneLinks(s.skillProjects),
+		skillExperiences:       cloneLinks(s.skillExperiences),
+		experienceTechnologies: cloneLinks(s.experienceTechnologies),
+		trash:                  trash,
+		revisions:              append([]*entities.Revision(nil), s.revisions...),
+		auditLogs:              append([]*entities.AuditLog(nil), s.auditLogs...),
+		sequences:              sequences,
+	}
+}
+
+// restore puts back the tables of a snapshot; callers must hold the write
+// lock.
+func (s *Store) restore(snapshot *Store) {
+	s.settings = snapshot.settings
+	s.users = snapshot.users
+	s.revokedTokens = snapshot.revokedTokens
+	s.personalInfos = snapshot.personalInfos
+	s.projects = snapshot.projects
+	s.skills = snapshot.skills
+	s.experiences = snapshot.experiences
+	s.educations = snapshot.educations
+	s.certifications = snapshot.certifications
+	s.publications = snapshot.publications
+	s.spokenLanguages = snapshot.spokenLanguages
+	s.awards = snapshot.awards
+	s.volunteerings = snapshot.volunteerings
+	s.testimonials = snapshot.testimonials
+	s.technologies = snapshot.technologies
+	s.projectTechnologies = snapshot.projectTechnologies
+	s.projectMedia = snapshot.projectMedia
+	s.projectLinks = snapshot.projectLinks
+	s.projectSlugs = snapshot.projectSlugs
+	s.skillCategories = snapshot.skillCategories
+	s.skillProjects = snapshot.skillProjects
+	s.skillExperiences = snapshot.skillExperiences
+	s.experienceTechnologies = snapshot.experienceTechnologies
+	s.trash = snapshot.trash
+	s.revisions = snapshot.revisions
+	s.auditLogs = snapshot.auditLogs
+	s.sequences = snapshot.sequences
+}
+
+func cloneRows[T any](rows map[int]*T) map[int]*T {
+	cloned := make(map[int]*T, len(rows))
+	for id, row := range rows {
+		copied := *row
+		cloned[id] = &copied
+	}
+	return cloned
+}
+
+// cloneLinks copies a join table that maps IDs to ordered linked IDs.
+func cloneLinks(links map[int][]int) map[int][]int {
+	cloned := make(map[int][]int, len(links))
+	for id, linkedIDs := range links {
+		cloned[id] = append([]int(nil), linkedIDs...)
+	}
+	return cloned
+}
+
+// nextID mimics AUTOINCREMENT; callers must hold the write lock.
+func (s *Store) nextID(table string) int {
+	s.sequences[table]++
+	return s.sequences[table]
+}
+
+// checkUser mimics the users(user_id) foreign key; callers must hold a lock.
+func (s *Store) checkUser(userID int) error {
+	if _, ok := s.users[userID]; !ok {
+		return fmt.Errorf("FOREIGN KEY constraint failed")
+	}
+	return nil
+}
+
+func uniqueConstraintError(columns string) error {
+	return fmt.Errorf("UNIQUE constraint failed: %s", columns)
+}
+
+// moveToTrash soft-deletes a row by moving it from its live table into the
+// trash; callers must hold the write lock.
+func moveToTrash[T any](s *Store, table string, live map[int]*T, id int) bool {
+	row, ok := live[id]
+	if !ok {
+		return false
+	}
+
+	if s.trash[table] == nil {
+		s.trash[table] = make(map[int]*trashedRow)
+	}
+	s.trash[table][id] = &trashedRow{row: row, deletedAt: time.Now()}
+	delete(live, id)
+	return true
+}
+
+// restoreFromTrash moves a trashed row back into its live table; callers must
+// hold the write lock.
+func restoreFromTrash[T any](s *Store, table string, live map[int]*T, id int) bool {
+	trashed, ok := s.trash[table][id]
+	if !ok {
+		return false
+	}
+
+	live[id] = trashed.row.(*T)
+	delete(s.trash[table], id)
+	return true
+}
+
+// purge permanently removes a trashed row and, like the ON DELETE CASCADE
+// of project_technologies, project_media, project_links, project_slugs,
+// skill_projects, skill_experiences and experience_technologies, the rows
+// that reference it. Like the ON DELETE SET NULL of testimonials, it unlinks
+// the testimonials about it; callers must hold the write lock.
+func (s *Store) purge(table string, id int) {
+	delete(s.trash[table], id)
+
+	switch table {
+	case entities.TrashTypeProject:
+		delete(s.projectTechnologies, id)
+		maps.DeleteFunc(s.projectMedia, func(_ int, media *entities.ProjectMedia) bool { return media.ProjectID == id })
+		maps.DeleteFunc(s.projectLinks, func(_ int, link *entities.ProjectLink) bool { return link.ProjectID == id })
+		maps.DeleteFunc(s.projectSlugs, func(_ int, slug *projectSlug) bool { return slug.projectID == id })
+		unlink(s.skillProjects, id)
+		for _, testimonial := range withTrashed(s, entities.TrashTypeTestimonial, s.testimonials) {
+			if testimonial.ProjectID != nil && *testimonial.ProjectID == id {
+				testimonial.ProjectID = nil
+			}
+		}
+	case entities.TrashTypeTechnology:
+		unlink(s.projectTechnologies, id)
+		unlink(s.experienceTechnologies, id)
+	case entities.TrashTypeSkill:
+		delete(s.skillProjects, id)
+		delete(s.skillExperiences, id)
+	case entities.TrashTypeExperience:
+		delete(s.experienceTechnologies, id)
+		unlink(s.skillExperiences, id)
+		for _, testimonial := range withTrashed(s, entities.TrashTypeTestimonial, s.testimonials) {
+			if testimonial.ExperienceID != nil && *testimonial.ExperienceID == id {
+				testimonial.ExperienceID = nil
+			}
+		}
+	}
+}
+
+// unlink removes linkedID from every row of a join table; callers must hold
+// the write lock.
+func unlink(links map[int][]int, linkedID int) {
+	for id, linkedIDs := range links {
+		links[id] = slices.DeleteFunc(linkedIDs, func(candidate int) bool { return candidate == linkedID })
+	}
+}
+
+// withTrashed returns the live rows plus the trashed ones, which keep their
+// unique keys until purged, as in the SQL schema; callers must hold a lock.
+func withTrashed[T any](s *Store, table string, live map[int]*T) map[int]*T {
+	if len(s.trash[table]) == 0 {
+		return live
+	}
+
+	rows := make(map[int]*T, len(live)+len(s.trash[table]))
+	for id, row := range live {
+		rows[id] = row
+	}
+	for id, trashed := range s.trash[table] {
+		rows[id] = trashed.row.(*T)
+	}
+	return rows
+}
+
+// checkVersion mirrors the version condition of the SQL backends: a write
+// carrying If-Match only applies while the row is still at one of its versions.
+func checkVersion(ctx context.Context, resource string, id, current int) error {
+	if !domain.MatchesExpectedVersion(ctx, current) {
+		return domain.NewPreconditionFailedError(resource, strconv.Itoa(id))
+	}
+	return nil
+}
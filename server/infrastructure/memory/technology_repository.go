@@ -0,0 +1,242 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"sort"
+	"strings"
+	"time"
+)
+
+type technologyRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewTechnologyRepository(store *Store, logger *logger.Logger) interfaces.TechnologyRepository {
+	return &technologyRepository{store: store, logger: logger}
+}
+
+func (repo *technologyRepository) Create(ctx context.Context, technology *entities.Technology) (*entities.Technology, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.checkConstraints(0, technology.Name, technology.UserID); err != nil {
+		repo.logger.Error("Failed to create technology: %v", err)
+		return nil, domain.NewDatabaseError("create technology", err)
+	}
+
+	technology.TechnologyID = repo.store.nextID("technologies")
+	technology.Position = nextPosition(repo.store.technologies, technologyPlace, technology.UserID)
+	technology.CreatedAt = time.Now()
+	technology.UpdatedAt = time.Now()
+	technology.Version = 1
+
+	stored := *technology
+	repo.store.technologies[technology.TechnologyID] = &stored
+
+	return technology, nil
+}
+
+func (repo *technologyRepository) GetByID(ctx context.Context, technologyID int) (*entities.Technology, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	technology, ok := repo.store.technologies[technologyID]
+	if !ok {
+		return nil, nil
+	}
+
+	found := *technology
+	return &found, nil
+}
+
+var technologyListSpec = listSpec[*entities.Technology]{
+	id: func(technology *entities.Technology) int { return technology.TechnologyID },
+	sorts: map[string]func(a, b *entities.Technology) int{
+		"position":   func(a, b *entities.Technology) int { return cmp.Compare(a.Position, b.Position) },
+		"name":       func(a, b *entities.Technology) int { return compareFolded(a.Name, b.Name) },
+		"created_at": func(a, b *entities.Technology) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	},
+	filters: map[string]func(technology *entities.Technology, value string) bool{
+		"state": func(technology *entities.Technology, value string) bool { return technology.State == value },
+		"name": func(technology *entities.Technology, value string) bool {
+			return strings.EqualFold(technology.Name, value)
+		},
+	},
+}
+
+func (repo *technologyRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Technology], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var technologies []*entities.Technology
+	for _, technology := range repo.store.technologies {
+		if technology.UserID == userID {
+			found := *technology
+			technologies = append(technologies, &found)
+		}
+	}
+
+	return listPage(technologyListSpec, technologies, query)
+}
+
+func (repo *technologyRepository) Update(ctx context.Context, technologyID int, technology *entities.Technology) (*entities.Technology, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.technologies[technologyID]; ok {
+		if err := checkVersion(ctx, "Technology", technologyID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		if err := repo.checkConstraints(technologyID, technology.Name, stored.UserID); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to update technology: %v", err)
+			return nil, domain.NewDatabaseError("update technology", err)
+		}
+		stored.Name = technology.Name
+		stored.IconURL = technology.IconURL
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, technologyID)
+}
+
+func (repo *technologyRepository) Patch(ctx context.Context, technologyID int, technology *entities.Technology) (*entities.Technology, error) {
+	if technology.Name == "" && technology.IconURL == "" {
+		return technology, nil
+	}
+
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.technologies[technologyID]; ok {
+		if err := checkVersion(ctx, "Technology", technologyID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		name := stored.Name
+		if technology.Name != "" {
+			name = technology.Name
+		}
+		if err := repo.checkConstraints(technologyID, name, stored.UserID); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to patch technology: %v", err)
+			return nil, fmt.Errorf("unable to patch technology: %w", err)
+		}
+		stored.Name = name
+		if technology.IconURL != "" {
+			stored.IconURL = technology.IconURL
+		}
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, technologyID)
+}
+
+func (repo *technologyRepository) Delete(ctx context.Context, technologyID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.technologies[technologyID]; ok {
+		if err := checkVersion(ctx, "Technology", technologyID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, "technologies", repo.store.technologies, technologyID)
+	return nil
+}
+
+func (repo *technologyRepository) ExistsByID(ctx context.Context, technologyID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	_, ok := repo.store.technologies[technologyID]
+	return ok, nil
+}
+
+func (repo *technologyRepository) ExistsByNameAndUserID(ctx context.Context, name string, userID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for _, technology := range withTrashed(repo.store, "technologies", repo.store.technologies) {
+		if technology.Name == name && technology.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (repo *technologyRepository) GetByNames(ctx context.Context, names []string, userID int) ([]*entities.Technology, error) {
+	if len(names) == 0 {
+		return []*entities.Technology{}, nil
+	}
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[entities.NormalizeTechnologyName(name)] = true
+	}
+
+	return repo.list(func(technology *entities.Technology) bool {
+		return technology.UserID == userID && wanted[technology.GetNormalizedName()]
+	}), nil
+}
+
+func (repo *technologyRepository) GetAll(ctx context.Context) ([]*entities.Technology, error) {
+	return repo.list(func(*entities.Technology) bool { return true }), nil
+}
+
+// list returns copies of the matching technologies ordered by name.
+func (repo *technologyRepository) list(match func(*entities.Technology) bool) []*entities.Technology {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var technologies []*entities.Technology
+	for _, technology := range repo.store.technologies {
+		if match(technology) {
+			found := *technology
+			technologies = append(technologies, &found)
+		}
+	}
+
+	sort.Slice(technologies, func(i, j int) bool {
+		if technologies[i].Name == technologies[j].Name {
+			return technologies[i].TechnologyID < technologies[j].TechnologyID
+		}
+		return technologies[i].Name < technologies[j].Name
+	})
+
+	return technologies
+}
+
+// checkConstraints mirrors UNIQUE(technology_name, user_id) and the user
+// foreign key; callers must hold the write lock.
+func (repo *technologyRepository) checkConstraints(technologyID int, name string, userID int) error {
+	if err := repo.store.checkUser(userID); err != nil {
+		return err
+	}
+	for id, technology := range withTrashed(repo.store, "technologies", repo.store.technologies) {
+		if id != technologyID && technology.Name == name && technology.UserID == userID {
+			return uniqueConstraintError("technologies.technology_name, technologies.user_id")
+		}
+	}
+	return nil
+}
+
+func (repo *technologyRepository) Reorder(ctx context.Context, userID int, technologyIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("technologies", repo.store.technologies, technologyPlace, userID, technologyIDs)
+}
+
+func technologyPlace(technology *entities.Technology) (int, *int) {
+	return technology.UserID, &technology.Position
+}
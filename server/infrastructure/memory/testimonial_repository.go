@@ -0,0 +1,230 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type testimonialRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewTestimonialRepository(store *Store, logger *logger.Logger) interfaces.TestimonialRepository {
+	return &testimonialRepository{store: store, logger: logger}
+}
+
+func (repo *testimonialRepository) Create(ctx context.Context, testimonial *entities.Testimonial) (*entities.Testimonial, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.store.checkUser(testimonial.UserID); err != nil {
+		repo.logger.Error("Failed to create testimonial: %v", err)
+		return nil, domain.NewDatabaseError("create testimonial", err)
+	}
+	if err := repo.checkSubjects(testimonial); err != nil {
+		repo.logger.Error("Failed to create testimonial: %v", err)
+		return nil, domain.NewDatabaseError("create testimonial", err)
+	}
+
+	now := time.Now()
+	testimonial.TestimonialID = repo.store.nextID("testimonials")
+	testimonial.Position = nextPosition(repo.store.testimonials, testimonialPlace, testimonial.UserID)
+	testimonial.CreatedAt = now
+	testimonial.UpdatedAt = now
+	testimonial.Version = 1
+
+	stored := *testimonial
+	stored.Project = nil
+	stored.Experience = nil
+	repo.store.testimonials[testimonial.TestimonialID] = &stored
+
+	return repo.withSubjects(&stored), nil
+}
+
+func (repo *testimonialRepository) GetByID(ctx context.Context, testimonialID int) (*entities.Testimonial, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	testimonial, ok := repo.store.testimonials[testimonialID]
+	if !ok {
+		return nil, nil
+	}
+
+	return repo.withSubjects(testimonial), nil
+}
+
+var testimonialListSpec = listSpec[*entities.Testimonial]{
+	id: func(testimonial *entities.Testimonial) int { return testimonial.TestimonialID },
+	sorts: map[string]func(a, b *entities.Testimonial) int{
+		"position":    func(a, b *entities.Testimonial) int { return cmp.Compare(a.Position, b.Position) },
+		"created_at":  func(a, b *entities.Testimonial) int { return a.CreatedAt.Compare(b.CreatedAt) },
+		"author_name": func(a, b *entities.Testimonial) int { return compareFolded(a.AuthorName, b.AuthorName) },
+		"status":      func(a, b *entities.Testimonial) int { return cmp.Compare(a.Status, b.Status) },
+	},
+	filters: map[string]func(testimonial *entities.Testimonial, value string) bool{
+		"status": func(testimonial *entities.Testimonial, value string) bool { return testimonial.Status == value },
+		"project": func(testimonial *entities.Testimonial, value string) bool {
+			return testimonial.ProjectID != nil && strconv.Itoa(*testimonial.ProjectID) == value
+		},
+		"experience": func(testimonial *entities.Testimonial, value string) bool {
+			return testimonial.ExperienceID != nil && strconv.Itoa(*testimonial.ExperienceID) == value
+		},
+	},
+}
+
+func (repo *testimonialRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Testimonial], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var testimonials []*entities.Testimonial
+	for _, testimonial := range repo.store.testimonials {
+		if testimonial.UserID == userID {
+			testimonials = append(testimonials, repo.withSubjects(testimonial))
+		}
+	}
+
+	return listPage(testimonialListSpec, testimonials, query)
+}
+
+func (repo *testimonialRepository) GetByAuthorEmail(ctx context.Context, userID int, email string) ([]*entities.Testimonial, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var testimonials []*entities.Testimonial
+	for _, testimonial := range repo.store.testimonials {
+		if testimonial.UserID == userID && strings.EqualFold(testimonial.AuthorEmail, email) {
+			testimonials = append(testimonials, repo.withSubjects(testimonial))
+		}
+	}
+
+	sort.Slice(testimonials, func(i, j int) bool {
+		return testimonials[i].TestimonialID < testimonials[j].TestimonialID
+	})
+	return testimonials, nil
+}
+
+func (repo *testimonialRepository) Update(ctx context.Context, testimonialID int, testimonial *entities.Testimonial) (*entities.Testimonial, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.testimonials[testimonialID]; ok {
+		if err := checkVersion(ctx, "Testimonial", testimonialID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		if err := repo.checkSubjects(testimonial); err != nil {
+			repo.store.unlock(ctx)
+			repo.logger.Error("Failed to update testimonial: %v", err)
+			return nil, domain.NewDatabaseError("update testimonial", err)
+		}
+		stored.AuthorName = testimonial.AuthorName
+		stored.AuthorTitle = testimonial.AuthorTitle
+		stored.AuthorCompany = testimonial.AuthorCompany
+		stored.AuthorEmail = testimonial.AuthorEmail
+		stored.AuthorURL = testimonial.AuthorURL
+		stored.Content = testimonial.Content
+		stored.ProjectID = testimonial.ProjectID
+		stored.ExperienceID = testimonial.ExperienceID
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, testimonialID)
+}
+
+func (repo *testimonialRepository) SetStatus(ctx context.Context, testimonialID int, testimonial *entities.Testimonial) (*entities.Testimonial, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.testimonials[testimonialID]; ok {
+		if err := checkVersion(ctx, "Testimonial", testimonialID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		stored.Status = testimonial.Status
+		stored.RejectionReason = testimonial.RejectionReason
+		stored.ModeratedAt = copyTime(testimonial.ModeratedAt)
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, testimonialID)
+}
+
+func (repo *testimonialRepository) Delete(ctx context.Context, testimonialID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.testimonials[testimonialID]; ok {
+		if err := checkVersion(ctx, "Testimonial", testimonialID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, entities.TrashTypeTestimonial, repo.store.testimonials, testimonialID)
+	return nil
+}
+
+func (repo *testimonialRepository) Reorder(ctx context.Context, userID int, testimonialIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("testimonials", repo.store.testimonials, testimonialPlace, userID, testimonialIDs)
+}
+
+// checkSubjects mimics the projects(project_id) and
+// experiences(experience_id) foreign keys, which trashed rows satisfy;
+// callers must hold a lock.
+func (repo *testimonialRepository) checkSubjects(testimonial *entities.Testimonial) error {
+	if testimonial.ProjectID != nil {
+		if _, ok := findRow(repo.store.projects, repo.store.trash[entities.TrashTypeProject], *testimonial.ProjectID); !ok {
+			return fmt.Errorf("FOREIGN KEY constraint failed")
+		}
+	}
+	if testimonial.ExperienceID != nil {
+		if _, ok := findRow(repo.store.experiences, repo.store.trash[entities.TrashTypeExperience], *testimonial.ExperienceID); !ok {
+			return fmt.Errorf("FOREIGN KEY constraint failed")
+		}
+	}
+	return nil
+}
+
+// withSubjects copies a stored testimonial and fills in its live linked
+// project and experience; callers must hold a lock.
+func (repo *testimonialRepository) withSubjects(testimonial *entities.Testimonial) *entities.Testimonial {
+	found := *testimonial
+	found.ProjectID = testimonial.ProjectID
+	found.ExperienceID = testimonial.ExperienceID
+	found.ModeratedAt = copyTime(testimonial.ModeratedAt)
+	found.Project = nil
+	found.Experience = nil
+	if testimonial.ProjectID != nil {
+		if project, ok := repo.store.projects[*testimonial.ProjectID]; ok {
+			found.Project = &entities.TestimonialSubject{ID: project.ProjectID, Title: project.Title, Publishing: project.Publishing}
+		}
+	}
+	if testimonial.ExperienceID != nil {
+		if experience, ok := repo.store.experiences[*testimonial.ExperienceID]; ok {
+			found.Experience = &entities.TestimonialSubject{
+				ID:           experience.ExperienceID,
+				Title:        experience.JobTitle,
+				Organization: experience.CompanyName,
+				Publishing:   experience.Publishing,
+			}
+		}
+	}
+	return &found
+}
+
+func testimonialPlace(testimonial *entities.Testimonial) (int, *int) {
+	return testimonial.UserID, &testimonial.Position
+}
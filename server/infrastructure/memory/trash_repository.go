@@ -0,0 +1,142 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"sort"
+	"time"
+)
+
+type trashRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewTrashRepository(store *Store, logger *logger.Logger) interfaces.TrashRepository {
+	return &trashRepository{store: store, logger: logger}
+}
+
+func (repo *trashRepository) GetAll(ctx context.Context) ([]*entities.TrashItem, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var items []*entities.TrashItem
+	for _, itemType := range entities.TrashTypes {
+		for id, trashed := range repo.store.trash[itemType] {
+			item := &entities.TrashItem{Type: itemType, ID: id, DeletedAt: trashed.deletedAt}
+			switch row := trashed.row.(type) {
+			case *entities.Project:
+				item.UserID, item.Label = row.UserID, row.Title
+			case *entities.Skill:
+				item.UserID, item.Label = row.UserID, row.Name
+			case *entities.Experience:
+				item.UserID, item.Label = row.UserID, row.JobTitle+" at "+row.CompanyName
+			case *entities.Education:
+				item.UserID, item.Label = row.UserID, row.Degree+", "+row.Institution
+			case *entities.Certification:
+				item.UserID, item.Label = row.UserID, row.Name+" by "+row.Issuer
+			case *entities.Publication:
+				item.UserID, item.Label = row.UserID, row.Title
+			case *entities.SpokenLanguage:
+				item.UserID, item.Label = row.UserID, row.Name
+			case *entities.Award:
+				item.UserID, item.Label = row.UserID, row.Title+" by "+row.Issuer
+			case *entities.Volunteering:
+				item.UserID, item.Label = row.UserID, row.Role+" at "+row.Organization
+			case *entities.Testimonial:
+				item.UserID, item.Label = row.UserID, "Testimonial from "+row.AuthorName
+			case *entities.Technology:
+				item.UserID, item.Label = row.UserID, row.Name
+			case *entities.PersonalInfo:
+				item.UserID, item.Label = row.UserID, row.FirstName+" "+row.LastName
+			}
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].DeletedAt.Equal(items[j].DeletedAt) {
+			return items[i].ID < items[j].ID
+		}
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return items, nil
+}
+
+func (repo *trashRepository) Restore(ctx context.Context, itemType string, id int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	var restored bool
+	switch itemType {
+	case entities.TrashTypeProject:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.projects, id)
+	case entities.TrashTypeSkill:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.skills, id)
+	case entities.TrashTypeExperience:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.experiences, id)
+	case entities.TrashTypeEducation:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.educations, id)
+	case entities.TrashTypeCertification:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.certifications, id)
+	case entities.TrashTypePublication:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.publications, id)
+	case entities.TrashTypeSpokenLanguage:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.spokenLanguages, id)
+	case entities.TrashTypeAward:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.awards, id)
+	case entities.TrashTypeVolunteering:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.volunteerings, id)
+	case entities.TrashTypeTestimonial:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.testimonials, id)
+	case entities.TrashTypeTechnology:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.technologies, id)
+	case entities.TrashTypePersonalInfo:
+		restored = restoreFromTrash(repo.store, itemType, repo.store.personalInfos, id)
+	default:
+		return domain.NewValidationError("Unknown trash item type", "type", nil)
+	}
+
+	if !restored {
+		return domain.NewNotFoundError("Trash item", fmt.Sprintf("%s/%d", itemType, id))
+	}
+	return nil
+}
+
+func (repo *trashRepository) Purge(ctx context.Context, itemType string, id int) error {
+	if !entities.IsValidTrashType(itemType) {
+		return domain.NewValidationError("Unknown trash item type", "type", nil)
+	}
+
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if _, ok := repo.store.trash[itemType][id]; !ok {
+		return domain.NewNotFoundError("Trash item", fmt.Sprintf("%s/%d", itemType, id))
+	}
+
+	repo.store.purge(itemType, id)
+	return nil
+}
+
+func (repo *trashRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	var purged int64
+	for table, rows := range repo.store.trash {
+		for id, trashed := range rows {
+			if trashed.deletedAt.Before(cutoff) {
+				repo.store.purge(table, id)
+				purged++
+			}
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+)
+
+type unitOfWorkKey struct{}
+
+type unitOfWork struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+// NewUnitOfWork rolls back by restoring a snapshot of the store taken when the
+// unit of work began. Units of work run one at a time, and writes made outside
+// of one wait for the running unit of work, so a rollback only ever discards
+// its own writes.
+func NewUnitOfWork(store *Store, logger *logger.Logger) interfaces.UnitOfWork {
+	return &unitOfWork{store: store, logger: logger}
+}
+
+func (uow *unitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(unitOfWorkKey{}) != nil {
+		return fn(ctx)
+	}
+
+	uow.store.unitMu.Lock()
+	defer uow.store.unitMu.Unlock()
+
+	uow.store.mu.RLock()
+	snapshot := uow.store.snapshot()
+	uow.store.mu.RUnlock()
+
+	rollback := func() {
+		uow.store.mu.Lock()
+		uow.store.restore(snapshot)
+		uow.store.mu.Unlock()
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, unitOfWorkKey{}, true)); err != nil {
+		uow.logger.Warn("Rolling back in-memory unit of work: %v", err)
+		rollback()
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"portfolio/domain/entities"
+	"portfolio/infrastructure/memory"
+	"portfolio/logger"
+	"testing"
+	"time"
+)
+
+func TestRollbackKeepsWritesMadeOutsideTheUnitOfWork(t *testing.T) {
+	logger := logger.NewWriterLogger(io.Discard)
+	store := memory.NewStore()
+	users := memory.NewUserRepository(store, logger)
+	technologies := memory.NewTechnologyRepository(store, logger)
+	unitOfWork := memory.NewUnitOfWork(store, logger)
+
+	now := time.Now()
+	user, err := users.CreateUser(t.Context(), &entities.User{
+		Username:  "admin",
+		Email:     "admin@example.com",
+		Password:  "hashed",
+		Role:      entities.RoleAdmin,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+	technology := func(name string) *entities.Technology {
+		return &entities.Technology{UserID: user.ID, Name: name, CreatedAt: now, UpdatedAt: now}
+	}
+
+	outside := make(chan error, 1)
+	failure := errors.New("rolled back")
+	err = unitOfWork.Do(t.Context(), func(ctx context.Context) error {
+		if _, err := technologies.Create(ctx, technology("Inside")); err != nil {
+			return err
+		}
+		go func() {
+			_, err := technologies.Create(context.Background(), technology("Outside"))
+			outside <- err
+		}()
+		// Give the outside write every chance to land before the rollback.
+		time.Sleep(20 * time.Millisecond)
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("Do error = %v, want %v", err, failure)
+	}
+	if err := <-outside; err != nil {
+		t.Fatalf("Create outside the unit of work failed: %v", err)
+	}
+
+	for name, want := range map[string]bool{"Inside": false, "Outside": true} {
+		exists, err := technologies.ExistsByNameAndUserID(t.Context(), name, user.ID)
+		if err != nil {
+			t.Fatalf("ExistsByNameAndUserID(%q) failed: %v", name, err)
+		}
+		if exists != want {
+			t.Errorf("technology %q exists = %v, want %v", name, exists, want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"time"
+)
+
+type userRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewUserRepository(store *Store, logger *logger.Logger) interfaces.UserRepository {
+	return &userRepository{store: store, logger: logger}
+}
+
+func (repo *userRepository) CreateUser(ctx context.Context, user *entities.User) (*entities.User, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	for _, existing := range repo.store.users {
+		if existing.Username == user.Username {
+			err := uniqueConstraintError("users.user_username")
+			repo.logger.Error("Failed to createuser: %v", err)
+			return nil, domain.NewDatabaseError("user creation", err)
+		}
+	}
+
+	user.ID = repo.store.nextID("users")
+	stored := *user
+	repo.store.users[user.ID] = &stored
+
+	return user, nil
+}
+
+func (repo *userRepository) GetByUsername(ctx context.Context, username string) (*entities.User, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	for _, user := range repo.store.users {
+		if user.Username == username {
+			found := *user
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (repo *userRepository) UpdateLastLogin(ctx context.Context, userID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if user, ok := repo.store.users[userID]; ok {
+		user.LastLogin = time.Now()
+	}
+	return nil
+}
+
+func (repo *userRepository) ExistsByID(ctx context.Context, userID int) (bool, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	_, ok := repo.store.users[userID]
+	return ok, nil
+}
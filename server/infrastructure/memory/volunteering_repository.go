@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type volunteeringRepository struct {
+	store  *Store
+	logger *logger.Logger
+}
+
+func NewVolunteeringRepository(store *Store, logger *logger.Logger) interfaces.VolunteeringRepository {
+	return &volunteeringRepository{store: store, logger: logger}
+}
+
+func (repo *volunteeringRepository) Create(ctx context.Context, volunteering *entities.Volunteering) (*entities.Volunteering, error) {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if err := repo.store.checkUser(volunteering.UserID); err != nil {
+		repo.logger.Error("Failed to create volunteering: %v", err)
+		return nil, domain.NewDatabaseError("create volunteering", err)
+	}
+
+	now := time.Now()
+	volunteering.VolunteeringID = repo.store.nextID("volunteerings")
+	volunteering.Position = nextPosition(repo.store.volunteerings, volunteeringPlace, volunteering.UserID)
+	volunteering.CreatedAt = now
+	volunteering.UpdatedAt = now
+	volunteering.Version = 1
+
+	repo.store.volunteerings[volunteering.VolunteeringID] = copyVolunteering(volunteering)
+
+	return copyVolunteering(volunteering), nil
+}
+
+func (repo *volunteeringRepository) GetByID(ctx context.Context, volunteeringID int) (*entities.Volunteering, error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	volunteering, ok := repo.store.volunteerings[volunteeringID]
+	if !ok {
+		return nil, nil
+	}
+
+	return copyVolunteering(volunteering), nil
+}
+
+var volunteeringListSpec = listSpec[*entities.Volunteering]{
+	id: func(volunteering *entities.Volunteering) int { return volunteering.VolunteeringID },
+	sorts: map[string]func(a, b *entities.Volunteering) int{
+		"position":     func(a, b *entities.Volunteering) int { return cmp.Compare(a.Position, b.Position) },
+		"start_date":   func(a, b *entities.Volunteering) int { return a.StartDate.Compare(b.StartDate) },
+		"role":         func(a, b *entities.Volunteering) int { return compareFolded(a.Role, b.Role) },
+		"organization": func(a, b *entities.Volunteering) int { return compareFolded(a.Organization, b.Organization) },
+		"created_at":   func(a, b *entities.Volunteering) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	},
+	filters: map[string]func(volunteering *entities.Volunteering, value string) bool{
+		"state": func(volunteering *entities.Volunteering, value string) bool { return volunteering.State == value },
+		"organization": func(volunteering *entities.Volunteering, value string) bool {
+			return strings.EqualFold(volunteering.Organization, value)
+		},
+		"cause": func(volunteering *entities.Volunteering, value string) bool {
+			return strings.EqualFold(volunteering.Cause, value)
+		},
+		"current": func(volunteering *entities.Volunteering, value string) bool {
+			return strconv.FormatBool(volunteering.IsCurrent()) == value
+		},
+	},
+}
+
+func (repo *volunteeringRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Volunteering], error) {
+	repo.store.mu.RLock()
+	defer repo.store.mu.RUnlock()
+
+	var volunteerings []*entities.Volunteering
+	for _, volunteering := range repo.store.volunteerings {
+		if volunteering.UserID == userID {
+			volunteerings = append(volunteerings, copyVolunteering(volunteering))
+		}
+	}
+
+	return listPage(volunteeringListSpec, volunteerings, query)
+}
+
+func (repo *volunteeringRepository) Update(ctx context.Context, volunteeringID int, volunteering *entities.Volunteering) (*entities.Volunteering, error) {
+	repo.store.lock(ctx)
+	if stored, ok := repo.store.volunteerings[volunteeringID]; ok {
+		if err := checkVersion(ctx, "Volunteering", volunteeringID, stored.Version); err != nil {
+			repo.store.unlock(ctx)
+			return nil, err
+		}
+		stored.Role = volunteering.Role
+		stored.Organization = volunteering.Organization
+		stored.Cause = volunteering.Cause
+		stored.StartDate = volunteering.StartDate
+		stored.EndDate = copyTime(volunteering.EndDate)
+		stored.URL = volunteering.URL
+		stored.Description = volunteering.Description
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+	}
+	repo.store.unlock(ctx)
+
+	return repo.GetByID(ctx, volunteeringID)
+}
+
+func (repo *volunteeringRepository) Delete(ctx context.Context, volunteeringID int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	if stored, ok := repo.store.volunteerings[volunteeringID]; ok {
+		if err := checkVersion(ctx, "Volunteering", volunteeringID, stored.Version); err != nil {
+			return err
+		}
+		stored.Version++
+	}
+
+	moveToTrash(repo.store, entities.TrashTypeVolunteering, repo.store.volunteerings, volunteeringID)
+	return nil
+}
+
+func (repo *volunteeringRepository) Reorder(ctx context.Context, userID int, volunteeringIDs []int) error {
+	repo.store.lock(ctx)
+	defer repo.store.unlock(ctx)
+
+	return reorder("volunteerings", repo.store.volunteerings, volunteeringPlace, userID, volunteeringIDs)
+}
+
+func copyVolunteering(volunteering *entities.Volunteering) *entities.Volunteering {
+	copied := *volunteering
+	copied.EndDate = copyTime(volunteering.EndDate)
+	return &copied
+}
+
+func volunteeringPlace(volunteering *entities.Volunteering) (int, *int) {
+	return volunteering.UserID, &volunteering.Position
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type auditLogRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewAuditLogRepository(db *sql.DB, logger *logger.Logger) interfaces.AuditLogRepository {
+	return &auditLogRepository{db: db, logger: logger}
+}
+
+func (repo *auditLogRepository) Create(ctx context.Context, auditLog *entities.AuditLog) (*entities.AuditLog, error) {
+	query := `INSERT INTO audit_logs (user_id, audit_log_action, audit_log_resource_type, audit_log_resource_id, audit_log_before, audit_log_after, audit_log_ip, audit_log_request_id, audit_log_created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			  RETURNING audit_log_id`
+
+	auditLog.CreatedAt = time.Now()
+	var id int
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query,
+		auditLog.UserID,
+		auditLog.Action,
+		auditLog.ResourceType,
+		auditLog.ResourceID,
+		auditLog.Before,
+		auditLog.After,
+		auditLog.IP,
+		auditLog.RequestID,
+		auditLog.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		repo.logger.Error("Failed to create audit log: %v", err)
+		return nil, domain.NewDatabaseError("create audit log", err)
+	}
+
+	auditLog.AuditLogID = id
+	return auditLog, nil
+}
+
+func (repo *auditLogRepository) List(ctx context.Context, filter *entities.AuditLogFilter) ([]*entities.AuditLog, int, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	placeholder := func(value any) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+	if filter.UserID > 0 {
+		conditions = append(conditions, "user_id = "+placeholder(filter.UserID))
+	}
+	if filter.Action != "" {
+		conditions = append(conditions, "audit_log_action = "+placeholder(filter.Action))
+	}
+	if filter.ResourceType != "" {
+		conditions = append(conditions, "audit_log_resource_type = "+placeholder(filter.ResourceType))
+	}
+	if filter.ResourceID != "" {
+		conditions = append(conditions, "audit_log_resource_id = "+placeholder(filter.ResourceID))
+	}
+	if filter.Since != nil {
+		conditions = append(conditions, "audit_log_created_at >= "+placeholder(*filter.Since))
+	}
+	if filter.Until != nil {
+		conditions = append(conditions, "audit_log_created_at < "+placeholder(*filter.Until))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := transaction.From(ctx, repo.db).QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_logs`+where, args...).Scan(&total); err != nil {
+		repo.logger.Error("Failed to count audit logs: %v", err)
+		return nil, 0, domain.NewDatabaseError("count audit logs", err)
+	}
+
+	query := `SELECT audit_log_id, user_id, audit_log_action, audit_log_resource_type, audit_log_resource_id, audit_log_before, audit_log_after, audit_log_ip, audit_log_request_id, audit_log_created_at
+			  FROM audit_logs` + where + ` ORDER BY audit_log_id DESC`
+	if filter.Limit > 0 {
+		query += ` LIMIT ` + placeholder(filter.Limit) + ` OFFSET ` + placeholder(filter.Offset)
+	}
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		repo.logger.Error("Failed to list audit logs: %v", err)
+		return nil, 0, domain.NewDatabaseError("list audit logs", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			repo.logger.Error("Failed to closing rows: %v", err)
+		}
+	}()
+
+	var auditLogs []*entities.AuditLog
+	for rows.Next() {
+		auditLog, err := scanAuditLog(rows)
+		if err != nil {
+			repo.logger.Error("Failed to scan audit log: %v", err)
+			return nil, 0, domain.NewDatabaseError("scan audit log", err)
+		}
+		auditLogs = append(auditLogs, auditLog)
+	}
+
+	if err := rows.Err(); err != nil {
+		repo.logger.Error("Failed to iterate audit logs: %v", err)
+		return nil, 0, domain.NewDatabaseError("iterate audit logs", err)
+	}
+
+	return auditLogs, total, nil
+}
+
+func scanAuditLog(row interface{ Scan(dest ...any) error }) (*entities.AuditLog, error) {
+	var (
+		auditLog                                 entities.AuditLog
+		userID                                   sql.NullInt64
+		resourceID, before, after, ip, requestID sql.NullString
+	)
+	err := row.Scan(
+		&auditLog.AuditLogID,
+		&userID,
+		&auditLog.Action,
+		&auditLog.ResourceType,
+		&resourceID,
+		&before,
+		&after,
+		&ip,
+		&requestID,
+		&auditLog.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID.Valid {
+		id := int(userID.Int64)
+		auditLog.UserID = &id
+	}
+	auditLog.ResourceID = resourceID.String
+	auditLog.Before = before.String
+	auditLog.After = after.String
+	auditLog.IP = ip.String
+	auditLog.RequestID = requestID.String
+	return &auditLog, nil
+}
@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"time"
+)
+
+type awardRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewAwardRepository(db *sql.DB, logger *logger.Logger) interfaces.AwardRepository {
+	return &awardRepository{db: db, logger: logger}
+}
+
+const awardColumns = "award_id, user_id, award_title, award_issuer, award_date, award_url, award_description, award_created_at, award_updated_at, award_version, award_position, award_state, award_publish_at, award_unpublish_at"
+
+var awardList = listing.Table{
+	Name:     "awards",
+	IDColumn: "award_id",
+	Columns:  awardColumns,
+	Scope:    "user_id = ? AND award_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":   "award_position",
+		"date":       "award_date",
+		"title":      "lower(award_title)",
+		"issuer":     "lower(award_issuer)",
+		"created_at": "award_created_at",
+	},
+	Filters: map[string]string{
+		"state":  "award_state = ?",
+		"issuer": "lower(award_issuer) = lower(?)",
+	},
+	Position: "award_position",
+}
+
+func scanAward(row rowScanner) (*entities.Award, error) {
+	award := &entities.Award{}
+	err := row.Scan(
+		&award.AwardID,
+		&award.UserID,
+		&award.Title,
+		&award.Issuer,
+		&award.Date,
+		&award.URL,
+		&award.Description,
+		&award.CreatedAt,
+		&award.UpdatedAt,
+		&award.Version,
+		&award.Position,
+		&award.State,
+		&award.PublishAt,
+		&award.UnpublishAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return award, nil
+}
+
+func (repo *awardRepository) Create(ctx context.Context, award *entities.Award) (*entities.Award, error) {
+	executor := transaction.From(ctx, repo.db)
+	position, err := listing.NextPosition(ctx, executor, listing.Postgres, awardList, []any{award.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create award: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO awards (user_id, award_title, award_issuer, award_date, award_url, award_description,
+			  award_position, award_state, award_publish_at, award_unpublish_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			  RETURNING award_id`
+
+	var id int
+	err = executor.QueryRowContext(ctx, query,
+		award.UserID,
+		award.Title,
+		award.Issuer,
+		award.Date,
+		award.URL,
+		award.Description,
+		position,
+		award.State,
+		award.PublishAt,
+		award.UnpublishAt,
+	).Scan(&id)
+	if err != nil {
+		repo.logger.Error("Failed to create award: %v", err)
+		return nil, domain.NewDatabaseError("create award", err)
+	}
+
+	return repo.GetByID(ctx, id)
+}
+
+func (repo *awardRepository) GetByID(ctx context.Context, awardID int) (*entities.Award, error) {
+	query := `SELECT ` + awardColumns + ` FROM awards WHERE award_id = $1 AND award_deleted_at IS NULL`
+
+	award, err := scanAward(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, awardID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to retrieve award by ID: %v", err)
+		return nil, domain.NewDatabaseError("retrieve award", err)
+	}
+	return award, nil
+}
+
+func (repo *awardRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Award], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.Postgres, awardList, []any{userID}, query,
+		func(rows *sql.Rows) (*entities.Award, error) { return scanAward(rows) },
+		func(award *entities.Award) int { return award.AwardID })
+	if err != nil {
+		repo.logger.Error("Failed to retrieve awards by user ID: %v", err)
+		return nil, err
+	}
+	return page, nil
+}
+
+func (repo *awardRepository) Update(ctx context.Context, awardID int, award *entities.Award) (*entities.Award, error) {
+	query := `UPDATE awards SET award_title = $1, award_issuer = $2, award_date = $3, award_url = $4, award_description = $5,
+			  award_updated_at = $6, award_version = award_version + 1
+			  WHERE award_id = $7 AND award_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "award_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		award.Title,
+		award.Issuer,
+		award.Date,
+		award.URL,
+		award.Description,
+		time.Now(),
+		awardID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update award: %v", err)
+		return nil, domain.NewDatabaseError("update award", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Award", awardID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, awardID)
+}
+
+func (repo *awardRepository) Delete(ctx context.Context, awardID int) error {
+	query := `UPDATE awards SET award_deleted_at = $1, award_version = award_version + 1
+			  WHERE award_id = $2 AND award_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "award_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), awardID)
+	if err != nil {
+		repo.logger.Error("Failed to delete award: %v", err)
+		return domain.NewDatabaseError("delete award", err)
+	}
+
+	return transaction.CheckVersion(result, condition, "Award", awardID)
+}
+
+func (repo *awardRepository) Reorder(ctx context.Context, userID int, awardIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.Postgres, awardList, []any{userID}, awardIDs); err != nil {
+		repo.logger.Error("Failed to reorder awards: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strconv"
+	"time"
+)
+
+type certificationRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewCertificationRepository(db *sql.DB, logger *logger.Logger) interfaces.CertificationRepository {
+	return &certificationRepository{db: db, logger: logger}
+}
+
+const certificationColumns = "certification_id, user_id, certification_name, certification_issuer, certification_credential_id, certification_verification_url, certification_issue_date, certification_expiry_date, certification_description, certification_created_at, certification_updated_at, certification_version, certification_position, certification_state, certification_publish_at, certification_unpublish_at"
+
+// certificationExpiryStatus computes entities.Certification.ExpiryStatus on
+// the current date.
+var certificationExpiryStatus = "CASE WHEN certification_expiry_date IS NULL OR certification_expiry_date >= CURRENT_DATE + " +
+	strconv.Itoa(entities.CertificationExpiryWarningDays) + " THEN 'valid' " +
+	"WHEN certification_expiry_date < CURRENT_DATE THEN 'expired' ELSE 'expiring_soon' END"
+
+var certificationList = listing.Table{
+	Name:     "certifications",
+	IDColumn: "certification_id",
+	Columns:  certificationColumns,
+	Scope:    "user_id = ? AND certification_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":    "certification_position",
+		"issue_date":  "certification_issue_date",
+		"expiry_date": "coalesce(certification_expiry_date, DATE '9999-12-31')",
+		"name":        "lower(certification_name)",
+		"issuer":      "lower(certification_issuer)",
+		"created_at":  "certification_created_at",
+	},
+	Filters: map[string]string{
+		"state":  "certification_state = ?",
+		"issuer": "lower(certification_issuer) = lower(?)",
+		"expiry": certificationExpiryStatus + " = ?",
+	},
+	Position: "certification_position",
+}
+
+func scanCertification(row rowScanner) (*entities.Certification, error) {
+	certification := &entities.Certification{}
+	err := row.Scan(
+		&certification.CertificationID,
+		&certification.UserID,
+		&certification.Name,
+		&certification.Issuer,
+		&certification.CredentialID,
+		&certification.VerificationURL,
+		&certification.IssueDate,
+		&certification.ExpiryDate,
+		&certification.Description,
+		&certification.CreatedAt,
+		&certification.UpdatedAt,
+		&certification.Version,
+		&certification.Position,
+		&certification.State,
+		&certification.PublishAt,
+		&certification.UnpublishAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return certification, nil
+}
+
+func (repo *certificationRepository) Create(ctx context.Context, certification *entities.Certification) (*entities.Certification, error) {
+	executor := transaction.From(ctx, repo.db)
+	position, err := listing.NextPosition(ctx, executor, listing.Postgres, certificationList, []any{certification.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create certification: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO certifications (user_id, certification_name, certification_issuer, certification_credential_id,
+			  certification_verification_url, certification_issue_date, certification_expiry_date, certification_description,
+			  certification_position, certification_state, certification_publish_at, certification_unpublish_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			  RETURNING certification_id`
+
+	var id int
+	err = executor.QueryRowContext(ctx, query,
+		certification.UserID,
+		certification.Name,
+		certification.Issuer,
+		certification.CredentialID,
+		certification.VerificationURL,
+		certification.IssueDate,
+		certification.ExpiryDate,
+		certification.Description,
+		position,
+		certification.State,
+		certification.PublishAt,
+		certification.UnpublishAt,
+	).Scan(&id)
+	if err != nil {
+		repo.logger.Error("Failed to create certification: %v", err)
+		return nil, domain.NewDatabaseError("create certification", err)
+	}
+
+	return repo.GetByID(ctx, id)
+}
+
+func (repo *certificationRepository) GetByID(ctx context.Context, certificationID int) (*entities.Certification, error) {
+	query := `SELECT ` + certificationColumns + ` FROM certifications WHERE certification_id = $1 AND certification_deleted_at IS NULL`
+
+	certification, err := scanCertification(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, certificationID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to retrieve certification by ID: %v", err)
+		return nil, domain.NewDatabaseError("retrieve certification", err)
+	}
+	return certification, nil
+}
+
+func (repo *certificationRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Certification], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.Postgres, certificationList, []any{userID}, query,
+		func(rows *sql.Rows) (*entities.Certification, error) { return scanCertification(rows) },
+		func(certification *entities.Certification) int { return certification.CertificationID })
+	if err != nil {
+		repo.logger.Error("Failed to retrieve certifications by user ID: %v", err)
+		return nil, err
+	}
+	return page, nil
+}
+
+func (repo *certificationRepository) Update(ctx context.Context, certificationID int, certification *entities.Certification) (*entities.Certification, error) {
+	query := `UPDATE certifications SET certification_name = $1, certification_issuer = $2, certification_credential_id = $3,
+			  certification_verification_url = $4, certification_issue_date = $5, certification_expiry_date = $6,
+			  certification_description = $7, certification_updated_at = $8, certification_version = certification_version + 1
+			  WHERE certification_id = $9 AND certification_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "certification_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		certification.Name,
+		certification.Issuer,
+		certification.CredentialID,
+		certification.VerificationURL,
+		certification.IssueDate,
+		certification.ExpiryDate,
+		certification.Description,
+		time.Now(),
+		certificationID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update certification: %v", err)
+		return nil, domain.NewDatabaseError("update certification", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Certification", certificationID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, certificationID)
+}
+
+func (repo *certificationRepository) Delete(ctx context.Context, certificationID int) error {
+	query := `UPDATE certifications SET certification_deleted_at = $1, certification_version = certification_version + 1
+			  WHERE certification_id = $2 AND certification_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "certification_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), certificationID)
+	if err != nil {
+		repo.logger.Error("Failed to delete certification: %v", err)
+		return domain.NewDatabaseError("delete certification", err)
+	}
+
+	return transaction.CheckVersion(result, condition, "Certification", certificationID)
+}
+
+func (repo *certificationRepository) ExistsByCredential(ctx context.Context, userID int, issuer, credentialID string, exceptCertificationID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM certifications WHERE user_id = $1 AND certification_issuer = $2
+			  AND certification_credential_id = $3 AND certification_id <> $4`
+
+	var count int
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID, issuer, credentialID, exceptCertificationID).Scan(&count)
+	if err != nil {
+		repo.logger.Error("Failed to check certification credential: %v", err)
+		return false, domain.NewDatabaseError("check certification existence by credential", err)
+	}
+	return count > 0, nil
+}
+
+func (repo *certificationRepository) Reorder(ctx context.Context, userID int, certificationIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.Postgres, certificationList, []any{userID}, certificationIDs); err != nil {
+		repo.logger.Error("Failed to reorder certifications: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package postgres_test
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"portfolio/config"
+	"portfolio/infrastructure/conformance"
+	"portfolio/infrastructure/postgres"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// The suite needs a PostgreSQL server. The compose "postgres" profile
+// starts one and runs the suite against it:
+//
+//	docker compose --profile postgres run --rm test-postgres
+//
+// Without PORTFOLIO_TEST_POSTGRES_DSN the suite is skipped, unless
+// PORTFOLIO_TEST_POSTGRES_REQUIRED is set (as in CI), where it fails instead.
+const (
+	dsnVariable      = "PORTFOLIO_TEST_POSTGRES_DSN"
+	requiredVariable = "PORTFOLIO_TEST_POSTGRES_REQUIRED"
+)
+
+var schemas atomic.Int64
+
+func TestConformance(t *testing.T) {
+	dsn := os.Getenv(dsnVariable)
+	if dsn == "" {
+		if os.Getenv(requiredVariable) != "" {
+			t.Fatalf("%s is set but %s is not", requiredVariable, dsnVariable)
+		}
+		t.Skipf("PostgreSQL was not exercised: %s is not set (docker compose --profile postgres run --rm test-postgres)", dsnVariable)
+	}
+
+	conformance.Run(t, func(t *testing.T) *conformance.Repositories {
+		logger := logger.NewWriterLogger(io.Discard)
+
+		db, err := postgres.NewConnection(&config.DatabaseConfig{
+			Driver:             config.DriverPostgres,
+			DSN:                schemaDSN(t, dsn),
+			MaxOpenConnections: 5,
+			MaxIdleConnections: 5,
+		}, logger)
+		if err != nil {
+			t.Fatalf("Failed to open PostgreSQL database: %v", err)
+		}
+		t.Cleanup(func() {
+			_ = db.Close()
+		})
+
+		return &conformance.Repositories{
+			User:           postgres.NewUserRepository(db, logger),
+			Setting:        postgres.NewSettingRepository(db, logger, "portfolio"),
+			Technology:     postgres.NewTechnologyRepository(db, logger),
+			Project:        postgres.NewProjectRepository(db, logger),
+			Search:         postgres.NewSearchRepository(db, logger),
+			Certification:  postgres.NewCertificationRepository(db, logger),
+			SpokenLanguage: postgres.NewSpokenLanguageRepository(db, logger),
+			Volunteering:   postgres.NewVolunteeringRepository(db, logger),
+			Trash:          postgres.NewTrashRepository(db, logger),
+			UnitOfWork:     transaction.NewUnitOfWork(db, logger),
+		}
+	})
+}
+
+// schemaDSN creates an empty schema for one test, dropped when it ends, and
+// returns dsn with the schema as its search path, so that the migrations
+// and the repositories only see that schema.
+func schemaDSN(t *testing.T, dsn string) string {
+	t.Helper()
+
+	admin, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatalf("Failed to open PostgreSQL database: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = admin.Close()
+	})
+
+	schema := fmt.Sprintf("conformance_%d_%d", time.Now().UnixNano(), schemas.Add(1))
+	if _, err := admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		t.Fatalf("Failed to create schema %s: %v", schema, err)
+	}
+	// Cleanups run last in first out, so the schema is dropped once the
+	// connection of the test, opened after this, is closed.
+	t.Cleanup(func() {
+		if _, err := admin.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			t.Errorf("Failed to drop schema %s: %v", schema, err)
+		}
+	})
+
+	if !strings.Contains(dsn, "://") {
+		return dsn + " search_path=" + schema
+	}
+
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		t.Fatalf("Invalid %s: %v", dsnVariable, err)
+	}
+	query := parsed.Query()
+	query.Set("search_path", schema)
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
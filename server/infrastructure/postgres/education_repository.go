@@ -0,0 +1,375 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"time"
+)
+
+type educationRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewEducationRepository(db *sql.DB, logger *logger.Logger) interfaces.EducationRepository {
+	return &educationRepository{db: db, logger: logger}
+}
+
+func (repo *educationRepository) Create(ctx context.Context, education *entities.Education) (*entities.Education, error) {
+	position, err := listing.NextPosition(ctx, transaction.From(ctx, repo.db), listing.Postgres, educationList, []any{education.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create education: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO educations (user_id, education_degree, education_institution, 
+			  education_start_date, education_end_date, education_description, education_position, 
+			  education_state, education_publish_at, education_unpublish_at) 
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			  RETURNING education_id`
+
+	var id int
+	err = transaction.From(ctx, repo.db).QueryRowContext(ctx, query,
+		education.UserID,
+		education.Degree,
+		education.Institution,
+		education.StartDate,
+		education.EndDate,
+		education.Description,
+		position,
+		education.State,
+		education.PublishAt,
+		education.UnpublishAt,
+	).Scan(&id)
+	if err != nil {
+		repo.logger.Error("Failed to create education: %v", err)
+		return nil, domain.NewDatabaseError("create education", err)
+	}
+
+	education.EducationID = id
+	education.Position = position
+	education.CreatedAt = time.Now()
+	education.UpdatedAt = time.Now()
+
+	return education, nil
+}
+
+func (repo *educationRepository) GetByID(ctx context.Context, educationID int) (*entities.Education, error) {
+	var education entities.Education
+	query := `SELECT education_id, user_id, education_degree, education_institution, 
+			  education_start_date, education_end_date, education_description, 
+			  education_created_at, education_updated_at, education_version, education_position, education_state, education_publish_at, education_unpublish_at 
+			  FROM educations WHERE education_id = $1 AND education_deleted_at IS NULL`
+
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, educationID)
+	err := row.Scan(
+		&education.EducationID,
+		&education.UserID,
+		&education.Degree,
+		&education.Institution,
+		&education.StartDate,
+		&education.EndDate,
+		&education.Description,
+		&education.CreatedAt,
+		&education.UpdatedAt,
+		&education.Version,
+		&education.Position,
+		&education.State,
+		&education.PublishAt,
+		&education.UnpublishAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to retrieve education by ID: %v", err)
+		return nil, domain.NewDatabaseError("retrieve education", err)
+	}
+
+	return &education, nil
+}
+
+var educationList = listing.Table{
+	Name:     "educations",
+	IDColumn: "education_id",
+	Columns:  "education_id, user_id, education_degree, education_institution, education_start_date, education_end_date, education_description, education_created_at, education_updated_at, education_version, education_position, education_state, education_publish_at, education_unpublish_at",
+	Scope:    "user_id = ? AND education_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":    "education_position",
+		"start_date":  "education_start_date",
+		"degree":      "lower(education_degree)",
+		"institution": "lower(education_institution)",
+		"created_at":  "education_created_at",
+	},
+	Filters: map[string]string{
+		"state":       "education_state = ?",
+		"institution": "lower(education_institution) = lower(?)",
+	},
+	Position: "education_position",
+}
+
+func (repo *educationRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Education], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.Postgres, educationList, []any{userID}, query,
+		scanEducation, func(education *entities.Education) int { return education.EducationID })
+	if err != nil {
+		repo.logger.Error("Failed to retrieve educations by user ID: %v", err)
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func scanEducation(rows *sql.Rows) (*entities.Education, error) {
+	education := &entities.Education{}
+	err := rows.Scan(
+		&education.EducationID,
+		&education.UserID,
+		&education.Degree,
+		&education.Institution,
+		&education.StartDate,
+		&education.EndDate,
+		&education.Description,
+		&education.CreatedAt,
+		&education.UpdatedAt,
+		&education.Version,
+		&education.Position,
+		&education.State,
+		&education.PublishAt,
+		&education.UnpublishAt,
+	)
+	return education, err
+}
+
+func (repo *educationRepository) Update(ctx context.Context, educationID int, education *entities.Education) (*entities.Education, error) {
+	query := `UPDATE educations SET education_degree = $1, education_institution = $2, 
+			  education_start_date = $3, education_end_date = $4, education_description = $5, 
+			  education_updated_at = $6, education_version = education_version + 1 WHERE education_id = $7 AND education_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "education_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		education.Degree,
+		education.Institution,
+		education.StartDate,
+		education.EndDate,
+		education.Description,
+		time.Now(),
+		educationID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update education: %v", err)
+		return nil, domain.NewDatabaseError("update education", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Education", educationID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, educationID)
+}
+
+func (repo *educationRepository) Patch(ctx context.Context, educationID int, education *entities.Education) (*entities.Education, error) {
+	type field struct {
+		Name  string
+		Value interface{}
+		Set   bool
+	}
+
+	var fields []field
+	if education.Degree != "" {
+		fields = append(fields, field{"education_degree", education.Degree, true})
+	}
+	if education.Institution != "" {
+		fields = append(fields, field{"education_institution", education.Institution, true})
+	}
+	if !education.StartDate.IsZero() {
+		fields = append(fields, field{"education_start_date", education.StartDate, true})
+	}
+	if !education.EndDate.IsZero() {
+		fields = append(fields, field{"education_end_date", education.EndDate, true})
+	}
+	if education.Description != "" {
+		fields = append(fields, field{"education_description", education.Description, true})
+	}
+
+	if len(fields) == 0 {
+		return education, nil
+	}
+
+	query := "UPDATE educations SET "
+	var args []interface{}
+	for i, f := range fields {
+		args = append(args, f.Value)
+		query += fmt.Sprintf("%s = $%d", f.Name, len(args))
+		if i < len(fields)-1 {
+			query += ", "
+		}
+	}
+	args = append(args, educationID)
+	query += fmt.Sprintf(", education_version = education_version + 1 WHERE education_id = $%d AND education_deleted_at IS NULL", len(args))
+
+	condition := transaction.VersionCondition(ctx, "education_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, args...)
+	if err != nil {
+		repo.logger.Error("Failed to patch education: %v", err)
+		return nil, fmt.Errorf("unable to patch education: %w", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Education", educationID); err != nil {
+		return nil, err
+	}
+	return repo.GetByID(ctx, educationID)
+}
+
+func (repo *educationRepository) Delete(ctx context.Context, educationID int) error {
+	query := `UPDATE educations SET education_deleted_at = $1, education_version = education_version + 1 WHERE education_id = $2 AND education_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "education_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), educationID)
+	if err != nil {
+		repo.logger.Error("Failed to delete education: %v", err)
+		return domain.NewDatabaseError("delete education", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Education", educationID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *educationRepository) ExistsByID(ctx context.Context, educationID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM educations WHERE education_id = $1 AND education_deleted_at IS NULL`
+	var count int
+
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, educationID).Scan(&count)
+	if err != nil {
+		repo.logger.Error("Failed to existsbyid education: %v", err)
+		return false, domain.NewDatabaseError("check education existence", err)
+	}
+
+	return count > 0, nil
+}
+
+func (repo *educationRepository) ExistsByDegreeInstitutionAndUserID(ctx context.Context, degree, institution string, userID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM educations WHERE education_degree = $1 AND education_institution = $2 AND user_id = $3`
+	var count int
+
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, degree, institution, userID).Scan(&count)
+	if err != nil {
+		repo.logger.Error("Failed to existsbydegreeinstitutionanduserid education: %v", err)
+		return false, domain.NewDatabaseError("check education existence by degree and institution", err)
+	}
+
+	return count > 0, nil
+}
+
+func (repo *educationRepository) GetCurrentEducations(ctx context.Context, userID int) ([]*entities.Education, error) {
+	query := `SELECT education_id, user_id, education_degree, education_institution, 
+			  education_start_date, education_end_date, education_description,
+			  education_created_at, education_updated_at, education_version, education_position, education_state, education_publish_at, education_unpublish_at 
+			  FROM educations WHERE user_id = $1 AND education_deleted_at IS NULL AND education_end_date IS NULL
+			  ORDER BY education_start_date DESC`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		repo.logger.Error("Failed to getcurrenteducations: %v", err)
+		return nil, domain.NewDatabaseError("retrieve current educations", err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			repo.logger.Error("Failed to closing rows: %v", err)
+		}
+	}()
+
+	var educations []*entities.Education
+	for rows.Next() {
+		var education entities.Education
+		err := rows.Scan(
+			&education.EducationID,
+			&education.UserID,
+			&education.Degree,
+			&education.Institution,
+			&education.StartDate,
+			&education.EndDate,
+			&education.Description,
+			&education.CreatedAt,
+			&education.UpdatedAt,
+			&education.Version,
+			&education.Position,
+			&education.State,
+			&education.PublishAt,
+			&education.UnpublishAt,
+		)
+		if err != nil {
+			repo.logger.Error("Failed to scanning current education: %v", err)
+			continue
+		}
+
+		educations = append(educations, &education)
+	}
+
+	return educations, nil
+}
+
+func (repo *educationRepository) GetAll(ctx context.Context) ([]*entities.Education, error) {
+	query := `SELECT education_id, user_id, education_degree, education_institution, 
+			  education_start_date, education_end_date, education_description,
+			  education_created_at, education_updated_at, education_version, education_position, education_state, education_publish_at, education_unpublish_at 
+			  FROM educations WHERE education_deleted_at IS NULL ORDER BY education_start_date DESC`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query)
+	if err != nil {
+		repo.logger.Error("Failed to getall educations: %v", err)
+		return nil, domain.NewDatabaseError("retrieve all educations", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			repo.logger.Error("Failed to closing rows: %v", err)
+		}
+	}()
+
+	var educations []*entities.Education
+	for rows.Next() {
+		var education entities.Education
+		err := rows.Scan(
+			&education.EducationID,
+			&education.UserID,
+			&education.Degree,
+			&education.Institution,
+			&education.StartDate,
+			&education.EndDate,
+			&education.Description,
+			&education.CreatedAt,
+			&education.UpdatedAt,
+			&education.Version,
+			&education.Position,
+			&education.State,
+			&education.PublishAt,
+			&education.UnpublishAt,
+		)
+		if err != nil {
+			repo.logger.Error("Failed to scanning education: %v", err)
+			continue
+		}
+
+		educations = append(educations, &education)
+	}
+
+	return educations, nil
+}
+
+func (repo *educationRepository) Reorder(ctx context.Context, userID int, educationIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.Postgres, educationList, []any{userID}, educationIDs); err != nil {
+		repo.logger.Error("Failed to reorder educations: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,364 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/domain/utils"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strings"
+	"time"
+)
+
+type personalInfoRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewPersonalInfoRepository(db *sql.DB, logger *logger.Logger) interfaces.PersonalInfoRepository {
+	return &personalInfoRepository{db: db, logger: logger}
+}
+
+func (repo *personalInfoRepository) Get(ctx context.Context) (*entities.PersonalInfo, error) {
+	var info entities.PersonalInfo
+	var dateOfBirth time.Time
+
+	query := `SELECT personal_info_id, user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture, personal_info_created_at, personal_info_updated_at, personal_info_version FROM personal_infos WHERE personal_info_deleted_at IS NULL LIMIT 1`
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query)
+
+	err := row.Scan(
+		&info.PersonalInfoID,
+		&info.UserID,
+		&info.FirstName,
+		&info.LastName,
+		&info.ProfessionalTitle,
+		&info.Intro,
+		&info.AboutMe,
+		&info.Location,
+		&info.ResumeURL,
+		&info.WebsiteURL,
+		&info.LinkedinURL,
+		&info.GithubURL,
+		&info.XURL,
+		(*nullDate)(&dateOfBirth),
+		&info.PhoneNumber,
+		&info.Interests,
+		&info.ProfilePicture,
+		&info.CreatedAt,
+		&info.UpdatedAt,
+		&info.Version,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to get personalinfo: %v", err)
+		return nil, domain.NewDatabaseError("retrieve personal information", err)
+	}
+
+	date := utils.NewDate(dateOfBirth)
+	info.DateOfBirth = &date
+
+	return &info, nil
+}
+
+func (repo *personalInfoRepository) GetByID(ctx context.Context, personalInfoID int) (*entities.PersonalInfo, error) {
+	var personalInfo entities.PersonalInfo
+
+	var dateOfBirth time.Time
+	query := `SELECT personal_info_id, user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture, personal_info_created_at, personal_info_updated_at, personal_info_version FROM personal_infos WHERE personal_info_id = $1 AND personal_info_deleted_at IS NULL`
+
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, personalInfoID)
+	err := row.Scan(
+		&personalInfo.PersonalInfoID,
+		&personalInfo.UserID,
+		&personalInfo.FirstName,
+		&personalInfo.LastName,
+		&personalInfo.ProfessionalTitle,
+		&personalInfo.Intro,
+		&personalInfo.AboutMe,
+		&personalInfo.Location,
+		&personalInfo.ResumeURL,
+		&personalInfo.WebsiteURL,
+		&personalInfo.LinkedinURL,
+		&personalInfo.GithubURL,
+		&personalInfo.XURL,
+		(*nullDate)(&dateOfBirth),
+		&personalInfo.PhoneNumber,
+		&personalInfo.Interests,
+		&personalInfo.ProfilePicture,
+		&personalInfo.CreatedAt,
+		&personalInfo.UpdatedAt,
+		&personalInfo.Version,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to get personalinfo: %v", err)
+		return nil, domain.NewDatabaseError("retrieve personal information", err)
+	}
+
+	date := utils.NewDate(dateOfBirth)
+	personalInfo.DateOfBirth = &date
+
+	return &personalInfo, nil
+}
+
+func (repo *personalInfoRepository) Create(ctx context.Context, personalInfo *entities.PersonalInfo) (*entities.PersonalInfo, error) {
+	query := `INSERT INTO personal_infos (user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture) 
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`
+
+	var dateOfBirth *time.Time
+	if personalInfo.DateOfBirth != nil {
+		t := personalInfo.DateOfBirth.Time()
+		dateOfBirth = &t
+	}
+
+	_, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
+		personalInfo.UserID,
+		personalInfo.FirstName,
+		personalInfo.LastName,
+		personalInfo.ProfessionalTitle,
+		personalInfo.Intro,
+		personalInfo.AboutMe,
+		personalInfo.Location,
+		personalInfo.ResumeURL,
+		personalInfo.WebsiteURL,
+		personalInfo.LinkedinURL,
+		personalInfo.GithubURL,
+		personalInfo.XURL,
+		dateOfBirth,
+		personalInfo.PhoneNumber,
+		personalInfo.Interests,
+		personalInfo.ProfilePicture,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to create personalinfo: %v", err)
+		return nil, domain.NewDatabaseError("create personal information", err)
+	}
+
+	return repo.Get(ctx)
+}
+
+func (repo *personalInfoRepository) Update(ctx context.Context, personalInfoId int, personalInfo *entities.PersonalInfo) (*entities.PersonalInfo, error) {
+	query := `UPDATE personal_infos SET 
+		personal_info_first_name = $1, 
+		personal_info_last_name = $2, 
+		personal_info_professional_title = $3, 
+		personal_info_intro = $4, 
+		personal_info_about_me = $5, 
+		personal_info_location = $6, 
+		personal_info_resume_url = $7, 
+		personal_info_website_url = $8, 
+		personal_info_linkedin_url = $9, 
+		personal_info_github_url = $10, 
+		personal_info_x_url = $11, 
+		personal_info_date_of_birth = $12, 
+		personal_info_phone_number = $13, 
+		personal_info_interests = $14, 
+		personal_info_profile_picture = $15, personal_info_version = personal_info_version + 1 
+	WHERE personal_info_id = $16 AND personal_info_deleted_at IS NULL`
+
+	// Convert utils.Date to time.Time for database storage
+	var dateOfBirth *time.Time
+	if personalInfo.DateOfBirth != nil {
+		t := personalInfo.DateOfBirth.Time()
+		dateOfBirth = &t
+	}
+
+	condition := transaction.VersionCondition(ctx, "personal_info_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		personalInfo.FirstName,
+		personalInfo.LastName,
+		personalInfo.ProfessionalTitle,
+		personalInfo.Intro,
+		personalInfo.AboutMe,
+		personalInfo.Location,
+		personalInfo.ResumeURL,
+		personalInfo.WebsiteURL,
+		personalInfo.LinkedinURL,
+		personalInfo.GithubURL,
+		personalInfo.XURL,
+		dateOfBirth,
+		personalInfo.PhoneNumber,
+		personalInfo.Interests,
+		personalInfo.ProfilePicture,
+		personalInfoId,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update personalinfo: %v", err)
+		return nil, domain.NewDatabaseError("update personal information", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Personal info", personalInfoId); err != nil {
+		return nil, err
+	}
+	return repo.Get(ctx)
+}
+
+func (repo *personalInfoRepository) Patch(ctx context.Context, personalInfoId int, personalInfo *entities.PersonalInfo) (*entities.PersonalInfo, error) {
+	type field struct {
+		Name  string
+		Value interface{}
+		Set   bool
+	}
+
+	var dateOfBirth *time.Time
+	if personalInfo.DateOfBirth != nil {
+		t := personalInfo.DateOfBirth.Time()
+		dateOfBirth = &t
+	}
+
+	fields := []field{
+		{"personal_info_first_name", personalInfo.FirstName, personalInfo.FirstName != ""},
+		{"personal_info_last_name", personalInfo.LastName, personalInfo.LastName != ""},
+		{"personal_info_professional_title", personalInfo.ProfessionalTitle, personalInfo.ProfessionalTitle != ""},
+		{"personal_info_intro", personalInfo.Intro, personalInfo.Intro != ""},
+		{"personal_info_about_me", personalInfo.AboutMe, personalInfo.AboutMe != nil},
+		{"personal_info_location", personalInfo.Location, personalInfo.Location != ""},
+		{"personal_info_resume_url", personalInfo.ResumeURL, personalInfo.ResumeURL != ""},
+		{"personal_info_website_url", personalInfo.WebsiteURL, personalInfo.WebsiteURL != ""},
+		{"personal_info_linkedin_url", personalInfo.LinkedinURL, personalInfo.LinkedinURL != ""},
+		{"personal_info_github_url", personalInfo.GithubURL, personalInfo.GithubURL != ""},
+		{"personal_info_x_url", personalInfo.XURL, personalInfo.XURL != ""},
+		{"personal_info_date_of_birth", dateOfBirth, personalInfo.DateOfBirth != nil},
+		{"personal_info_phone_number", personalInfo.PhoneNumber, personalInfo.PhoneNumber != ""},
+		{"personal_info_interests", personalInfo.Interests, personalInfo.Interests != ""},
+		{"personal_info_profile_picture", personalInfo.ProfilePicture, personalInfo.ProfilePicture != ""},
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+
+	for _, f := range fields {
+		if f.Set {
+			args = append(args, f.Value)
+			setClauses = append(setClauses, fmt.Sprintf("%s = $%d", f.Name, len(args)))
+		}
+	}
+
+	if len(setClauses) == 0 {
+		return repo.Get(ctx)
+	}
+
+	args = append(args, personalInfoId)
+	query := "UPDATE personal_infos SET " + strings.Join(setClauses, ", ") + fmt.Sprintf(", personal_info_version = personal_info_version + 1 WHERE personal_info_id = $%d AND personal_info_deleted_at IS NULL", len(args))
+	condition := transaction.VersionCondition(ctx, "personal_info_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, args...)
+	if err != nil {
+		repo.logger.Error("Failed to patch personalinfo: %v", err)
+		return nil, fmt.Errorf("unable to patch personal information: %w", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Personal info", personalInfoId); err != nil {
+		return nil, err
+	}
+	return repo.Get(ctx)
+}
+
+func (repo *personalInfoRepository) Delete(ctx context.Context, personalInfoId int) error {
+	query := `UPDATE personal_infos SET personal_info_deleted_at = $1, personal_info_version = personal_info_version + 1 WHERE personal_info_id = $2 AND personal_info_deleted_at IS NULL`
+	condition := transaction.VersionCondition(ctx, "personal_info_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), personalInfoId)
+	if err != nil {
+		repo.logger.Error("Failed to delete personalinfo: %v", err)
+		return domain.NewDatabaseError("delete personal information", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Personal info", personalInfoId); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *personalInfoRepository) GetByUserID(ctx context.Context, userID int) (*entities.PersonalInfo, error) {
+	query := `SELECT personal_info_id, user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture, personal_info_created_at, personal_info_updated_at, personal_info_version FROM personal_infos WHERE user_id = $1 AND personal_info_deleted_at IS NULL`
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID)
+
+	info := &entities.PersonalInfo{}
+	var dateOfBirth time.Time
+
+	err := row.Scan(
+		&info.PersonalInfoID,
+		&info.UserID,
+		&info.FirstName,
+		&info.LastName,
+		&info.ProfessionalTitle,
+		&info.Intro,
+		&info.AboutMe,
+		&info.Location,
+		&info.ResumeURL,
+		&info.WebsiteURL,
+		&info.LinkedinURL,
+		&info.GithubURL,
+		&info.XURL,
+		(*nullDate)(&dateOfBirth),
+		&info.PhoneNumber,
+		&info.Interests,
+		&info.ProfilePicture,
+		&info.CreatedAt,
+		&info.UpdatedAt,
+		&info.Version,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to getbyuserid personalinfo: %v", err)
+		return nil, domain.NewDatabaseError("retrieve personal information by user ID", err)
+	}
+
+	date := utils.NewDate(dateOfBirth)
+	info.DateOfBirth = &date
+
+	return info, nil
+}
+
+func (repo *personalInfoRepository) ExistsByID(ctx context.Context, personalInfoId int) (bool, error) {
+	query := `SELECT COUNT(*) FROM personal_infos WHERE personal_info_id = $1 AND personal_info_deleted_at IS NULL`
+	var count int
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, personalInfoId).Scan(&count)
+	if err != nil {
+		repo.logger.Error("Failed to existsbyid personalinfo: %v", err)
+		return false, domain.NewDatabaseError("check if personal information exists by ID", err)
+	}
+	return count > 0, nil
+}
+
+func (repo *personalInfoRepository) ExistsByUserID(ctx context.Context, userID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM personal_infos WHERE user_id = $1`
+	var count int
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID).Scan(&count)
+	if err != nil {
+		repo.logger.Error("Failed to existsbyuserid personalinfo: %v", err)
+		return false, domain.NewDatabaseError("check if personal information exists by user ID", err)
+	}
+	return count > 0, nil
+}
+
+func (repo *personalInfoRepository) GetUserByID(ctx context.Context, userID int) (*entities.User, error) {
+	query := `SELECT user_id, user_username, user_email, user_role, user_is_active, user_created_at, user_updated_at FROM users WHERE user_id = $1`
+	user := &entities.User{}
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID).Scan(
+		&user.ID,
+		&user.Username,
+		&user.Email,
+		&user.Role,
+		&user.IsActive,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to getuserbyid: %v", err)
+		return nil, domain.NewDatabaseError("retrieve user by ID", err)
+	}
+	return user, nil
+}
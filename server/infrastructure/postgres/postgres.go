@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"portfolio/config"
+	"portfolio/logger"
+	migration "portfolio/migrations"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+func NewConnection(cfg *config.DatabaseConfig, logger *logger.Logger) (*sql.DB, error) {
+	if cfg.DSN == "" {
+		return nil, fmt.Errorf("database driver %s requires a dsn", cfg.Driver)
+	}
+
+	db, err := sql.Open("pgx", cfg.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	db.SetMaxOpenConns(cfg.MaxOpenConnections)
+	db.SetMaxIdleConns(cfg.MaxIdleConnections)
+	db.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime) * time.Second)
+
+	if err := db.Ping(); err != nil {
+		closeDB(db, logger)
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	if err := applyMigrations(db, logger); err != nil {
+		closeDB(db, logger)
+		return nil, fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	return db, nil
+}
+
+func DiagnoseConnection(db *sql.DB, logger *logger.Logger) (map[string]string, error) {
+	settings := []string{
+		"server_version", "server_encoding", "TimeZone", "max_connections",
+		"shared_buffers", "work_mem", "statement_timeout", "default_transaction_isolation",
+	}
+
+	values := make(map[string]string, len(settings))
+
+	logger.Println("=== PostgreSQL Configuration ===")
+	for _, setting := range settings {
+		var value string
+
+		if err := db.QueryRow("SELECT current_setting($1)", setting).Scan(&value); err != nil {
+			logger.Printf("❌ %s: ERROR - %v\n", setting, err)
+			values[setting] = "ERROR: " + err.Error()
+		} else {
+			logger.Printf("✅ %s: %s\n", setting, value)
+			values[setting] = value
+		}
+	}
+	logger.Println("================================")
+
+	return values, nil
+}
+
+func applyMigrations(db *sql.DB, logger *logger.Logger) error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		schema_migration_id SERIAL PRIMARY KEY,
+		schema_migration_filename TEXT NOT NULL UNIQUE,
+		schema_migration_applied_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		return err
+	}
+
+	rows, err := db.Query("SELECT schema_migration_filename FROM schema_migrations")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Printf("Error closing rows: %v", err)
+		}
+	}()
+
+	applied := make(map[string]bool)
+	for rows.Next() {
+		var filename string
+		if err := rows.Scan(&filename); err != nil {
+			return err
+		}
+		applied[filename] = true
+	}
+
+	migrationFiles, err := migration.GetMigrationFiles("postgres")
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrationFiles {
+		if applied[migration.Name] {
+			continue
+		}
+
+		// Each migration runs in its own transaction: Postgres supports
+		// transactional DDL, so a failing file leaves no partial schema behind.
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(string(migration.Content)); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("migration %s: %w", migration.Name, err)
+		}
+		if _, err := tx.Exec("INSERT INTO schema_migrations (schema_migration_filename) VALUES ($1)", migration.Name); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		logger.Printf("Applied Migration: %s\n", migration.Name)
+	}
+	return nil
+}
+
+func closeDB(db *sql.DB, logger *logger.Logger) {
+	if err := db.Close(); err != nil {
+		logger.Printf("db close: %v", err)
+	}
+}
+
+// nullableTime maps the zero time to NULL, which DATE columns need for open
+// ended periods where SQLite would store the zero value as text.
+func nullableTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+// nullDate scans a nullable DATE into a time.Time, leaving the zero value for
+// NULL so entities keep the same shape as with the SQLite backend.
+type nullDate time.Time
+
+func (d *nullDate) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*d = nullDate(time.Time{})
+	case time.Time:
+		*d = nullDate(v)
+	default:
+		return fmt.Errorf("cannot scan %T into date", value)
+	}
+	return nil
+}
@@ -0,0 +1,567 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strings"
+	"time"
+)
+
+type projectRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewProjectRepository(db *sql.DB, logger *logger.Logger) interfaces.ProjectRepository {
+	return &projectRepository{db: db, logger: logger}
+}
+
+var projectList = listing.Table{
+	Name:     "projects",
+	IDColumn: "project_id",
+	Columns:  "project_id, user_id, project_title, project_description, project_short_description, project_technologies, project_status, project_created_at, project_updated_at, project_version, project_position, project_slug, project_featured, project_state, project_publish_at, project_unpublish_at",
+	Scope:    "user_id = ? AND project_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":   "project_position",
+		"title":      "lower(project_title)",
+		"status":     "project_status",
+		"created_at": "project_created_at",
+		"updated_at": "project_updated_at",
+	},
+	Filters: map[string]string{
+		"state":      "project_state = ?",
+		"status":     "project_status = ?",
+		"featured":   "project_featured = (? = 'true')",
+		"technology": "project_id IN (SELECT project_technologies.project_id FROM project_technologies JOIN technologies ON technologies.technology_id = project_technologies.technology_id WHERE technologies.technology_deleted_at IS NULL AND lower(technologies.technology_name) = lower(trim(?)))",
+	},
+	Position: "project_position",
+}
+
+func (repo *projectRepository) GetAll(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Project], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.Postgres, projectList, []any{userID}, query,
+		scanProject, func(project *entities.Project) int { return project.ProjectID })
+	if err != nil {
+		repo.logger.Error("Failed to getall projects: %v", err)
+		return nil, err
+	}
+
+	if err := repo.loadRelations(ctx, page.Items...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (repo *projectRepository) GetByTechnologyID(ctx context.Context, userID, technologyID int, query *entities.ListQuery) (*entities.ListPage[*entities.Project], error) {
+	table := projectList
+	table.Scope += " AND project_id IN (SELECT project_id FROM project_technologies WHERE technology_id = ?)"
+
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.Postgres, table, []any{userID, technologyID}, query,
+		scanProject, func(project *entities.Project) int { return project.ProjectID })
+	if err != nil {
+		repo.logger.Error("Failed to get projects of technology %d: %v", technologyID, err)
+		return nil, err
+	}
+
+	if err := repo.loadRelations(ctx, page.Items...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func scanProject(rows *sql.Rows) (*entities.Project, error) {
+	project := &entities.Project{}
+	err := rows.Scan(
+		&project.ProjectID,
+		&project.UserID,
+		&project.Title,
+		&project.Description,
+		&project.ShortDescription,
+		&project.Technologies,
+		&project.Status,
+		&project.CreatedAt,
+		&project.UpdatedAt,
+		&project.Version,
+		&project.Position,
+		&project.Slug,
+		&project.Featured,
+		&project.State,
+		&project.PublishAt,
+		&project.UnpublishAt,
+	)
+	return project, err
+}
+
+func (repo *projectRepository) GetByID(ctx context.Context, projectID int) (*entities.Project, error) {
+	query := `SELECT project_id, user_id, project_title, project_description, project_short_description, 
+	          project_technologies, project_status, 
+	          project_created_at, project_updated_at, project_version, project_position, project_slug, project_featured, project_state, project_publish_at, project_unpublish_at 
+	          FROM projects WHERE project_id = $1 AND project_deleted_at IS NULL`
+
+	project := &entities.Project{}
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, projectID)
+
+	err := row.Scan(
+		&project.ProjectID,
+		&project.UserID,
+		&project.Title,
+		&project.Description,
+		&project.ShortDescription,
+		&project.Technologies,
+		&project.Status,
+		&project.CreatedAt,
+		&project.UpdatedAt,
+		&project.Version,
+		&project.Position,
+		&project.Slug,
+		&project.Featured,
+		&project.State,
+		&project.PublishAt,
+		&project.UnpublishAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.NewNotFoundError("Project", fmt.Sprint(projectID))
+		}
+		repo.logger.Error("Failed to getbyid project: %v", err)
+		return nil, domain.NewDatabaseError("project retrieval by ID", err)
+	}
+
+	if err := repo.loadRelations(ctx, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+func (repo *projectRepository) Create(ctx context.Context, project *entities.Project) (*entities.Project, error) {
+	position, err := listing.NextPosition(ctx, transaction.From(ctx, repo.db), listing.Postgres, projectList, []any{project.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create project: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO projects (user_id, project_title, project_description, project_short_description, 
+	          project_technologies, project_status, 
+	          project_created_at, project_updated_at, project_position, project_slug, project_featured, 
+	          project_state, project_publish_at, project_unpublish_at) 
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	          RETURNING project_id`
+
+	now := time.Now()
+	var id int
+	err = transaction.From(ctx, repo.db).QueryRowContext(ctx, query,
+		project.UserID,
+		project.Title,
+		project.Description,
+		project.ShortDescription,
+		project.Technologies,
+		project.Status,
+		now,
+		now,
+		position,
+		project.Slug,
+		project.Featured,
+		project.State,
+		project.PublishAt,
+		project.UnpublishAt,
+	).Scan(&id)
+
+	if err != nil {
+		repo.logger.Error("Failed to create project: %v", err)
+		return nil, domain.NewDatabaseError("project creation", err)
+	}
+
+	project.ProjectID = id
+	project.Position = position
+	project.CreatedAt = now
+	project.UpdatedAt = now
+
+	return project, nil
+}
+
+func (repo *projectRepository) Update(ctx context.Context, projectID int, project *entities.Project) (*entities.Project, error) {
+	query := `UPDATE projects SET project_title = $1, project_description = $2, project_short_description = $3, 
+	          project_technologies = $4, project_status = $5, project_slug = $6, project_featured = $7, 
+	          project_updated_at = $8, project_version = project_version + 1 WHERE project_id = $9 AND project_deleted_at IS NULL`
+
+	now := time.Now()
+	condition := transaction.VersionCondition(ctx, "project_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		project.Title,
+		project.Description,
+		project.ShortDescription,
+		project.Technologies,
+		project.Status,
+		project.Slug,
+		project.Featured,
+		now,
+		projectID,
+	)
+
+	if err != nil {
+		repo.logger.Error("Failed to update project: %v", err)
+		return nil, domain.NewDatabaseError("project update", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Project", projectID); err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.Error("Failed to update project rowsaffected: %v", err)
+		return nil, domain.NewDatabaseError("project update verification", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, domain.NewNotFoundError("Project", fmt.Sprint(project.ProjectID))
+	}
+
+	project.UpdatedAt = now
+	return repo.GetByID(ctx, projectID)
+}
+
+func (repo *projectRepository) Patch(ctx context.Context, projectID int, project *entities.Project) (*entities.Project, error) {
+	type field struct {
+		Name  string
+		Value interface{}
+		Set   bool
+	}
+
+	var fields []field
+	if project.Title != "" {
+		fields = append(fields, field{"project_title", project.Title, true})
+	}
+	if project.Description != "" {
+		fields = append(fields, field{"project_description", project.Description, true})
+	}
+	if project.ShortDescription != "" {
+		fields = append(fields, field{"project_short_description", project.ShortDescription, true})
+	}
+	if project.Technologies != "" {
+		fields = append(fields, field{"project_technologies", project.Technologies, true})
+	}
+	if project.Status != "" {
+		fields = append(fields, field{"project_status", project.Status, true})
+	}
+	if project.Slug != "" {
+		fields = append(fields, field{"project_slug", project.Slug, true})
+	}
+
+	if len(fields) == 0 {
+		return project, nil
+	}
+	// Featured has no unset value, so it is always written; callers patch
+	// with the whole project.
+	fields = append(fields, field{"project_featured", project.Featured, true})
+
+	query := "UPDATE projects SET "
+	var args []interface{}
+	for i, f := range fields {
+		args = append(args, f.Value)
+		query += fmt.Sprintf("%s = $%d", f.Name, len(args))
+		if i < len(fields)-1 {
+			query += ", "
+		}
+	}
+	args = append(args, projectID)
+	query += fmt.Sprintf(", project_version = project_version + 1 WHERE project_id = $%d AND project_deleted_at IS NULL", len(args))
+
+	condition := transaction.VersionCondition(ctx, "project_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, args...)
+	if err != nil {
+		repo.logger.Error("Failed to patch project: %v", err)
+		return nil, fmt.Errorf("unable to patch project: %w", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Project", projectID); err != nil {
+		return nil, err
+	}
+	return repo.GetByID(ctx, projectID)
+}
+
+func (repo *projectRepository) Delete(ctx context.Context, projectID int) error {
+	query := `UPDATE projects SET project_deleted_at = $1, project_version = project_version + 1 WHERE project_id = $2 AND project_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "project_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), projectID)
+	if err != nil {
+		repo.logger.Error("Failed to delete project: %v", err)
+		return domain.NewDatabaseError("project deletion", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Project", projectID); err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.Error("Failed to delete project rowsaffected: %v", err)
+		return domain.NewDatabaseError("project deletion verification", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewNotFoundError("Project", fmt.Sprint(projectID))
+	}
+
+	return nil
+}
+
+func (repo *projectRepository) SetTechnologies(ctx context.Context, projectID int, technologies []*entities.Technology) error {
+	executor := transaction.From(ctx, repo.db)
+
+	if _, err := executor.ExecContext(ctx, `DELETE FROM project_technologies WHERE project_id = $1`, projectID); err != nil {
+		repo.logger.Error("Failed to unlink technologies of project %d: %v", projectID, err)
+		return domain.NewDatabaseError("project technologies update", err)
+	}
+
+	for position, technology := range technologies {
+		query := `INSERT INTO project_technologies (project_id, technology_id, project_technology_position) VALUES ($1, $2, $3)`
+		if _, err := executor.ExecContext(ctx, query, projectID, technology.TechnologyID, position); err != nil {
+			repo.logger.Error("Failed to link technology %d to project %d: %v", technology.TechnologyID, projectID, err)
+			return domain.NewDatabaseError("project technologies update", err)
+		}
+	}
+
+	query := `UPDATE projects SET project_technologies = $1 WHERE project_id = $2`
+	if _, err := executor.ExecContext(ctx, query, entities.JoinTechnologyNames(technologies), projectID); err != nil {
+		repo.logger.Error("Failed to update technologies text of project %d: %v", projectID, err)
+		return domain.NewDatabaseError("project technologies update", err)
+	}
+
+	return nil
+}
+
+// loadTechnologies fills in the live technologies linked to projects.
+func (repo *projectRepository) loadTechnologies(ctx context.Context, projects ...*entities.Project) error {
+	if len(projects) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*entities.Project, len(projects))
+	placeholders := make([]string, 0, len(projects))
+	args := make([]any, 0, len(projects))
+	for _, project := range projects {
+		project.LinkedTechnologies = []*entities.Technology{}
+		byID[project.ProjectID] = project
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)+1))
+		args = append(args, project.ProjectID)
+	}
+
+	query := `SELECT project_technologies.project_id, technologies.technology_id, technologies.user_id,
+	          technologies.technology_name, technologies.technology_icon_url, technologies.technology_created_at,
+	          technologies.technology_updated_at, technologies.technology_version, technologies.technology_position,
+	          technologies.technology_state, technologies.technology_publish_at, technologies.technology_unpublish_at
+	          FROM project_technologies JOIN technologies ON technologies.technology_id = project_technologies.technology_id
+	          WHERE technologies.technology_deleted_at IS NULL AND project_technologies.project_id IN (` + strings.Join(placeholders, ", ") + `)
+	          ORDER BY project_technologies.project_technology_position, technologies.technology_id`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		repo.logger.Error("Failed to load project technologies: %v", err)
+		return domain.NewDatabaseError("project technologies retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var projectID int
+		technology := &entities.Technology{}
+		err := rows.Scan(
+			&projectID,
+			&technology.TechnologyID,
+			&technology.UserID,
+			&technology.Name,
+			&technology.IconURL,
+			&technology.CreatedAt,
+			&technology.UpdatedAt,
+			&technology.Version,
+			&technology.Position,
+			&technology.State,
+			&technology.PublishAt,
+			&technology.UnpublishAt,
+		)
+		if err != nil {
+			repo.logger.Error("Failed to scan project technology: %v", err)
+			return domain.NewDatabaseError("project technologies scanning", err)
+		}
+		byID[projectID].LinkedTechnologies = append(byID[projectID].LinkedTechnologies, technology)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.NewDatabaseError("project technologies iteration", err)
+	}
+
+	return nil
+}
+
+// loadRelations fills in the technologies, media and links of projects.
+func (repo *projectRepository) loadRelations(ctx context.Context, projects ...*entities.Project) error {
+	if len(projects) == 0 {
+		return nil
+	}
+	if err := repo.loadTechnologies(ctx, projects...); err != nil {
+		return err
+	}
+	if err := repo.loadMedia(ctx, projects...); err != nil {
+		return err
+	}
+	return repo.loadLinks(ctx, projects...)
+}
+
+// loadMedia fills in the galleries of projects, by position.
+func (repo *projectRepository) loadMedia(ctx context.Context, projects ...*entities.Project) error {
+	in, args := projectIDsIn(projects)
+	query := `SELECT ` + projectMediaColumns + ` FROM project_media
+	          WHERE project_id IN (` + in + `) ORDER BY project_media_position, project_media_id`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		repo.logger.Error("Failed to load project media: %v", err)
+		return domain.NewDatabaseError("project media retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	media := make(map[int][]*entities.ProjectMedia, len(projects))
+	for rows.Next() {
+		item, err := scanProjectMedia(rows)
+		if err != nil {
+			repo.logger.Error("Failed to scan project media: %v", err)
+			return domain.NewDatabaseError("project media scanning", err)
+		}
+		media[item.ProjectID] = append(media[item.ProjectID], item)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.NewDatabaseError("project media iteration", err)
+	}
+
+	for _, project := range projects {
+		project.SetMedia(append([]*entities.ProjectMedia{}, media[project.ProjectID]...))
+	}
+	return nil
+}
+
+// loadLinks fills in the links of projects, by position.
+func (repo *projectRepository) loadLinks(ctx context.Context, projects ...*entities.Project) error {
+	in, args := projectIDsIn(projects)
+	query := `SELECT ` + projectLinkColumns + ` FROM project_links
+	          WHERE project_id IN (` + in + `) ORDER BY project_link_position, project_link_id`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		repo.logger.Error("Failed to load project links: %v", err)
+		return domain.NewDatabaseError("project link retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	links := make(map[int][]*entities.ProjectLink, len(projects))
+	for rows.Next() {
+		link, err := scanProjectLink(rows)
+		if err != nil {
+			repo.logger.Error("Failed to scan project link: %v", err)
+			return domain.NewDatabaseError("project link scanning", err)
+		}
+		links[link.ProjectID] = append(links[link.ProjectID], link)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.NewDatabaseError("project link iteration", err)
+	}
+
+	for _, project := range projects {
+		project.SetLinks(append([]*entities.ProjectLink{}, links[project.ProjectID]...))
+	}
+	return nil
+}
+
+// projectIDsIn returns the placeholders and arguments of an IN list of the
+// projects' IDs.
+func projectIDsIn(projects []*entities.Project) (string, []any) {
+	placeholders := make([]string, 0, len(projects))
+	args := make([]any, 0, len(projects))
+	for _, project := range projects {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)+1))
+		args = append(args, project.ProjectID)
+	}
+	return strings.Join(placeholders, ", "), args
+}
+
+func (repo *projectRepository) Reorder(ctx context.Context, userID int, projectIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.Postgres, projectList, []any{userID}, projectIDs); err != nil {
+		repo.logger.Error("Failed to reorder projects: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (repo *projectRepository) GetBySlug(ctx context.Context, userID int, slug string) (*entities.Project, error) {
+	query := `SELECT ` + projectList.Columns + ` FROM projects
+	          WHERE user_id = $1 AND project_deleted_at IS NULL
+	          AND (project_slug = $2 OR project_id IN (SELECT project_id FROM project_slugs WHERE user_id = $1 AND project_slug_value = $2))
+	          ORDER BY project_slug = $2 DESC LIMIT 1`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, userID, slug)
+	if err != nil {
+		repo.logger.Error("Failed to get project by slug: %v", err)
+		return nil, domain.NewDatabaseError("project retrieval by slug", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, domain.NewDatabaseError("project retrieval by slug", err)
+		}
+		return nil, domain.NewNotFoundError("Project", slug)
+	}
+	project, err := scanProject(rows)
+	if err != nil {
+		repo.logger.Error("Failed to scan project: %v", err)
+		return nil, domain.NewDatabaseError("project retrieval by slug", err)
+	}
+	rows.Close()
+
+	if err := repo.loadRelations(ctx, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+func (repo *projectRepository) SlugExists(ctx context.Context, userID int, slug string, exceptProjectID int) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM projects WHERE user_id = $1 AND project_slug = $2 AND project_id <> $3)
+	          OR EXISTS (SELECT 1 FROM project_slugs WHERE user_id = $1 AND project_slug_value = $2 AND project_id <> $3)`
+
+	var exists bool
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID, slug, exceptProjectID).Scan(&exists)
+	if err != nil {
+		repo.logger.Error("Failed to check project slug: %v", err)
+		return false, domain.NewDatabaseError("project slug check", err)
+	}
+	return exists, nil
+}
+
+func (repo *projectRepository) RetireSlug(ctx context.Context, project *entities.Project, oldSlug string) error {
+	executor := transaction.From(ctx, repo.db)
+
+	if _, err := executor.ExecContext(ctx, `DELETE FROM project_slugs WHERE user_id = $1 AND project_slug_value IN ($2, $3)`,
+		project.UserID, project.Slug, oldSlug); err != nil {
+		repo.logger.Error("Failed to clear project slug history: %v", err)
+		return domain.NewDatabaseError("project slug history update", err)
+	}
+
+	query := `INSERT INTO project_slugs (project_id, user_id, project_slug_value, project_slug_created_at) VALUES ($1, $2, $3, $4)`
+	if _, err := executor.ExecContext(ctx, query, project.ProjectID, project.UserID, oldSlug, time.Now()); err != nil {
+		repo.logger.Error("Failed to retire project slug: %v", err)
+		return domain.NewDatabaseError("project slug history update", err)
+	}
+	return nil
+}
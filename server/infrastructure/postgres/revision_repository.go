@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"time"
+)
+
+type revisionRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewRevisionRepository(db *sql.DB, logger *logger.Logger) interfaces.RevisionRepository {
+	return &revisionRepository{db: db, logger: logger}
+}
+
+func (repo *revisionRepository) Create(ctx context.Context, revision *entities.Revision) (*entities.Revision, error) {
+	query := `INSERT INTO revisions (revision_entity_type, revision_entity_id, revision_action, revision_snapshot, user_id, revision_request_id, revision_created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7)
+			  RETURNING revision_id`
+
+	revision.CreatedAt = time.Now()
+	var id int
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query,
+		revision.EntityType,
+		revision.EntityID,
+		revision.Action,
+		string(revision.Snapshot),
+		revision.UserID,
+		revision.RequestID,
+		revision.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		repo.logger.Error("Failed to create revision: %v", err)
+		return nil, domain.NewDatabaseError("create revision", err)
+	}
+
+	revision.RevisionID = id
+	return revision, nil
+}
+
+func (repo *revisionRepository) GetByID(ctx context.Context, revisionID int) (*entities.Revision, error) {
+	query := `SELECT revision_id, revision_entity_type, revision_entity_id, revision_action, revision_snapshot, user_id, revision_request_id, revision_created_at
+			  FROM revisions WHERE revision_id = $1`
+
+	revision, err := scanRevision(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, revisionID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to get revision: %v", err)
+		return nil, domain.NewDatabaseError("retrieve revision", err)
+	}
+
+	return revision, nil
+}
+
+func (repo *revisionRepository) GetByEntity(ctx context.Context, entityType string, entityID int) ([]*entities.Revision, error) {
+	query := `SELECT revision_id, revision_entity_type, revision_entity_id, revision_action, revision_snapshot, user_id, revision_request_id, revision_created_at
+			  FROM revisions WHERE revision_entity_type = $1 AND revision_entity_id = $2 ORDER BY revision_id DESC`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, entityType, entityID)
+	if err != nil {
+		repo.logger.Error("Failed to get revisions: %v", err)
+		return nil, domain.NewDatabaseError("retrieve revisions", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			repo.logger.Error("Failed to closing rows: %v", err)
+		}
+	}()
+
+	var revisions []*entities.Revision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			repo.logger.Error("Failed to scan revision: %v", err)
+			return nil, domain.NewDatabaseError("scan revision", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		repo.logger.Error("Failed to iterate revisions: %v", err)
+		return nil, domain.NewDatabaseError("iterate revisions", err)
+	}
+
+	return revisions, nil
+}
+
+func scanRevision(row interface{ Scan(dest ...any) error }) (*entities.Revision, error) {
+	var (
+		revision  entities.Revision
+		snapshot  string
+		userID    sql.NullInt64
+		requestID sql.NullString
+	)
+	err := row.Scan(
+		&revision.RevisionID,
+		&revision.EntityType,
+		&revision.EntityID,
+		&revision.Action,
+		&snapshot,
+		&userID,
+		&requestID,
+		&revision.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revision.Snapshot = []byte(snapshot)
+	if userID.Valid {
+		id := int(userID.Int64)
+		revision.UserID = &id
+	}
+	revision.RequestID = requestID.String
+	return &revision, nil
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strings"
+)
+
+// searchTable names the generated tsvector column of a table and the text
+// that snippets are cut from. The table itself is read through trashTables.
+type searchTable struct {
+	vectorColumn string
+	textExpr     string
+}
+
+var searchTables = map[string]searchTable{
+	entities.SearchTypeProject: {"project_search",
+		"concat_ws(' … ', project_title, project_short_description, project_description, project_technologies)"},
+	entities.SearchTypeSkill: {"skill_search", "skill_name"},
+	entities.SearchTypeExperience: {"experience_search",
+		"concat_ws(' … ', experience_job_title, experience_company_name, experience_description)"},
+	entities.SearchTypeEducation: {"education_search",
+		"concat_ws(' … ', education_degree, education_institution, education_description)"},
+	entities.SearchTypePersonalInfo: {"personal_info_search",
+		"concat_ws(' … ', personal_info_first_name || ' ' || personal_info_last_name, personal_info_professional_title, " +
+			"personal_info_intro, personal_info_about_me, personal_info_location, personal_info_interests)"},
+}
+
+type searchRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewSearchRepository(db *sql.DB, logger *logger.Logger) interfaces.SearchRepository {
+	return &searchRepository{db: db, logger: logger}
+}
+
+func (repo *searchRepository) Search(ctx context.Context, query entities.SearchQuery) ([]*entities.SearchHit, error) {
+	tsQuery := tsQueryExpression(query.Terms)
+	headlineOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=1, MaxWords=24, MinWords=8, FragmentDelimiter=\" … \"",
+		entities.SearchHighlightStart, entities.SearchHighlightEnd)
+
+	var hits []*entities.SearchHit
+	for _, itemType := range entities.SearchTypes {
+		table, index := trashTables[itemType], searchTables[itemType]
+
+		filter := ""
+		if prefix, ok := publishingPrefixes[itemType]; ok && !query.IncludeHidden {
+			filter = " AND " + prefix + "_state = 'published'"
+		}
+		if itemType == entities.SearchTypeProject && !query.IncludeHidden {
+			// Public project pages only show active projects.
+			filter += " AND project_status = 'active'"
+		}
+
+		statement := fmt.Sprintf(`SELECT %s, %s, ts_headline('simple', %s, search_query, $2), ts_rank(%s, search_query) AS score
+			FROM %s, to_tsquery('simple', $1) AS search_query
+			WHERE %s @@ search_query AND user_id = $3 AND %s IS NULL%s
+			ORDER BY score DESC
+			LIMIT $4`,
+			table.idColumn, table.labelExpr, index.textExpr, index.vectorColumn,
+			table.name,
+			index.vectorColumn, table.deletedColumn, filter)
+
+		rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, statement, tsQuery, headlineOptions, query.UserID, query.Limit)
+		if err != nil {
+			repo.logger.Error("Failed to search %s: %v", itemType, err)
+			return nil, domain.NewDatabaseError("search", err)
+		}
+
+		for rows.Next() {
+			hit := &entities.SearchHit{Type: itemType}
+			if err := rows.Scan(&hit.ID, &hit.Title, &hit.Snippet, &hit.Score); err != nil {
+				_ = rows.Close()
+				repo.logger.Error("Failed to scan %s search hit: %v", itemType, err)
+				return nil, domain.NewDatabaseError("search scanning", err)
+			}
+			hits = append(hits, hit)
+		}
+		err = rows.Err()
+		if closeErr := rows.Close(); closeErr != nil {
+			repo.logger.Error("Failed to closing rows: %v", closeErr)
+		}
+		if err != nil {
+			repo.logger.Error("Failed to iterate %s search hits: %v", itemType, err)
+			return nil, domain.NewDatabaseError("search iteration", err)
+		}
+	}
+
+	return hits, nil
+}
+
+// tsQueryExpression requires every term, each as a prefix. Terms are made of
+// letters and digits only, so they cannot carry tsquery operators.
+func tsQueryExpression(terms []string) string {
+	prefixes := make([]string, 0, len(terms))
+	for _, term := range terms {
+		prefixes = append(prefixes, term+":*")
+	}
+	return strings.Join(prefixes, " & ")
+}
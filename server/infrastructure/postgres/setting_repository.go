@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strconv"
+	"strings"
+)
+
+type settingRepository struct {
+	settingKey string
+	db         *sql.DB
+	logger     *logger.Logger
+}
+
+func NewSettingRepository(db *sql.DB, logger *logger.Logger, settingKey string) interfaces.SettingRepository {
+	return &settingRepository{
+		db:         db,
+		logger:     logger,
+		settingKey: settingKey,
+	}
+}
+
+func (repo *settingRepository) namespaceKey(namespace entities.SettingNamespace) string {
+	return repo.settingKey + "." + string(namespace)
+}
+
+func (repo *settingRepository) UpsertNamespace(ctx context.Context, namespace entities.SettingNamespace, document []byte) error {
+	query := `
+	INSERT INTO settings (setting_key, setting_json, setting_created_at, setting_updated_at)
+	VALUES ($1, $2::jsonb, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	ON CONFLICT(setting_key) DO UPDATE SET
+		setting_json = excluded.setting_json,
+		setting_updated_at = CURRENT_TIMESTAMP
+	`
+	_, err := transaction.From(ctx, repo.db).ExecContext(
+		ctx, query,
+		repo.namespaceKey(namespace),
+		string(document),
+	)
+	return err
+}
+
+func (repo *settingRepository) GetNamespace(ctx context.Context, namespace entities.SettingNamespace) ([]byte, error) {
+	return repo.getDocument(ctx, repo.namespaceKey(namespace))
+}
+
+func (repo *settingRepository) GetLegacy(ctx context.Context) ([]byte, error) {
+	return repo.getDocument(ctx, repo.settingKey)
+}
+
+func (sr *settingRepository) getDocument(ctx context.Context, key string) ([]byte, error) {
+	query := `SELECT setting_key, setting_json::text, setting_created_at, setting_updated_at FROM settings WHERE setting_key = $1`
+	var setting entities.Setting
+	err := transaction.From(ctx, sr.db).QueryRowContext(ctx, query, key).Scan(
+		&setting.SettingKey,
+		&setting.SettingJson,
+		&setting.SettingCreatedAt,
+		&setting.SettingUpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return setting.SettingJson, nil
+}
+
+func (sr *settingRepository) Delete(ctx context.Context) error {
+	keys := []string{sr.settingKey}
+	for _, namespace := range entities.SettingNamespaces {
+		keys = append(keys, sr.namespaceKey(namespace))
+	}
+
+	placeholders := make([]string, 0, len(keys))
+	args := make([]any, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, key)
+		placeholders = append(placeholders, "$"+strconv.Itoa(len(args)))
+	}
+
+	query := `DELETE FROM settings WHERE setting_key IN (` + strings.Join(placeholders, ", ") + `)`
+	_, err := transaction.From(ctx, sr.db).ExecContext(ctx, query, args...)
+	return err
+}
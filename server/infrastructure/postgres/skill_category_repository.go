@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"time"
+)
+
+type skillCategoryRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewSkillCategoryRepository(db *sql.DB, logger *logger.Logger) interfaces.SkillCategoryRepository {
+	return &skillCategoryRepository{db: db, logger: logger}
+}
+
+const skillCategoryColumns = `skill_category_id, user_id, skill_category_name, skill_category_position,
+	skill_category_created_at, skill_category_updated_at`
+
+// skillCategoryList places categories for NextPosition and Reorder.
+var skillCategoryList = listing.Table{
+	Name:     "skill_categories",
+	IDColumn: "skill_category_id",
+	Scope:    "user_id = ?",
+	Position: "skill_category_position",
+}
+
+func scanSkillCategory(row rowScanner) (*entities.SkillCategory, error) {
+	category := &entities.SkillCategory{}
+	err := row.Scan(
+		&category.SkillCategoryID,
+		&category.UserID,
+		&category.Name,
+		&category.Position,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+	)
+	return category, err
+}
+
+func (repo *skillCategoryRepository) GetByID(ctx context.Context, categoryID int) (*entities.SkillCategory, error) {
+	query := `SELECT ` + skillCategoryColumns + ` FROM skill_categories WHERE skill_category_id = $1`
+
+	category, err := scanSkillCategory(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, categoryID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.NewNotFoundError("Skill category", fmt.Sprint(categoryID))
+		}
+		repo.logger.Error("Failed to get skill category %d: %v", categoryID, err)
+		return nil, domain.NewDatabaseError("skill category retrieval by ID", err)
+	}
+	return category, nil
+}
+
+func (repo *skillCategoryRepository) GetByUserID(ctx context.Context, userID int) ([]*entities.SkillCategory, error) {
+	query := `SELECT ` + skillCategoryColumns + ` FROM skill_categories WHERE user_id = $1
+	          ORDER BY skill_category_position, skill_category_id`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		repo.logger.Error("Failed to get skill categories of user %d: %v", userID, err)
+		return nil, domain.NewDatabaseError("skill category retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	categories := []*entities.SkillCategory{}
+	for rows.Next() {
+		category, err := scanSkillCategory(rows)
+		if err != nil {
+			repo.logger.Error("Failed to scan skill category: %v", err)
+			return nil, domain.NewDatabaseError("skill category scanning", err)
+		}
+		categories = append(categories, category)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("skill category iteration", err)
+	}
+	return categories, nil
+}
+
+func (repo *skillCategoryRepository) Create(ctx context.Context, category *entities.SkillCategory) (*entities.SkillCategory, error) {
+	executor := transaction.From(ctx, repo.db)
+	position, err := listing.NextPosition(ctx, executor, listing.Postgres, skillCategoryList, []any{category.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create skill category: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO skill_categories (user_id, skill_category_name, skill_category_position,
+	          skill_category_created_at, skill_category_updated_at) VALUES ($1, $2, $3, $4, $5)
+	          RETURNING skill_category_id`
+
+	now := time.Now()
+	var id int
+	err = executor.QueryRowContext(ctx, query, category.UserID, category.Name, position, now, now).Scan(&id)
+	if err != nil {
+		repo.logger.Error("Failed to create skill category: %v", err)
+		return nil, domain.NewDatabaseError("skill category creation", err)
+	}
+	return repo.GetByID(ctx, id)
+}
+
+func (repo *skillCategoryRepository) Update(ctx context.Context, categoryID int, category *entities.SkillCategory) (*entities.SkillCategory, error) {
+	query := `UPDATE skill_categories SET skill_category_name = $1, skill_category_updated_at = $2 WHERE skill_category_id = $3`
+
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query, category.Name, time.Now(), categoryID)
+	if err != nil {
+		repo.logger.Error("Failed to update skill category %d: %v", categoryID, err)
+		return nil, domain.NewDatabaseError("skill category update", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, domain.NewDatabaseError("skill category update verification", err)
+	}
+	if rowsAffected == 0 {
+		return nil, domain.NewNotFoundError("Skill category", fmt.Sprint(categoryID))
+	}
+	return repo.GetByID(ctx, categoryID)
+}
+
+func (repo *skillCategoryRepository) Delete(ctx context.Context, categoryID int) error {
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, `DELETE FROM skill_categories WHERE skill_category_id = $1`, categoryID)
+	if err != nil {
+		repo.logger.Error("Failed to delete skill category %d: %v", categoryID, err)
+		return domain.NewDatabaseError("skill category deletion", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domain.NewDatabaseError("skill category deletion verification", err)
+	}
+	if rowsAffected == 0 {
+		return domain.NewNotFoundError("Skill category", fmt.Sprint(categoryID))
+	}
+	return nil
+}
+
+func (repo *skillCategoryRepository) ExistsByNameAndUserID(ctx context.Context, name string, userID int, exceptCategoryID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM skill_categories WHERE user_id = $1 AND skill_category_name = $2 AND skill_category_id <> $3`
+
+	var count int
+	if err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID, name, exceptCategoryID).Scan(&count); err != nil {
+		repo.logger.Error("Failed to check skill category name: %v", err)
+		return false, domain.NewDatabaseError("skill category name lookup", err)
+	}
+	return count > 0, nil
+}
+
+func (repo *skillCategoryRepository) Reorder(ctx context.Context, userID int, categoryIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.Postgres, skillCategoryList, []any{userID}, categoryIDs); err != nil {
+		repo.logger.Error("Failed to reorder skill categories: %v", err)
+		return err
+	}
+	return nil
+}
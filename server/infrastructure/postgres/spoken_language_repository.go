@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type spokenLanguageRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewSpokenLanguageRepository(db *sql.DB, logger *logger.Logger) interfaces.SpokenLanguageRepository {
+	return &spokenLanguageRepository{db: db, logger: logger}
+}
+
+const spokenLanguageColumns = "spoken_language_id, user_id, spoken_language_name, spoken_language_level, spoken_language_description, spoken_language_created_at, spoken_language_updated_at, spoken_language_version, spoken_language_position, spoken_language_state, spoken_language_publish_at, spoken_language_unpublish_at"
+
+// spokenLanguageLevelRank computes entities.SpokenLanguage.LevelRank.
+var spokenLanguageLevelRank = func() string {
+	var rank strings.Builder
+	rank.WriteString("CASE spoken_language_level")
+	for i, level := range entities.SpokenLanguageLevels {
+		rank.WriteString(" WHEN '" + level + "' THEN " + strconv.Itoa(i))
+	}
+	rank.WriteString(" ELSE -1 END")
+	return rank.String()
+}()
+
+var spokenLanguageList = listing.Table{
+	Name:     "spoken_languages",
+	IDColumn: "spoken_language_id",
+	Columns:  spokenLanguageColumns,
+	Scope:    "user_id = ? AND spoken_language_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":   "spoken_language_position",
+		"name":       "lower(spoken_language_name)",
+		"level":      spokenLanguageLevelRank,
+		"created_at": "spoken_language_created_at",
+	},
+	Filters: map[string]string{
+		"state": "spoken_language_state = ?",
+		"level": "spoken_language_level = ?",
+	},
+	Position: "spoken_language_position",
+}
+
+func scanSpokenLanguage(row rowScanner) (*entities.SpokenLanguage, error) {
+	spokenLanguage := &entities.SpokenLanguage{}
+	err := row.Scan(
+		&spokenLanguage.SpokenLanguageID,
+		&spokenLanguage.UserID,
+		&spokenLanguage.Name,
+		&spokenLanguage.Level,
+		&spokenLanguage.Description,
+		&spokenLanguage.CreatedAt,
+		&spokenLanguage.UpdatedAt,
+		&spokenLanguage.Version,
+		&spokenLanguage.Position,
+		&spokenLanguage.State,
+		&spokenLanguage.PublishAt,
+		&spokenLanguage.UnpublishAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return spokenLanguage, nil
+}
+
+func (repo *spokenLanguageRepository) Create(ctx context.Context, spokenLanguage *entities.SpokenLanguage) (*entities.SpokenLanguage, error) {
+	executor := transaction.From(ctx, repo.db)
+	position, err := listing.NextPosition(ctx, executor, listing.Postgres, spokenLanguageList, []any{spokenLanguage.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create spoken language: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO spoken_languages (user_id, spoken_language_name, spoken_language_level, spoken_language_description,
+			  spoken_language_position, spoken_language_state, spoken_language_publish_at, spoken_language_unpublish_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			  RETURNING spoken_language_id`
+
+	var id int
+	err = executor.QueryRowContext(ctx, query,
+		spokenLanguage.UserID,
+		spokenLanguage.Name,
+		spokenLanguage.Level,
+		spokenLanguage.Description,
+		position,
+		spokenLanguage.State,
+		spokenLanguage.PublishAt,
+		spokenLanguage.UnpublishAt,
+	).Scan(&id)
+	if err != nil {
+		repo.logger.Error("Failed to create spoken language: %v", err)
+		return nil, domain.NewDatabaseError("create spoken language", err)
+	}
+
+	return repo.GetByID(ctx, id)
+}
+
+func (repo *spokenLanguageRepository) GetByID(ctx context.Context, spokenLanguageID int) (*entities.SpokenLanguage, error) {
+	query := `SELECT ` + spokenLanguageColumns + ` FROM spoken_languages WHERE spoken_language_id = $1 AND spoken_language_deleted_at IS NULL`
+
+	spokenLanguage, err := scanSpokenLanguage(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, spokenLanguageID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to retrieve spoken language by ID: %v", err)
+		return nil, domain.NewDatabaseError("retrieve spoken language", err)
+	}
+	return spokenLanguage, nil
+}
+
+func (repo *spokenLanguageRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.SpokenLanguage], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.Postgres, spokenLanguageList, []any{userID}, query,
+		func(rows *sql.Rows) (*entities.SpokenLanguage, error) { return scanSpokenLanguage(rows) },
+		func(spokenLanguage *entities.SpokenLanguage) int { return spokenLanguage.SpokenLanguageID })
+	if err != nil {
+		repo.logger.Error("Failed to retrieve spoken languages by user ID: %v", err)
+		return nil, err
+	}
+	return page, nil
+}
+
+func (repo *spokenLanguageRepository) Update(ctx context.Context, spokenLanguageID int, spokenLanguage *entities.SpokenLanguage) (*entities.SpokenLanguage, error) {
+	query := `UPDATE spoken_languages SET spoken_language_name = $1, spoken_language_level = $2, spoken_language_description = $3,
+			  spoken_language_updated_at = $4, spoken_language_version = spoken_language_version + 1
+			  WHERE spoken_language_id = $5 AND spoken_language_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "spoken_language_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		spokenLanguage.Name,
+		spokenLanguage.Level,
+		spokenLanguage.Description,
+		time.Now(),
+		spokenLanguageID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update spoken language: %v", err)
+		return nil, domain.NewDatabaseError("update spoken language", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Spoken language", spokenLanguageID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, spokenLanguageID)
+}
+
+func (repo *spokenLanguageRepository) Delete(ctx context.Context, spokenLanguageID int) error {
+	query := `UPDATE spoken_languages SET spoken_language_deleted_at = $1, spoken_language_version = spoken_language_version + 1
+			  WHERE spoken_language_id = $2 AND spoken_language_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "spoken_language_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), spokenLanguageID)
+	if err != nil {
+		repo.logger.Error("Failed to delete spoken language: %v", err)
+		return domain.NewDatabaseError("delete spoken language", err)
+	}
+
+	return transaction.CheckVersion(result, condition, "Spoken language", spokenLanguageID)
+}
+
+func (repo *spokenLanguageRepository) ExistsByName(ctx context.Context, userID int, name string, exceptSpokenLanguageID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM spoken_languages WHERE user_id = $1 AND lower(spoken_language_name) = lower($2)
+			  AND spoken_language_id <> $3`
+
+	var count int
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID, name, exceptSpokenLanguageID).Scan(&count)
+	if err != nil {
+		repo.logger.Error("Failed to check spoken language name: %v", err)
+		return false, domain.NewDatabaseError("check spoken language existence by name", err)
+	}
+	return count > 0, nil
+}
+
+func (repo *spokenLanguageRepository) Reorder(ctx context.Context, userID int, spokenLanguageIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.Postgres, spokenLanguageList, []any{userID}, spokenLanguageIDs); err != nil {
+		repo.logger.Error("Failed to reorder spoken languages: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"time"
+)
+
+type technologyRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewTechnologyRepository(db *sql.DB, logger *logger.Logger) interfaces.TechnologyRepository {
+	return &technologyRepository{db: db, logger: logger}
+}
+
+func (repo *technologyRepository) Create(ctx context.Context, technology *entities.Technology) (*entities.Technology, error) {
+	position, err := listing.NextPosition(ctx, transaction.From(ctx, repo.db), listing.Postgres, technologyList, []any{technology.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create technology: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO technologies (user_id, technology_name, technology_icon_url, technology_position, 
+			  technology_state, technology_publish_at, technology_unpublish_at) 
+			  VALUES ($1, $2, $3, $4, $5, $6, $7)
+			  RETURNING technology_id`
+
+	var id int
+	err = transaction.From(ctx, repo.db).QueryRowContext(ctx, query,
+		technology.UserID,
+		technology.Name,
+		technology.IconURL,
+		position,
+		technology.State,
+		technology.PublishAt,
+		technology.UnpublishAt,
+	).Scan(&id)
+	if err != nil {
+		repo.logger.Error("Failed to create technology: %v", err)
+		return nil, domain.NewDatabaseError("create technology", err)
+	}
+
+	technology.TechnologyID = id
+	technology.Position = position
+	technology.CreatedAt = time.Now()
+	technology.UpdatedAt = time.Now()
+
+	return technology, nil
+}
+
+func (repo *technologyRepository) GetByID(ctx context.Context, technologyID int) (*entities.Technology, error) {
+	var technology entities.Technology
+	query := `SELECT technology_id, user_id, technology_name, technology_icon_url, 
+			  technology_created_at, technology_updated_at, technology_version, technology_position, technology_state, technology_publish_at, technology_unpublish_at 
+			  FROM technologies WHERE technology_id = $1 AND technology_deleted_at IS NULL`
+
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, technologyID)
+	err := row.Scan(
+		&technology.TechnologyID,
+		&technology.UserID,
+		&technology.Name,
+		&technology.IconURL,
+		&technology.CreatedAt,
+		&technology.UpdatedAt,
+		&technology.Version,
+		&technology.Position,
+		&technology.State,
+		&technology.PublishAt,
+		&technology.UnpublishAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to get technology: %v", err)
+		return nil, domain.NewDatabaseError("retrieve technology", err)
+	}
+
+	return &technology, nil
+}
+
+var technologyList = listing.Table{
+	Name:     "technologies",
+	IDColumn: "technology_id",
+	Columns:  "technology_id, user_id, technology_name, technology_icon_url, technology_created_at, technology_updated_at, technology_version, technology_position, technology_state, technology_publish_at, technology_unpublish_at",
+	Scope:    "user_id = ? AND technology_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":   "technology_position",
+		"name":       "lower(technology_name)",
+		"created_at": "technology_created_at",
+	},
+	Filters: map[string]string{
+		"state": "technology_state = ?",
+		"name":  "lower(technology_name) = lower(?)",
+	},
+	Position: "technology_position",
+}
+
+func (repo *technologyRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Technology], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.Postgres, technologyList, []any{userID}, query,
+		scanTechnology, func(technology *entities.Technology) int { return technology.TechnologyID })
+	if err != nil {
+		repo.logger.Error("Failed to getbyuserid technologies: %v", err)
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func scanTechnology(rows *sql.Rows) (*entities.Technology, error) {
+	technology := &entities.Technology{}
+	err := rows.Scan(
+		&technology.TechnologyID,
+		&technology.UserID,
+		&technology.Name,
+		&technology.IconURL,
+		&technology.CreatedAt,
+		&technology.UpdatedAt,
+		&technology.Version,
+		&technology.Position,
+		&technology.State,
+		&technology.PublishAt,
+		&technology.UnpublishAt,
+	)
+	return technology, err
+}
+
+func (repo *technologyRepository) Update(ctx context.Context, technologyID int, technology *entities.Technology) (*entities.Technology, error) {
+	query := `UPDATE technologies SET technology_name = $1, technology_icon_url = $2, 
+			  technology_updated_at = $3, technology_version = technology_version + 1 WHERE technology_id = $4 AND technology_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "technology_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		technology.Name,
+		technology.IconURL,
+		time.Now(),
+		technologyID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update technology: %v", err)
+		return nil, domain.NewDatabaseError("update technology", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Technology", technologyID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, technologyID)
+}
+
+func (repo *technologyRepository) Patch(ctx context.Context, technologyID int, technology *entities.Technology) (*entities.Technology, error) {
+	type field struct {
+		Name  string
+		Value interface{}
+		Set   bool
+	}
+
+	var fields []field
+	if technology.Name != "" {
+		fields = append(fields, field{"technology_name", technology.Name, true})
+	}
+	if technology.IconURL != "" {
+		fields = append(fields, field{"technology_icon_url", technology.IconURL, true})
+	}
+
+	if len(fields) == 0 {
+		return technology, nil
+	}
+
+	query := "UPDATE technologies SET "
+	var args []interface{}
+	for i, f := range fields {
+		args = append(args, f.Value)
+		query += fmt.Sprintf("%s = $%d", f.Name, len(args))
+		if i < len(fields)-1 {
+			query += ", "
+		}
+	}
+	args = append(args, technologyID)
+	query += fmt.Sprintf(", technology_version = technology_version + 1 WHERE technology_id = $%d AND technology_deleted_at IS NULL", len(args))
+
+	condition := transaction.VersionCondition(ctx, "technology_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, args...)
+	if err != nil {
+		repo.logger.Error("Failed to patch technology: %v", err)
+		return nil, fmt.Errorf("unable to patch technology: %w", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Technology", technologyID); err != nil {
+		return nil, err
+	}
+	return repo.GetByID(ctx, technologyID)
+}
+
+func (repo *technologyRepository) Delete(ctx context.Context, technologyID int) error {
+	query := `UPDATE technologies SET technology_deleted_at = $1, technology_version = technology_version + 1 WHERE technology_id = $2 AND technology_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "technology_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), technologyID)
+	if err != nil {
+		repo.logger.Error("Failed to delete technology: %v", err)
+		return domain.NewDatabaseError("delete technology", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Technology", technologyID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *technologyRepository) ExistsByID(ctx context.Context, technologyID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM technologies WHERE technology_id = $1 AND technology_deleted_at IS NULL`
+	var count int
+
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, technologyID).Scan(&count)
+	if err != nil {
+		repo.logger.Error("Failed to existsbyid technology: %v", err)
+		return false, domain.NewDatabaseError("check technology existence", err)
+	}
+
+	return count > 0, nil
+}
+
+func (repo *technologyRepository) ExistsByNameAndUserID(ctx context.Context, name string, userID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM technologies WHERE technology_name = $1 AND user_id = $2`
+	var count int
+
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, name, userID).Scan(&count)
+	if err != nil {
+		repo.logger.Error("Failed to existsbynameanduserid technology: %v", err)
+		return false, domain.NewDatabaseError("check technology existence by name", err)
+	}
+
+	return count > 0, nil
+}
+
+func (repo *technologyRepository) GetByNames(ctx context.Context, names []string, userID int) ([]*entities.Technology, error) {
+	if len(names) == 0 {
+		return []*entities.Technology{}, nil
+	}
+
+	// Names are matched in Go, as SQL lower() only folds ASCII.
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[entities.NormalizeTechnologyName(name)] = true
+	}
+
+	query := `SELECT technology_id, user_id, technology_name, technology_icon_url,
+			  technology_created_at, technology_updated_at, technology_version, technology_position, technology_state, technology_publish_at, technology_unpublish_at 
+			  FROM technologies WHERE user_id = $1 AND technology_deleted_at IS NULL ORDER BY technology_name`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		repo.logger.Error("Failed to getbynames technologies: %v", err)
+		return nil, domain.NewDatabaseError("retrieve technologies by names", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			repo.logger.Error("Failed to closing rows: %v", err)
+		}
+	}()
+
+	var technologies []*entities.Technology
+	for rows.Next() {
+		var technology entities.Technology
+		err := rows.Scan(
+			&technology.TechnologyID,
+			&technology.UserID,
+			&technology.Name,
+			&technology.IconURL,
+			&technology.CreatedAt,
+			&technology.UpdatedAt,
+			&technology.Version,
+			&technology.Position,
+			&technology.State,
+			&technology.PublishAt,
+			&technology.UnpublishAt,
+		)
+		if err != nil {
+			repo.logger.Error("Failed to scanning technology: %v", err)
+			continue
+		}
+
+		if wanted[technology.GetNormalizedName()] {
+			technologies = append(technologies, &technology)
+		}
+	}
+
+	return technologies, nil
+}
+
+func (repo *technologyRepository) GetAll(ctx context.Context) ([]*entities.Technology, error) {
+	query := `SELECT technology_id, user_id, technology_name, technology_icon_url,
+			  technology_created_at, technology_updated_at, technology_version, technology_position, technology_state, technology_publish_at, technology_unpublish_at 
+			  FROM technologies WHERE technology_deleted_at IS NULL ORDER BY technology_name`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query)
+	if err != nil {
+		repo.logger.Error("Failed to getall technologies: %v", err)
+		return nil, domain.NewDatabaseError("retrieve all technologies", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			repo.logger.Error("Failed to closing rows: %v", err)
+		}
+	}()
+
+	var technologies []*entities.Technology
+	for rows.Next() {
+		var technology entities.Technology
+		err := rows.Scan(
+			&technology.TechnologyID,
+			&technology.UserID,
+			&technology.Name,
+			&technology.IconURL,
+			&technology.CreatedAt,
+			&technology.UpdatedAt,
+			&technology.Version,
+			&technology.Position,
+			&technology.State,
+			&technology.PublishAt,
+			&technology.UnpublishAt,
+		)
+		if err != nil {
+			repo.logger.Error("Failed to scanning technology: %v", err)
+			continue
+		}
+
+		technologies = append(technologies, &technology)
+	}
+
+	return technologies, nil
+}
+
+func (repo *technologyRepository) Reorder(ctx context.Context, userID int, technologyIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.Postgres, technologyList, []any{userID}, technologyIDs); err != nil {
+		repo.logger.Error("Failed to reorder technologies: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,323 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strings"
+	"time"
+)
+
+type testimonialRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewTestimonialRepository(db *sql.DB, logger *logger.Logger) interfaces.TestimonialRepository {
+	return &testimonialRepository{db: db, logger: logger}
+}
+
+const testimonialColumns = "testimonial_id, user_id, testimonial_author_name, testimonial_author_title, testimonial_author_company, testimonial_author_email, testimonial_author_url, testimonial_content, testimonial_status, testimonial_rejection_reason, testimonial_moderated_at, project_id, experience_id, testimonial_created_at, testimonial_updated_at, testimonial_version, testimonial_position"
+
+var testimonialList = listing.Table{
+	Name:     "testimonials",
+	IDColumn: "testimonial_id",
+	Columns:  testimonialColumns,
+	Scope:    "user_id = ? AND testimonial_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":    "testimonial_position",
+		"created_at":  "testimonial_created_at",
+		"author_name": "lower(testimonial_author_name)",
+		"status":      "testimonial_status",
+	},
+	Filters: map[string]string{
+		"status":     "testimonial_status = ?",
+		"project":    "CAST(project_id AS TEXT) = ?",
+		"experience": "CAST(experience_id AS TEXT) = ?",
+	},
+	Position: "testimonial_position",
+}
+
+func scanTestimonial(row rowScanner) (*entities.Testimonial, error) {
+	testimonial := &entities.Testimonial{}
+	err := row.Scan(
+		&testimonial.TestimonialID,
+		&testimonial.UserID,
+		&testimonial.AuthorName,
+		&testimonial.AuthorTitle,
+		&testimonial.AuthorCompany,
+		&testimonial.AuthorEmail,
+		&testimonial.AuthorURL,
+		&testimonial.Content,
+		&testimonial.Status,
+		&testimonial.RejectionReason,
+		&testimonial.ModeratedAt,
+		&testimonial.ProjectID,
+		&testimonial.ExperienceID,
+		&testimonial.CreatedAt,
+		&testimonial.UpdatedAt,
+		&testimonial.Version,
+		&testimonial.Position,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return testimonial, nil
+}
+
+func (repo *testimonialRepository) Create(ctx context.Context, testimonial *entities.Testimonial) (*entities.Testimonial, error) {
+	executor := transaction.From(ctx, repo.db)
+	position, err := listing.NextPosition(ctx, executor, listing.Postgres, testimonialList, []any{testimonial.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create testimonial: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO testimonials (user_id, testimonial_author_name, testimonial_author_title, testimonial_author_company,
+			  testimonial_author_email, testimonial_author_url, testimonial_content, testimonial_status,
+			  testimonial_rejection_reason, testimonial_moderated_at, project_id, experience_id, testimonial_position)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			  RETURNING testimonial_id`
+
+	var id int
+	err = executor.QueryRowContext(ctx, query,
+		testimonial.UserID,
+		testimonial.AuthorName,
+		testimonial.AuthorTitle,
+		testimonial.AuthorCompany,
+		testimonial.AuthorEmail,
+		testimonial.AuthorURL,
+		testimonial.Content,
+		testimonial.Status,
+		testimonial.RejectionReason,
+		testimonial.ModeratedAt,
+		testimonial.ProjectID,
+		testimonial.ExperienceID,
+		position,
+	).Scan(&id)
+	if err != nil {
+		repo.logger.Error("Failed to create testimonial: %v", err)
+		return nil, domain.NewDatabaseError("create testimonial", err)
+	}
+
+	return repo.GetByID(ctx, id)
+}
+
+func (repo *testimonialRepository) GetByID(ctx context.Context, testimonialID int) (*entities.Testimonial, error) {
+	query := `SELECT ` + testimonialColumns + ` FROM testimonials WHERE testimonial_id = $1 AND testimonial_deleted_at IS NULL`
+
+	testimonial, err := scanTestimonial(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, testimonialID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to retrieve testimonial by ID: %v", err)
+		return nil, domain.NewDatabaseError("retrieve testimonial", err)
+	}
+
+	if err := repo.loadSubjects(ctx, testimonial); err != nil {
+		return nil, err
+	}
+	return testimonial, nil
+}
+
+func (repo *testimonialRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Testimonial], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.Postgres, testimonialList, []any{userID}, query,
+		func(rows *sql.Rows) (*entities.Testimonial, error) { return scanTestimonial(rows) },
+		func(testimonial *entities.Testimonial) int { return testimonial.TestimonialID })
+	if err != nil {
+		repo.logger.Error("Failed to retrieve testimonials by user ID: %v", err)
+		return nil, err
+	}
+
+	if err := repo.loadSubjects(ctx, page.Items...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (repo *testimonialRepository) GetByAuthorEmail(ctx context.Context, userID int, email string) ([]*entities.Testimonial, error) {
+	query := `SELECT ` + testimonialColumns + ` FROM testimonials
+			  WHERE user_id = $1 AND lower(testimonial_author_email) = lower($2) AND testimonial_deleted_at IS NULL
+			  ORDER BY testimonial_id`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, userID, email)
+	if err != nil {
+		repo.logger.Error("Failed to retrieve testimonials by author email: %v", err)
+		return nil, domain.NewDatabaseError("testimonials retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var testimonials []*entities.Testimonial
+	for rows.Next() {
+		testimonial, err := scanTestimonial(rows)
+		if err != nil {
+			repo.logger.Error("Failed to scan testimonial: %v", err)
+			return nil, domain.NewDatabaseError("testimonial scanning", err)
+		}
+		testimonials = append(testimonials, testimonial)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("testimonials iteration", err)
+	}
+	return testimonials, nil
+}
+
+func (repo *testimonialRepository) Update(ctx context.Context, testimonialID int, testimonial *entities.Testimonial) (*entities.Testimonial, error) {
+	query := `UPDATE testimonials SET testimonial_author_name = $1, testimonial_author_title = $2, testimonial_author_company = $3,
+			  testimonial_author_email = $4, testimonial_author_url = $5, testimonial_content = $6, project_id = $7, experience_id = $8,
+			  testimonial_updated_at = $9, testimonial_version = testimonial_version + 1
+			  WHERE testimonial_id = $10 AND testimonial_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "testimonial_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		testimonial.AuthorName,
+		testimonial.AuthorTitle,
+		testimonial.AuthorCompany,
+		testimonial.AuthorEmail,
+		testimonial.AuthorURL,
+		testimonial.Content,
+		testimonial.ProjectID,
+		testimonial.ExperienceID,
+		time.Now(),
+		testimonialID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update testimonial: %v", err)
+		return nil, domain.NewDatabaseError("update testimonial", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Testimonial", testimonialID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, testimonialID)
+}
+
+func (repo *testimonialRepository) SetStatus(ctx context.Context, testimonialID int, testimonial *entities.Testimonial) (*entities.Testimonial, error) {
+	query := `UPDATE testimonials SET testimonial_status = $1, testimonial_rejection_reason = $2, testimonial_moderated_at = $3,
+			  testimonial_updated_at = $4, testimonial_version = testimonial_version + 1
+			  WHERE testimonial_id = $5 AND testimonial_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "testimonial_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		testimonial.Status,
+		testimonial.RejectionReason,
+		testimonial.ModeratedAt,
+		time.Now(),
+		testimonialID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to set testimonial status: %v", err)
+		return nil, domain.NewDatabaseError("update testimonial status", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Testimonial", testimonialID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, testimonialID)
+}
+
+func (repo *testimonialRepository) Delete(ctx context.Context, testimonialID int) error {
+	query := `UPDATE testimonials SET testimonial_deleted_at = $1, testimonial_version = testimonial_version + 1
+			  WHERE testimonial_id = $2 AND testimonial_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "testimonial_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), testimonialID)
+	if err != nil {
+		repo.logger.Error("Failed to delete testimonial: %v", err)
+		return domain.NewDatabaseError("delete testimonial", err)
+	}
+
+	return transaction.CheckVersion(result, condition, "Testimonial", testimonialID)
+}
+
+func (repo *testimonialRepository) Reorder(ctx context.Context, userID int, testimonialIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.Postgres, testimonialList, []any{userID}, testimonialIDs); err != nil {
+		repo.logger.Error("Failed to reorder testimonials: %v", err)
+		return err
+	}
+	return nil
+}
+
+// loadSubjects fills in the live projects and experiences linked to
+// testimonials.
+func (repo *testimonialRepository) loadSubjects(ctx context.Context, testimonials ...*entities.Testimonial) error {
+	if len(testimonials) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*entities.Testimonial, len(testimonials))
+	placeholders := make([]string, 0, len(testimonials))
+	args := make([]any, 0, len(testimonials))
+	for _, testimonial := range testimonials {
+		byID[testimonial.TestimonialID] = testimonial
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)+1))
+		args = append(args, testimonial.TestimonialID)
+	}
+	in := strings.Join(placeholders, ", ")
+
+	projects := `SELECT testimonials.testimonial_id, projects.project_id, projects.project_title, '',
+	             projects.project_state, projects.project_publish_at, projects.project_unpublish_at
+	             FROM testimonials JOIN projects ON projects.project_id = testimonials.project_id
+	             WHERE projects.project_deleted_at IS NULL AND testimonials.testimonial_id IN (` + in + `)`
+	err := repo.scanSubjects(ctx, projects, args, func(testimonialID int, subject *entities.TestimonialSubject) {
+		byID[testimonialID].Project = subject
+	})
+	if err != nil {
+		return err
+	}
+
+	experiences := `SELECT testimonials.testimonial_id, experiences.experience_id, experiences.experience_job_title, experiences.experience_company_name,
+	                experiences.experience_state, experiences.experience_publish_at, experiences.experience_unpublish_at
+	                FROM testimonials JOIN experiences ON experiences.experience_id = testimonials.experience_id
+	                WHERE experiences.experience_deleted_at IS NULL AND testimonials.testimonial_id IN (` + in + `)`
+	return repo.scanSubjects(ctx, experiences, args, func(testimonialID int, subject *entities.TestimonialSubject) {
+		byID[testimonialID].Experience = subject
+	})
+}
+
+// scanSubjects runs a subject query and hands each row to set with the ID
+// of its testimonial.
+func (repo *testimonialRepository) scanSubjects(ctx context.Context, query string, args []any, set func(testimonialID int, subject *entities.TestimonialSubject)) error {
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		repo.logger.Error("Failed to load testimonial subjects: %v", err)
+		return domain.NewDatabaseError("testimonial subjects retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var testimonialID int
+		subject := &entities.TestimonialSubject{}
+		err := rows.Scan(
+			&testimonialID,
+			&subject.ID,
+			&subject.Title,
+			&subject.Organization,
+			&subject.State,
+			&subject.PublishAt,
+			&subject.UnpublishAt,
+		)
+		if err != nil {
+			repo.logger.Error("Failed to scan testimonial subject: %v", err)
+			return domain.NewDatabaseError("testimonial subjects scanning", err)
+		}
+		set(testimonialID, subject)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.NewDatabaseError("testimonial subjects iteration", err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"sort"
+	"time"
+)
+
+// trashTable describes how to read a soft-deletable table from the trash.
+type trashTable struct {
+	name          string
+	idColumn      string
+	labelExpr     string
+	deletedColumn string
+}
+
+var trashTables = map[string]trashTable{
+	entities.TrashTypeProject:        {"projects", "project_id", "project_title", "project_deleted_at"},
+	entities.TrashTypeSkill:          {"skills", "skill_id", "skill_name", "skill_deleted_at"},
+	entities.TrashTypeExperience:     {"experiences", "experience_id", "experience_job_title || ' at ' || experience_company_name", "experience_deleted_at"},
+	entities.TrashTypeEducation:      {"educations", "education_id", "education_degree || ', ' || education_institution", "education_deleted_at"},
+	entities.TrashTypeCertification:  {"certifications", "certification_id", "certification_name || ' by ' || certification_issuer", "certification_deleted_at"},
+	entities.TrashTypePublication:    {"publications", "publication_id", "publication_title", "publication_deleted_at"},
+	entities.TrashTypeSpokenLanguage: {"spoken_languages", "spoken_language_id", "spoken_language_name", "spoken_language_deleted_at"},
+	entities.TrashTypeAward:          {"awards", "award_id", "award_title || ' by ' || award_issuer", "award_deleted_at"},
+	entities.TrashTypeVolunteering:   {"volunteerings", "volunteering_id", "volunteering_role || ' at ' || volunteering_organization", "volunteering_deleted_at"},
+	entities.TrashTypeTestimonial:    {"testimonials", "testimonial_id", "'Testimonial from ' || testimonial_author_name", "testimonial_deleted_at"},
+	entities.TrashTypeTechnology:     {"technologies", "technology_id", "technology_name", "technology_deleted_at"},
+	entities.TrashTypePersonalInfo:   {"personal_infos", "personal_info_id", "personal_info_first_name || ' ' || personal_info_last_name", "personal_info_deleted_at"},
+}
+
+type trashRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewTrashRepository(db *sql.DB, logger *logger.Logger) interfaces.TrashRepository {
+	return &trashRepository{db: db, logger: logger}
+}
+
+func (repo *trashRepository) GetAll(ctx context.Context) ([]*entities.TrashItem, error) {
+	var items []*entities.TrashItem
+	for _, itemType := range entities.TrashTypes {
+		table := trashTables[itemType]
+		query := fmt.Sprintf(`SELECT %s, user_id, %s, %s FROM %s WHERE %s IS NOT NULL`,
+			table.idColumn, table.labelExpr, table.deletedColumn, table.name, table.deletedColumn)
+
+		rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query)
+		if err != nil {
+			repo.logger.Error("Failed to list trashed %s: %v", itemType, err)
+			return nil, domain.NewDatabaseError("trash retrieval", err)
+		}
+
+		for rows.Next() {
+			item := &entities.TrashItem{Type: itemType}
+			if err := rows.Scan(&item.ID, &item.UserID, &item.Label, &item.DeletedAt); err != nil {
+				_ = rows.Close()
+				repo.logger.Error("Failed to scan trashed %s: %v", itemType, err)
+				return nil, domain.NewDatabaseError("trash scanning", err)
+			}
+			items = append(items, item)
+		}
+		err = rows.Err()
+		if closeErr := rows.Close(); closeErr != nil {
+			repo.logger.Error("Failed to closing rows: %v", closeErr)
+		}
+		if err != nil {
+			repo.logger.Error("Failed to iterate trashed %s: %v", itemType, err)
+			return nil, domain.NewDatabaseError("trash iteration", err)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return items, nil
+}
+
+func (repo *trashRepository) Restore(ctx context.Context, itemType string, id int) error {
+	table, ok := trashTables[itemType]
+	if !ok {
+		return domain.NewValidationError("Unknown trash item type", "type", nil)
+	}
+
+	query := fmt.Sprintf(`UPDATE %s SET %s = NULL WHERE %s = $1 AND %s IS NOT NULL`,
+		table.name, table.deletedColumn, table.idColumn, table.deletedColumn)
+
+	return repo.execOne(ctx, query, "trash restore", itemType, id)
+}
+
+func (repo *trashRepository) Purge(ctx context.Context, itemType string, id int) error {
+	table, ok := trashTables[itemType]
+	if !ok {
+		return domain.NewValidationError("Unknown trash item type", "type", nil)
+	}
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND %s IS NOT NULL`,
+		table.name, table.idColumn, table.deletedColumn)
+
+	return repo.execOne(ctx, query, "trash purge", itemType, id)
+}
+
+func (repo *trashRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var purged int64
+	for _, itemType := range entities.TrashTypes {
+		table := trashTables[itemType]
+		query := fmt.Sprintf(`DELETE FROM %s WHERE %s IS NOT NULL AND %s < $1`,
+			table.name, table.deletedColumn, table.deletedColumn)
+
+		result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query, cutoff)
+		if err != nil {
+			repo.logger.Error("Failed to purge expired %s: %v", itemType, err)
+			return purged, domain.NewDatabaseError("trash retention purge", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			repo.logger.Error("Failed to purge expired %s rowsaffected: %v", itemType, err)
+			return purged, domain.NewDatabaseError("trash retention purge verification", err)
+		}
+		purged += rowsAffected
+	}
+
+	return purged, nil
+}
+
+// execOne runs a statement that must touch exactly one trashed row.
+func (repo *trashRepository) execOne(ctx context.Context, query, operation, itemType string, id int) error {
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query, id)
+	if err != nil {
+		repo.logger.Error("Failed to %s %s %d: %v", operation, itemType, id, err)
+		return domain.NewDatabaseError(operation, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.logger.Error("Failed to %s %s %d rowsaffected: %v", operation, itemType, id, err)
+		return domain.NewDatabaseError(operation+" verification", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewNotFoundError("Trash item", fmt.Sprintf("%s/%d", itemType, id))
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strings"
+	"time"
+)
+
+type auditLogRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewAuditLogRepository(db *sql.DB, logger *logger.Logger) interfaces.AuditLogRepository {
+	return &auditLogRepository{db: db, logger: logger}
+}
+
+func (repo *auditLogRepository) Create(ctx context.Context, auditLog *entities.AuditLog) (*entities.AuditLog, error) {
+	query := `INSERT INTO audit_logs (user_id, audit_log_action, audit_log_resource_type, audit_log_resource_id, audit_log_before, audit_log_after, audit_log_ip, audit_log_request_id, audit_log_created_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	auditLog.CreatedAt = time.Now()
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
+		auditLog.UserID,
+		auditLog.Action,
+		auditLog.ResourceType,
+		auditLog.ResourceID,
+		auditLog.Before,
+		auditLog.After,
+		auditLog.IP,
+		auditLog.RequestID,
+		auditLog.CreatedAt,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to create audit log: %v", err)
+		return nil, domain.NewDatabaseError("create audit log", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		repo.logger.Error("Failed to create audit log lastinsertid: %v", err)
+		return nil, domain.NewDatabaseError("get audit log ID", err)
+	}
+
+	auditLog.AuditLogID = int(id)
+	return auditLog, nil
+}
+
+func (repo *auditLogRepository) List(ctx context.Context, filter *entities.AuditLogFilter) ([]*entities.AuditLog, int, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	if filter.UserID > 0 {
+		conditions = append(conditions, "user_id = ?")
+		args = append(args, filter.UserID)
+	}
+	if filter.Action != "" {
+		conditions = append(conditions, "audit_log_action = ?")
+		args = append(args, filter.Action)
+	}
+	if filter.ResourceType != "" {
+		conditions = append(conditions, "audit_log_resource_type = ?")
+		args = append(args, filter.ResourceType)
+	}
+	if filter.ResourceID != "" {
+		conditions = append(conditions, "audit_log_resource_id = ?")
+		args = append(args, filter.ResourceID)
+	}
+	if filter.Since != nil {
+		conditions = append(conditions, "audit_log_created_at >= ?")
+		args = append(args, *filter.Since)
+	}
+	if filter.Until != nil {
+		conditions = append(conditions, "audit_log_created_at < ?")
+		args = append(args, *filter.Until)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := transaction.From(ctx, repo.db).QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_logs`+where, args...).Scan(&total); err != nil {
+		repo.logger.Error("Failed to count audit logs: %v", err)
+		return nil, 0, domain.NewDatabaseError("count audit logs", err)
+	}
+
+	query := `SELECT audit_log_id, user_id, audit_log_action, audit_log_resource_type, audit_log_resource_id, audit_log_before, audit_log_after, audit_log_ip, audit_log_request_id, audit_log_created_at
+			  FROM audit_logs` + where + ` ORDER BY audit_log_id DESC`
+	if filter.Limit > 0 {
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, filter.Limit, filter.Offset)
+	}
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		repo.logger.Error("Failed to list audit logs: %v", err)
+		return nil, 0, domain.NewDatabaseError("list audit logs", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			repo.logger.Error("Failed to closing rows: %v", err)
+		}
+	}()
+
+	var auditLogs []*entities.AuditLog
+	for rows.Next() {
+		auditLog, err := scanAuditLog(rows)
+		if err != nil {
+			repo.logger.Error("Failed to scan audit log: %v", err)
+			return nil, 0, domain.NewDatabaseError("scan audit log", err)
+		}
+		auditLogs = append(auditLogs, auditLog)
+	}
+
+	if err := rows.Err(); err != nil {
+		repo.logger.Error("Failed to iterate audit logs: %v", err)
+		return nil, 0, domain.NewDatabaseError("iterate audit logs", err)
+	}
+
+	return auditLogs, total, nil
+}
+
+func scanAuditLog(row interface{ Scan(dest ...any) error }) (*entities.AuditLog, error) {
+	var (
+		auditLog                                 entities.AuditLog
+		userID                                   sql.NullInt64
+		resourceID, before, after, ip, requestID sql.NullString
+	)
+	err := row.Scan(
+		&auditLog.AuditLogID,
+		&userID,
+		&auditLog.Action,
+		&auditLog.ResourceType,
+		&resourceID,
+		&before,
+		&after,
+		&ip,
+		&requestID,
+		&auditLog.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID.Valid {
+		id := int(userID.Int64)
+		auditLog.UserID = &id
+	}
+	auditLog.ResourceID = resourceID.String
+	auditLog.Before = before.String
+	auditLog.After = after.String
+	auditLog.IP = ip.String
+	auditLog.RequestID = requestID.String
+	return &auditLog, nil
+}
@@ -0,0 +1,209 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strconv"
+	"time"
+)
+
+type certificationRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewCertificationRepository(db *sql.DB, logger *logger.Logger) interfaces.CertificationRepository {
+	return &certificationRepository{db: db, logger: logger}
+}
+
+const certificationColumns = "certification_id, user_id, certification_name, certification_issuer, certification_credential_id, certification_verification_url, certification_issue_date, certification_expiry_date, certification_description, certification_created_at, certification_updated_at, certification_version, certification_position, certification_state, certification_publish_at, certification_unpublish_at"
+
+// certificationExpiryStatus computes entities.Certification.ExpiryStatus on
+// the current UTC date.
+var certificationExpiryStatus = "CASE WHEN certification_expiry_date IS NULL OR date(certification_expiry_date) >= date('now', '+" +
+	strconv.Itoa(entities.CertificationExpiryWarningDays) + " days') THEN 'valid' " +
+	"WHEN date(certification_expiry_date) < date('now') THEN 'expired' ELSE 'expiring_soon' END"
+
+var certificationList = listing.Table{
+	Name:     "certifications",
+	IDColumn: "certification_id",
+	Columns:  certificationColumns,
+	Scope:    "user_id = ? AND certification_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":    "certification_position",
+		"issue_date":  "certification_issue_date",
+		"expiry_date": "coalesce(certification_expiry_date, '9999-12-31')",
+		"name":        "lower(certification_name)",
+		"issuer":      "lower(certification_issuer)",
+		"created_at":  "certification_created_at",
+	},
+	Filters: map[string]string{
+		"state":  "certification_state = ?",
+		"issuer": "lower(certification_issuer) = lower(?)",
+		"expiry": certificationExpiryStatus + " = ?",
+	},
+	Position: "certification_position",
+}
+
+func scanCertification(row rowScanner) (*entities.Certification, error) {
+	certification := &entities.Certification{}
+	err := row.Scan(
+		&certification.CertificationID,
+		&certification.UserID,
+		&certification.Name,
+		&certification.Issuer,
+		&certification.CredentialID,
+		&certification.VerificationURL,
+		&certification.IssueDate,
+		&certification.ExpiryDate,
+		&certification.Description,
+		&certification.CreatedAt,
+		&certification.UpdatedAt,
+		&certification.Version,
+		&certification.Position,
+		&certification.State,
+		&certification.PublishAt,
+		&certification.UnpublishAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return certification, nil
+}
+
+func (repo *certificationRepository) Create(ctx context.Context, certification *entities.Certification) (*entities.Certification, error) {
+	executor := transaction.From(ctx, repo.db)
+	position, err := listing.NextPosition(ctx, executor, listing.SQLite, certificationList, []any{certification.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create certification: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO certifications (user_id, certification_name, certification_issuer, certification_credential_id,
+			  certification_verification_url, certification_issue_date, certification_expiry_date, certification_description,
+			  certification_position, certification_state, certification_publish_at, certification_unpublish_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := executor.ExecContext(ctx, query,
+		certification.UserID,
+		certification.Name,
+		certification.Issuer,
+		certification.CredentialID,
+		certification.VerificationURL,
+		certification.IssueDate,
+		certification.ExpiryDate,
+		certification.Description,
+		position,
+		certification.State,
+		certification.PublishAt,
+		certification.UnpublishAt,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to create certification: %v", err)
+		return nil, domain.NewDatabaseError("create certification", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		repo.logger.Error("Failed to get certification ID after creation: %v", err)
+		return nil, domain.NewDatabaseError("get certification ID", err)
+	}
+
+	return repo.GetByID(ctx, int(id))
+}
+
+func (repo *certificationRepository) GetByID(ctx context.Context, certificationID int) (*entities.Certification, error) {
+	query := `SELECT ` + certificationColumns + ` FROM certifications WHERE certification_id = ? AND certification_deleted_at IS NULL`
+
+	certification, err := scanCertification(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, certificationID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to retrieve certification by ID: %v", err)
+		return nil, domain.NewDatabaseError("retrieve certification", err)
+	}
+	return certification, nil
+}
+
+func (repo *certificationRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Certification], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.SQLite, certificationList, []any{userID}, query,
+		func(rows *sql.Rows) (*entities.Certification, error) { return scanCertification(rows) },
+		func(certification *entities.Certification) int { return certification.CertificationID })
+	if err != nil {
+		repo.logger.Error("Failed to retrieve certifications by user ID: %v", err)
+		return nil, err
+	}
+	return page, nil
+}
+
+func (repo *certificationRepository) Update(ctx context.Context, certificationID int, certification *entities.Certification) (*entities.Certification, error) {
+	query := `UPDATE certifications SET certification_name = ?, certification_issuer = ?, certification_credential_id = ?,
+			  certification_verification_url = ?, certification_issue_date = ?, certification_expiry_date = ?,
+			  certification_description = ?, certification_updated_at = ?, certification_version = certification_version + 1
+			  WHERE certification_id = ? AND certification_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "certification_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		certification.Name,
+		certification.Issuer,
+		certification.CredentialID,
+		certification.VerificationURL,
+		certification.IssueDate,
+		certification.ExpiryDate,
+		certification.Description,
+		time.Now(),
+		certificationID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update certification: %v", err)
+		return nil, domain.NewDatabaseError("update certification", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Certification", certificationID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, certificationID)
+}
+
+func (repo *certificationRepository) Delete(ctx context.Context, certificationID int) error {
+	query := `UPDATE certifications SET certification_deleted_at = ?, certification_version = certification_version + 1
+			  WHERE certification_id = ? AND certification_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "certification_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), certificationID)
+	if err != nil {
+		repo.logger.Error("Failed to delete certification: %v", err)
+		return domain.NewDatabaseError("delete certification", err)
+	}
+
+	return transaction.CheckVersion(result, condition, "Certification", certificationID)
+}
+
+func (repo *certificationRepository) ExistsByCredential(ctx context.Context, userID int, issuer, credentialID string, exceptCertificationID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM certifications WHERE user_id = ? AND certification_issuer = ?
+			  AND certification_credential_id = ? AND certification_id <> ?`
+
+	var count int
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID, issuer, credentialID, exceptCertificationID).Scan(&count)
+	if err != nil {
+		repo.logger.Error("Failed to check certification credential: %v", err)
+		return false, domain.NewDatabaseError("check certification existence by credential", err)
+	}
+	return count > 0, nil
+}
+
+func (repo *certificationRepository) Reorder(ctx context.Context, userID int, certificationIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.SQLite, certificationList, []any{userID}, certificationIDs); err != nil {
+		repo.logger.Error("Failed to reorder certifications: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+//go:build sqlite_fts5
+
+package sqlite_test
+
+import (
+	"io"
+	"path/filepath"
+	"portfolio/config"
+	"portfolio/infrastructure/conformance"
+	"portfolio/infrastructure/sqlite"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) *conformance.Repositories {
+		logger := logger.NewWriterLogger(io.Discard)
+
+		db, err := sqlite.NewConnection(&config.DatabaseConfig{
+			Driver:             config.DriverSQLite,
+			Path:               filepath.Join(t.TempDir(), "portfolio.sqlite3"),
+			MaxOpenConnections: 5,
+			MaxIdleConnections: 5,
+			Pragmas: map[string]string{
+				"foreign_keys": "ON",
+				"journal_mode": "WAL",
+				"busy_timeout": "5000",
+			},
+		}, logger)
+		if err != nil {
+			t.Fatalf("Failed to open SQLite database: %v", err)
+		}
+		t.Cleanup(func() {
+			_ = db.Close()
+		})
+
+		return &conformance.Repositories{
+			User:           sqlite.NewUserRepository(db, logger),
+			Setting:        sqlite.NewSettingRepository(db, logger, "portfolio"),
+			Technology:     sqlite.NewTechnologyRepository(db, logger),
+			Project:        sqlite.NewProjectRepository(db, logger),
+			Search:         sqlite.NewSearchRepository(db, logger),
+			Certification:  sqlite.NewCertificationRepository(db, logger),
+			SpokenLanguage: sqlite.NewSpokenLanguageRepository(db, logger),
+			Volunteering:   sqlite.NewVolunteeringRepository(db, logger),
+			Trash:          sqlite.NewTrashRepository(db, logger),
+			UnitOfWork:     transaction.NewUnitOfWork(db, logger),
+		}
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
 	"portfolio/logger"
 	"time"
 )
@@ -21,17 +23,28 @@ func NewEducationRepository(db *sql.DB, logger *logger.Logger) interfaces.Educat
 }
 
 func (repo *educationRepository) Create(ctx context.Context, education *entities.Education) (*entities.Education, error) {
+	position, err := listing.NextPosition(ctx, transaction.From(ctx, repo.db), listing.SQLite, educationList, []any{education.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create education: %v", err)
+		return nil, err
+	}
+
 	query := `INSERT INTO educations (user_id, education_degree, education_institution, 
-			  education_start_date, education_end_date, education_description) 
-			  VALUES (?, ?, ?, ?, ?, ?)`
+			  education_start_date, education_end_date, education_description, education_position, 
+			  education_state, education_publish_at, education_unpublish_at) 
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
-	result, err := repo.db.ExecContext(ctx, query,
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
 		education.UserID,
 		education.Degree,
 		education.Institution,
 		education.StartDate.String(),
 		education.EndDate.String(),
 		education.Description,
+		position,
+		education.State,
+		education.PublishAt,
+		education.UnpublishAt,
 	)
 	if err != nil {
 		repo.logger.Error("Failed to create education: %v", err)
@@ -45,6 +58,7 @@ func (repo *educationRepository) Create(ctx context.Context, education *entities
 	}
 
 	education.EducationID = int(id)
+	education.Position = position
 	education.CreatedAt = time.Now()
 	education.UpdatedAt = time.Now()
 
@@ -55,10 +69,10 @@ func (repo *educationRepository) GetByID(ctx context.Context, educationID int) (
 	var education entities.Education
 	query := `SELECT education_id, user_id, education_degree, education_institution, 
 			  education_start_date, education_end_date, education_description, 
-			  education_created_at, education_updated_at 
-			  FROM educations WHERE education_id = ?`
+			  education_created_at, education_updated_at, education_version, education_position, education_state, education_publish_at, education_unpublish_at 
+			  FROM educations WHERE education_id = ? AND education_deleted_at IS NULL`
 
-	row := repo.db.QueryRowContext(ctx, query, educationID)
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, educationID)
 	err := row.Scan(
 		&education.EducationID,
 		&education.UserID,
@@ -69,6 +83,11 @@ func (repo *educationRepository) GetByID(ctx context.Context, educationID int) (
 		&education.Description,
 		&education.CreatedAt,
 		&education.UpdatedAt,
+		&education.Version,
+		&education.Position,
+		&education.State,
+		&education.PublishAt,
+		&education.UnpublishAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -81,54 +100,64 @@ func (repo *educationRepository) GetByID(ctx context.Context, educationID int) (
 	return &education, nil
 }
 
-func (repo *educationRepository) GetByUserID(ctx context.Context, userID int) ([]*entities.Education, error) {
-	query := `SELECT education_id, user_id, education_degree, education_institution, 
-			  education_start_date, education_end_date, education_description,
-			  education_created_at, education_updated_at 
-			  FROM educations WHERE user_id = ? ORDER BY education_start_date DESC`
+var educationList = listing.Table{
+	Name:     "educations",
+	IDColumn: "education_id",
+	Columns:  "education_id, user_id, education_degree, education_institution, education_start_date, education_end_date, education_description, education_created_at, education_updated_at, education_version, education_position, education_state, education_publish_at, education_unpublish_at",
+	Scope:    "user_id = ? AND education_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":    "education_position",
+		"start_date":  "education_start_date",
+		"degree":      "lower(education_degree)",
+		"institution": "lower(education_institution)",
+		"created_at":  "education_created_at",
+	},
+	Filters: map[string]string{
+		"state":       "education_state = ?",
+		"institution": "lower(education_institution) = lower(?)",
+	},
+	Position: "education_position",
+}
 
-	rows, err := repo.db.QueryContext(ctx, query, userID)
+func (repo *educationRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Education], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.SQLite, educationList, []any{userID}, query,
+		scanEducation, func(education *entities.Education) int { return education.EducationID })
 	if err != nil {
 		repo.logger.Error("Failed to retrieve educations by user ID: %v", err)
-		return nil, domain.NewDatabaseError("retrieve educations by user ID", err)
+		return nil, err
 	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			repo.logger.Error("Failed to closing rows: %v", err)
-		}
-	}()
-
-	var educations []*entities.Education
-	for rows.Next() {
-		var education entities.Education
-		err := rows.Scan(
-			&education.EducationID,
-			&education.UserID,
-			&education.Degree,
-			&education.Institution,
-			&education.StartDate,
-			&education.EndDate,
-			&education.Description,
-			&education.CreatedAt,
-			&education.UpdatedAt,
-		)
-		if err != nil {
-			repo.logger.Error("Failed to scanning education: %v", err)
-			continue
-		}
 
-		educations = append(educations, &education)
-	}
+	return page, nil
+}
 
-	return educations, nil
+func scanEducation(rows *sql.Rows) (*entities.Education, error) {
+	education := &entities.Education{}
+	err := rows.Scan(
+		&education.EducationID,
+		&education.UserID,
+		&education.Degree,
+		&education.Institution,
+		&education.StartDate,
+		&education.EndDate,
+		&education.Description,
+		&education.CreatedAt,
+		&education.UpdatedAt,
+		&education.Version,
+		&education.Position,
+		&education.State,
+		&education.PublishAt,
+		&education.UnpublishAt,
+	)
+	return education, err
 }
 
 func (repo *educationRepository) Update(ctx context.Context, educationID int, education *entities.Education) (*entities.Education, error) {
 	query := `UPDATE educations SET education_degree = ?, education_institution = ?, 
 			  education_start_date = ?, education_end_date = ?, education_description = ?, 
-			  education_updated_at = ? WHERE education_id = ?`
+			  education_updated_at = ?, education_version = education_version + 1 WHERE education_id = ? AND education_deleted_at IS NULL`
 
-	_, err := repo.db.ExecContext(ctx, query,
+	condition := transaction.VersionCondition(ctx, "education_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
 		education.Degree,
 		education.Institution,
 		education.StartDate.String(),
@@ -142,6 +171,10 @@ func (repo *educationRepository) Update(ctx context.Context, educationID int, ed
 		return nil, domain.NewDatabaseError("update education", err)
 	}
 
+	if err := transaction.CheckVersion(result, condition, "Education", educationID); err != nil {
+		return nil, err
+	}
+
 	return repo.GetByID(ctx, educationID)
 }
 
@@ -182,33 +215,44 @@ func (repo *educationRepository) Patch(ctx context.Context, educationID int, edu
 			query += ", "
 		}
 	}
-	query += " WHERE education_id = ?"
+	query += ", education_version = education_version + 1 WHERE education_id = ? AND education_deleted_at IS NULL"
 	args = append(args, educationID)
 
-	if _, err := repo.db.ExecContext(ctx, query, args...); err != nil {
+	condition := transaction.VersionCondition(ctx, "education_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, args...)
+	if err != nil {
 		repo.logger.Error("Failed to patch education: %v", err)
 		return nil, fmt.Errorf("unable to patch education: %w", err)
 	}
+
+	if err := transaction.CheckVersion(result, condition, "Education", educationID); err != nil {
+		return nil, err
+	}
 	return repo.GetByID(ctx, educationID)
 }
 
 func (repo *educationRepository) Delete(ctx context.Context, educationID int) error {
-	query := `DELETE FROM educations WHERE education_id = ?`
+	query := `UPDATE educations SET education_deleted_at = ?, education_version = education_version + 1 WHERE education_id = ? AND education_deleted_at IS NULL`
 
-	_, err := repo.db.ExecContext(ctx, query, educationID)
+	condition := transaction.VersionCondition(ctx, "education_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), educationID)
 	if err != nil {
 		repo.logger.Error("Failed to delete education: %v", err)
 		return domain.NewDatabaseError("delete education", err)
 	}
 
+	if err := transaction.CheckVersion(result, condition, "Education", educationID); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (repo *educationRepository) ExistsByID(ctx context.Context, educationID int) (bool, error) {
-	query := `SELECT COUNT(*) FROM educations WHERE education_id = ?`
+	query := `SELECT COUNT(*) FROM educations WHERE education_id = ? AND education_deleted_at IS NULL`
 	var count int
 
-	err := repo.db.QueryRowContext(ctx, query, educationID).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, educationID).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbyid education: %v", err)
 		return false, domain.NewDatabaseError("check education existence", err)
@@ -221,7 +265,7 @@ func (repo *educationRepository) ExistsByDegreeInstitutionAndUserID(ctx context.
 	query := `SELECT COUNT(*) FROM educations WHERE education_degree = ? AND education_institution = ? AND user_id = ?`
 	var count int
 
-	err := repo.db.QueryRowContext(ctx, query, degree, institution, userID).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, degree, institution, userID).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbydegreeinstitutionanduserid education: %v", err)
 		return false, domain.NewDatabaseError("check education existence by degree and institution", err)
@@ -233,11 +277,11 @@ func (repo *educationRepository) ExistsByDegreeInstitutionAndUserID(ctx context.
 func (repo *educationRepository) GetCurrentEducations(ctx context.Context, userID int) ([]*entities.Education, error) {
 	query := `SELECT education_id, user_id, education_degree, education_institution, 
 			  education_start_date, education_end_date, education_description,
-			  education_created_at, education_updated_at 
-			  FROM educations WHERE user_id = ? AND (education_end_date IS NULL OR education_end_date = '' OR education_end_date = '0000-00-00')
+			  education_created_at, education_updated_at, education_version, education_position, education_state, education_publish_at, education_unpublish_at 
+			  FROM educations WHERE user_id = ? AND education_deleted_at IS NULL AND (education_end_date IS NULL OR education_end_date = '' OR education_end_date = '0000-00-00')
 			  ORDER BY education_start_date DESC`
 
-	rows, err := repo.db.QueryContext(ctx, query, userID)
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, userID)
 	if err != nil {
 		repo.logger.Error("Failed to getcurrenteducations: %v", err)
 		return nil, domain.NewDatabaseError("retrieve current educations", err)
@@ -262,6 +306,11 @@ func (repo *educationRepository) GetCurrentEducations(ctx context.Context, userI
 			&education.Description,
 			&education.CreatedAt,
 			&education.UpdatedAt,
+			&education.Version,
+			&education.Position,
+			&education.State,
+			&education.PublishAt,
+			&education.UnpublishAt,
 		)
 		if err != nil {
 			repo.logger.Error("Failed to scanning current education: %v", err)
@@ -277,10 +326,10 @@ func (repo *educationRepository) GetCurrentEducations(ctx context.Context, userI
 func (repo *educationRepository) GetAll(ctx context.Context) ([]*entities.Education, error) {
 	query := `SELECT education_id, user_id, education_degree, education_institution, 
 			  education_start_date, education_end_date, education_description,
-			  education_created_at, education_updated_at 
-			  FROM educations ORDER BY education_start_date DESC`
+			  education_created_at, education_updated_at, education_version, education_position, education_state, education_publish_at, education_unpublish_at 
+			  FROM educations WHERE education_deleted_at IS NULL ORDER BY education_start_date DESC`
 
-	rows, err := repo.db.QueryContext(ctx, query)
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query)
 	if err != nil {
 		repo.logger.Error("Failed to getall educations: %v", err)
 		return nil, domain.NewDatabaseError("retrieve all educations", err)
@@ -304,6 +353,11 @@ func (repo *educationRepository) GetAll(ctx context.Context) ([]*entities.Educat
 			&education.Description,
 			&education.CreatedAt,
 			&education.UpdatedAt,
+			&education.Version,
+			&education.Position,
+			&education.State,
+			&education.PublishAt,
+			&education.UnpublishAt,
 		)
 		if err != nil {
 			repo.logger.Error("Failed to scanning education: %v", err)
@@ -315,3 +369,11 @@ func (repo *educationRepository) GetAll(ctx context.Context) ([]*entities.Educat
 
 	return educations, nil
 }
+
+func (repo *educationRepository) Reorder(ctx context.Context, userID int, educationIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.SQLite, educationList, []any{userID}, educationIDs); err != nil {
+		repo.logger.Error("Failed to reorder educations: %v", err)
+		return err
+	}
+	return nil
+}
@@ -7,7 +7,10 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
 	"portfolio/logger"
+	"strings"
 	"time"
 )
 
@@ -21,17 +24,32 @@ func NewExperienceRepository(db *sql.DB, logger *logger.Logger) interfaces.Exper
 }
 
 func (repo *experienceRepository) Create(ctx context.Context, experience *entities.Experience) (*entities.Experience, error) {
+	position, err := listing.NextPosition(ctx, transaction.From(ctx, repo.db), listing.SQLite, experienceList, []any{experience.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create experience: %v", err)
+		return nil, err
+	}
+
 	query := `INSERT INTO experiences (user_id, experience_company_name, experience_job_title, 
-			  experience_start_date, experience_end_date, experience_description) 
-			  VALUES (?, ?, ?, ?, ?, ?)`
+			  experience_start_date, experience_end_date, experience_description, experience_employment_type, 
+			  experience_location, experience_remote, experience_position, 
+			  experience_state, experience_publish_at, experience_unpublish_at) 
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
-	result, err := repo.db.ExecContext(ctx, query,
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
 		experience.UserID,
 		experience.CompanyName,
 		experience.JobTitle,
 		experience.StartDate.String(),
 		experience.EndDate.String(),
 		experience.Description,
+		experience.EmploymentType,
+		experience.Location,
+		experience.Remote,
+		position,
+		experience.State,
+		experience.PublishAt,
+		experience.UnpublishAt,
 	)
 	if err != nil {
 		repo.logger.Error("Failed to create experience: %v", err)
@@ -45,6 +63,7 @@ func (repo *experienceRepository) Create(ctx context.Context, experience *entiti
 	}
 
 	experience.ExperienceID = int(id)
+	experience.Position = position
 	experience.CreatedAt = time.Now()
 	experience.UpdatedAt = time.Now()
 
@@ -52,24 +71,10 @@ func (repo *experienceRepository) Create(ctx context.Context, experience *entiti
 }
 
 func (repo *experienceRepository) GetByID(ctx context.Context, experienceID int) (*entities.Experience, error) {
-	var experience entities.Experience
-	query := `SELECT experience_id, user_id, experience_company_name, experience_job_title, 
-			  experience_start_date, experience_end_date, experience_description, 
-			  experience_created_at, experience_updated_at 
-			  FROM experiences WHERE experience_id = ?`
+	query := `SELECT ` + experienceColumns + ` FROM experiences WHERE experience_id = ? AND experience_deleted_at IS NULL`
 
-	row := repo.db.QueryRowContext(ctx, query, experienceID)
-	err := row.Scan(
-		&experience.ExperienceID,
-		&experience.UserID,
-		&experience.CompanyName,
-		&experience.JobTitle,
-		&experience.StartDate,
-		&experience.EndDate,
-		&experience.Description,
-		&experience.CreatedAt,
-		&experience.UpdatedAt,
-	)
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, experienceID)
+	experience, err := scanExperience(row)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -78,62 +83,95 @@ func (repo *experienceRepository) GetByID(ctx context.Context, experienceID int)
 		return nil, domain.NewDatabaseError("retrieve experience", err)
 	}
 
-	return &experience, nil
+	if err := repo.loadDetails(ctx, experience); err != nil {
+		return nil, err
+	}
+	return experience, nil
 }
 
-func (repo *experienceRepository) GetByUserID(ctx context.Context, userID int) ([]*entities.Experience, error) {
-	query := `SELECT experience_id, user_id, experience_company_name, experience_job_title, 
-			  experience_start_date, experience_end_date, experience_description,
-			  experience_created_at, experience_updated_at 
-			  FROM experiences WHERE user_id = ? ORDER BY experience_start_date DESC`
+const experienceColumns = "experience_id, user_id, experience_company_name, experience_job_title, experience_start_date, experience_end_date, experience_description, experience_employment_type, experience_location, experience_remote, experience_created_at, experience_updated_at, experience_version, experience_position, experience_state, experience_publish_at, experience_unpublish_at"
+
+var experienceList = listing.Table{
+	Name:     "experiences",
+	IDColumn: "experience_id",
+	Columns:  experienceColumns,
+	Scope:    "user_id = ? AND experience_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":     "experience_position",
+		"start_date":   "experience_start_date",
+		"job_title":    "lower(experience_job_title)",
+		"company_name": "lower(experience_company_name)",
+		"company":      "coalesce((SELECT max(company.experience_start_date) FROM experiences company WHERE company.user_id = experiences.user_id AND company.experience_deleted_at IS NULL AND lower(company.experience_company_name) = lower(experiences.experience_company_name)), '') || ' ' || lower(experience_company_name)",
+		"created_at":   "experience_created_at",
+	},
+	Filters: map[string]string{
+		"state":           "experience_state = ?",
+		"company_name":    "lower(experience_company_name) = lower(?)",
+		"employment_type": "experience_employment_type = ?",
+		"remote":          "experience_remote = (? = 'true')",
+		"technology":      "experience_id IN (SELECT experience_technologies.experience_id FROM experience_technologies JOIN technologies ON technologies.technology_id = experience_technologies.technology_id WHERE technologies.technology_deleted_at IS NULL AND lower(technologies.technology_name) = lower(trim(?)))",
+	},
+	Position: "experience_position",
+}
 
-	rows, err := repo.db.QueryContext(ctx, query, userID)
+func (repo *experienceRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Experience], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.SQLite, experienceList, []any{userID}, query,
+		func(rows *sql.Rows) (*entities.Experience, error) { return scanExperience(rows) },
+		func(experience *entities.Experience) int { return experience.ExperienceID })
 	if err != nil {
 		repo.logger.Error("Failed to getbyuserid experiences: %v", err)
-		return nil, domain.NewDatabaseError("retrieve experiences by user ID", err)
+		return nil, err
 	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			repo.logger.Error("Failed to closing rows: %v", err)
-		}
-	}()
-
-	var experiences []*entities.Experience
-	for rows.Next() {
-		var experience entities.Experience
-		err := rows.Scan(
-			&experience.ExperienceID,
-			&experience.UserID,
-			&experience.CompanyName,
-			&experience.JobTitle,
-			&experience.StartDate,
-			&experience.EndDate,
-			&experience.Description,
-			&experience.CreatedAt,
-			&experience.UpdatedAt,
-		)
-		if err != nil {
-			repo.logger.Error("Failed to scanning experience: %v", err)
-			continue
-		}
 
-		experiences = append(experiences, &experience)
+	if err := repo.loadDetails(ctx, page.Items...); err != nil {
+		return nil, err
 	}
+	return page, nil
+}
 
-	return experiences, nil
+func scanExperience(row rowScanner) (*entities.Experience, error) {
+	experience := &entities.Experience{}
+	err := row.Scan(
+		&experience.ExperienceID,
+		&experience.UserID,
+		&experience.CompanyName,
+		&experience.JobTitle,
+		&experience.StartDate,
+		&experience.EndDate,
+		&experience.Description,
+		&experience.EmploymentType,
+		&experience.Location,
+		&experience.Remote,
+		&experience.CreatedAt,
+		&experience.UpdatedAt,
+		&experience.Version,
+		&experience.Position,
+		&experience.State,
+		&experience.PublishAt,
+		&experience.UnpublishAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return experience, nil
 }
 
 func (repo *experienceRepository) Update(ctx context.Context, experienceID int, experience *entities.Experience) (*entities.Experience, error) {
 	query := `UPDATE experiences SET experience_company_name = ?, experience_job_title = ?, 
 			  experience_start_date = ?, experience_end_date = ?, experience_description = ?, 
-			  experience_updated_at = ? WHERE experience_id = ?`
+			  experience_employment_type = ?, experience_location = ?, experience_remote = ?, 
+			  experience_updated_at = ?, experience_version = experience_version + 1 WHERE experience_id = ? AND experience_deleted_at IS NULL`
 
-	_, err := repo.db.ExecContext(ctx, query,
+	condition := transaction.VersionCondition(ctx, "experience_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
 		experience.CompanyName,
 		experience.JobTitle,
 		experience.StartDate.String(),
 		experience.EndDate.String(),
 		experience.Description,
+		experience.EmploymentType,
+		experience.Location,
+		experience.Remote,
 		time.Now(),
 		experienceID,
 	)
@@ -142,6 +180,10 @@ func (repo *experienceRepository) Update(ctx context.Context, experienceID int,
 		return nil, domain.NewDatabaseError("update experience", err)
 	}
 
+	if err := transaction.CheckVersion(result, condition, "Experience", experienceID); err != nil {
+		return nil, err
+	}
+
 	return repo.GetByID(ctx, experienceID)
 }
 
@@ -168,11 +210,22 @@ func (repo *experienceRepository) Patch(ctx context.Context, experienceID int, e
 	if experience.Description != "" {
 		fields = append(fields, field{"experience_description", experience.Description, true})
 	}
+	if experience.EmploymentType != "" {
+		fields = append(fields, field{"experience_employment_type", experience.EmploymentType, true})
+	}
 
 	if len(fields) == 0 {
 		return experience, nil
 	}
 
+	// Location and remote are always written: the use case patches a copy
+	// of the stored experience, so they hold either the stored or the new
+	// value.
+	fields = append(fields,
+		field{"experience_location", experience.Location, true},
+		field{"experience_remote", experience.Remote, true},
+	)
+
 	query := "UPDATE experiences SET "
 	var args []interface{}
 	for i, f := range fields {
@@ -182,33 +235,44 @@ func (repo *experienceRepository) Patch(ctx context.Context, experienceID int, e
 			query += ", "
 		}
 	}
-	query += " WHERE experience_id = ?"
+	query += ", experience_version = experience_version + 1 WHERE experience_id = ? AND experience_deleted_at IS NULL"
 	args = append(args, experienceID)
 
-	if _, err := repo.db.ExecContext(ctx, query, args...); err != nil {
+	condition := transaction.VersionCondition(ctx, "experience_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, args...)
+	if err != nil {
 		repo.logger.Error("Failed to patch experience: %v", err)
 		return nil, fmt.Errorf("unable to patch experience: %w", err)
 	}
+
+	if err := transaction.CheckVersion(result, condition, "Experience", experienceID); err != nil {
+		return nil, err
+	}
 	return repo.GetByID(ctx, experienceID)
 }
 
 func (repo *experienceRepository) Delete(ctx context.Context, experienceID int) error {
-	query := `DELETE FROM experiences WHERE experience_id = ?`
+	query := `UPDATE experiences SET experience_deleted_at = ?, experience_version = experience_version + 1 WHERE experience_id = ? AND experience_deleted_at IS NULL`
 
-	_, err := repo.db.ExecContext(ctx, query, experienceID)
+	condition := transaction.VersionCondition(ctx, "experience_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), experienceID)
 	if err != nil {
 		repo.logger.Error("Failed to delete experience: %v", err)
 		return domain.NewDatabaseError("delete experience", err)
 	}
 
+	if err := transaction.CheckVersion(result, condition, "Experience", experienceID); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (repo *experienceRepository) ExistsByID(ctx context.Context, experienceID int) (bool, error) {
-	query := `SELECT COUNT(*) FROM experiences WHERE experience_id = ?`
+	query := `SELECT COUNT(*) FROM experiences WHERE experience_id = ? AND experience_deleted_at IS NULL`
 	var count int
 
-	err := repo.db.QueryRowContext(ctx, query, experienceID).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, experienceID).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbyid experience: %v", err)
 		return false, domain.NewDatabaseError("check experience existence", err)
@@ -217,30 +281,27 @@ func (repo *experienceRepository) ExistsByID(ctx context.Context, experienceID i
 	return count > 0, nil
 }
 
-func (repo *experienceRepository) ExistsByJobTitleCompanyAndUserID(ctx context.Context, jobTitle, companyName string, userID int) (bool, error) {
-	query := `SELECT COUNT(*) FROM experiences WHERE experience_job_title = ? AND experience_company_name = ? AND user_id = ?`
-	var count int
-
-	err := repo.db.QueryRowContext(ctx, query, jobTitle, companyName, userID).Scan(&count)
-	if err != nil {
-		repo.logger.Error("Failed to existsbyjobtitlecompanyanduserid experience: %v", err)
-		return false, domain.NewDatabaseError("check experience existence by job title and company", err)
-	}
+func (repo *experienceRepository) GetCurrentExperiences(ctx context.Context, userID int) ([]*entities.Experience, error) {
+	query := `SELECT ` + experienceColumns + ` 
+			  FROM experiences WHERE user_id = ? AND experience_deleted_at IS NULL AND (experience_end_date IS NULL OR experience_end_date = '' OR experience_end_date = '0000-00-00')
+			  ORDER BY experience_start_date DESC`
 
-	return count > 0, nil
+	return repo.query(ctx, "current experiences", query, userID)
 }
 
-func (repo *experienceRepository) GetCurrentExperiences(ctx context.Context, userID int) ([]*entities.Experience, error) {
-	query := `SELECT experience_id, user_id, experience_company_name, experience_job_title, 
-			  experience_start_date, experience_end_date, experience_description,
-			  experience_created_at, experience_updated_at 
-			  FROM experiences WHERE user_id = ? AND (experience_end_date IS NULL OR experience_end_date = '' OR experience_end_date = '0000-00-00')
-			  ORDER BY experience_start_date DESC`
+func (repo *experienceRepository) GetAll(ctx context.Context) ([]*entities.Experience, error) {
+	query := `SELECT ` + experienceColumns + ` 
+			  FROM experiences WHERE experience_deleted_at IS NULL ORDER BY experience_start_date DESC`
+
+	return repo.query(ctx, "all experiences", query)
+}
 
-	rows, err := repo.db.QueryContext(ctx, query, userID)
+// query reads the experiences a query selects, with their details.
+func (repo *experienceRepository) query(ctx context.Context, name, query string, args ...any) ([]*entities.Experience, error) {
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, args...)
 	if err != nil {
-		repo.logger.Error("Failed to getcurrentexperiences: %v", err)
-		return nil, domain.NewDatabaseError("retrieve current experiences", err)
+		repo.logger.Error("Failed to get %s: %v", name, err)
+		return nil, domain.NewDatabaseError("retrieve "+name, err)
 	}
 	defer func() {
 		if err := rows.Close(); err != nil {
@@ -250,67 +311,151 @@ func (repo *experienceRepository) GetCurrentExperiences(ctx context.Context, use
 
 	var experiences []*entities.Experience
 	for rows.Next() {
-		var experience entities.Experience
-		err := rows.Scan(
-			&experience.ExperienceID,
-			&experience.UserID,
-			&experience.CompanyName,
-			&experience.JobTitle,
-			&experience.StartDate,
-			&experience.EndDate,
-			&experience.Description,
-			&experience.CreatedAt,
-			&experience.UpdatedAt,
-		)
+		experience, err := scanExperience(rows)
 		if err != nil {
-			repo.logger.Error("Failed to scanning current experience: %v", err)
+			repo.logger.Error("Failed to scanning experience: %v", err)
 			continue
 		}
 
-		experiences = append(experiences, &experience)
+		experiences = append(experiences, experience)
 	}
 
+	if err := repo.loadDetails(ctx, experiences...); err != nil {
+		return nil, err
+	}
 	return experiences, nil
 }
 
-func (repo *experienceRepository) GetAll(ctx context.Context) ([]*entities.Experience, error) {
-	query := `SELECT experience_id, user_id, experience_company_name, experience_job_title, 
-			  experience_start_date, experience_end_date, experience_description,
-			  experience_created_at, experience_updated_at 
-			  FROM experiences ORDER BY experience_start_date DESC`
+func (repo *experienceRepository) Reorder(ctx context.Context, userID int, experienceIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.SQLite, experienceList, []any{userID}, experienceIDs); err != nil {
+		repo.logger.Error("Failed to reorder experiences: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (repo *experienceRepository) SetAchievements(ctx context.Context, experienceID int, achievements []string) error {
+	executor := transaction.From(ctx, repo.db)
+
+	if _, err := executor.ExecContext(ctx, `DELETE FROM experience_achievements WHERE experience_id = ?`, experienceID); err != nil {
+		repo.logger.Error("Failed to clear achievements of experience %d: %v", experienceID, err)
+		return domain.NewDatabaseError("experience achievements update", err)
+	}
+
+	query := `INSERT INTO experience_achievements (experience_id, experience_achievement_position, experience_achievement_text) VALUES (?, ?, ?)`
+	for position, achievement := range achievements {
+		if _, err := executor.ExecContext(ctx, query, experienceID, position, achievement); err != nil {
+			repo.logger.Error("Failed to add achievement to experience %d: %v", experienceID, err)
+			return domain.NewDatabaseError("experience achievements update", err)
+		}
+	}
+	return nil
+}
+
+func (repo *experienceRepository) SetTechnologies(ctx context.Context, experienceID int, technologies []*entities.Technology) error {
+	executor := transaction.From(ctx, repo.db)
+
+	if _, err := executor.ExecContext(ctx, `DELETE FROM experience_technologies WHERE experience_id = ?`, experienceID); err != nil {
+		repo.logger.Error("Failed to unlink technologies of experience %d: %v", experienceID, err)
+		return domain.NewDatabaseError("experience technologies update", err)
+	}
 
-	rows, err := repo.db.QueryContext(ctx, query)
+	query := `INSERT INTO experience_technologies (experience_id, technology_id, experience_technology_position) VALUES (?, ?, ?)`
+	for position, technology := range technologies {
+		if _, err := executor.ExecContext(ctx, query, experienceID, technology.TechnologyID, position); err != nil {
+			repo.logger.Error("Failed to link technology %d to experience %d: %v", technology.TechnologyID, experienceID, err)
+			return domain.NewDatabaseError("experience technologies update", err)
+		}
+	}
+	return nil
+}
+
+// loadDetails fills in the achievements and the live technologies of
+// experiences.
+func (repo *experienceRepository) loadDetails(ctx context.Context, experiences ...*entities.Experience) error {
+	if len(experiences) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*entities.Experience, len(experiences))
+	placeholders := make([]string, 0, len(experiences))
+	args := make([]any, 0, len(experiences))
+	for _, experience := range experiences {
+		experience.Achievements = []string{}
+		experience.Technologies = []*entities.Technology{}
+		byID[experience.ExperienceID] = experience
+		placeholders = append(placeholders, "?")
+		args = append(args, experience.ExperienceID)
+	}
+	in := strings.Join(placeholders, ", ")
+
+	executor := transaction.From(ctx, repo.db)
+	rows, err := executor.QueryContext(ctx, `SELECT experience_id, experience_achievement_text FROM experience_achievements
+	          WHERE experience_id IN (`+in+`) ORDER BY experience_achievement_position`, args...)
 	if err != nil {
-		repo.logger.Error("Failed to getall experiences: %v", err)
-		return nil, domain.NewDatabaseError("retrieve all experiences", err)
+		repo.logger.Error("Failed to load experience achievements: %v", err)
+		return domain.NewDatabaseError("experience achievements retrieval", err)
 	}
 	defer func() {
-		if err := rows.Close(); err != nil {
-			repo.logger.Error("Failed to closing rows: %v", err)
-		}
+		_ = rows.Close()
 	}()
 
-	var experiences []*entities.Experience
 	for rows.Next() {
-		var experience entities.Experience
-		err := rows.Scan(
-			&experience.ExperienceID,
-			&experience.UserID,
-			&experience.CompanyName,
-			&experience.JobTitle,
-			&experience.StartDate,
-			&experience.EndDate,
-			&experience.Description,
-			&experience.CreatedAt,
-			&experience.UpdatedAt,
+		var experienceID int
+		var achievement string
+		if err := rows.Scan(&experienceID, &achievement); err != nil {
+			repo.logger.Error("Failed to scan experience achievement: %v", err)
+			return domain.NewDatabaseError("experience achievements scanning", err)
+		}
+		byID[experienceID].Achievements = append(byID[experienceID].Achievements, achievement)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.NewDatabaseError("experience achievements iteration", err)
+	}
+
+	query := `SELECT experience_technologies.experience_id, technologies.technology_id, technologies.user_id,
+	          technologies.technology_name, technologies.technology_icon_url, technologies.technology_created_at,
+	          technologies.technology_updated_at, technologies.technology_version, technologies.technology_position,
+	          technologies.technology_state, technologies.technology_publish_at, technologies.technology_unpublish_at
+	          FROM experience_technologies JOIN technologies ON technologies.technology_id = experience_technologies.technology_id
+	          WHERE technologies.technology_deleted_at IS NULL AND experience_technologies.experience_id IN (` + in + `)
+	          ORDER BY experience_technologies.experience_technology_position, technologies.technology_id`
+
+	technologyRows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		repo.logger.Error("Failed to load experience technologies: %v", err)
+		return domain.NewDatabaseError("experience technologies retrieval", err)
+	}
+	defer func() {
+		_ = technologyRows.Close()
+	}()
+
+	for technologyRows.Next() {
+		var experienceID int
+		technology := &entities.Technology{}
+		err := technologyRows.Scan(
+			&experienceID,
+			&technology.TechnologyID,
+			&technology.UserID,
+			&technology.Name,
+			&technology.IconURL,
+			&technology.CreatedAt,
+			&technology.UpdatedAt,
+			&technology.Version,
+			&technology.Position,
+			&technology.State,
+			&technology.PublishAt,
+			&technology.UnpublishAt,
 		)
 		if err != nil {
-			repo.logger.Error("Failed to scanning experience: %v", err)
-			continue
+			repo.logger.Error("Failed to scan experience technology: %v", err)
+			return domain.NewDatabaseError("experience technologies scanning", err)
 		}
-
-		experiences = append(experiences, &experience)
+		byID[experienceID].Technologies = append(byID[experienceID].Technologies, technology)
+	}
+	if err := technologyRows.Err(); err != nil {
+		return domain.NewDatabaseError("experience technologies iteration", err)
 	}
 
-	return experiences, nil
+	return nil
 }
@@ -0,0 +1,180 @@
+//go:build sqlite_fts5
+
+package sqlite_test
+
+import (
+	"database/sql"
+	"io"
+	"path/filepath"
+	"portfolio/config"
+	"portfolio/infrastructure/sqlite"
+	"portfolio/logger"
+	migration "portfolio/migrations"
+	"strings"
+	"testing"
+)
+
+// openAt opens the database at path, which applies the migrations not
+// recorded as applied yet.
+func openAt(t *testing.T, path string) *sql.DB {
+	t.Helper()
+
+	db, err := sqlite.NewConnection(&config.DatabaseConfig{
+		Driver:             config.DriverSQLite,
+		Path:               path,
+		MaxOpenConnections: 1,
+		MaxIdleConnections: 1,
+		Pragmas:            map[string]string{"foreign_keys": "ON"},
+	}, logger.NewWriterLogger(io.Discard))
+	if err != nil {
+		t.Fatalf("Failed to open SQLite database: %v", err)
+	}
+	return db
+}
+
+func exec(t *testing.T, db *sql.DB, query string, args ...any) {
+	t.Helper()
+
+	if _, err := db.Exec(query, args...); err != nil {
+		t.Fatalf("%s failed: %v", query, err)
+	}
+}
+
+// TestExperienceDetailsMigrationKeepsData runs the migrations up to 013 on a
+// database, fills it the way 013 left it, then runs the table rebuild of 014
+// and checks that rows, IDs, links and search survived it.
+func TestExperienceDetailsMigrationKeepsData(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "portfolio.sqlite3")
+	migrations, err := migration.GetMigrationFiles("sqlite")
+	if err != nil {
+		t.Fatalf("GetMigrationFiles failed: %v", err)
+	}
+	var later []string
+	for _, m := range migrations {
+		if m.Name >= "014" {
+			later = append(later, m.Name)
+		}
+	}
+
+	// Recording 014 onwards as applied stops the first open at 013.
+	raw, err := sql.Open(config.DriverSQLite, path)
+	if err != nil {
+		t.Fatalf("Failed to open SQLite database: %v", err)
+	}
+	exec(t, raw, `CREATE TABLE schema_migrations (
+		schema_migration_id INTEGER PRIMARY KEY AUTOINCREMENT,
+		schema_migration_filename TEXT NOT NULL UNIQUE,
+		schema_migration_applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`)
+	for _, name := range later {
+		exec(t, raw, "INSERT INTO schema_migrations (schema_migration_filename) VALUES (?)", name)
+	}
+	_ = raw.Close()
+
+	db := openAt(t, path)
+	exec(t, db, "INSERT INTO users (user_id, user_username, user_password) VALUES (1, 'admin', 'hashed')")
+	exec(t, db, `INSERT INTO experiences (experience_id, user_id, experience_job_title, experience_company_name,
+		experience_start_date, experience_end_date, experience_description, experience_version,
+		experience_position, experience_state)
+		VALUES (1, 1, 'Engineer', 'Acme', '2020-01-01', '2022-06-30', 'Built a kubernetes operator', 3, 2, 'archived')`)
+	exec(t, db, `INSERT INTO experiences (experience_id, user_id, experience_job_title, experience_company_name,
+		experience_description, experience_deleted_at)
+		VALUES (2, 1, 'Intern', 'Acme', 'Fixed bugs', '2024-01-01 00:00:00')`)
+	// A purged experience leaves its ID used.
+	exec(t, db, `INSERT INTO experiences (experience_id, user_id, experience_job_title, experience_company_name)
+		VALUES (3, 1, 'Purged', 'Acme')`)
+	exec(t, db, "DELETE FROM experiences WHERE experience_id = 3")
+	exec(t, db, "INSERT INTO skills (skill_id, user_id, skill_name, skill_level) VALUES (1, 1, 'Go', 5)")
+	exec(t, db, "INSERT INTO skill_experiences (skill_id, experience_id) VALUES (1, 1)")
+
+	exec(t, db, "DELETE FROM schema_migrations WHERE schema_migration_filename >= '014'")
+	_ = db.Close()
+	db = openAt(t, path)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	type row struct {
+		title, company, description, state, employmentType, location string
+		startDate, endDate, deletedAt                                sql.NullString
+		version, position                                            int
+		remote                                                       bool
+	}
+	read := func(id int) row {
+		t.Helper()
+
+		var r row
+		err := db.QueryRow(`SELECT experience_job_title, experience_company_name, experience_description,
+			experience_state, experience_employment_type, experience_location, experience_start_date,
+			experience_end_date, experience_deleted_at, experience_version, experience_position, experience_remote
+			FROM experiences WHERE experience_id = ?`, id).Scan(&r.title, &r.company, &r.description, &r.state,
+			&r.employmentType, &r.location, &r.startDate, &r.endDate, &r.deletedAt, &r.version, &r.position, &r.remote)
+		if err != nil {
+			t.Fatalf("reading experience %d failed: %v", id, err)
+		}
+		return r
+	}
+
+	first := read(1)
+	if first.title != "Engineer" || first.company != "Acme" || first.description != "Built a kubernetes operator" ||
+		first.state != "archived" || first.version != 3 || first.position != 2 ||
+		!strings.HasPrefix(first.startDate.String, "2020-01-01") || !strings.HasPrefix(first.endDate.String, "2022-06-30") ||
+		first.deletedAt.Valid {
+		t.Errorf("experience 1 after the migration = %+v, want its values kept", first)
+	}
+	if first.employmentType != "full_time" || first.location != "" || first.remote {
+		t.Errorf("experience 1 details = %q, %q, %v, want full_time, on site", first.employmentType, first.location, first.remote)
+	}
+	if second := read(2); second.title != "Intern" || !second.deletedAt.Valid {
+		t.Errorf("trashed experience 2 after the migration = %+v, want it kept in the trash", second)
+	}
+
+	var links int
+	if err := db.QueryRow("SELECT COUNT(*) FROM skill_experiences WHERE experience_id = 1").Scan(&links); err != nil {
+		t.Fatalf("counting skill links failed: %v", err)
+	}
+	if links != 1 {
+		t.Errorf("skill links of experience 1 = %d, want 1", links)
+	}
+
+	// The search index still finds the row, and its triggers follow writes.
+	match := func(term string) []int {
+		t.Helper()
+
+		rows, err := db.Query("SELECT rowid FROM experiences_fts WHERE experiences_fts MATCH ? ORDER BY rowid", term)
+		if err != nil {
+			t.Fatalf("searching %q failed: %v", term, err)
+		}
+		defer func() { _ = rows.Close() }()
+		var ids []int
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				t.Fatalf("scanning a search hit failed: %v", err)
+			}
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	if ids := match("kubernetes"); len(ids) != 1 || ids[0] != 1 {
+		t.Errorf("search for kubernetes = %v, want [1]", ids)
+	}
+	exec(t, db, "UPDATE experiences SET experience_description = 'Ran terraform' WHERE experience_id = 1")
+	if ids := match("kubernetes"); len(ids) != 0 {
+		t.Errorf("search for the old description = %v, want none", ids)
+	}
+	if ids := match("terraform"); len(ids) != 1 || ids[0] != 1 {
+		t.Errorf("search for terraform = %v, want [1]", ids)
+	}
+
+	// The same title at the same company is now allowed, and new rows do not
+	// reuse the purged ID.
+	result, err := db.Exec(`INSERT INTO experiences (user_id, experience_job_title, experience_company_name)
+		VALUES (1, 'Engineer', 'Acme')`)
+	if err != nil {
+		t.Fatalf("inserting a second Engineer position at Acme failed: %v", err)
+	}
+	if id, _ := result.LastInsertId(); id != 4 {
+		t.Errorf("ID of a new experience = %d, want 4", id)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
 	"portfolio/domain/utils"
+	"portfolio/infrastructure/transaction"
 	"portfolio/logger"
 	"strings"
 	"time"
@@ -26,8 +27,8 @@ func (repo *personalInfoRepository) Get(ctx context.Context) (*entities.Personal
 	var info entities.PersonalInfo
 	var dateOfBirth time.Time
 
-	query := `SELECT personal_info_id, user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture, personal_info_created_at, personal_info_updated_at FROM personal_infos LIMIT 1`
-	row := repo.db.QueryRowContext(ctx, query)
+	query := `SELECT personal_info_id, user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture, personal_info_created_at, personal_info_updated_at, personal_info_version FROM personal_infos WHERE personal_info_deleted_at IS NULL LIMIT 1`
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query)
 
 	err := row.Scan(
 		&info.PersonalInfoID,
@@ -49,6 +50,7 @@ func (repo *personalInfoRepository) Get(ctx context.Context) (*entities.Personal
 		&info.ProfilePicture,
 		&info.CreatedAt,
 		&info.UpdatedAt,
+		&info.Version,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -68,9 +70,9 @@ func (repo *personalInfoRepository) GetByID(ctx context.Context, personalInfoID
 	var personalInfo entities.PersonalInfo
 
 	var dateOfBirth time.Time
-	query := `SELECT personal_info_id, user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture, personal_info_created_at, personal_info_updated_at FROM personal_infos WHERE personal_info_id = ?`
+	query := `SELECT personal_info_id, user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture, personal_info_created_at, personal_info_updated_at, personal_info_version FROM personal_infos WHERE personal_info_id = ? AND personal_info_deleted_at IS NULL`
 
-	row := repo.db.QueryRowContext(ctx, query, personalInfoID)
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, personalInfoID)
 	err := row.Scan(
 		&personalInfo.PersonalInfoID,
 		&personalInfo.UserID,
@@ -91,6 +93,7 @@ func (repo *personalInfoRepository) GetByID(ctx context.Context, personalInfoID
 		&personalInfo.ProfilePicture,
 		&personalInfo.CreatedAt,
 		&personalInfo.UpdatedAt,
+		&personalInfo.Version,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -116,7 +119,7 @@ func (repo *personalInfoRepository) Create(ctx context.Context, personalInfo *en
 		dateOfBirth = &t
 	}
 
-	_, err := repo.db.ExecContext(ctx, query,
+	_, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
 		personalInfo.UserID,
 		personalInfo.FirstName,
 		personalInfo.LastName,
@@ -158,8 +161,8 @@ func (repo *personalInfoRepository) Update(ctx context.Context, personalInfoId i
 		personal_info_date_of_birth = ?, 
 		personal_info_phone_number = ?, 
 		personal_info_interests = ?, 
-		personal_info_profile_picture = ? 
-	WHERE personal_info_id = ?`
+		personal_info_profile_picture = ?, personal_info_version = personal_info_version + 1 
+	WHERE personal_info_id = ? AND personal_info_deleted_at IS NULL`
 
 	// Convert utils.Date to time.Time for database storage
 	var dateOfBirth *time.Time
@@ -168,7 +171,8 @@ func (repo *personalInfoRepository) Update(ctx context.Context, personalInfoId i
 		dateOfBirth = &t
 	}
 
-	_, err := repo.db.ExecContext(ctx, query,
+	condition := transaction.VersionCondition(ctx, "personal_info_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
 		personalInfo.FirstName,
 		personalInfo.LastName,
 		personalInfo.ProfessionalTitle,
@@ -190,6 +194,10 @@ func (repo *personalInfoRepository) Update(ctx context.Context, personalInfoId i
 		repo.logger.Error("Failed to update personalinfo: %v", err)
 		return nil, domain.NewDatabaseError("update personal information", err)
 	}
+
+	if err := transaction.CheckVersion(result, condition, "Personal info", personalInfoId); err != nil {
+		return nil, err
+	}
 	return repo.Get(ctx)
 }
 
@@ -238,29 +246,40 @@ func (repo *personalInfoRepository) Patch(ctx context.Context, personalInfoId in
 		return repo.Get(ctx)
 	}
 
-	query := "UPDATE personal_infos SET " + strings.Join(setClauses, ", ") + " WHERE personal_info_id = ?"
+	query := "UPDATE personal_infos SET " + strings.Join(setClauses, ", ") + ", personal_info_version = personal_info_version + 1 WHERE personal_info_id = ? AND personal_info_deleted_at IS NULL"
 	args = append(args, personalInfoId)
 	fmt.Println("Executing query:", query, "with args:", args)
-	if _, err := repo.db.ExecContext(ctx, query, args...); err != nil {
+	condition := transaction.VersionCondition(ctx, "personal_info_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, args...)
+	if err != nil {
 		repo.logger.Error("Failed to patch personalinfo: %v", err)
 		return nil, fmt.Errorf("unable to patch personal information: %w", err)
 	}
+
+	if err := transaction.CheckVersion(result, condition, "Personal info", personalInfoId); err != nil {
+		return nil, err
+	}
 	return repo.Get(ctx)
 }
 
 func (repo *personalInfoRepository) Delete(ctx context.Context, personalInfoId int) error {
-	query := `DELETE FROM personal_infos WHERE personal_info_id = ?`
-	_, err := repo.db.ExecContext(ctx, query, personalInfoId)
+	query := `UPDATE personal_infos SET personal_info_deleted_at = ?, personal_info_version = personal_info_version + 1 WHERE personal_info_id = ? AND personal_info_deleted_at IS NULL`
+	condition := transaction.VersionCondition(ctx, "personal_info_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), personalInfoId)
 	if err != nil {
 		repo.logger.Error("Failed to delete personalinfo: %v", err)
 		return domain.NewDatabaseError("delete personal information", err)
 	}
+
+	if err := transaction.CheckVersion(result, condition, "Personal info", personalInfoId); err != nil {
+		return err
+	}
 	return nil
 }
 
 func (repo *personalInfoRepository) GetByUserID(ctx context.Context, userID int) (*entities.PersonalInfo, error) {
-	query := `SELECT personal_info_id, user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture, personal_info_created_at, personal_info_updated_at FROM personal_infos WHERE user_id = ?`
-	row := repo.db.QueryRowContext(ctx, query, userID)
+	query := `SELECT personal_info_id, user_id, personal_info_first_name, personal_info_last_name, personal_info_professional_title, personal_info_intro, personal_info_about_me, personal_info_location, personal_info_resume_url, personal_info_website_url, personal_info_linkedin_url, personal_info_github_url, personal_info_x_url, personal_info_date_of_birth, personal_info_phone_number, personal_info_interests, personal_info_profile_picture, personal_info_created_at, personal_info_updated_at, personal_info_version FROM personal_infos WHERE user_id = ? AND personal_info_deleted_at IS NULL`
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID)
 
 	info := &entities.PersonalInfo{}
 	var dateOfBirth time.Time
@@ -285,6 +304,7 @@ func (repo *personalInfoRepository) GetByUserID(ctx context.Context, userID int)
 		&info.ProfilePicture,
 		&info.CreatedAt,
 		&info.UpdatedAt,
+		&info.Version,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -301,9 +321,9 @@ func (repo *personalInfoRepository) GetByUserID(ctx context.Context, userID int)
 }
 
 func (repo *personalInfoRepository) ExistsByID(ctx context.Context, personalInfoId int) (bool, error) {
-	query := `SELECT COUNT(*) FROM personal_infos WHERE personal_info_id = ?`
+	query := `SELECT COUNT(*) FROM personal_infos WHERE personal_info_id = ? AND personal_info_deleted_at IS NULL`
 	var count int
-	err := repo.db.QueryRowContext(ctx, query, personalInfoId).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, personalInfoId).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbyid personalinfo: %v", err)
 		return false, domain.NewDatabaseError("check if personal information exists by ID", err)
@@ -314,7 +334,7 @@ func (repo *personalInfoRepository) ExistsByID(ctx context.Context, personalInfo
 func (repo *personalInfoRepository) ExistsByUserID(ctx context.Context, userID int) (bool, error) {
 	query := `SELECT COUNT(*) FROM personal_infos WHERE user_id = ?`
 	var count int
-	err := repo.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbyuserid personalinfo: %v", err)
 		return false, domain.NewDatabaseError("check if personal information exists by user ID", err)
@@ -325,7 +345,7 @@ func (repo *personalInfoRepository) ExistsByUserID(ctx context.Context, userID i
 func (repo *personalInfoRepository) GetUserByID(ctx context.Context, userID int) (*entities.User, error) {
 	query := `SELECT user_id, user_username, user_email, user_role, user_is_active, user_created_at, user_updated_at FROM users WHERE user_id = ?`
 	user := &entities.User{}
-	err := repo.db.QueryRowContext(ctx, query, userID).Scan(
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Email,
@@ -0,0 +1,192 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"time"
+)
+
+type projectLinkRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewProjectLinkRepository(db *sql.DB, logger *logger.Logger) interfaces.ProjectLinkRepository {
+	return &projectLinkRepository{db: db, logger: logger}
+}
+
+const projectLinkColumns = `project_link_id, project_id, project_link_type, project_link_url, project_link_label,
+	project_link_position, project_link_is_primary, project_link_created_at, project_link_updated_at`
+
+func scanProjectLink(row rowScanner) (*entities.ProjectLink, error) {
+	link := &entities.ProjectLink{}
+	err := row.Scan(
+		&link.ProjectLinkID,
+		&link.ProjectID,
+		&link.Type,
+		&link.URL,
+		&link.Label,
+		&link.Position,
+		&link.IsPrimary,
+		&link.CreatedAt,
+		&link.UpdatedAt,
+	)
+	return link, err
+}
+
+func (repo *projectLinkRepository) GetByID(ctx context.Context, linkID int) (*entities.ProjectLink, error) {
+	query := `SELECT ` + projectLinkColumns + ` FROM project_links WHERE project_link_id = ?`
+
+	link, err := scanProjectLink(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, linkID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.NewNotFoundError("Project link", fmt.Sprint(linkID))
+		}
+		repo.logger.Error("Failed to get project link %d: %v", linkID, err)
+		return nil, domain.NewDatabaseError("project link retrieval by ID", err)
+	}
+	return link, nil
+}
+
+func (repo *projectLinkRepository) GetByProjectID(ctx context.Context, projectID int) ([]*entities.ProjectLink, error) {
+	query := `SELECT ` + projectLinkColumns + ` FROM project_links WHERE project_id = ?
+	          ORDER BY project_link_position, project_link_id`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, projectID)
+	if err != nil {
+		repo.logger.Error("Failed to get links of project %d: %v", projectID, err)
+		return nil, domain.NewDatabaseError("project link retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	links := []*entities.ProjectLink{}
+	for rows.Next() {
+		item, err := scanProjectLink(rows)
+		if err != nil {
+			repo.logger.Error("Failed to scan project link: %v", err)
+			return nil, domain.NewDatabaseError("project link scanning", err)
+		}
+		links = append(links, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("project link iteration", err)
+	}
+	return links, nil
+}
+
+func (repo *projectLinkRepository) Create(ctx context.Context, link *entities.ProjectLink) (*entities.ProjectLink, error) {
+	query := `INSERT INTO project_links (project_id, project_link_type, project_link_url, project_link_label,
+	          project_link_position, project_link_is_primary, project_link_created_at, project_link_updated_at)
+	          VALUES (?, ?, ?, ?, (SELECT coalesce(max(project_link_position) + 1, 0) FROM project_links WHERE project_id = ?), ?, ?, ?)`
+
+	now := time.Now()
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
+		link.ProjectID,
+		link.Type,
+		link.URL,
+		link.Label,
+		link.ProjectID,
+		link.IsPrimary,
+		now,
+		now,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to create project link: %v", err)
+		return nil, domain.NewDatabaseError("project link creation", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		repo.logger.Error("Failed to create project link lastinsertid: %v", err)
+		return nil, domain.NewDatabaseError("project link id retrieval", err)
+	}
+
+	if err := repo.takePrimary(ctx, link.ProjectID, int(id), link.IsPrimary); err != nil {
+		return nil, err
+	}
+	return repo.GetByID(ctx, int(id))
+}
+
+func (repo *projectLinkRepository) Update(ctx context.Context, linkID int, link *entities.ProjectLink) (*entities.ProjectLink, error) {
+	query := `UPDATE project_links SET project_link_type = ?, project_link_url = ?, project_link_label = ?,
+	          project_link_is_primary = ?, project_link_updated_at = ? WHERE project_link_id = ?`
+
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
+		link.Type,
+		link.URL,
+		link.Label,
+		link.IsPrimary,
+		time.Now(),
+		linkID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update project link %d: %v", linkID, err)
+		return nil, domain.NewDatabaseError("project link update", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, domain.NewDatabaseError("project link update verification", err)
+	}
+	if rowsAffected == 0 {
+		return nil, domain.NewNotFoundError("Project link", fmt.Sprint(linkID))
+	}
+
+	if err := repo.takePrimary(ctx, link.ProjectID, linkID, link.IsPrimary); err != nil {
+		return nil, err
+	}
+	return repo.GetByID(ctx, linkID)
+}
+
+func (repo *projectLinkRepository) Delete(ctx context.Context, linkID int) error {
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, `DELETE FROM project_links WHERE project_link_id = ?`, linkID)
+	if err != nil {
+		repo.logger.Error("Failed to delete project link %d: %v", linkID, err)
+		return domain.NewDatabaseError("project link deletion", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domain.NewDatabaseError("project link deletion verification", err)
+	}
+	if rowsAffected == 0 {
+		return domain.NewNotFoundError("Project link", fmt.Sprint(linkID))
+	}
+	return nil
+}
+
+func (repo *projectLinkRepository) Reorder(ctx context.Context, projectID int, linkIDs []int) error {
+	executor := transaction.From(ctx, repo.db)
+	query := `UPDATE project_links SET project_link_position = ? WHERE project_link_id = ? AND project_id = ?`
+
+	for position, linkID := range linkIDs {
+		if _, err := executor.ExecContext(ctx, query, position, linkID, projectID); err != nil {
+			repo.logger.Error("Failed to move project link %d: %v", linkID, err)
+			return domain.NewDatabaseError("project link reordering", err)
+		}
+	}
+	return nil
+}
+
+// takePrimary clears the primary flag of the project's other links once
+// linkID is primary.
+func (repo *projectLinkRepository) takePrimary(ctx context.Context, projectID, linkID int, isPrimary bool) error {
+	if !isPrimary {
+		return nil
+	}
+
+	query := `UPDATE project_links SET project_link_is_primary = 0 WHERE project_id = ? AND project_link_id <> ?`
+	if _, err := transaction.From(ctx, repo.db).ExecContext(ctx, query, projectID, linkID); err != nil {
+		repo.logger.Error("Failed to clear primary link of project %d: %v", projectID, err)
+		return domain.NewDatabaseError("project link update", err)
+	}
+	return nil
+}
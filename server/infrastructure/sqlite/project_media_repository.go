@@ -0,0 +1,199 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"time"
+)
+
+type projectMediaRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewProjectMediaRepository(db *sql.DB, logger *logger.Logger) interfaces.ProjectMediaRepository {
+	return &projectMediaRepository{db: db, logger: logger}
+}
+
+const projectMediaColumns = `project_media_id, project_id, project_media_type, project_media_url, project_media_caption,
+	project_media_alt_text, project_media_position, project_media_is_primary, project_media_created_at, project_media_updated_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanProjectMedia(row rowScanner) (*entities.ProjectMedia, error) {
+	media := &entities.ProjectMedia{}
+	err := row.Scan(
+		&media.ProjectMediaID,
+		&media.ProjectID,
+		&media.Type,
+		&media.URL,
+		&media.Caption,
+		&media.AltText,
+		&media.Position,
+		&media.IsPrimary,
+		&media.CreatedAt,
+		&media.UpdatedAt,
+	)
+	return media, err
+}
+
+func (repo *projectMediaRepository) GetByID(ctx context.Context, mediaID int) (*entities.ProjectMedia, error) {
+	query := `SELECT ` + projectMediaColumns + ` FROM project_media WHERE project_media_id = ?`
+
+	media, err := scanProjectMedia(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, mediaID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.NewNotFoundError("Project media", fmt.Sprint(mediaID))
+		}
+		repo.logger.Error("Failed to get project media %d: %v", mediaID, err)
+		return nil, domain.NewDatabaseError("project media retrieval by ID", err)
+	}
+	return media, nil
+}
+
+func (repo *projectMediaRepository) GetByProjectID(ctx context.Context, projectID int) ([]*entities.ProjectMedia, error) {
+	query := `SELECT ` + projectMediaColumns + ` FROM project_media WHERE project_id = ?
+	          ORDER BY project_media_position, project_media_id`
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, projectID)
+	if err != nil {
+		repo.logger.Error("Failed to get media of project %d: %v", projectID, err)
+		return nil, domain.NewDatabaseError("project media retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	media := []*entities.ProjectMedia{}
+	for rows.Next() {
+		item, err := scanProjectMedia(rows)
+		if err != nil {
+			repo.logger.Error("Failed to scan project media: %v", err)
+			return nil, domain.NewDatabaseError("project media scanning", err)
+		}
+		media = append(media, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("project media iteration", err)
+	}
+	return media, nil
+}
+
+func (repo *projectMediaRepository) Create(ctx context.Context, media *entities.ProjectMedia) (*entities.ProjectMedia, error) {
+	query := `INSERT INTO project_media (project_id, project_media_type, project_media_url, project_media_caption,
+	          project_media_alt_text, project_media_position, project_media_is_primary, project_media_created_at, project_media_updated_at)
+	          VALUES (?, ?, ?, ?, ?, (SELECT coalesce(max(project_media_position) + 1, 0) FROM project_media WHERE project_id = ?), ?, ?, ?)`
+
+	now := time.Now()
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
+		media.ProjectID,
+		media.Type,
+		media.URL,
+		media.Caption,
+		media.AltText,
+		media.ProjectID,
+		media.IsPrimary,
+		now,
+		now,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to create project media: %v", err)
+		return nil, domain.NewDatabaseError("project media creation", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		repo.logger.Error("Failed to create project media lastinsertid: %v", err)
+		return nil, domain.NewDatabaseError("project media id retrieval", err)
+	}
+
+	if err := repo.takePrimary(ctx, media.ProjectID, int(id), media.IsPrimary); err != nil {
+		return nil, err
+	}
+	return repo.GetByID(ctx, int(id))
+}
+
+func (repo *projectMediaRepository) Update(ctx context.Context, mediaID int, media *entities.ProjectMedia) (*entities.ProjectMedia, error) {
+	query := `UPDATE project_media SET project_media_type = ?, project_media_url = ?, project_media_caption = ?,
+	          project_media_alt_text = ?, project_media_is_primary = ?, project_media_updated_at = ? WHERE project_media_id = ?`
+
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
+		media.Type,
+		media.URL,
+		media.Caption,
+		media.AltText,
+		media.IsPrimary,
+		time.Now(),
+		mediaID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update project media %d: %v", mediaID, err)
+		return nil, domain.NewDatabaseError("project media update", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, domain.NewDatabaseError("project media update verification", err)
+	}
+	if rowsAffected == 0 {
+		return nil, domain.NewNotFoundError("Project media", fmt.Sprint(mediaID))
+	}
+
+	if err := repo.takePrimary(ctx, media.ProjectID, mediaID, media.IsPrimary); err != nil {
+		return nil, err
+	}
+	return repo.GetByID(ctx, mediaID)
+}
+
+func (repo *projectMediaRepository) Delete(ctx context.Context, mediaID int) error {
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, `DELETE FROM project_media WHERE project_media_id = ?`, mediaID)
+	if err != nil {
+		repo.logger.Error("Failed to delete project media %d: %v", mediaID, err)
+		return domain.NewDatabaseError("project media deletion", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domain.NewDatabaseError("project media deletion verification", err)
+	}
+	if rowsAffected == 0 {
+		return domain.NewNotFoundError("Project media", fmt.Sprint(mediaID))
+	}
+	return nil
+}
+
+func (repo *projectMediaRepository) Reorder(ctx context.Context, projectID int, mediaIDs []int) error {
+	executor := transaction.From(ctx, repo.db)
+	query := `UPDATE project_media SET project_media_position = ? WHERE project_media_id = ? AND project_id = ?`
+
+	for position, mediaID := range mediaIDs {
+		if _, err := executor.ExecContext(ctx, query, position, mediaID, projectID); err != nil {
+			repo.logger.Error("Failed to move project media %d: %v", mediaID, err)
+			return domain.NewDatabaseError("project media reordering", err)
+		}
+	}
+	return nil
+}
+
+// takePrimary clears the primary flag of the project's other items once
+// mediaID is primary.
+func (repo *projectMediaRepository) takePrimary(ctx context.Context, projectID, mediaID int, isPrimary bool) error {
+	if !isPrimary {
+		return nil
+	}
+
+	query := `UPDATE project_media SET project_media_is_primary = 0 WHERE project_id = ? AND project_media_id <> ?`
+	if _, err := transaction.From(ctx, repo.db).ExecContext(ctx, query, projectID, mediaID); err != nil {
+		repo.logger.Error("Failed to clear primary media of project %d: %v", projectID, err)
+		return domain.NewDatabaseError("project media update", err)
+	}
+	return nil
+}
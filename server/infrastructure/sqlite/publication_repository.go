@@ -0,0 +1,259 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strings"
+	"time"
+)
+
+type publicationRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewPublicationRepository(db *sql.DB, logger *logger.Logger) interfaces.PublicationRepository {
+	return &publicationRepository{db: db, logger: logger}
+}
+
+const publicationColumns = "publication_id, user_id, publication_title, publication_type, publication_venue, publication_date, publication_url, publication_slides_url, publication_video_url, publication_description, publication_created_at, publication_updated_at, publication_version, publication_position, publication_state, publication_publish_at, publication_unpublish_at"
+
+var publicationList = listing.Table{
+	Name:     "publications",
+	IDColumn: "publication_id",
+	Columns:  publicationColumns,
+	Scope:    "user_id = ? AND publication_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":   "publication_position",
+		"date":       "publication_date",
+		"title":      "lower(publication_title)",
+		"type":       "publication_type",
+		"created_at": "publication_created_at",
+	},
+	Filters: map[string]string{
+		"state": "publication_state = ?",
+		"type":  "publication_type = ?",
+		"venue": "lower(publication_venue) = lower(?)",
+	},
+	Position: "publication_position",
+}
+
+func scanPublication(row rowScanner) (*entities.Publication, error) {
+	publication := &entities.Publication{}
+	err := row.Scan(
+		&publication.PublicationID,
+		&publication.UserID,
+		&publication.Title,
+		&publication.Type,
+		&publication.Venue,
+		&publication.Date,
+		&publication.URL,
+		&publication.SlidesURL,
+		&publication.VideoURL,
+		&publication.Description,
+		&publication.CreatedAt,
+		&publication.UpdatedAt,
+		&publication.Version,
+		&publication.Position,
+		&publication.State,
+		&publication.PublishAt,
+		&publication.UnpublishAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return publication, nil
+}
+
+func (repo *publicationRepository) Create(ctx context.Context, publication *entities.Publication) (*entities.Publication, error) {
+	executor := transaction.From(ctx, repo.db)
+	position, err := listing.NextPosition(ctx, executor, listing.SQLite, publicationList, []any{publication.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create publication: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO publications (user_id, publication_title, publication_type, publication_venue, publication_date,
+			  publication_url, publication_slides_url, publication_video_url, publication_description,
+			  publication_position, publication_state, publication_publish_at, publication_unpublish_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := executor.ExecContext(ctx, query,
+		publication.UserID,
+		publication.Title,
+		publication.Type,
+		publication.Venue,
+		publication.Date,
+		publication.URL,
+		publication.SlidesURL,
+		publication.VideoURL,
+		publication.Description,
+		position,
+		publication.State,
+		publication.PublishAt,
+		publication.UnpublishAt,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to create publication: %v", err)
+		return nil, domain.NewDatabaseError("create publication", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		repo.logger.Error("Failed to get publication ID after creation: %v", err)
+		return nil, domain.NewDatabaseError("get publication ID", err)
+	}
+
+	return repo.GetByID(ctx, int(id))
+}
+
+func (repo *publicationRepository) GetByID(ctx context.Context, publicationID int) (*entities.Publication, error) {
+	query := `SELECT ` + publicationColumns + ` FROM publications WHERE publication_id = ? AND publication_deleted_at IS NULL`
+
+	publication, err := scanPublication(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, publicationID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to retrieve publication by ID: %v", err)
+		return nil, domain.NewDatabaseError("retrieve publication", err)
+	}
+
+	if err := repo.loadCoAuthors(ctx, publication); err != nil {
+		return nil, err
+	}
+	return publication, nil
+}
+
+func (repo *publicationRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Publication], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.SQLite, publicationList, []any{userID}, query,
+		func(rows *sql.Rows) (*entities.Publication, error) { return scanPublication(rows) },
+		func(publication *entities.Publication) int { return publication.PublicationID })
+	if err != nil {
+		repo.logger.Error("Failed to retrieve publications by user ID: %v", err)
+		return nil, err
+	}
+
+	if err := repo.loadCoAuthors(ctx, page.Items...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (repo *publicationRepository) Update(ctx context.Context, publicationID int, publication *entities.Publication) (*entities.Publication, error) {
+	query := `UPDATE publications SET publication_title = ?, publication_type = ?, publication_venue = ?, publication_date = ?,
+			  publication_url = ?, publication_slides_url = ?, publication_video_url = ?, publication_description = ?,
+			  publication_updated_at = ?, publication_version = publication_version + 1
+			  WHERE publication_id = ? AND publication_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "publication_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		publication.Title,
+		publication.Type,
+		publication.Venue,
+		publication.Date,
+		publication.URL,
+		publication.SlidesURL,
+		publication.VideoURL,
+		publication.Description,
+		time.Now(),
+		publicationID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update publication: %v", err)
+		return nil, domain.NewDatabaseError("update publication", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Publication", publicationID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, publicationID)
+}
+
+func (repo *publicationRepository) Delete(ctx context.Context, publicationID int) error {
+	query := `UPDATE publications SET publication_deleted_at = ?, publication_version = publication_version + 1
+			  WHERE publication_id = ? AND publication_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "publication_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), publicationID)
+	if err != nil {
+		repo.logger.Error("Failed to delete publication: %v", err)
+		return domain.NewDatabaseError("delete publication", err)
+	}
+
+	return transaction.CheckVersion(result, condition, "Publication", publicationID)
+}
+
+func (repo *publicationRepository) Reorder(ctx context.Context, userID int, publicationIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.SQLite, publicationList, []any{userID}, publicationIDs); err != nil {
+		repo.logger.Error("Failed to reorder publications: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (repo *publicationRepository) SetCoAuthors(ctx context.Context, publicationID int, coAuthors []string) error {
+	executor := transaction.From(ctx, repo.db)
+
+	if _, err := executor.ExecContext(ctx, `DELETE FROM publication_co_authors WHERE publication_id = ?`, publicationID); err != nil {
+		repo.logger.Error("Failed to clear co-authors of publication %d: %v", publicationID, err)
+		return domain.NewDatabaseError("publication co-authors update", err)
+	}
+
+	query := `INSERT INTO publication_co_authors (publication_id, publication_co_author_position, publication_co_author_name) VALUES (?, ?, ?)`
+	for position, coAuthor := range coAuthors {
+		if _, err := executor.ExecContext(ctx, query, publicationID, position, coAuthor); err != nil {
+			repo.logger.Error("Failed to add co-author to publication %d: %v", publicationID, err)
+			return domain.NewDatabaseError("publication co-authors update", err)
+		}
+	}
+	return nil
+}
+
+// loadCoAuthors fills in the co-authors of publications.
+func (repo *publicationRepository) loadCoAuthors(ctx context.Context, publications ...*entities.Publication) error {
+	if len(publications) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*entities.Publication, len(publications))
+	placeholders := make([]string, 0, len(publications))
+	args := make([]any, 0, len(publications))
+	for _, publication := range publications {
+		publication.CoAuthors = []string{}
+		byID[publication.PublicationID] = publication
+		placeholders = append(placeholders, "?")
+		args = append(args, publication.PublicationID)
+	}
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, `SELECT publication_id, publication_co_author_name FROM publication_co_authors
+	          WHERE publication_id IN (`+strings.Join(placeholders, ", ")+`) ORDER BY publication_co_author_position`, args...)
+	if err != nil {
+		repo.logger.Error("Failed to load publication co-authors: %v", err)
+		return domain.NewDatabaseError("publication co-authors retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var publicationID int
+		var coAuthor string
+		if err := rows.Scan(&publicationID, &coAuthor); err != nil {
+			repo.logger.Error("Failed to scan publication co-author: %v", err)
+			return domain.NewDatabaseError("publication co-authors scanning", err)
+		}
+		byID[publicationID].CoAuthors = append(byID[publicationID].CoAuthors, coAuthor)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.NewDatabaseError("publication co-authors iteration", err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"time"
+)
+
+// publishingPrefixes are the column prefixes of the tables with a
+// publishing state. The tables themselves are read through trashTables.
+var publishingPrefixes = map[string]string{
+	entities.TrashTypeProject:        "project",
+	entities.TrashTypeSkill:          "skill",
+	entities.TrashTypeExperience:     "experience",
+	entities.TrashTypeEducation:      "education",
+	entities.TrashTypeCertification:  "certification",
+	entities.TrashTypePublication:    "publication",
+	entities.TrashTypeSpokenLanguage: "spoken_language",
+	entities.TrashTypeAward:          "award",
+	entities.TrashTypeVolunteering:   "volunteering",
+	entities.TrashTypeTechnology:     "technology",
+}
+
+type publishingRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewPublishingRepository(db *sql.DB, logger *logger.Logger) interfaces.PublishingRepository {
+	return &publishingRepository{db: db, logger: logger}
+}
+
+func (repo *publishingRepository) GetAll(ctx context.Context) ([]*entities.PublishingItem, error) {
+	var items []*entities.PublishingItem
+	for _, itemType := range entities.PublishingTypes {
+		found, err := repo.query(ctx, itemType, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, found...)
+	}
+	return items, nil
+}
+
+func (repo *publishingRepository) Get(ctx context.Context, itemType string, id int) (*entities.PublishingItem, error) {
+	if _, ok := publishingPrefixes[itemType]; !ok {
+		return nil, domain.NewValidationError("Unknown publishing item type", "type", nil)
+	}
+
+	items, err := repo.query(ctx, itemType, " AND "+trashTables[itemType].idColumn+" = ?", []any{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, domain.NewNotFoundError("Publishing item", fmt.Sprintf("%s/%d", itemType, id))
+	}
+	return items[0], nil
+}
+
+func (repo *publishingRepository) GetDue(ctx context.Context, now time.Time) ([]*entities.PublishingItem, error) {
+	var items []*entities.PublishingItem
+	for _, itemType := range entities.PublishingTypes {
+		prefix := publishingPrefixes[itemType]
+		condition := fmt.Sprintf(` AND ((%[1]s_state IN ('scheduled', 'published') AND %[1]s_unpublish_at <= ?)
+			OR (%[1]s_state = 'scheduled' AND %[1]s_publish_at <= ?))`, prefix)
+
+		found, err := repo.query(ctx, itemType, condition, []any{now, now})
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, found...)
+	}
+	return items, nil
+}
+
+func (repo *publishingRepository) Set(ctx context.Context, itemType string, id int, publishing entities.Publishing) error {
+	prefix, ok := publishingPrefixes[itemType]
+	if !ok {
+		return domain.NewValidationError("Unknown publishing item type", "type", nil)
+	}
+	table := trashTables[itemType]
+
+	query := fmt.Sprintf(`UPDATE %[1]s SET %[2]s_state = ?, %[2]s_publish_at = ?, %[2]s_unpublish_at = ?,
+		%[2]s_updated_at = ?, %[2]s_version = %[2]s_version + 1 WHERE %[3]s = ? AND %[4]s IS NULL`,
+		table.name, prefix, table.idColumn, table.deletedColumn)
+
+	condition := transaction.VersionCondition(ctx, prefix+"_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		publishing.State,
+		publishing.PublishAt,
+		publishing.UnpublishAt,
+		time.Now(),
+		id,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to set the publishing state of %s %d: %v", itemType, id, err)
+		return domain.NewDatabaseError("publishing update", err)
+	}
+
+	return transaction.CheckVersion(result, condition, "Publishing item", id)
+}
+
+// query reads the publishing state of the live items of itemType that match the
+// extra condition.
+func (repo *publishingRepository) query(ctx context.Context, itemType, condition string, args []any) ([]*entities.PublishingItem, error) {
+	table, prefix := trashTables[itemType], publishingPrefixes[itemType]
+	query := fmt.Sprintf(`SELECT %[1]s, user_id, %[2]s, %[4]s_version, %[4]s_state, %[4]s_publish_at, %[4]s_unpublish_at
+		FROM %[3]s WHERE %[5]s IS NULL%[6]s ORDER BY %[1]s`,
+		table.idColumn, table.labelExpr, table.name, prefix, table.deletedColumn, condition)
+
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		repo.logger.Error("Failed to read the publishing state of %s: %v", itemType, err)
+		return nil, domain.NewDatabaseError("publishing retrieval", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			repo.logger.Error("Failed to closing rows: %v", closeErr)
+		}
+	}()
+
+	var items []*entities.PublishingItem
+	for rows.Next() {
+		item := &entities.PublishingItem{Type: itemType}
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Label, &item.Version,
+			&item.State, &item.PublishAt, &item.UnpublishAt); err != nil {
+			repo.logger.Error("Failed to scan the publishing state of %s: %v", itemType, err)
+			return nil, domain.NewDatabaseError("publishing scanning", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		repo.logger.Error("Failed to iterate the publishing state of %s: %v", itemType, err)
+		return nil, domain.NewDatabaseError("publishing iteration", err)
+	}
+	return items, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
 	"portfolio/logger"
 )
 
@@ -17,7 +18,7 @@ func NewRevokedTokenRepository(db *sql.DB, logger *logger.Logger) interfaces.Rev
 }
 
 func (repo *revokedTokenRepository) RevokedToken(ctx context.Context, userID int, token string) error {
-	_, err := repo.db.ExecContext(ctx, "INSERT INTO revoked_tokens (user_id, token) VALUES (?, ?)", userID, token)
+	_, err := transaction.From(ctx, repo.db).ExecContext(ctx, "INSERT INTO revoked_tokens (user_id, token) VALUES (?, ?)", userID, token)
 	if err != nil {
 		repo.logger.Error("failed to revoke token for user %d: %v", userID, err)
 	}
@@ -26,7 +27,7 @@ func (repo *revokedTokenRepository) RevokedToken(ctx context.Context, userID int
 
 func (repo *revokedTokenRepository) IsTokenRevoked(ctx context.Context, userID int, token string) (bool, error) {
 	var count int
-	err := repo.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM revoked_tokens WHERE user_id = ? AND token = ?", userID, token).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM revoked_tokens WHERE user_id = ? AND token = ?", userID, token).Scan(&count)
 	if err != nil {
 		repo.logger.Error("failed to check if token is revoked for user %d: %v", userID, err)
 		return false, err
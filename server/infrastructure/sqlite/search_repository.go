@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"strings"
+)
+
+// searchTable names the FTS5 index of a table and the bm25 weights of its
+// indexed columns, in index order. The table itself is read through
+// trashTables.
+type searchTable struct {
+	ftsName string
+	weights string
+}
+
+var searchTables = map[string]searchTable{
+	entities.SearchTypeProject:      {"projects_fts", "10.0, 4.0, 1.0, 2.0"},
+	entities.SearchTypeSkill:        {"skills_fts", "1.0"},
+	entities.SearchTypeExperience:   {"experiences_fts", "10.0, 5.0, 1.0"},
+	entities.SearchTypeEducation:    {"educations_fts", "10.0, 5.0, 1.0"},
+	entities.SearchTypePersonalInfo: {"personal_infos_fts", "10.0, 10.0, 5.0, 2.0, 1.0, 2.0, 2.0"},
+}
+
+type searchRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewSearchRepository(db *sql.DB, logger *logger.Logger) interfaces.SearchRepository {
+	return &searchRepository{db: db, logger: logger}
+}
+
+func (repo *searchRepository) Search(ctx context.Context, query entities.SearchQuery) ([]*entities.SearchHit, error) {
+	match := matchExpression(query.Terms)
+
+	var hits []*entities.SearchHit
+	for _, itemType := range entities.SearchTypes {
+		table, index := trashTables[itemType], searchTables[itemType]
+
+		filter := ""
+		if prefix, ok := publishingPrefixes[itemType]; ok && !query.IncludeHidden {
+			filter = " AND " + prefix + "_state = 'published'"
+		}
+		if itemType == entities.SearchTypeProject && !query.IncludeHidden {
+			// Public project pages only show active projects.
+			filter += " AND project_status = 'active'"
+		}
+
+		// snippet() and bm25() only work in the query on the index itself,
+		// so the join back to the table happens outside of it.
+		statement := fmt.Sprintf(`SELECT %s, %s, matches.snippet, matches.score
+			FROM (
+				SELECT rowid, snippet(%s, -1, ?, ?, '…', 16) AS snippet, bm25(%s, %s) AS score
+				FROM %s WHERE %s MATCH ?
+			) AS matches
+			JOIN %s ON %s = matches.rowid
+			WHERE user_id = ? AND %s IS NULL%s
+			ORDER BY matches.score
+			LIMIT ?`,
+			table.idColumn, table.labelExpr,
+			index.ftsName, index.ftsName, index.weights,
+			index.ftsName, index.ftsName,
+			table.name, table.idColumn,
+			table.deletedColumn, filter)
+
+		rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, statement,
+			entities.SearchHighlightStart, entities.SearchHighlightEnd, match, query.UserID, query.Limit)
+		if err != nil {
+			repo.logger.Error("Failed to search %s: %v", itemType, err)
+			return nil, domain.NewDatabaseError("search", err)
+		}
+
+		for rows.Next() {
+			hit := &entities.SearchHit{Type: itemType}
+			var bm25 float64
+			if err := rows.Scan(&hit.ID, &hit.Title, &hit.Snippet, &bm25); err != nil {
+				_ = rows.Close()
+				repo.logger.Error("Failed to scan %s search hit: %v", itemType, err)
+				return nil, domain.NewDatabaseError("search scanning", err)
+			}
+			// bm25 is negative, lower being better.
+			hit.Score = -bm25
+			hits = append(hits, hit)
+		}
+		err = rows.Err()
+		if closeErr := rows.Close(); closeErr != nil {
+			repo.logger.Error("Failed to closing rows: %v", closeErr)
+		}
+		if err != nil {
+			repo.logger.Error("Failed to iterate %s search hits: %v", itemType, err)
+			return nil, domain.NewDatabaseError("search iteration", err)
+		}
+	}
+
+	return hits, nil
+}
+
+// matchExpression requires every term, each as a prefix. Terms are made of
+// letters and digits only, so quoting them is enough to keep FTS5 from
+// reading them as operators.
+func matchExpression(terms []string) string {
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, `"`+term+`"*`)
+	}
+	return strings.Join(quoted, " ")
+}
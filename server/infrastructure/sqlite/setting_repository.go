@@ -3,10 +3,11 @@ package sqlite
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
 	"portfolio/logger"
+	"strings"
 )
 
 type settingRepository struct {
@@ -23,14 +24,11 @@ func NewSettingRepository(db *sql.DB, logger *logger.Logger, settingKey string)
 	}
 }
 
-func (repo *settingRepository) Upsert(ctx context.Context, settingJson *entities.SettingJson) error {
-
-	dataBytes, err := json.Marshal(settingJson)
-
-	if err != nil {
-		return err
-	}
+func (repo *settingRepository) namespaceKey(namespace entities.SettingNamespace) string {
+	return repo.settingKey + "." + string(namespace)
+}
 
+func (repo *settingRepository) UpsertNamespace(ctx context.Context, namespace entities.SettingNamespace, document []byte) error {
 	query := `
 	INSERT INTO settings (setting_key, setting_json, setting_created_at, setting_updated_at)
 	VALUES (?, JSON(?), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
@@ -38,18 +36,26 @@ func (repo *settingRepository) Upsert(ctx context.Context, settingJson *entities
 		setting_json = JSONB(excluded.setting_json),
 		setting_updated_at = CURRENT_TIMESTAMP
 	`
-	_, err = repo.db.ExecContext(
+	_, err := transaction.From(ctx, repo.db).ExecContext(
 		ctx, query,
-		repo.settingKey,
-		dataBytes,
+		repo.namespaceKey(namespace),
+		document,
 	)
 	return err
 }
 
-func (sr *settingRepository) GetSettings(ctx context.Context) (*entities.SettingJson, error) {
+func (repo *settingRepository) GetNamespace(ctx context.Context, namespace entities.SettingNamespace) ([]byte, error) {
+	return repo.getDocument(ctx, repo.namespaceKey(namespace))
+}
+
+func (repo *settingRepository) GetLegacy(ctx context.Context) ([]byte, error) {
+	return repo.getDocument(ctx, repo.settingKey)
+}
+
+func (sr *settingRepository) getDocument(ctx context.Context, key string) ([]byte, error) {
 	query := `SELECT setting_key, JSON(setting_json), setting_created_at, setting_updated_at FROM settings WHERE setting_key = ?`
 	var setting entities.Setting
-	err := sr.db.QueryRowContext(ctx, query, sr.settingKey).Scan(
+	err := transaction.From(ctx, sr.db).QueryRowContext(ctx, query, key).Scan(
 		&setting.SettingKey,
 		&setting.SettingJson,
 		&setting.SettingCreatedAt,
@@ -62,17 +68,23 @@ func (sr *settingRepository) GetSettings(ctx context.Context) (*entities.Setting
 		return nil, err
 	}
 
-	var settingJson entities.SettingJson
-	err = json.Unmarshal(setting.SettingJson, &settingJson)
-	if err != nil {
-		return nil, err
-	}
-
-	return &settingJson, nil
+	return setting.SettingJson, nil
 }
 
 func (sr *settingRepository) Delete(ctx context.Context) error {
-	query := `DELETE FROM settings WHERE setting_key = ?`
-	_, err := sr.db.ExecContext(ctx, query, sr.settingKey)
+	keys := []string{sr.settingKey}
+	for _, namespace := range entities.SettingNamespaces {
+		keys = append(keys, sr.namespaceKey(namespace))
+	}
+
+	placeholders := make([]string, 0, len(keys))
+	args := make([]any, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, key)
+		placeholders = append(placeholders, "?")
+	}
+
+	query := `DELETE FROM settings WHERE setting_key IN (` + strings.Join(placeholders, ", ") + `)`
+	_, err := transaction.From(ctx, sr.db).ExecContext(ctx, query, args...)
 	return err
 }
@@ -7,7 +7,10 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
 	"portfolio/logger"
+	"strings"
 	"time"
 )
 
@@ -21,12 +24,26 @@ func NewSkillRepository(db *sql.DB, logger *logger.Logger) interfaces.SkillRepos
 }
 
 func (repo *skillRepository) Create(ctx context.Context, skill *entities.Skill) (*entities.Skill, error) {
-	query := `INSERT INTO skills (user_id, skill_name, skill_level) VALUES (?, ?, ?)`
+	position, err := listing.NextPosition(ctx, transaction.From(ctx, repo.db), listing.SQLite, skillList, []any{skill.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create skill: %v", err)
+		return nil, err
+	}
 
-	result, err := repo.db.ExecContext(ctx, query,
+	query := `INSERT INTO skills (user_id, skill_name, skill_level, skill_category_id, skill_years_of_experience, skill_last_used_at, 
+			  skill_position, skill_state, skill_publish_at, skill_unpublish_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
 		skill.UserID,
 		skill.Name,
 		skill.Level,
+		skill.CategoryID,
+		skill.YearsOfExperience,
+		skill.LastUsedAt,
+		position,
+		skill.State,
+		skill.PublishAt,
+		skill.UnpublishAt,
 	)
 	if err != nil {
 		repo.logger.Error("Failed to create skill: %v", err)
@@ -40,6 +57,7 @@ func (repo *skillRepository) Create(ctx context.Context, skill *entities.Skill)
 	}
 
 	skill.SkillID = int(id)
+	skill.Position = position
 	skill.CreatedAt = time.Now()
 	skill.UpdatedAt = time.Now()
 
@@ -47,19 +65,9 @@ func (repo *skillRepository) Create(ctx context.Context, skill *entities.Skill)
 }
 
 func (repo *skillRepository) GetByID(ctx context.Context, skillID int) (*entities.Skill, error) {
-	var skill entities.Skill
-	query := `SELECT skill_id, user_id, skill_name, skill_level, skill_created_at, skill_updated_at 
-			  FROM skills WHERE skill_id = ?`
+	query := `SELECT ` + skillColumns + ` FROM skills WHERE skill_id = ? AND skill_deleted_at IS NULL`
 
-	row := repo.db.QueryRowContext(ctx, query, skillID)
-	err := row.Scan(
-		&skill.SkillID,
-		&skill.UserID,
-		&skill.Name,
-		&skill.Level,
-		&skill.CreatedAt,
-		&skill.UpdatedAt,
-	)
+	skill, err := scanSkill(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, skillID))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -68,51 +76,82 @@ func (repo *skillRepository) GetByID(ctx context.Context, skillID int) (*entitie
 		return nil, domain.NewDatabaseError("retrieve skill", err)
 	}
 
-	return &skill, nil
+	if err := repo.loadEvidence(ctx, skill); err != nil {
+		return nil, err
+	}
+	return skill, nil
 }
 
-func (repo *skillRepository) GetByUserID(ctx context.Context, userID int) ([]*entities.Skill, error) {
-	query := `SELECT skill_id, user_id, skill_name, skill_level, skill_created_at, skill_updated_at 
-			  FROM skills WHERE user_id = ? ORDER BY skill_name`
+const skillColumns = "skill_id, user_id, skill_name, skill_level, skill_category_id, skill_years_of_experience, skill_last_used_at, skill_created_at, skill_updated_at, skill_version, skill_position, skill_state, skill_publish_at, skill_unpublish_at"
+
+var skillList = listing.Table{
+	Name:     "skills",
+	IDColumn: "skill_id",
+	Columns:  skillColumns,
+	Scope:    "user_id = ? AND skill_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":            "skill_position",
+		"name":                "lower(skill_name)",
+		"level":               "skill_level",
+		"category":            "coalesce((SELECT skill_category_position FROM skill_categories WHERE skill_categories.skill_category_id = skills.skill_category_id), 2147483647)",
+		"years_of_experience": "coalesce(skill_years_of_experience, -1)",
+		"created_at":          "skill_created_at",
+	},
+	Filters: map[string]string{
+		"state":    "skill_state = ?",
+		"level":    "skill_level = ?",
+		"category": "CAST(skill_category_id AS TEXT) = ?",
+	},
+	Position: "skill_position",
+}
 
-	rows, err := repo.db.QueryContext(ctx, query, userID)
+func (repo *skillRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Skill], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.SQLite, skillList, []any{userID}, query,
+		func(rows *sql.Rows) (*entities.Skill, error) { return scanSkill(rows) },
+		func(skill *entities.Skill) int { return skill.SkillID })
 	if err != nil {
 		repo.logger.Error("Failed to getbyuserid skills: %v", err)
-		return nil, domain.NewDatabaseError("retrieve skills by user ID", err)
+		return nil, err
 	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			repo.logger.Error("Failed to closing rows: %v", err)
-		}
-	}()
 
-	var skills []*entities.Skill
-	for rows.Next() {
-		var skill entities.Skill
-		err := rows.Scan(
-			&skill.SkillID,
-			&skill.UserID,
-			&skill.Name,
-			&skill.Level,
-			&skill.CreatedAt,
-			&skill.UpdatedAt,
-		)
-		if err != nil {
-			repo.logger.Error("Failed to scanning skill: %v", err)
-			continue
-		}
-		skills = append(skills, &skill)
+	if err := repo.loadEvidence(ctx, page.Items...); err != nil {
+		return nil, err
 	}
+	return page, nil
+}
 
-	return skills, nil
+func scanSkill(row rowScanner) (*entities.Skill, error) {
+	skill := &entities.Skill{}
+	err := row.Scan(
+		&skill.SkillID,
+		&skill.UserID,
+		&skill.Name,
+		&skill.Level,
+		&skill.CategoryID,
+		&skill.YearsOfExperience,
+		&skill.LastUsedAt,
+		&skill.CreatedAt,
+		&skill.UpdatedAt,
+		&skill.Version,
+		&skill.Position,
+		&skill.State,
+		&skill.PublishAt,
+		&skill.UnpublishAt,
+	)
+	return skill, err
 }
 
 func (repo *skillRepository) Update(ctx context.Context, skillID int, skill *entities.Skill) (*entities.Skill, error) {
-	query := `UPDATE skills SET skill_name = ?, skill_level = ?, skill_updated_at = ? WHERE skill_id = ?`
+	query := `UPDATE skills SET skill_name = ?, skill_level = ?, skill_category_id = ?, skill_years_of_experience = ?, skill_last_used_at = ?, 
+			  skill_updated_at = ?, skill_version = skill_version + 1 WHERE skill_id = ? AND skill_deleted_at IS NULL`
 
-	_, err := repo.db.ExecContext(ctx, query,
+	condition := transaction.VersionCondition(ctx, "skill_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
 		skill.Name,
 		skill.Level,
+		skill.CategoryID,
+		skill.YearsOfExperience,
+		skill.LastUsedAt,
 		time.Now(),
 		skillID,
 	)
@@ -121,6 +160,10 @@ func (repo *skillRepository) Update(ctx context.Context, skillID int, skill *ent
 		return nil, domain.NewDatabaseError("update skill", err)
 	}
 
+	if err := transaction.CheckVersion(result, condition, "Skill", skillID); err != nil {
+		return nil, err
+	}
+
 	return repo.GetByID(ctx, skillID)
 }
 
@@ -143,6 +186,14 @@ func (repo *skillRepository) Patch(ctx context.Context, skillID int, skill *enti
 		return skill, nil
 	}
 
+	// The nullable fields are always written: the use case patches a copy
+	// of the stored skill, so they hold either the stored or the new value.
+	fields = append(fields,
+		field{"skill_category_id", skill.CategoryID, true},
+		field{"skill_years_of_experience", skill.YearsOfExperience, true},
+		field{"skill_last_used_at", skill.LastUsedAt, true},
+	)
+
 	query := "UPDATE skills SET "
 	var args []interface{}
 	for i, f := range fields {
@@ -152,33 +203,44 @@ func (repo *skillRepository) Patch(ctx context.Context, skillID int, skill *enti
 			query += ", "
 		}
 	}
-	query += " WHERE skill_id = ?"
+	query += ", skill_version = skill_version + 1 WHERE skill_id = ? AND skill_deleted_at IS NULL"
 	args = append(args, skillID)
 
-	if _, err := repo.db.ExecContext(ctx, query, args...); err != nil {
+	condition := transaction.VersionCondition(ctx, "skill_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, args...)
+	if err != nil {
 		repo.logger.Error("Failed to patch skill: %v", err)
 		return nil, fmt.Errorf("unable to patch skill: %w", err)
 	}
+
+	if err := transaction.CheckVersion(result, condition, "Skill", skillID); err != nil {
+		return nil, err
+	}
 	return repo.GetByID(ctx, skillID)
 }
 
 func (repo *skillRepository) Delete(ctx context.Context, skillID int) error {
-	query := `DELETE FROM skills WHERE skill_id = ?`
+	query := `UPDATE skills SET skill_deleted_at = ?, skill_version = skill_version + 1 WHERE skill_id = ? AND skill_deleted_at IS NULL`
 
-	_, err := repo.db.ExecContext(ctx, query, skillID)
+	condition := transaction.VersionCondition(ctx, "skill_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), skillID)
 	if err != nil {
 		repo.logger.Error("Failed to delete skill: %v", err)
 		return domain.NewDatabaseError("delete skill", err)
 	}
 
+	if err := transaction.CheckVersion(result, condition, "Skill", skillID); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (repo *skillRepository) ExistsByID(ctx context.Context, skillID int) (bool, error) {
-	query := `SELECT COUNT(*) FROM skills WHERE skill_id = ?`
+	query := `SELECT COUNT(*) FROM skills WHERE skill_id = ? AND skill_deleted_at IS NULL`
 	var count int
 
-	err := repo.db.QueryRowContext(ctx, query, skillID).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, skillID).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbyid skill: %v", err)
 		return false, domain.NewDatabaseError("check skill existence", err)
@@ -191,7 +253,7 @@ func (repo *skillRepository) ExistsByNameAndUserID(ctx context.Context, name str
 	query := `SELECT COUNT(*) FROM skills WHERE skill_name = ? AND user_id = ?`
 	var count int
 
-	err := repo.db.QueryRowContext(ctx, query, name, userID).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, name, userID).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbynameanduserid skill: %v", err)
 		return false, domain.NewDatabaseError("check skill existence by name", err)
@@ -201,10 +263,9 @@ func (repo *skillRepository) ExistsByNameAndUserID(ctx context.Context, name str
 }
 
 func (repo *skillRepository) GetAll(ctx context.Context) ([]*entities.Skill, error) {
-	query := `SELECT skill_id, user_id, skill_name, skill_level, skill_created_at, skill_updated_at 
-			  FROM skills ORDER BY skill_name`
+	query := `SELECT ` + skillColumns + ` FROM skills WHERE skill_deleted_at IS NULL ORDER BY skill_name`
 
-	rows, err := repo.db.QueryContext(ctx, query)
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query)
 	if err != nil {
 		repo.logger.Error("Failed to getall skills: %v", err)
 		return nil, domain.NewDatabaseError("retrieve all skills", err)
@@ -217,21 +278,125 @@ func (repo *skillRepository) GetAll(ctx context.Context) ([]*entities.Skill, err
 
 	var skills []*entities.Skill
 	for rows.Next() {
-		var skill entities.Skill
-		err := rows.Scan(
-			&skill.SkillID,
-			&skill.UserID,
-			&skill.Name,
-			&skill.Level,
-			&skill.CreatedAt,
-			&skill.UpdatedAt,
-		)
+		skill, err := scanSkill(rows)
 		if err != nil {
 			repo.logger.Error("Failed to scanning skill: %v", err)
 			continue
 		}
-		skills = append(skills, &skill)
+		skills = append(skills, skill)
 	}
 
 	return skills, nil
 }
+
+func (repo *skillRepository) Reorder(ctx context.Context, userID int, skillIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.SQLite, skillList, []any{userID}, skillIDs); err != nil {
+		repo.logger.Error("Failed to reorder skills: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (repo *skillRepository) SetProjects(ctx context.Context, skillID int, projectIDs []int) error {
+	return repo.setEvidence(ctx, "skill_projects", "project_id", "skill_project_position", skillID, projectIDs)
+}
+
+func (repo *skillRepository) SetExperiences(ctx context.Context, skillID int, experienceIDs []int) error {
+	return repo.setEvidence(ctx, "skill_experiences", "experience_id", "skill_experience_position", skillID, experienceIDs)
+}
+
+// setEvidence replaces the rows of the join table that link the skill to
+// evidence with ids, in order.
+func (repo *skillRepository) setEvidence(ctx context.Context, table, idColumn, positionColumn string, skillID int, ids []int) error {
+	executor := transaction.From(ctx, repo.db)
+
+	if _, err := executor.ExecContext(ctx, `DELETE FROM `+table+` WHERE skill_id = ?`, skillID); err != nil {
+		repo.logger.Error("Failed to unlink %s of skill %d: %v", table, skillID, err)
+		return domain.NewDatabaseError(table+" update", err)
+	}
+
+	query := `INSERT INTO ` + table + ` (skill_id, ` + idColumn + `, ` + positionColumn + `) VALUES (?, ?, ?)`
+	for position, id := range ids {
+		if _, err := executor.ExecContext(ctx, query, skillID, id, position); err != nil {
+			repo.logger.Error("Failed to link %s %d to skill %d: %v", idColumn, id, skillID, err)
+			return domain.NewDatabaseError(table+" update", err)
+		}
+	}
+	return nil
+}
+
+// loadEvidence fills in the live projects and experiences linked to skills.
+func (repo *skillRepository) loadEvidence(ctx context.Context, skills ...*entities.Skill) error {
+	if len(skills) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*entities.Skill, len(skills))
+	placeholders := make([]string, 0, len(skills))
+	args := make([]any, 0, len(skills))
+	for _, skill := range skills {
+		skill.Projects = []*entities.SkillEvidence{}
+		skill.Experiences = []*entities.SkillEvidence{}
+		byID[skill.SkillID] = skill
+		placeholders = append(placeholders, "?")
+		args = append(args, skill.SkillID)
+	}
+	in := strings.Join(placeholders, ", ")
+
+	projects := `SELECT skill_projects.skill_id, projects.project_id, projects.project_title, '',
+	             projects.project_state, projects.project_publish_at, projects.project_unpublish_at
+	             FROM skill_projects JOIN projects ON projects.project_id = skill_projects.project_id
+	             WHERE projects.project_deleted_at IS NULL AND skill_projects.skill_id IN (` + in + `)
+	             ORDER BY skill_projects.skill_project_position, projects.project_id`
+	err := repo.scanEvidence(ctx, projects, args, func(skillID int, evidence *entities.SkillEvidence) {
+		byID[skillID].Projects = append(byID[skillID].Projects, evidence)
+	})
+	if err != nil {
+		return err
+	}
+
+	experiences := `SELECT skill_experiences.skill_id, experiences.experience_id, experiences.experience_job_title, experiences.experience_company_name,
+	                experiences.experience_state, experiences.experience_publish_at, experiences.experience_unpublish_at
+	                FROM skill_experiences JOIN experiences ON experiences.experience_id = skill_experiences.experience_id
+	                WHERE experiences.experience_deleted_at IS NULL AND skill_experiences.skill_id IN (` + in + `)
+	                ORDER BY skill_experiences.skill_experience_position, experiences.experience_id`
+	return repo.scanEvidence(ctx, experiences, args, func(skillID int, evidence *entities.SkillEvidence) {
+		byID[skillID].Experiences = append(byID[skillID].Experiences, evidence)
+	})
+}
+
+// scanEvidence runs an evidence query and hands each row to add with the ID
+// of its skill.
+func (repo *skillRepository) scanEvidence(ctx context.Context, query string, args []any, add func(skillID int, evidence *entities.SkillEvidence)) error {
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		repo.logger.Error("Failed to load skill evidence: %v", err)
+		return domain.NewDatabaseError("skill evidence retrieval", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var skillID int
+		evidence := &entities.SkillEvidence{}
+		err := rows.Scan(
+			&skillID,
+			&evidence.ID,
+			&evidence.Title,
+			&evidence.Organization,
+			&evidence.State,
+			&evidence.PublishAt,
+			&evidence.UnpublishAt,
+		)
+		if err != nil {
+			repo.logger.Error("Failed to scan skill evidence: %v", err)
+			return domain.NewDatabaseError("skill evidence scanning", err)
+		}
+		add(skillID, evidence)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.NewDatabaseError("skill evidence iteration", err)
+	}
+	return nil
+}
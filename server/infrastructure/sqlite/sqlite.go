@@ -1,6 +1,7 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
@@ -41,6 +42,11 @@ func NewConnection(cfg *config.DatabaseConfig, logger *logger.Logger) (*sql.DB,
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
+	if err := requireFTS5(db); err != nil {
+		closeDB(db, logger)
+		return nil, err
+	}
+
 	if err := applyMigrations(db, logger); err != nil {
 		closeDB(db, logger)
 		return nil, fmt.Errorf("failed to apply migrations: %w", err)
@@ -110,12 +116,14 @@ func verifyPragmas(db *sql.DB, pragmas map[string]string) error {
 	return nil
 }
 
-func DiagnoseConnection(db *sql.DB, logger *logger.Logger) error {
+func DiagnoseConnection(db *sql.DB, logger *logger.Logger) (map[string]string, error) {
 	pragmas := []string{
 		"foreign_keys", "journal_mode", "synchronous", "busy_timeout",
 		"temp_store", "mmap_size", "journal_size_limit", "cache_size",
 	}
 
+	values := make(map[string]string, len(pragmas))
+
 	logger.Println("=== SQLite Configuration ===")
 	for _, pragma := range pragmas {
 		var value string
@@ -123,12 +131,46 @@ func DiagnoseConnection(db *sql.DB, logger *logger.Logger) error {
 
 		if err := db.QueryRow(query).Scan(&value); err != nil {
 			logger.Printf("❌ %s: ERROR - %v\n", pragma, err)
+			values[pragma] = "ERROR: " + err.Error()
 		} else {
 			logger.Printf("✅ %s: %s\n", pragma, value)
+			values[pragma] = value
 		}
 	}
 	logger.Println("=============================")
 
+	return values, nil
+}
+
+// Checkpoint flushes the WAL into the main database file and truncates it,
+// so a clean shutdown leaves no -wal file behind.
+func Checkpoint(ctx context.Context, db *sql.DB, logger *logger.Logger) error {
+	var busy, logFrames, checkpointed int
+	err := db.QueryRowContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)").Scan(&busy, &logFrames, &checkpointed)
+	if err != nil {
+		return fmt.Errorf("failed to checkpoint wal: %w", err)
+	}
+
+	if busy != 0 {
+		logger.Warn("WAL checkpoint could not complete: database busy (%d/%d frames checkpointed)", checkpointed, logFrames)
+	} else {
+		logger.Info("WAL checkpoint completed (%d frames)", checkpointed)
+	}
+
+	return nil
+}
+
+// requireFTS5 fails early when the driver was built without FTS5, which the
+// search migration and repository need, rather than halfway through the
+// migrations.
+func requireFTS5(db *sql.DB) error {
+	var enabled bool
+	if err := db.QueryRow("SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&enabled); err != nil {
+		return fmt.Errorf("failed to check for FTS5 support: %w", err)
+	}
+	if !enabled {
+		return fmt.Errorf("sqlite was built without FTS5, rebuild with -tags sqlite_fts5")
+	}
 	return nil
 }
 
@@ -161,7 +203,7 @@ func applyMigrations(db *sql.DB, logger *logger.Logger) error {
 		applied[filename] = true
 	}
 
-	migrationFiles, err := migration.GetMigrationFiles()
+	migrationFiles, err := migration.GetMigrationFiles("sqlite")
 
 	if err != nil {
 		return err
@@ -7,8 +7,9 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
 	"portfolio/logger"
-	"strings"
 	"time"
 )
 
@@ -22,13 +23,24 @@ func NewTechnologyRepository(db *sql.DB, logger *logger.Logger) interfaces.Techn
 }
 
 func (repo *technologyRepository) Create(ctx context.Context, technology *entities.Technology) (*entities.Technology, error) {
-	query := `INSERT INTO technologies (user_id, technology_name, technology_icon_url) 
-			  VALUES (?, ?, ?)`
+	position, err := listing.NextPosition(ctx, transaction.From(ctx, repo.db), listing.SQLite, technologyList, []any{technology.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create technology: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO technologies (user_id, technology_name, technology_icon_url, technology_position, 
+			  technology_state, technology_publish_at, technology_unpublish_at) 
+			  VALUES (?, ?, ?, ?, ?, ?, ?)`
 
-	result, err := repo.db.ExecContext(ctx, query,
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
 		technology.UserID,
 		technology.Name,
 		technology.IconURL,
+		position,
+		technology.State,
+		technology.PublishAt,
+		technology.UnpublishAt,
 	)
 	if err != nil {
 		repo.logger.Error("Failed to create technology: %v", err)
@@ -42,6 +54,7 @@ func (repo *technologyRepository) Create(ctx context.Context, technology *entiti
 	}
 
 	technology.TechnologyID = int(id)
+	technology.Position = position
 	technology.CreatedAt = time.Now()
 	technology.UpdatedAt = time.Now()
 
@@ -51,10 +64,10 @@ func (repo *technologyRepository) Create(ctx context.Context, technology *entiti
 func (repo *technologyRepository) GetByID(ctx context.Context, technologyID int) (*entities.Technology, error) {
 	var technology entities.Technology
 	query := `SELECT technology_id, user_id, technology_name, technology_icon_url, 
-			  technology_created_at, technology_updated_at 
-			  FROM technologies WHERE technology_id = ?`
+			  technology_created_at, technology_updated_at, technology_version, technology_position, technology_state, technology_publish_at, technology_unpublish_at 
+			  FROM technologies WHERE technology_id = ? AND technology_deleted_at IS NULL`
 
-	row := repo.db.QueryRowContext(ctx, query, technologyID)
+	row := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, technologyID)
 	err := row.Scan(
 		&technology.TechnologyID,
 		&technology.UserID,
@@ -62,6 +75,11 @@ func (repo *technologyRepository) GetByID(ctx context.Context, technologyID int)
 		&technology.IconURL,
 		&technology.CreatedAt,
 		&technology.UpdatedAt,
+		&technology.Version,
+		&technology.Position,
+		&technology.State,
+		&technology.PublishAt,
+		&technology.UnpublishAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -74,49 +92,58 @@ func (repo *technologyRepository) GetByID(ctx context.Context, technologyID int)
 	return &technology, nil
 }
 
-func (repo *technologyRepository) GetByUserID(ctx context.Context, userID int) ([]*entities.Technology, error) {
-	query := `SELECT technology_id, user_id, technology_name, technology_icon_url,
-			  technology_created_at, technology_updated_at 
-			  FROM technologies WHERE user_id = ? ORDER BY technology_name`
+var technologyList = listing.Table{
+	Name:     "technologies",
+	IDColumn: "technology_id",
+	Columns:  "technology_id, user_id, technology_name, technology_icon_url, technology_created_at, technology_updated_at, technology_version, technology_position, technology_state, technology_publish_at, technology_unpublish_at",
+	Scope:    "user_id = ? AND technology_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":   "technology_position",
+		"name":       "lower(technology_name)",
+		"created_at": "technology_created_at",
+	},
+	Filters: map[string]string{
+		"state": "technology_state = ?",
+		"name":  "lower(technology_name) = lower(?)",
+	},
+	Position: "technology_position",
+}
 
-	rows, err := repo.db.QueryContext(ctx, query, userID)
+func (repo *technologyRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Technology], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.SQLite, technologyList, []any{userID}, query,
+		scanTechnology, func(technology *entities.Technology) int { return technology.TechnologyID })
 	if err != nil {
 		repo.logger.Error("Failed to getbyuserid technologies: %v", err)
-		return nil, domain.NewDatabaseError("retrieve technologies by user ID", err)
+		return nil, err
 	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			repo.logger.Error("Failed to closing rows: %v", err)
-		}
-	}()
-
-	var technologies []*entities.Technology
-	for rows.Next() {
-		var technology entities.Technology
-		err := rows.Scan(
-			&technology.TechnologyID,
-			&technology.UserID,
-			&technology.Name,
-			&technology.IconURL,
-			&technology.CreatedAt,
-			&technology.UpdatedAt,
-		)
-		if err != nil {
-			repo.logger.Error("Failed to scanning technology: %v", err)
-			continue
-		}
 
-		technologies = append(technologies, &technology)
-	}
+	return page, nil
+}
 
-	return technologies, nil
+func scanTechnology(rows *sql.Rows) (*entities.Technology, error) {
+	technology := &entities.Technology{}
+	err := rows.Scan(
+		&technology.TechnologyID,
+		&technology.UserID,
+		&technology.Name,
+		&technology.IconURL,
+		&technology.CreatedAt,
+		&technology.UpdatedAt,
+		&technology.Version,
+		&technology.Position,
+		&technology.State,
+		&technology.PublishAt,
+		&technology.UnpublishAt,
+	)
+	return technology, err
 }
 
 func (repo *technologyRepository) Update(ctx context.Context, technologyID int, technology *entities.Technology) (*entities.Technology, error) {
 	query := `UPDATE technologies SET technology_name = ?, technology_icon_url = ?, 
-			  technology_updated_at = ? WHERE technology_id = ?`
+			  technology_updated_at = ?, technology_version = technology_version + 1 WHERE technology_id = ? AND technology_deleted_at IS NULL`
 
-	_, err := repo.db.ExecContext(ctx, query,
+	condition := transaction.VersionCondition(ctx, "technology_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
 		technology.Name,
 		technology.IconURL,
 		time.Now(),
@@ -127,6 +154,10 @@ func (repo *technologyRepository) Update(ctx context.Context, technologyID int,
 		return nil, domain.NewDatabaseError("update technology", err)
 	}
 
+	if err := transaction.CheckVersion(result, condition, "Technology", technologyID); err != nil {
+		return nil, err
+	}
+
 	return repo.GetByID(ctx, technologyID)
 }
 
@@ -158,33 +189,44 @@ func (repo *technologyRepository) Patch(ctx context.Context, technologyID int, t
 			query += ", "
 		}
 	}
-	query += " WHERE technology_id = ?"
+	query += ", technology_version = technology_version + 1 WHERE technology_id = ? AND technology_deleted_at IS NULL"
 	args = append(args, technologyID)
 
-	if _, err := repo.db.ExecContext(ctx, query, args...); err != nil {
+	condition := transaction.VersionCondition(ctx, "technology_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, args...)
+	if err != nil {
 		repo.logger.Error("Failed to patch technology: %v", err)
 		return nil, fmt.Errorf("unable to patch technology: %w", err)
 	}
+
+	if err := transaction.CheckVersion(result, condition, "Technology", technologyID); err != nil {
+		return nil, err
+	}
 	return repo.GetByID(ctx, technologyID)
 }
 
 func (repo *technologyRepository) Delete(ctx context.Context, technologyID int) error {
-	query := `DELETE FROM technologies WHERE technology_id = ?`
+	query := `UPDATE technologies SET technology_deleted_at = ?, technology_version = technology_version + 1 WHERE technology_id = ? AND technology_deleted_at IS NULL`
 
-	_, err := repo.db.ExecContext(ctx, query, technologyID)
+	condition := transaction.VersionCondition(ctx, "technology_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), technologyID)
 	if err != nil {
 		repo.logger.Error("Failed to delete technology: %v", err)
 		return domain.NewDatabaseError("delete technology", err)
 	}
 
+	if err := transaction.CheckVersion(result, condition, "Technology", technologyID); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (repo *technologyRepository) ExistsByID(ctx context.Context, technologyID int) (bool, error) {
-	query := `SELECT COUNT(*) FROM technologies WHERE technology_id = ?`
+	query := `SELECT COUNT(*) FROM technologies WHERE technology_id = ? AND technology_deleted_at IS NULL`
 	var count int
 
-	err := repo.db.QueryRowContext(ctx, query, technologyID).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, technologyID).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbyid technology: %v", err)
 		return false, domain.NewDatabaseError("check technology existence", err)
@@ -197,7 +239,7 @@ func (repo *technologyRepository) ExistsByNameAndUserID(ctx context.Context, nam
 	query := `SELECT COUNT(*) FROM technologies WHERE technology_name = ? AND user_id = ?`
 	var count int
 
-	err := repo.db.QueryRowContext(ctx, query, name, userID).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, name, userID).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbynameanduserid technology: %v", err)
 		return false, domain.NewDatabaseError("check technology existence by name", err)
@@ -211,21 +253,17 @@ func (repo *technologyRepository) GetByNames(ctx context.Context, names []string
 		return []*entities.Technology{}, nil
 	}
 
-	placeholders := make([]string, len(names))
-	args := make([]interface{}, len(names)+1)
-	args[0] = userID
-
-	for i, name := range names {
-		placeholders[i] = "?"
-		args[i+1] = name
+	// Names are matched in Go, as SQL lower() only folds ASCII.
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[entities.NormalizeTechnologyName(name)] = true
 	}
 
 	query := `SELECT technology_id, user_id, technology_name, technology_icon_url,
-			  technology_created_at, technology_updated_at 
-			  FROM technologies WHERE user_id = ? AND technology_name IN (` +
-		strings.Join(placeholders, ",") + `) ORDER BY technology_name`
+			  technology_created_at, technology_updated_at, technology_version, technology_position, technology_state, technology_publish_at, technology_unpublish_at 
+			  FROM technologies WHERE user_id = ? AND technology_deleted_at IS NULL ORDER BY technology_name`
 
-	rows, err := repo.db.QueryContext(ctx, query, args...)
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query, userID)
 	if err != nil {
 		repo.logger.Error("Failed to getbynames technologies: %v", err)
 		return nil, domain.NewDatabaseError("retrieve technologies by names", err)
@@ -246,13 +284,20 @@ func (repo *technologyRepository) GetByNames(ctx context.Context, names []string
 			&technology.IconURL,
 			&technology.CreatedAt,
 			&technology.UpdatedAt,
+			&technology.Version,
+			&technology.Position,
+			&technology.State,
+			&technology.PublishAt,
+			&technology.UnpublishAt,
 		)
 		if err != nil {
 			repo.logger.Error("Failed to scanning technology: %v", err)
 			continue
 		}
 
-		technologies = append(technologies, &technology)
+		if wanted[technology.GetNormalizedName()] {
+			technologies = append(technologies, &technology)
+		}
 	}
 
 	return technologies, nil
@@ -260,10 +305,10 @@ func (repo *technologyRepository) GetByNames(ctx context.Context, names []string
 
 func (repo *technologyRepository) GetAll(ctx context.Context) ([]*entities.Technology, error) {
 	query := `SELECT technology_id, user_id, technology_name, technology_icon_url,
-			  technology_created_at, technology_updated_at 
-			  FROM technologies ORDER BY technology_name`
+			  technology_created_at, technology_updated_at, technology_version, technology_position, technology_state, technology_publish_at, technology_unpublish_at 
+			  FROM technologies WHERE technology_deleted_at IS NULL ORDER BY technology_name`
 
-	rows, err := repo.db.QueryContext(ctx, query)
+	rows, err := transaction.From(ctx, repo.db).QueryContext(ctx, query)
 	if err != nil {
 		repo.logger.Error("Failed to getall technologies: %v", err)
 		return nil, domain.NewDatabaseError("retrieve all technologies", err)
@@ -284,6 +329,11 @@ func (repo *technologyRepository) GetAll(ctx context.Context) ([]*entities.Techn
 			&technology.IconURL,
 			&technology.CreatedAt,
 			&technology.UpdatedAt,
+			&technology.Version,
+			&technology.Position,
+			&technology.State,
+			&technology.PublishAt,
+			&technology.UnpublishAt,
 		)
 		if err != nil {
 			repo.logger.Error("Failed to scanning technology: %v", err)
@@ -295,3 +345,11 @@ func (repo *technologyRepository) GetAll(ctx context.Context) ([]*entities.Techn
 
 	return technologies, nil
 }
+
+func (repo *technologyRepository) Reorder(ctx context.Context, userID int, technologyIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.SQLite, technologyList, []any{userID}, technologyIDs); err != nil {
+		repo.logger.Error("Failed to reorder technologies: %v", err)
+		return err
+	}
+	return nil
+}
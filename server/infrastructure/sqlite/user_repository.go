@@ -6,6 +6,7 @@ import (
 	"portfolio/domain"
 	"portfolio/domain/entities"
 	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/transaction"
 	"portfolio/logger"
 	"time"
 )
@@ -24,7 +25,7 @@ func (repo *userRepository) CreateUser(ctx context.Context, user *entities.User)
         INSERT INTO users (user_username, user_email, user_password, user_role, user_is_active, user_created_at, user_updated_at)
         VALUES (?, ?, ?, ?, ?, ?, ?)
     `
-	result, err := repo.db.ExecContext(ctx, query,
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query,
 		user.Username,
 		user.Email,
 		user.Password,
@@ -57,7 +58,7 @@ func (repo *userRepository) GetByUsername(ctx context.Context, username string)
 	user := &entities.User{}
 	var lastLogin sql.NullTime
 
-	err := repo.db.QueryRowContext(ctx, query, username).Scan(
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, username).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Email,
@@ -86,7 +87,7 @@ func (repo *userRepository) GetByUsername(ctx context.Context, username string)
 
 func (repo *userRepository) UpdateLastLogin(ctx context.Context, userID int) error {
 	query := "UPDATE users SET user_last_login = ? WHERE user_id = ?"
-	_, err := repo.db.ExecContext(ctx, query, time.Now(), userID)
+	_, err := transaction.From(ctx, repo.db).ExecContext(ctx, query, time.Now(), userID)
 	if err != nil {
 		repo.logger.Error("Failed to updatelastlogin: %v", err)
 		return domain.NewDatabaseError("last login update", err)
@@ -97,7 +98,7 @@ func (repo *userRepository) UpdateLastLogin(ctx context.Context, userID int) err
 func (repo *userRepository) ExistsByID(ctx context.Context, userID int) (bool, error) {
 	query := "SELECT COUNT(*) FROM users WHERE user_id = ?"
 	var count int
-	err := repo.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	err := transaction.From(ctx, repo.db).QueryRowContext(ctx, query, userID).Scan(&count)
 	if err != nil {
 		repo.logger.Error("Failed to existsbyid: %v", err)
 		return false, domain.NewDatabaseError("user existence check", err)
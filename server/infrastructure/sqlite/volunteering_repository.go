@@ -0,0 +1,189 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/entities"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/infrastructure/listing"
+	"portfolio/infrastructure/transaction"
+	"portfolio/logger"
+	"time"
+)
+
+type volunteeringRepository struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewVolunteeringRepository(db *sql.DB, logger *logger.Logger) interfaces.VolunteeringRepository {
+	return &volunteeringRepository{db: db, logger: logger}
+}
+
+const volunteeringColumns = "volunteering_id, user_id, volunteering_role, volunteering_organization, volunteering_cause, volunteering_start_date, volunteering_end_date, volunteering_url, volunteering_description, volunteering_created_at, volunteering_updated_at, volunteering_version, volunteering_position, volunteering_state, volunteering_publish_at, volunteering_unpublish_at"
+
+var volunteeringList = listing.Table{
+	Name:     "volunteerings",
+	IDColumn: "volunteering_id",
+	Columns:  volunteeringColumns,
+	Scope:    "user_id = ? AND volunteering_deleted_at IS NULL",
+	Sorts: map[string]string{
+		"position":     "volunteering_position",
+		"start_date":   "volunteering_start_date",
+		"role":         "lower(volunteering_role)",
+		"organization": "lower(volunteering_organization)",
+		"created_at":   "volunteering_created_at",
+	},
+	Filters: map[string]string{
+		"state":        "volunteering_state = ?",
+		"organization": "lower(volunteering_organization) = lower(?)",
+		"cause":        "lower(volunteering_cause) = lower(?)",
+		"current":      "(volunteering_end_date IS NULL) = (? = 'true')",
+	},
+	Position: "volunteering_position",
+}
+
+func scanVolunteering(row rowScanner) (*entities.Volunteering, error) {
+	volunteering := &entities.Volunteering{}
+	err := row.Scan(
+		&volunteering.VolunteeringID,
+		&volunteering.UserID,
+		&volunteering.Role,
+		&volunteering.Organization,
+		&volunteering.Cause,
+		&volunteering.StartDate,
+		&volunteering.EndDate,
+		&volunteering.URL,
+		&volunteering.Description,
+		&volunteering.CreatedAt,
+		&volunteering.UpdatedAt,
+		&volunteering.Version,
+		&volunteering.Position,
+		&volunteering.State,
+		&volunteering.PublishAt,
+		&volunteering.UnpublishAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return volunteering, nil
+}
+
+func (repo *volunteeringRepository) Create(ctx context.Context, volunteering *entities.Volunteering) (*entities.Volunteering, error) {
+	executor := transaction.From(ctx, repo.db)
+	position, err := listing.NextPosition(ctx, executor, listing.SQLite, volunteeringList, []any{volunteering.UserID})
+	if err != nil {
+		repo.logger.Error("Failed to create volunteering: %v", err)
+		return nil, err
+	}
+
+	query := `INSERT INTO volunteerings (user_id, volunteering_role, volunteering_organization, volunteering_cause,
+			  volunteering_start_date, volunteering_end_date, volunteering_url, volunteering_description,
+			  volunteering_position, volunteering_state, volunteering_publish_at, volunteering_unpublish_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := executor.ExecContext(ctx, query,
+		volunteering.UserID,
+		volunteering.Role,
+		volunteering.Organization,
+		volunteering.Cause,
+		volunteering.StartDate,
+		volunteering.EndDate,
+		volunteering.URL,
+		volunteering.Description,
+		position,
+		volunteering.State,
+		volunteering.PublishAt,
+		volunteering.UnpublishAt,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to create volunteering: %v", err)
+		return nil, domain.NewDatabaseError("create volunteering", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		repo.logger.Error("Failed to get volunteering ID after creation: %v", err)
+		return nil, domain.NewDatabaseError("get volunteering ID", err)
+	}
+
+	return repo.GetByID(ctx, int(id))
+}
+
+func (repo *volunteeringRepository) GetByID(ctx context.Context, volunteeringID int) (*entities.Volunteering, error) {
+	query := `SELECT ` + volunteeringColumns + ` FROM volunteerings WHERE volunteering_id = ? AND volunteering_deleted_at IS NULL`
+
+	volunteering, err := scanVolunteering(transaction.From(ctx, repo.db).QueryRowContext(ctx, query, volunteeringID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		repo.logger.Error("Failed to retrieve volunteering by ID: %v", err)
+		return nil, domain.NewDatabaseError("retrieve volunteering", err)
+	}
+	return volunteering, nil
+}
+
+func (repo *volunteeringRepository) GetByUserID(ctx context.Context, userID int, query *entities.ListQuery) (*entities.ListPage[*entities.Volunteering], error) {
+	page, err := listing.Fetch(ctx, transaction.From(ctx, repo.db), listing.SQLite, volunteeringList, []any{userID}, query,
+		func(rows *sql.Rows) (*entities.Volunteering, error) { return scanVolunteering(rows) },
+		func(volunteering *entities.Volunteering) int { return volunteering.VolunteeringID })
+	if err != nil {
+		repo.logger.Error("Failed to retrieve volunteerings by user ID: %v", err)
+		return nil, err
+	}
+	return page, nil
+}
+
+func (repo *volunteeringRepository) Update(ctx context.Context, volunteeringID int, volunteering *entities.Volunteering) (*entities.Volunteering, error) {
+	query := `UPDATE volunteerings SET volunteering_role = ?, volunteering_organization = ?, volunteering_cause = ?,
+			  volunteering_start_date = ?, volunteering_end_date = ?, volunteering_url = ?, volunteering_description = ?,
+			  volunteering_updated_at = ?, volunteering_version = volunteering_version + 1
+			  WHERE volunteering_id = ? AND volunteering_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "volunteering_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition,
+		volunteering.Role,
+		volunteering.Organization,
+		volunteering.Cause,
+		volunteering.StartDate,
+		volunteering.EndDate,
+		volunteering.URL,
+		volunteering.Description,
+		time.Now(),
+		volunteeringID,
+	)
+	if err != nil {
+		repo.logger.Error("Failed to update volunteering: %v", err)
+		return nil, domain.NewDatabaseError("update volunteering", err)
+	}
+
+	if err := transaction.CheckVersion(result, condition, "Volunteering", volunteeringID); err != nil {
+		return nil, err
+	}
+
+	return repo.GetByID(ctx, volunteeringID)
+}
+
+func (repo *volunteeringRepository) Delete(ctx context.Context, volunteeringID int) error {
+	query := `UPDATE volunteerings SET volunteering_deleted_at = ?, volunteering_version = volunteering_version + 1
+			  WHERE volunteering_id = ? AND volunteering_deleted_at IS NULL`
+
+	condition := transaction.VersionCondition(ctx, "volunteering_version")
+	result, err := transaction.From(ctx, repo.db).ExecContext(ctx, query+condition, time.Now(), volunteeringID)
+	if err != nil {
+		repo.logger.Error("Failed to delete volunteering: %v", err)
+		return domain.NewDatabaseError("delete volunteering", err)
+	}
+
+	return transaction.CheckVersion(result, condition, "Volunteering", volunteeringID)
+}
+
+func (repo *volunteeringRepository) Reorder(ctx context.Context, userID int, volunteeringIDs []int) error {
+	if err := listing.Reorder(ctx, transaction.From(ctx, repo.db), listing.SQLite, volunteeringList, []any{userID}, volunteeringIDs); err != nil {
+		repo.logger.Error("Failed to reorder volunteerings: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"portfolio/domain"
+	"portfolio/domain/repositories/interfaces"
+	"portfolio/logger"
+	"strconv"
+	"strings"
+)
+
+// Executor is the part of *sql.DB and *sql.Tx the SQL repositories use.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+// From returns the transaction of the unit of work running in ctx, or db when
+// there is none.
+func From(ctx context.Context, db *sql.DB) Executor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+type unitOfWork struct {
+	db     *sql.DB
+	logger *logger.Logger
+}
+
+func NewUnitOfWork(db *sql.DB, logger *logger.Logger) interfaces.UnitOfWork {
+	return &unitOfWork{db: db, logger: logger}
+}
+
+func (uow *unitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := uow.db.BeginTx(ctx, nil)
+	if err != nil {
+		uow.logger.Error("Failed to begin transaction: %v", err)
+		return domain.NewDatabaseError("begin transaction", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			uow.rollback(tx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		uow.rollback(tx)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		uow.logger.Error("Failed to commit transaction: %v", err)
+		return domain.NewDatabaseError("commit transaction", err)
+	}
+	return nil
+}
+
+func (uow *unitOfWork) rollback(tx *sql.Tx) {
+	if err := tx.Rollback(); err != nil {
+		uow.logger.Error("Failed to roll back transaction: %v", err)
+	}
+}
+
+// VersionCondition returns the WHERE condition that limits a write to the row
+// versions the client expects (see domain.WithExpectedVersion), or "" when it
+// expects none. Versions are ints, so they are safe to inline for every
+// driver.
+func VersionCondition(ctx context.Context, versionColumn string) string {
+	versions, ok := domain.ExpectedVersions(ctx)
+	if !ok {
+		return ""
+	}
+	if len(versions) == 0 {
+		return " AND 1 = 0"
+	}
+
+	values := make([]string, len(versions))
+	for i, version := range versions {
+		values[i] = strconv.Itoa(version)
+	}
+	return " AND " + versionColumn + " IN (" + strings.Join(values, ", ") + ")"
+}
+
+// CheckVersion reports a write that changed no row under a version condition
+// as a precondition failure: the row moved on since the client read it.
+func CheckVersion(result sql.Result, condition, resource string, id int) error {
+	if condition == "" {
+		return nil
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domain.NewDatabaseError("version check", err)
+	}
+	if rowsAffected == 0 {
+		return domain.NewPreconditionFailedError(resource, strconv.Itoa(id))
+	}
+	return nil
+}
@@ -80,6 +80,15 @@ func NewLogger(cfg *config.LoggingConfig, environment string) *Logger {
 	return logger
 }
 
+// NewWriterLogger returns a logger that writes to w and never rotates, for
+// tests that have no log file.
+func NewWriterLogger(w io.Writer) *Logger {
+	return &Logger{
+		Logger:      log.New(w, "", log.LstdFlags|log.Lshortfile),
+		currentDate: time.Now().Truncate(24 * time.Hour),
+	}
+}
+
 func getLogConfig(cfg *config.LoggingConfig) LogConfig {
 
 	compress := true
@@ -2,11 +2,12 @@ package migration
 
 import (
 	"embed"
+	"path"
 	"path/filepath"
 	"sort"
 )
 
-//go:embed *.sql
+//go:embed sqlite/*.sql postgres/*.sql
 var migrationFiles embed.FS
 
 type Migration struct {
@@ -14,8 +15,9 @@ type Migration struct {
 	Name    string
 }
 
-func GetMigrationFiles() ([]Migration, error) {
-	migrationsDir := "."
+// GetMigrationFiles returns the migrations of the given driver directory
+// ("sqlite" or "postgres") sorted by filename.
+func GetMigrationFiles(migrationsDir string) ([]Migration, error) {
 	entries, err := migrationFiles.ReadDir(migrationsDir)
 	if err != nil {
 		return nil, err
@@ -27,7 +29,7 @@ func GetMigrationFiles() ([]Migration, error) {
 			continue
 		}
 		name := entry.Name()
-		fullPath := filepath.Join(migrationsDir, name)
+		fullPath := path.Join(migrationsDir, name)
 		content, err := migrationFiles.ReadFile(fullPath)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,39 @@
+-- Migration: Full-text search
+-- Each searchable table gets a generated tsvector column, weighted so that
+-- titles rank above descriptions, and a GIN index over it. Generated columns
+-- follow every write, so no trigger is needed.
+
+ALTER TABLE projects ADD COLUMN project_search tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(project_title, '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce(project_short_description, '')), 'B') ||
+  setweight(to_tsvector('simple', coalesce(project_technologies, '')), 'C') ||
+  setweight(to_tsvector('simple', coalesce(project_description, '')), 'D')
+) STORED;
+CREATE INDEX IF NOT EXISTS idx_projects_search ON projects USING GIN (project_search);
+
+ALTER TABLE skills ADD COLUMN skill_search tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(skill_name, '')), 'A')
+) STORED;
+CREATE INDEX IF NOT EXISTS idx_skills_search ON skills USING GIN (skill_search);
+
+ALTER TABLE experiences ADD COLUMN experience_search tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(experience_job_title, '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce(experience_company_name, '')), 'B') ||
+  setweight(to_tsvector('simple', coalesce(experience_description, '')), 'D')
+) STORED;
+CREATE INDEX IF NOT EXISTS idx_experiences_search ON experiences USING GIN (experience_search);
+
+ALTER TABLE educations ADD COLUMN education_search tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(education_degree, '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce(education_institution, '')), 'B') ||
+  setweight(to_tsvector('simple', coalesce(education_description, '')), 'D')
+) STORED;
+CREATE INDEX IF NOT EXISTS idx_educations_search ON educations USING GIN (education_search);
+
+ALTER TABLE personal_infos ADD COLUMN personal_info_search tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(personal_info_first_name, '') || ' ' || coalesce(personal_info_last_name, '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce(personal_info_professional_title, '')), 'B') ||
+  setweight(to_tsvector('simple', coalesce(personal_info_intro, '') || ' ' || coalesce(personal_info_location, '') || ' ' || coalesce(personal_info_interests, '')), 'C') ||
+  setweight(to_tsvector('simple', coalesce(personal_info_about_me, '')), 'D')
+) STORED;
+CREATE INDEX IF NOT EXISTS idx_personal_infos_search ON personal_infos USING GIN (personal_info_search);
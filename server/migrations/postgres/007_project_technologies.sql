@@ -0,0 +1,29 @@
+-- Migration: Project technologies
+-- Links projects to technology rows. projects.project_technologies stays as the
+-- comma-separated names, which search indexes.
+
+CREATE TABLE IF NOT EXISTS project_technologies (
+  project_id INTEGER NOT NULL,
+  technology_id INTEGER NOT NULL,
+  project_technology_position INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (project_id, technology_id),
+  FOREIGN KEY (project_id) REFERENCES projects(project_id) ON DELETE CASCADE,
+  FOREIGN KEY (technology_id) REFERENCES technologies(technology_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_technologies_technology_id ON project_technologies(technology_id);
+
+-- Link existing projects to the technologies their free text names. Names are
+-- split on commas and matched to the owner's technologies trimmed and
+-- lowercased, like Technology.GetNormalizedName; names without a technology
+-- are left in the text only.
+INSERT INTO project_technologies (project_id, technology_id, project_technology_position)
+SELECT projects.project_id, technologies.technology_id, min(names.position)
+FROM projects
+CROSS JOIN LATERAL unnest(string_to_array(coalesce(projects.project_technologies, ''), ','))
+  WITH ORDINALITY AS names(name, position)
+JOIN technologies ON technologies.user_id = projects.user_id
+  AND lower(btrim(technologies.technology_name)) = lower(btrim(names.name))
+WHERE btrim(names.name) <> ''
+GROUP BY projects.project_id, technologies.technology_id
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,42 @@
+-- Migration: Manual display order
+-- Each collection gets a position that its lists follow unless another sort
+-- is requested. Existing rows keep the order they were listed in: projects
+-- newest first, experiences and educations by start date, newest first,
+-- skills and technologies by name.
+
+ALTER TABLE projects ADD COLUMN project_position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE skills ADD COLUMN skill_position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE technologies ADD COLUMN technology_position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE experiences ADD COLUMN experience_position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE educations ADD COLUMN education_position INTEGER NOT NULL DEFAULT 0;
+
+UPDATE projects SET project_position = ordered.position
+FROM (SELECT project_id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY project_created_at DESC, project_id) - 1 AS position
+      FROM projects) AS ordered
+WHERE projects.project_id = ordered.project_id;
+
+UPDATE skills SET skill_position = ordered.position
+FROM (SELECT skill_id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY lower(skill_name), skill_id) - 1 AS position
+      FROM skills) AS ordered
+WHERE skills.skill_id = ordered.skill_id;
+
+UPDATE technologies SET technology_position = ordered.position
+FROM (SELECT technology_id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY lower(technology_name), technology_id) - 1 AS position
+      FROM technologies) AS ordered
+WHERE technologies.technology_id = ordered.technology_id;
+
+UPDATE experiences SET experience_position = ordered.position
+FROM (SELECT experience_id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY experience_start_date DESC, experience_id) - 1 AS position
+      FROM experiences) AS ordered
+WHERE experiences.experience_id = ordered.experience_id;
+
+UPDATE educations SET education_position = ordered.position
+FROM (SELECT education_id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY education_start_date DESC, education_id) - 1 AS position
+      FROM educations) AS ordered
+WHERE educations.education_id = ordered.education_id;
+
+CREATE INDEX IF NOT EXISTS idx_projects_user_position ON projects(user_id, project_position);
+CREATE INDEX IF NOT EXISTS idx_skills_user_position ON skills(user_id, skill_position);
+CREATE INDEX IF NOT EXISTS idx_technologies_user_position ON technologies(user_id, technology_position);
+CREATE INDEX IF NOT EXISTS idx_experiences_user_position ON experiences(user_id, experience_position);
+CREATE INDEX IF NOT EXISTS idx_educations_user_position ON educations(user_id, education_position);
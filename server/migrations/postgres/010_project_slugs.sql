@@ -0,0 +1,39 @@
+-- Migration: Project slugs and featured projects
+-- Projects are named in public URLs by a slug, unique per user, which starts
+-- out derived from the title. Former slugs are kept in project_slugs so that
+-- old URLs can point to the current one.
+
+ALTER TABLE projects ADD COLUMN project_slug TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN project_featured BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- The lowercased title with every run of characters other than a-z and 0-9
+-- replaced by one hyphen, as entities.NewProjectSlug derives it.
+UPDATE projects SET project_slug = trim(BOTH '-' FROM substr(
+  trim(BOTH '-' FROM regexp_replace(lower(project_title), '[^a-z0-9]+', '-', 'g')), 1, 100));
+
+UPDATE projects SET project_slug = 'project' WHERE project_slug = '';
+UPDATE projects SET project_slug = rtrim(substr(project_slug, 1, 92), '-') || '-project'
+WHERE project_slug !~ '[a-z]' OR project_slug = 'featured';
+
+-- Titles are unique per user but may still share a slug; all but the oldest
+-- project get their ID appended.
+UPDATE projects SET project_slug = rtrim(substr(project_slug, 1, 90), '-') || '-' || project_id
+WHERE EXISTS (
+  SELECT 1 FROM projects AS older
+  WHERE older.user_id = projects.user_id AND older.project_slug = projects.project_slug AND older.project_id < projects.project_id
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_user_slug ON projects(user_id, project_slug);
+
+CREATE TABLE IF NOT EXISTS project_slugs (
+  project_slug_id SERIAL PRIMARY KEY,
+  project_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  project_slug_value TEXT NOT NULL,
+  project_slug_created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(user_id, project_slug_value),
+  FOREIGN KEY (project_id) REFERENCES projects(project_id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_slugs_project_id ON project_slugs(project_id);
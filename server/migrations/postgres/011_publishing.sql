@@ -0,0 +1,34 @@
+-- Migration: Publishing workflow
+-- Content is a draft, scheduled, published or archived, and only published
+-- content is shown on the public API. Scheduled content is published at its
+-- publish_at and published content archived at its unpublish_at by the
+-- publishing scheduler. Existing content stays as visible as it was:
+-- projects follow their status, everything else is published.
+
+ALTER TABLE projects ADD COLUMN project_state TEXT NOT NULL DEFAULT 'published';
+ALTER TABLE projects ADD COLUMN project_publish_at TIMESTAMPTZ;
+ALTER TABLE projects ADD COLUMN project_unpublish_at TIMESTAMPTZ;
+ALTER TABLE skills ADD COLUMN skill_state TEXT NOT NULL DEFAULT 'published';
+ALTER TABLE skills ADD COLUMN skill_publish_at TIMESTAMPTZ;
+ALTER TABLE skills ADD COLUMN skill_unpublish_at TIMESTAMPTZ;
+ALTER TABLE technologies ADD COLUMN technology_state TEXT NOT NULL DEFAULT 'published';
+ALTER TABLE technologies ADD COLUMN technology_publish_at TIMESTAMPTZ;
+ALTER TABLE technologies ADD COLUMN technology_unpublish_at TIMESTAMPTZ;
+ALTER TABLE experiences ADD COLUMN experience_state TEXT NOT NULL DEFAULT 'published';
+ALTER TABLE experiences ADD COLUMN experience_publish_at TIMESTAMPTZ;
+ALTER TABLE experiences ADD COLUMN experience_unpublish_at TIMESTAMPTZ;
+ALTER TABLE educations ADD COLUMN education_state TEXT NOT NULL DEFAULT 'published';
+ALTER TABLE educations ADD COLUMN education_publish_at TIMESTAMPTZ;
+ALTER TABLE educations ADD COLUMN education_unpublish_at TIMESTAMPTZ;
+
+UPDATE projects SET project_state = CASE project_status
+  WHEN 'inactive' THEN 'draft'
+  WHEN 'archived' THEN 'archived'
+  ELSE 'published'
+END;
+
+CREATE INDEX IF NOT EXISTS idx_projects_state ON projects(project_state);
+CREATE INDEX IF NOT EXISTS idx_skills_state ON skills(skill_state);
+CREATE INDEX IF NOT EXISTS idx_technologies_state ON technologies(technology_state);
+CREATE INDEX IF NOT EXISTS idx_experiences_state ON experiences(experience_state);
+CREATE INDEX IF NOT EXISTS idx_educations_state ON educations(education_state);
@@ -0,0 +1,43 @@
+-- Migration: Skill categories and evidence
+-- Skills can be grouped into ordered categories and carry how long and how
+-- recently they were used. Projects and experiences can be linked to a skill
+-- as evidence of it. Deleting a category leaves its skills uncategorized.
+
+CREATE TABLE IF NOT EXISTS skill_categories (
+  skill_category_id SERIAL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  skill_category_name TEXT NOT NULL,
+  skill_category_position INTEGER NOT NULL DEFAULT 0,
+  skill_category_created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  skill_category_updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(user_id, skill_category_name),
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+ALTER TABLE skills ADD COLUMN skill_category_id INTEGER REFERENCES skill_categories(skill_category_id) ON DELETE SET NULL;
+ALTER TABLE skills ADD COLUMN skill_years_of_experience INTEGER CHECK(skill_years_of_experience BETWEEN 0 AND 80);
+ALTER TABLE skills ADD COLUMN skill_last_used_at DATE;
+
+CREATE INDEX IF NOT EXISTS idx_skills_category_id ON skills(skill_category_id);
+
+CREATE TABLE IF NOT EXISTS skill_projects (
+  skill_id INTEGER NOT NULL,
+  project_id INTEGER NOT NULL,
+  skill_project_position INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (skill_id, project_id),
+  FOREIGN KEY (skill_id) REFERENCES skills(skill_id) ON DELETE CASCADE,
+  FOREIGN KEY (project_id) REFERENCES projects(project_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_skill_projects_project_id ON skill_projects(project_id);
+
+CREATE TABLE IF NOT EXISTS skill_experiences (
+  skill_id INTEGER NOT NULL,
+  experience_id INTEGER NOT NULL,
+  skill_experience_position INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (skill_id, experience_id),
+  FOREIGN KEY (skill_id) REFERENCES skills(skill_id) ON DELETE CASCADE,
+  FOREIGN KEY (experience_id) REFERENCES experiences(experience_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_skill_experiences_experience_id ON skill_experiences(experience_id);
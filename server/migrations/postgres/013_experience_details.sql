@@ -0,0 +1,45 @@
+-- Migration: Experience details
+-- An experience is one position at a company; a company is the group of
+-- positions with the same company name, so promotions and returns to a former
+-- title are separate rows told apart by their dates. The unique key on
+-- (experience_job_title, experience_company_name, user_id) is dropped by
+-- lookup, as its generated name is truncated. Existing positions become
+-- full-time, on site.
+
+DO $$
+DECLARE
+  unique_key TEXT;
+BEGIN
+  FOR unique_key IN
+    SELECT conname FROM pg_constraint
+    WHERE conrelid = 'experiences'::regclass AND contype = 'u'
+  LOOP
+    EXECUTE 'ALTER TABLE experiences DROP CONSTRAINT ' || quote_ident(unique_key);
+  END LOOP;
+END $$;
+
+ALTER TABLE experiences ADD COLUMN experience_employment_type TEXT NOT NULL DEFAULT 'full_time'
+  CHECK(experience_employment_type IN ('full_time', 'contract', 'freelance'));
+ALTER TABLE experiences ADD COLUMN experience_location TEXT NOT NULL DEFAULT '';
+ALTER TABLE experiences ADD COLUMN experience_remote BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_experiences_user_company ON experiences(user_id, lower(experience_company_name));
+
+CREATE TABLE IF NOT EXISTS experience_achievements (
+  experience_id INTEGER NOT NULL,
+  experience_achievement_position INTEGER NOT NULL,
+  experience_achievement_text TEXT NOT NULL,
+  PRIMARY KEY (experience_id, experience_achievement_position),
+  FOREIGN KEY (experience_id) REFERENCES experiences(experience_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS experience_technologies (
+  experience_id INTEGER NOT NULL,
+  technology_id INTEGER NOT NULL,
+  experience_technology_position INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (experience_id, technology_id),
+  FOREIGN KEY (experience_id) REFERENCES experiences(experience_id) ON DELETE CASCADE,
+  FOREIGN KEY (technology_id) REFERENCES technologies(technology_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_experience_technologies_technology_id ON experience_technologies(technology_id);
@@ -0,0 +1,39 @@
+-- Migration: Publications
+-- Articles, talks, papers, podcast episodes and open-source contributions,
+-- with where and when they appeared and links to the content, the slides and
+-- the recording. The portfolio owner is the first author; the co-authors are
+-- kept in order in publication_co_authors.
+
+CREATE TABLE IF NOT EXISTS publications (
+  publication_id SERIAL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  publication_title TEXT NOT NULL,
+  publication_type TEXT NOT NULL CHECK(publication_type IN ('article', 'talk', 'paper', 'podcast', 'open_source')),
+  publication_venue TEXT NOT NULL DEFAULT '',
+  publication_date DATE NOT NULL,
+  publication_url TEXT NOT NULL DEFAULT '',
+  publication_slides_url TEXT NOT NULL DEFAULT '',
+  publication_video_url TEXT NOT NULL DEFAULT '',
+  publication_description TEXT NOT NULL DEFAULT '',
+  publication_created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  publication_updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  publication_deleted_at TIMESTAMPTZ,
+  publication_version INTEGER NOT NULL DEFAULT 1,
+  publication_position INTEGER NOT NULL DEFAULT 0,
+  publication_state TEXT NOT NULL DEFAULT 'published',
+  publication_publish_at TIMESTAMPTZ,
+  publication_unpublish_at TIMESTAMPTZ,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_publications_user_position ON publications(user_id, publication_position);
+CREATE INDEX IF NOT EXISTS idx_publications_state ON publications(publication_state);
+CREATE INDEX IF NOT EXISTS idx_publications_date ON publications(publication_date);
+
+CREATE TABLE IF NOT EXISTS publication_co_authors (
+  publication_id INTEGER NOT NULL,
+  publication_co_author_position INTEGER NOT NULL,
+  publication_co_author_name TEXT NOT NULL,
+  PRIMARY KEY (publication_id, publication_co_author_position),
+  FOREIGN KEY (publication_id) REFERENCES publications(publication_id) ON DELETE CASCADE
+);
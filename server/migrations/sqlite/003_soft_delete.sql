@@ -0,0 +1,10 @@
+-- Migration: Soft delete for portfolio entities
+-- Rows with a deleted_at timestamp sit in the admin trash until they are
+-- restored or purged. They keep their UNIQUE keys while trashed.
+
+ALTER TABLE projects ADD COLUMN project_deleted_at DATETIME;
+ALTER TABLE skills ADD COLUMN skill_deleted_at DATETIME;
+ALTER TABLE experiences ADD COLUMN experience_deleted_at DATETIME;
+ALTER TABLE educations ADD COLUMN education_deleted_at DATETIME;
+ALTER TABLE technologies ADD COLUMN technology_deleted_at DATETIME;
+ALTER TABLE personal_infos ADD COLUMN personal_info_deleted_at DATETIME;
@@ -0,0 +1,17 @@
+-- Migration: Revision history for portfolio entities
+-- Every create, update, patch and delete stores a JSON snapshot of the
+-- entity. user_id is the author of the change, not the owner of the entity.
+
+CREATE TABLE IF NOT EXISTS revisions (
+  revision_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  revision_entity_type TEXT NOT NULL,
+  revision_entity_id INTEGER NOT NULL,
+  revision_action TEXT NOT NULL CHECK(revision_action IN ('create', 'update', 'patch', 'delete')),
+  revision_snapshot TEXT NOT NULL,
+  user_id INTEGER,
+  revision_request_id TEXT,
+  revision_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_revisions_entity ON revisions(revision_entity_type, revision_entity_id);
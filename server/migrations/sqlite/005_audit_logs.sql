@@ -0,0 +1,19 @@
+-- Migration: Audit log of admin writes
+-- Records are append-only. user_id is the admin who made the change.
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+  audit_log_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER,
+  audit_log_action TEXT NOT NULL,
+  audit_log_resource_type TEXT NOT NULL,
+  audit_log_resource_id TEXT,
+  audit_log_before TEXT,
+  audit_log_after TEXT,
+  audit_log_ip TEXT,
+  audit_log_request_id TEXT,
+  audit_log_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON audit_logs(audit_log_created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_resource ON audit_logs(audit_log_resource_type, audit_log_resource_id);
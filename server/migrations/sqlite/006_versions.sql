@@ -0,0 +1,10 @@
+-- Migration: Row versions for optimistic concurrency
+-- Every write bumps the version; admin GETs expose it as the ETag and writes
+-- carrying If-Match only apply while the row is still at that version.
+
+ALTER TABLE projects ADD COLUMN project_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE skills ADD COLUMN skill_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE experiences ADD COLUMN experience_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE educations ADD COLUMN education_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE technologies ADD COLUMN technology_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE personal_infos ADD COLUMN personal_info_version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,154 @@
+-- Migration: Full-text search
+-- One external-content FTS5 table per searchable table, indexing the text
+-- columns only. Triggers keep each index in sync with its table; soft-delete,
+-- status and owner filters are applied by joining back to the table.
+-- FTS5 is only compiled into go-sqlite3 with the sqlite_fts5 build tag.
+
+CREATE VIRTUAL TABLE IF NOT EXISTS projects_fts USING fts5(
+  project_title,
+  project_short_description,
+  project_description,
+  project_technologies,
+  content='projects',
+  content_rowid='project_id',
+  tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS projects_fts_insert AFTER INSERT ON projects BEGIN
+  INSERT INTO projects_fts(rowid, project_title, project_short_description, project_description, project_technologies)
+  VALUES (new.project_id, new.project_title, new.project_short_description, new.project_description, new.project_technologies);
+END;
+
+CREATE TRIGGER IF NOT EXISTS projects_fts_delete AFTER DELETE ON projects BEGIN
+  INSERT INTO projects_fts(projects_fts, rowid, project_title, project_short_description, project_description, project_technologies)
+  VALUES ('delete', old.project_id, old.project_title, old.project_short_description, old.project_description, old.project_technologies);
+END;
+
+CREATE TRIGGER IF NOT EXISTS projects_fts_update
+AFTER UPDATE OF project_title, project_short_description, project_description, project_technologies ON projects BEGIN
+  INSERT INTO projects_fts(projects_fts, rowid, project_title, project_short_description, project_description, project_technologies)
+  VALUES ('delete', old.project_id, old.project_title, old.project_short_description, old.project_description, old.project_technologies);
+  INSERT INTO projects_fts(rowid, project_title, project_short_description, project_description, project_technologies)
+  VALUES (new.project_id, new.project_title, new.project_short_description, new.project_description, new.project_technologies);
+END;
+
+CREATE VIRTUAL TABLE IF NOT EXISTS skills_fts USING fts5(
+  skill_name,
+  content='skills',
+  content_rowid='skill_id',
+  tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS skills_fts_insert AFTER INSERT ON skills BEGIN
+  INSERT INTO skills_fts(rowid, skill_name) VALUES (new.skill_id, new.skill_name);
+END;
+
+CREATE TRIGGER IF NOT EXISTS skills_fts_delete AFTER DELETE ON skills BEGIN
+  INSERT INTO skills_fts(skills_fts, rowid, skill_name) VALUES ('delete', old.skill_id, old.skill_name);
+END;
+
+CREATE TRIGGER IF NOT EXISTS skills_fts_update AFTER UPDATE OF skill_name ON skills BEGIN
+  INSERT INTO skills_fts(skills_fts, rowid, skill_name) VALUES ('delete', old.skill_id, old.skill_name);
+  INSERT INTO skills_fts(rowid, skill_name) VALUES (new.skill_id, new.skill_name);
+END;
+
+CREATE VIRTUAL TABLE IF NOT EXISTS experiences_fts USING fts5(
+  experience_job_title,
+  experience_company_name,
+  experience_description,
+  content='experiences',
+  content_rowid='experience_id',
+  tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS experiences_fts_insert AFTER INSERT ON experiences BEGIN
+  INSERT INTO experiences_fts(rowid, experience_job_title, experience_company_name, experience_description)
+  VALUES (new.experience_id, new.experience_job_title, new.experience_company_name, new.experience_description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS experiences_fts_delete AFTER DELETE ON experiences BEGIN
+  INSERT INTO experiences_fts(experiences_fts, rowid, experience_job_title, experience_company_name, experience_description)
+  VALUES ('delete', old.experience_id, old.experience_job_title, old.experience_company_name, old.experience_description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS experiences_fts_update
+AFTER UPDATE OF experience_job_title, experience_company_name, experience_description ON experiences BEGIN
+  INSERT INTO experiences_fts(experiences_fts, rowid, experience_job_title, experience_company_name, experience_description)
+  VALUES ('delete', old.experience_id, old.experience_job_title, old.experience_company_name, old.experience_description);
+  INSERT INTO experiences_fts(rowid, experience_job_title, experience_company_name, experience_description)
+  VALUES (new.experience_id, new.experience_job_title, new.experience_company_name, new.experience_description);
+END;
+
+CREATE VIRTUAL TABLE IF NOT EXISTS educations_fts USING fts5(
+  education_degree,
+  education_institution,
+  education_description,
+  content='educations',
+  content_rowid='education_id',
+  tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS educations_fts_insert AFTER INSERT ON educations BEGIN
+  INSERT INTO educations_fts(rowid, education_degree, education_institution, education_description)
+  VALUES (new.education_id, new.education_degree, new.education_institution, new.education_description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS educations_fts_delete AFTER DELETE ON educations BEGIN
+  INSERT INTO educations_fts(educations_fts, rowid, education_degree, education_institution, education_description)
+  VALUES ('delete', old.education_id, old.education_degree, old.education_institution, old.education_description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS educations_fts_update
+AFTER UPDATE OF education_degree, education_institution, education_description ON educations BEGIN
+  INSERT INTO educations_fts(educations_fts, rowid, education_degree, education_institution, education_description)
+  VALUES ('delete', old.education_id, old.education_degree, old.education_institution, old.education_description);
+  INSERT INTO educations_fts(rowid, education_degree, education_institution, education_description)
+  VALUES (new.education_id, new.education_degree, new.education_institution, new.education_description);
+END;
+
+CREATE VIRTUAL TABLE IF NOT EXISTS personal_infos_fts USING fts5(
+  personal_info_first_name,
+  personal_info_last_name,
+  personal_info_professional_title,
+  personal_info_intro,
+  personal_info_about_me,
+  personal_info_location,
+  personal_info_interests,
+  content='personal_infos',
+  content_rowid='personal_info_id',
+  tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS personal_infos_fts_insert AFTER INSERT ON personal_infos BEGIN
+  INSERT INTO personal_infos_fts(rowid, personal_info_first_name, personal_info_last_name, personal_info_professional_title,
+    personal_info_intro, personal_info_about_me, personal_info_location, personal_info_interests)
+  VALUES (new.personal_info_id, new.personal_info_first_name, new.personal_info_last_name, new.personal_info_professional_title,
+    new.personal_info_intro, new.personal_info_about_me, new.personal_info_location, new.personal_info_interests);
+END;
+
+CREATE TRIGGER IF NOT EXISTS personal_infos_fts_delete AFTER DELETE ON personal_infos BEGIN
+  INSERT INTO personal_infos_fts(personal_infos_fts, rowid, personal_info_first_name, personal_info_last_name, personal_info_professional_title,
+    personal_info_intro, personal_info_about_me, personal_info_location, personal_info_interests)
+  VALUES ('delete', old.personal_info_id, old.personal_info_first_name, old.personal_info_last_name, old.personal_info_professional_title,
+    old.personal_info_intro, old.personal_info_about_me, old.personal_info_location, old.personal_info_interests);
+END;
+
+CREATE TRIGGER IF NOT EXISTS personal_infos_fts_update
+AFTER UPDATE OF personal_info_first_name, personal_info_last_name, personal_info_professional_title,
+  personal_info_intro, personal_info_about_me, personal_info_location, personal_info_interests ON personal_infos BEGIN
+  INSERT INTO personal_infos_fts(personal_infos_fts, rowid, personal_info_first_name, personal_info_last_name, personal_info_professional_title,
+    personal_info_intro, personal_info_about_me, personal_info_location, personal_info_interests)
+  VALUES ('delete', old.personal_info_id, old.personal_info_first_name, old.personal_info_last_name, old.personal_info_professional_title,
+    old.personal_info_intro, old.personal_info_about_me, old.personal_info_location, old.personal_info_interests);
+  INSERT INTO personal_infos_fts(rowid, personal_info_first_name, personal_info_last_name, personal_info_professional_title,
+    personal_info_intro, personal_info_about_me, personal_info_location, personal_info_interests)
+  VALUES (new.personal_info_id, new.personal_info_first_name, new.personal_info_last_name, new.personal_info_professional_title,
+    new.personal_info_intro, new.personal_info_about_me, new.personal_info_location, new.personal_info_interests);
+END;
+
+-- Index the rows that already exist.
+INSERT INTO projects_fts(projects_fts) VALUES ('rebuild');
+INSERT INTO skills_fts(skills_fts) VALUES ('rebuild');
+INSERT INTO experiences_fts(experiences_fts) VALUES ('rebuild');
+INSERT INTO educations_fts(educations_fts) VALUES ('rebuild');
+INSERT INTO personal_infos_fts(personal_infos_fts) VALUES ('rebuild');
@@ -0,0 +1,36 @@
+-- Migration: Project technologies
+-- Links projects to technology rows. projects.project_technologies stays as the
+-- comma-separated names, which search indexes.
+
+CREATE TABLE IF NOT EXISTS project_technologies (
+  project_id INTEGER NOT NULL,
+  technology_id INTEGER NOT NULL,
+  project_technology_position INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (project_id, technology_id),
+  FOREIGN KEY (project_id) REFERENCES projects(project_id) ON DELETE CASCADE,
+  FOREIGN KEY (technology_id) REFERENCES technologies(technology_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_technologies_technology_id ON project_technologies(technology_id);
+
+-- Link existing projects to the technologies their free text names. Names are
+-- split on commas and matched to the owner's technologies trimmed and
+-- lowercased, like Technology.GetNormalizedName; names without a technology
+-- are left in the text only.
+WITH RECURSIVE project_technology_names(project_id, user_id, position, name, rest) AS (
+  SELECT project_id, user_id, 0, '', coalesce(project_technologies, '') || ','
+  FROM projects
+  UNION ALL
+  SELECT project_id, user_id, position + 1,
+         lower(trim(substr(rest, 1, instr(rest, ',') - 1))),
+         substr(rest, instr(rest, ',') + 1)
+  FROM project_technology_names
+  WHERE rest <> ''
+)
+INSERT OR IGNORE INTO project_technologies (project_id, technology_id, project_technology_position)
+SELECT names.project_id, technologies.technology_id, min(names.position)
+FROM project_technology_names names
+JOIN technologies ON technologies.user_id = names.user_id
+  AND lower(trim(technologies.technology_name)) = names.name
+WHERE names.name <> ''
+GROUP BY names.project_id, technologies.technology_id;
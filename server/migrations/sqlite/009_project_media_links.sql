@@ -0,0 +1,47 @@
+-- Migration: Project media and links
+-- A project's gallery and links replace its single image and GitHub URLs,
+-- which are copied in as primary items and then dropped.
+
+CREATE TABLE IF NOT EXISTS project_media (
+  project_media_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  project_id INTEGER NOT NULL,
+  project_media_type TEXT NOT NULL CHECK(project_media_type IN ('image', 'video')),
+  project_media_url TEXT NOT NULL,
+  project_media_caption TEXT NOT NULL DEFAULT '',
+  project_media_alt_text TEXT NOT NULL DEFAULT '',
+  project_media_position INTEGER NOT NULL DEFAULT 0,
+  project_media_is_primary INTEGER NOT NULL DEFAULT 0,
+  project_media_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  project_media_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (project_id) REFERENCES projects(project_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_media_project_id ON project_media(project_id, project_media_position);
+
+CREATE TABLE IF NOT EXISTS project_links (
+  project_link_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  project_id INTEGER NOT NULL,
+  project_link_type TEXT NOT NULL CHECK(project_link_type IN ('github', 'demo', 'docs', 'video', 'website', 'other')),
+  project_link_url TEXT NOT NULL,
+  project_link_label TEXT NOT NULL DEFAULT '',
+  project_link_position INTEGER NOT NULL DEFAULT 0,
+  project_link_is_primary INTEGER NOT NULL DEFAULT 0,
+  project_link_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  project_link_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (project_id) REFERENCES projects(project_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_links_project_id ON project_links(project_id, project_link_position);
+
+INSERT INTO project_media (project_id, project_media_type, project_media_url, project_media_is_primary)
+SELECT project_id, 'image', trim(project_image_url), 1
+FROM projects
+WHERE trim(coalesce(project_image_url, '')) <> '';
+
+INSERT INTO project_links (project_id, project_link_type, project_link_url, project_link_label, project_link_is_primary)
+SELECT project_id, 'github', trim(project_github_url), 'GitHub', 1
+FROM projects
+WHERE trim(coalesce(project_github_url, '')) <> '';
+
+ALTER TABLE projects DROP COLUMN project_image_url;
+ALTER TABLE projects DROP COLUMN project_github_url;
@@ -0,0 +1,53 @@
+-- Migration: Project slugs and featured projects
+-- Projects are named in public URLs by a slug, unique per user, which starts
+-- out derived from the title. Former slugs are kept in project_slugs so that
+-- old URLs can point to the current one.
+
+ALTER TABLE projects ADD COLUMN project_slug TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN project_featured INTEGER NOT NULL DEFAULT 0;
+
+-- The lowercased title with every run of characters other than a-z and 0-9
+-- replaced by one hyphen, as entities.NewProjectSlug derives it.
+WITH RECURSIVE slugs(project_id, rest, slug) AS (
+  SELECT project_id, lower(project_title), '' FROM projects
+  UNION ALL
+  SELECT project_id, substr(rest, 2),
+    CASE
+      WHEN substr(rest, 1, 1) BETWEEN 'a' AND 'z' OR substr(rest, 1, 1) BETWEEN '0' AND '9' THEN slug || substr(rest, 1, 1)
+      WHEN slug = '' OR substr(slug, -1) = '-' THEN slug
+      ELSE slug || '-'
+    END
+  FROM slugs
+  WHERE rest <> ''
+)
+UPDATE projects SET project_slug = (
+  SELECT rtrim(substr(rtrim(slug, '-'), 1, 100), '-') FROM slugs
+  WHERE slugs.project_id = projects.project_id AND slugs.rest = ''
+);
+
+UPDATE projects SET project_slug = 'project' WHERE project_slug = '';
+UPDATE projects SET project_slug = rtrim(substr(project_slug, 1, 92), '-') || '-project'
+WHERE project_slug NOT GLOB '*[a-z]*' OR project_slug = 'featured';
+
+-- Titles are unique per user but may still share a slug; all but the oldest
+-- project get their ID appended.
+UPDATE projects SET project_slug = rtrim(substr(project_slug, 1, 90), '-') || '-' || project_id
+WHERE EXISTS (
+  SELECT 1 FROM projects AS older
+  WHERE older.user_id = projects.user_id AND older.project_slug = projects.project_slug AND older.project_id < projects.project_id
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_user_slug ON projects(user_id, project_slug);
+
+CREATE TABLE IF NOT EXISTS project_slugs (
+  project_slug_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  project_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  project_slug_value TEXT NOT NULL,
+  project_slug_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(user_id, project_slug_value),
+  FOREIGN KEY (project_id) REFERENCES projects(project_id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_slugs_project_id ON project_slugs(project_id);
@@ -0,0 +1,97 @@
+-- Migration: Experience details
+-- An experience is one position at a company; a company is the group of
+-- positions with the same company name, so promotions and returns to a former
+-- title are separate rows told apart by their dates. That needs
+-- UNIQUE(experience_job_title, experience_company_name, user_id) gone, and
+-- SQLite cannot drop a table constraint: the table is rebuilt with its rows,
+-- IDs and ID sequence, and its indexes and search triggers are recreated.
+-- Foreign keys are off meanwhile so that dropping the old table does not
+-- cascade to skill_experiences. Existing positions become full-time, on site.
+
+PRAGMA foreign_keys = OFF;
+
+BEGIN;
+
+CREATE TABLE experiences_new (
+  experience_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  experience_job_title TEXT NOT NULL,
+  experience_company_name TEXT NOT NULL,
+  experience_start_date DATE,
+  experience_end_date DATE,
+  experience_description TEXT,
+  experience_employment_type TEXT NOT NULL DEFAULT 'full_time' CHECK(experience_employment_type IN ('full_time', 'contract', 'freelance')),
+  experience_location TEXT NOT NULL DEFAULT '',
+  experience_remote BOOLEAN NOT NULL DEFAULT 0,
+  experience_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  experience_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  experience_deleted_at DATETIME,
+  experience_version INTEGER NOT NULL DEFAULT 1,
+  experience_position INTEGER NOT NULL DEFAULT 0,
+  experience_state TEXT NOT NULL DEFAULT 'published',
+  experience_publish_at DATETIME,
+  experience_unpublish_at DATETIME,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+INSERT INTO experiences_new (experience_id, user_id, experience_job_title, experience_company_name,
+  experience_start_date, experience_end_date, experience_description, experience_created_at,
+  experience_updated_at, experience_deleted_at, experience_version, experience_position,
+  experience_state, experience_publish_at, experience_unpublish_at)
+SELECT experience_id, user_id, experience_job_title, experience_company_name,
+  experience_start_date, experience_end_date, experience_description, experience_created_at,
+  experience_updated_at, experience_deleted_at, experience_version, experience_position,
+  experience_state, experience_publish_at, experience_unpublish_at
+FROM experiences;
+
+-- Keep the IDs of purged experiences used, as revisions refer to them.
+UPDATE sqlite_sequence SET seq = (SELECT seq FROM sqlite_sequence WHERE name = 'experiences')
+WHERE name = 'experiences_new' AND EXISTS (SELECT 1 FROM sqlite_sequence WHERE name = 'experiences');
+
+DROP TABLE experiences;
+ALTER TABLE experiences_new RENAME TO experiences;
+
+CREATE INDEX IF NOT EXISTS idx_experiences_user_position ON experiences(user_id, experience_position);
+CREATE INDEX IF NOT EXISTS idx_experiences_state ON experiences(experience_state);
+CREATE INDEX IF NOT EXISTS idx_experiences_user_company ON experiences(user_id, lower(experience_company_name));
+
+CREATE TRIGGER IF NOT EXISTS experiences_fts_insert AFTER INSERT ON experiences BEGIN
+  INSERT INTO experiences_fts(rowid, experience_job_title, experience_company_name, experience_description)
+  VALUES (new.experience_id, new.experience_job_title, new.experience_company_name, new.experience_description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS experiences_fts_delete AFTER DELETE ON experiences BEGIN
+  INSERT INTO experiences_fts(experiences_fts, rowid, experience_job_title, experience_company_name, experience_description)
+  VALUES ('delete', old.experience_id, old.experience_job_title, old.experience_company_name, old.experience_description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS experiences_fts_update
+AFTER UPDATE OF experience_job_title, experience_company_name, experience_description ON experiences BEGIN
+  INSERT INTO experiences_fts(experiences_fts, rowid, experience_job_title, experience_company_name, experience_description)
+  VALUES ('delete', old.experience_id, old.experience_job_title, old.experience_company_name, old.experience_description);
+  INSERT INTO experiences_fts(rowid, experience_job_title, experience_company_name, experience_description)
+  VALUES (new.experience_id, new.experience_job_title, new.experience_company_name, new.experience_description);
+END;
+
+CREATE TABLE IF NOT EXISTS experience_achievements (
+  experience_id INTEGER NOT NULL,
+  experience_achievement_position INTEGER NOT NULL,
+  experience_achievement_text TEXT NOT NULL,
+  PRIMARY KEY (experience_id, experience_achievement_position),
+  FOREIGN KEY (experience_id) REFERENCES experiences(experience_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS experience_technologies (
+  experience_id INTEGER NOT NULL,
+  technology_id INTEGER NOT NULL,
+  experience_technology_position INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (experience_id, technology_id),
+  FOREIGN KEY (experience_id) REFERENCES experiences(experience_id) ON DELETE CASCADE,
+  FOREIGN KEY (technology_id) REFERENCES technologies(technology_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_experience_technologies_technology_id ON experience_technologies(technology_id);
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
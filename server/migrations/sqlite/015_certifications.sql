@@ -0,0 +1,33 @@
+-- Migration: Certifications
+-- Certifications and licenses, with the issuer, the credential and how to
+-- verify it. A certification without an expiry date never expires. A
+-- credential ID is unique per issuer; certifications without one are not
+-- checked.
+
+CREATE TABLE IF NOT EXISTS certifications (
+  certification_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  certification_name TEXT NOT NULL,
+  certification_issuer TEXT NOT NULL,
+  certification_credential_id TEXT NOT NULL DEFAULT '',
+  certification_verification_url TEXT NOT NULL DEFAULT '',
+  certification_issue_date DATE NOT NULL,
+  certification_expiry_date DATE,
+  certification_description TEXT NOT NULL DEFAULT '',
+  certification_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  certification_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  certification_deleted_at DATETIME,
+  certification_version INTEGER NOT NULL DEFAULT 1,
+  certification_position INTEGER NOT NULL DEFAULT 0,
+  certification_state TEXT NOT NULL DEFAULT 'published',
+  certification_publish_at DATETIME,
+  certification_unpublish_at DATETIME,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_certifications_credential
+  ON certifications(user_id, certification_issuer, certification_credential_id)
+  WHERE certification_credential_id <> '';
+CREATE INDEX IF NOT EXISTS idx_certifications_user_position ON certifications(user_id, certification_position);
+CREATE INDEX IF NOT EXISTS idx_certifications_state ON certifications(certification_state);
+CREATE INDEX IF NOT EXISTS idx_certifications_expiry_date ON certifications(certification_expiry_date);
@@ -0,0 +1,33 @@
+-- Migration: Testimonials
+-- Recommendations from colleagues and clients. Visitors submit them as
+-- pending; an admin approves or rejects them, and only approved ones are
+-- shown. A testimonial can be about one project and one experience of the
+-- portfolio owner; purging those unlinks it.
+
+CREATE TABLE IF NOT EXISTS testimonials (
+  testimonial_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  testimonial_author_name TEXT NOT NULL,
+  testimonial_author_title TEXT NOT NULL DEFAULT '',
+  testimonial_author_company TEXT NOT NULL DEFAULT '',
+  testimonial_author_email TEXT NOT NULL,
+  testimonial_author_url TEXT NOT NULL DEFAULT '',
+  testimonial_content TEXT NOT NULL,
+  testimonial_status TEXT NOT NULL DEFAULT 'pending' CHECK(testimonial_status IN ('pending', 'approved', 'rejected')),
+  testimonial_rejection_reason TEXT NOT NULL DEFAULT '',
+  testimonial_moderated_at DATETIME,
+  project_id INTEGER,
+  experience_id INTEGER,
+  testimonial_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  testimonial_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  testimonial_deleted_at DATETIME,
+  testimonial_version INTEGER NOT NULL DEFAULT 1,
+  testimonial_position INTEGER NOT NULL DEFAULT 0,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE,
+  FOREIGN KEY (project_id) REFERENCES projects(project_id) ON DELETE SET NULL,
+  FOREIGN KEY (experience_id) REFERENCES experiences(experience_id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_testimonials_user_position ON testimonials(user_id, testimonial_position);
+CREATE INDEX IF NOT EXISTS idx_testimonials_status ON testimonials(testimonial_status);
+CREATE INDEX IF NOT EXISTS idx_testimonials_author_email ON testimonials(user_id, lower(testimonial_author_email));
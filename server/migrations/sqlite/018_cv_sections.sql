@@ -0,0 +1,71 @@
+-- Migration: CV sections
+-- Spoken languages with their CEFR level (or native), awards and volunteer
+-- work. A spoken language name is unique per user, ignoring case. A
+-- volunteering without an end date is ongoing.
+
+CREATE TABLE IF NOT EXISTS spoken_languages (
+  spoken_language_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  spoken_language_name TEXT NOT NULL,
+  spoken_language_level TEXT NOT NULL CHECK(spoken_language_level IN ('A1', 'A2', 'B1', 'B2', 'C1', 'C2', 'native')),
+  spoken_language_description TEXT NOT NULL DEFAULT '',
+  spoken_language_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  spoken_language_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  spoken_language_deleted_at DATETIME,
+  spoken_language_version INTEGER NOT NULL DEFAULT 1,
+  spoken_language_position INTEGER NOT NULL DEFAULT 0,
+  spoken_language_state TEXT NOT NULL DEFAULT 'published',
+  spoken_language_publish_at DATETIME,
+  spoken_language_unpublish_at DATETIME,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_spoken_languages_name ON spoken_languages(user_id, lower(spoken_language_name));
+CREATE INDEX IF NOT EXISTS idx_spoken_languages_user_position ON spoken_languages(user_id, spoken_language_position);
+CREATE INDEX IF NOT EXISTS idx_spoken_languages_state ON spoken_languages(spoken_language_state);
+
+CREATE TABLE IF NOT EXISTS awards (
+  award_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  award_title TEXT NOT NULL,
+  award_issuer TEXT NOT NULL,
+  award_date DATE NOT NULL,
+  award_url TEXT NOT NULL DEFAULT '',
+  award_description TEXT NOT NULL DEFAULT '',
+  award_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  award_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  award_deleted_at DATETIME,
+  award_version INTEGER NOT NULL DEFAULT 1,
+  award_position INTEGER NOT NULL DEFAULT 0,
+  award_state TEXT NOT NULL DEFAULT 'published',
+  award_publish_at DATETIME,
+  award_unpublish_at DATETIME,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_awards_user_position ON awards(user_id, award_position);
+CREATE INDEX IF NOT EXISTS idx_awards_state ON awards(award_state);
+
+CREATE TABLE IF NOT EXISTS volunteerings (
+  volunteering_id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  volunteering_role TEXT NOT NULL,
+  volunteering_organization TEXT NOT NULL,
+  volunteering_cause TEXT NOT NULL DEFAULT '',
+  volunteering_start_date DATE NOT NULL,
+  volunteering_end_date DATE,
+  volunteering_url TEXT NOT NULL DEFAULT '',
+  volunteering_description TEXT NOT NULL DEFAULT '',
+  volunteering_created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  volunteering_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  volunteering_deleted_at DATETIME,
+  volunteering_version INTEGER NOT NULL DEFAULT 1,
+  volunteering_position INTEGER NOT NULL DEFAULT 0,
+  volunteering_state TEXT NOT NULL DEFAULT 'published',
+  volunteering_publish_at DATETIME,
+  volunteering_unpublish_at DATETIME,
+  FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_volunteerings_user_position ON volunteerings(user_id, volunteering_position);
+CREATE INDEX IF NOT EXISTS idx_volunteerings_state ON volunteerings(volunteering_state);
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"container/list"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheService is a bounded, in-process LRU cache with a per-entry TTL.
+// Keys are namespaced ("projects:list:1") and every entry records the user
+// whose rows it holds, so that a write can drop the entries derived from the
+// same table and owner without touching the others.
+type CacheService struct {
+	mu         sync.Mutex
+	enabled    bool
+	maxEntries int
+	ttl        time.Duration
+	entries    map[string]*list.Element
+	order      *list.List
+	// generations counts the invalidations of each namespace, and flushes
+	// those of the whole cache; see Generation.
+	generations map[string]uint64
+	flushes     uint64
+
+	hits          uint64
+	misses        uint64
+	evictions     uint64
+	invalidations uint64
+}
+
+type cacheEntry struct {
+	key       string
+	namespace string
+	ownerID   int
+	value     any
+	expiresAt time.Time
+}
+
+type CacheStats struct {
+	Enabled       bool    `json:"enabled"`
+	Entries       int     `json:"entries"`
+	MaxEntries    int     `json:"max_entries"`
+	TTLSeconds    int     `json:"ttl_seconds"`
+	Hits          uint64  `json:"hits"`
+	Misses        uint64  `json:"misses"`
+	HitRatio      float64 `json:"hit_ratio"`
+	Evictions     uint64  `json:"evictions"`
+	Invalidations uint64  `json:"invalidations"`
+}
+
+func NewCacheService(enabled bool, maxEntries int, ttl time.Duration) *CacheService {
+	return &CacheService{
+		enabled:     enabled && maxEntries > 0 && ttl > 0,
+		maxEntries:  maxEntries,
+		ttl:         ttl,
+		entries:     make(map[string]*list.Element),
+		order:       list.New(),
+		generations: make(map[string]uint64),
+	}
+}
+
+func CacheKey(namespace string, parts ...string) string {
+	return namespace + ":" + strings.Join(parts, ":")
+}
+
+// keyNamespace returns the namespace a key was built with by CacheKey.
+func keyNamespace(key string) string {
+	namespace, _, _ := strings.Cut(key, ":")
+	return namespace
+}
+
+// Get returns the value stored under key if it has not expired. A nil
+// receiver behaves like a disabled cache.
+func (cs *CacheService) Get(key string) (any, bool) {
+	if cs == nil || !cs.enabled {
+		return nil, false
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	element, ok := cs.entries[key]
+	if !ok {
+		cs.misses++
+		return nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		cs.removeElement(element)
+		cs.misses++
+		return nil, false
+	}
+
+	cs.order.MoveToFront(element)
+	cs.hits++
+	return entry.value, true
+}
+
+// Generation returns a counter that changes whenever the namespace of key is
+// invalidated. Read it before loading a value and hand it to SetIfCurrent.
+func (cs *CacheService) Generation(key string) uint64 {
+	if cs == nil || !cs.enabled {
+		return 0
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return cs.flushes + cs.generations[keyNamespace(key)]
+}
+
+// SetIfCurrent stores value under key on behalf of ownerID, unless the
+// namespace of key was invalidated since generation was read: the value may
+// then have been loaded before the write that invalidated it, and caching it
+// would serve stale data until it expires. It reports whether it stored value.
+func (cs *CacheService) SetIfCurrent(key string, ownerID int, generation uint64, value any) bool {
+	if cs == nil || !cs.enabled {
+		return false
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	namespace := keyNamespace(key)
+	if cs.flushes+cs.generations[namespace] != generation {
+		return false
+	}
+
+	expiresAt := time.Now().Add(cs.ttl)
+	if element, ok := cs.entries[key]; ok {
+		entry := element.Value.(*cacheEntry)
+		entry.ownerID = ownerID
+		entry.value = value
+		entry.expiresAt = expiresAt
+		cs.order.MoveToFront(element)
+		return true
+	}
+
+	cs.entries[key] = cs.order.PushFront(&cacheEntry{key: key, namespace: namespace, ownerID: ownerID, value: value, expiresAt: expiresAt})
+
+	for cs.order.Len() > cs.maxEntries {
+		cs.removeElement(cs.order.Back())
+		cs.evictions++
+	}
+	return true
+}
+
+// InvalidateOwner drops the entries of namespace stored on behalf of one of
+// ownerIDs.
+func (cs *CacheService) InvalidateOwner(namespace string, ownerIDs ...int) {
+	if cs == nil || !cs.enabled {
+		return
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	for _, element := range cs.entries {
+		entry := element.Value.(*cacheEntry)
+		if entry.namespace == namespace && slices.Contains(ownerIDs, entry.ownerID) {
+			cs.removeElement(element)
+		}
+	}
+	cs.generations[namespace]++
+	cs.invalidations++
+}
+
+// InvalidateNamespace drops every entry whose key was built with one of the
+// given namespaces.
+func (cs *CacheService) InvalidateNamespace(namespaces ...string) {
+	if cs == nil || !cs.enabled {
+		return
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	for _, namespace := range namespaces {
+		for _, element := range cs.entries {
+			if element.Value.(*cacheEntry).namespace == namespace {
+				cs.removeElement(element)
+			}
+		}
+		cs.generations[namespace]++
+		cs.invalidations++
+	}
+}
+
+func (cs *CacheService) Flush() {
+	if cs == nil {
+		return
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	cs.entries = make(map[string]*list.Element)
+	cs.order.Init()
+	cs.flushes++
+	cs.invalidations++
+}
+
+func (cs *CacheService) Stats() CacheStats {
+	if cs == nil {
+		return CacheStats{}
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	stats := CacheStats{
+		Enabled:       cs.enabled,
+		Entries:       cs.order.Len(),
+		MaxEntries:    cs.maxEntries,
+		TTLSeconds:    int(cs.ttl / time.Second),
+		Hits:          cs.hits,
+		Misses:        cs.misses,
+		Evictions:     cs.evictions,
+		Invalidations: cs.invalidations,
+	}
+	if total := cs.hits + cs.misses; total > 0 {
+		stats.HitRatio = float64(cs.hits) / float64(total)
+	}
+
+	return stats
+}
+
+// removeElement unlinks an entry; callers must hold the lock.
+func (cs *CacheService) removeElement(element *list.Element) {
+	cs.order.Remove(element)
+	delete(cs.entries, element.Value.(*cacheEntry).key)
+}
@@ -0,0 +1,72 @@
+package service_test
+
+import (
+	"portfolio/service"
+	"testing"
+	"time"
+)
+
+func newCache() *service.CacheService {
+	return service.NewCacheService(true, 100, time.Minute)
+}
+
+func set(t *testing.T, cache *service.CacheService, key string, ownerID int, value any) {
+	t.Helper()
+
+	if !cache.SetIfCurrent(key, ownerID, cache.Generation(key), value) {
+		t.Fatalf("SetIfCurrent(%q) stored nothing", key)
+	}
+}
+
+func assertCached(t *testing.T, cache *service.CacheService, key string, want bool) {
+	t.Helper()
+
+	if _, ok := cache.Get(key); ok != want {
+		t.Errorf("Get(%q) found = %v, want %v", key, ok, want)
+	}
+}
+
+func TestInvalidateOwnerKeepsOtherOwnersAndNamespaces(t *testing.T) {
+	cache := newCache()
+	mine := service.CacheKey("projects", "list", "1")
+	theirs := service.CacheKey("projects", "list", "2")
+	other := service.CacheKey("skills", "list", "1")
+	set(t, cache, mine, 1, "mine")
+	set(t, cache, theirs, 2, "theirs")
+	set(t, cache, other, 1, "other")
+
+	cache.InvalidateOwner("projects", 1)
+
+	assertCached(t, cache, mine, false)
+	assertCached(t, cache, theirs, true)
+	assertCached(t, cache, other, true)
+}
+
+func TestSetIfCurrentRefusesValuesLoadedBeforeAnInvalidation(t *testing.T) {
+	cache := newCache()
+	key := service.CacheKey("projects", "item", "7")
+
+	// A read misses and starts loading; a write lands and invalidates before
+	// the read stores what it loaded.
+	generation := cache.Generation(key)
+	cache.InvalidateOwner("projects", 1)
+	if cache.SetIfCurrent(key, 1, generation, "stale") {
+		t.Fatal("SetIfCurrent stored a value loaded before the invalidation")
+	}
+	assertCached(t, cache, key, false)
+
+	// The same holds after a flush.
+	generation = cache.Generation(key)
+	cache.Flush()
+	if cache.SetIfCurrent(key, 1, generation, "stale") {
+		t.Fatal("SetIfCurrent stored a value loaded before the flush")
+	}
+
+	// Invalidating another namespace does not hold back the read.
+	generation = cache.Generation(key)
+	cache.InvalidateOwner("skills", 1)
+	if !cache.SetIfCurrent(key, 1, generation, "fresh") {
+		t.Fatal("SetIfCurrent refused a value after another namespace was invalidated")
+	}
+	assertCached(t, cache, key, true)
+}
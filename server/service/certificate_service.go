@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"portfolio/config"
+	"portfolio/logger"
+	"strings"
+	"sync"
+	"time"
+)
+
+type CertificateService struct {
+	cfg          *config.TLSConfig
+	logger       *logger.Logger
+	mu           sync.RWMutex
+	certificate  *tls.Certificate
+	clientCAs    *x509.CertPool
+	minVersion   uint16
+	cipherSuites []uint16
+	modTimes     map[string]time.Time
+}
+
+func NewCertificateService(cfg *config.TLSConfig, logger *logger.Logger) (*CertificateService, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, fmt.Errorf("tls is enabled but cert_file or key_file is missing")
+	}
+
+	minVersion, err := parseTLSVersion(cfg.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	cipherSuites, err := parseCipherSuites(cfg.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+
+	service := &CertificateService{
+		cfg:          cfg,
+		logger:       logger,
+		minVersion:   minVersion,
+		cipherSuites: cipherSuites,
+		modTimes:     make(map[string]time.Time),
+	}
+
+	if err := service.reload(); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// TLSConfig returns a server configuration whose certificate and client CA
+// pool are resolved on every handshake, so reloads apply to new connections.
+func (cs *CertificateService) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:         cs.minVersion,
+		CipherSuites:       cs.cipherSuites,
+		GetConfigForClient: cs.getConfigForClient,
+	}
+}
+
+func (cs *CertificateService) getConfigForClient(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+
+	tlsConfig := &tls.Config{
+		MinVersion:   cs.minVersion,
+		CipherSuites: cs.cipherSuites,
+		Certificates: []tls.Certificate{*cs.certificate},
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	if cs.clientCAs != nil {
+		// Client certificates are optional at the TLS layer: only /admin
+		// requires one, which is enforced by the client certificate middleware.
+		tlsConfig.ClientCAs = cs.clientCAs
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return tlsConfig, nil
+}
+
+func (cs *CertificateService) HasClientCAs() bool {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	return cs.clientCAs != nil
+}
+
+// Watch polls the certificate, key and client CA files and reloads them when
+// one of them changes. It returns once ctx is cancelled.
+func (cs *CertificateService) Watch(ctx context.Context) {
+	interval := time.Duration(cs.cfg.ReloadInterval) * time.Second
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !cs.filesChanged() {
+				continue
+			}
+			if err := cs.reload(); err != nil {
+				cs.logger.Error("Failed to reload TLS certificates, keeping previous ones: %v", err)
+				continue
+			}
+			cs.logger.Info("TLS certificates reloaded from %s", cs.cfg.CertFile)
+		}
+	}
+}
+
+func (cs *CertificateService) reload() error {
+	certificate, err := tls.LoadX509KeyPair(cs.cfg.CertFile, cs.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load key pair: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if cs.cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cs.cfg.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no valid certificate found in client CA file %s", cs.cfg.ClientCAFile)
+		}
+	}
+
+	modTimes := make(map[string]time.Time)
+	for _, path := range cs.watchedFiles() {
+		if info, err := os.Stat(path); err == nil {
+			modTimes[path] = info.ModTime()
+		}
+	}
+
+	cs.mu.Lock()
+	cs.certificate = &certificate
+	cs.clientCAs = clientCAs
+	cs.modTimes = modTimes
+	cs.mu.Unlock()
+
+	return nil
+}
+
+func (cs *CertificateService) filesChanged() bool {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+
+	for _, path := range cs.watchedFiles() {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(cs.modTimes[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (cs *CertificateService) watchedFiles() []string {
+	files := []string{cs.cfg.CertFile, cs.cfg.KeyFile}
+	if cs.cfg.ClientCAFile != "" {
+		files = append(files, cs.cfg.ClientCAFile)
+	}
+	return files
+}
+
+func parseTLSVersion(version string) (uint16, error) {
+	switch strings.TrimSpace(version) {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("unsupported tls min_version %q (expected 1.2 or 1.3)", version)
+	}
+}
+
+// parseCipherSuites maps IANA cipher suite names to their IDs. An empty list
+// keeps Go's default secure selection; insecure suites are always rejected.
+func parseCipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	available := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		available[suite.Name] = suite.ID
+	}
+
+	ids := make([]uint16, 0, len(names))
+	for _, name := range names {
+		id, ok := available[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported or insecure tls cipher suite %q", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,144 @@
+package service_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"portfolio/config"
+	"portfolio/logger"
+	"portfolio/service"
+	"testing"
+	"time"
+)
+
+// writeKeyPair writes a self-signed certificate for commonName and its key,
+// stamped with modTime so that the watcher sees a change.
+func writeKeyPair(t *testing.T, cfg *config.TLSConfig, commonName string, modTime time.Time) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate failed: %v", err)
+	}
+	encodedKey, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalECPrivateKey failed: %v", err)
+	}
+
+	writeFile(t, cfg.CertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), modTime)
+	writeFile(t, cfg.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: encodedKey}), modTime)
+}
+
+func writeFile(t *testing.T, path string, content []byte, modTime time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("WriteFile(%s) failed: %v", path, err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Chtimes(%s) failed: %v", path, err)
+	}
+}
+
+// servedName is the common name of the certificate a new connection gets.
+func servedName(t *testing.T, certificates *service.CertificateService) string {
+	t.Helper()
+
+	tlsConfig, err := certificates.TLSConfig().GetConfigForClient(nil)
+	if err != nil {
+		t.Fatalf("GetConfigForClient failed: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(tlsConfig.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatalf("ParseCertificate failed: %v", err)
+	}
+	return leaf.Subject.CommonName
+}
+
+// waitForName polls until new connections get the certificate for want.
+func waitForName(t *testing.T, certificates *service.CertificateService, want string) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for servedName(t, certificates) != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("served certificate = %q, want %q", servedName(t, certificates), want)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestCertificateServiceReloadsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config.TLSConfig{
+		CertFile:       filepath.Join(dir, "server.crt"),
+		KeyFile:        filepath.Join(dir, "server.key"),
+		ReloadInterval: 1,
+	}
+	start := time.Now().Add(-time.Minute)
+	writeKeyPair(t, cfg, "first", start)
+
+	certificates, err := service.NewCertificateService(cfg, logger.NewWriterLogger(io.Discard))
+	if err != nil {
+		t.Fatalf("NewCertificateService failed: %v", err)
+	}
+	if name := servedName(t, certificates); name != "first" {
+		t.Fatalf("served certificate = %q, want %q", name, "first")
+	}
+
+	go certificates.Watch(t.Context())
+
+	writeKeyPair(t, cfg, "second", start.Add(time.Second))
+	waitForName(t, certificates, "second")
+
+	// A broken key pair is not loaded: the previous certificate keeps being
+	// served until a valid one replaces it.
+	writeFile(t, cfg.KeyFile, []byte("not a key"), start.Add(2*time.Second))
+	time.Sleep(1500 * time.Millisecond)
+	if name := servedName(t, certificates); name != "second" {
+		t.Fatalf("served certificate after a broken reload = %q, want %q", name, "second")
+	}
+
+	writeKeyPair(t, cfg, "third", start.Add(3*time.Second))
+	waitForName(t, certificates, "third")
+}
+
+func TestCertificateServiceRejectsInvalidSettings(t *testing.T) {
+	dir := t.TempDir()
+	valid := &config.TLSConfig{
+		CertFile: filepath.Join(dir, "server.crt"),
+		KeyFile:  filepath.Join(dir, "server.key"),
+	}
+	writeKeyPair(t, valid, "portfolio", time.Now())
+
+	for name, cfg := range map[string]config.TLSConfig{
+		"missing key":     {CertFile: valid.CertFile},
+		"old version":     {CertFile: valid.CertFile, KeyFile: valid.KeyFile, MinVersion: "1.1"},
+		"insecure suite":  {CertFile: valid.CertFile, KeyFile: valid.KeyFile, CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}},
+		"missing CA file": {CertFile: valid.CertFile, KeyFile: valid.KeyFile, ClientCAFile: filepath.Join(dir, "ca.crt")},
+		"swapped files":   {CertFile: valid.KeyFile, KeyFile: valid.CertFile},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := service.NewCertificateService(&cfg, logger.NewWriterLogger(io.Discard)); err == nil {
+				t.Fatal("NewCertificateService succeeded")
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"portfolio/domain/entities"
+	"sync"
+)
+
+// EventService hands publishing events to the subscribers registered at
+// startup. Subscribers run synchronously, in order, on the publisher's
+// goroutine, so they must not block.
+type EventService struct {
+	mu          sync.RWMutex
+	subscribers []func(ctx context.Context, event *entities.PublishingEvent)
+}
+
+func NewEventService() *EventService {
+	return &EventService{}
+}
+
+func (es *EventService) Subscribe(subscriber func(ctx context.Context, event *entities.PublishingEvent)) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	es.subscribers = append(es.subscribers, subscriber)
+}
+
+func (es *EventService) Publish(ctx context.Context, event *entities.PublishingEvent) {
+	es.mu.RLock()
+	subscribers := es.subscribers
+	es.mu.RUnlock()
+
+	for _, subscriber := range subscribers {
+		subscriber(ctx, event)
+	}
+}
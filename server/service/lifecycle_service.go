@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"portfolio/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LifecycleService owns the root context shared by background jobs and the
+// readiness state reported to load balancers.
+type LifecycleService struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	ready  atomic.Bool
+	wg     sync.WaitGroup
+	logger *logger.Logger
+}
+
+func NewLifecycleService(logger *logger.Logger) *LifecycleService {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &LifecycleService{
+		ctx:    ctx,
+		cancel: cancel,
+		logger: logger,
+	}
+}
+
+func (ls *LifecycleService) Context() context.Context {
+	return ls.ctx
+}
+
+func (ls *LifecycleService) SetReady(ready bool) {
+	ls.ready.Store(ready)
+}
+
+func (ls *LifecycleService) IsReady() bool {
+	return ls.ready.Load()
+}
+
+// Go runs a background job bound to the root context and tracks it so that
+// Stop can wait for it to return.
+func (ls *LifecycleService) Go(name string, job func(ctx context.Context)) {
+	ls.wg.Add(1)
+	go func() {
+		defer ls.wg.Done()
+		job(ls.ctx)
+		ls.logger.Debug("Background job %s stopped", name)
+	}()
+}
+
+// Stop cancels the root context and waits up to timeout for background jobs.
+func (ls *LifecycleService) Stop(timeout time.Duration) bool {
+	ls.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		ls.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
@@ -0,0 +1,26 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"portfolio/logger"
+	"portfolio/service"
+	"testing"
+	"time"
+)
+
+func TestLifecycleStopGivesUpOnStuckJobs(t *testing.T) {
+	lifecycle := service.NewLifecycleService(logger.NewWriterLogger(io.Discard))
+	release := make(chan struct{})
+	defer close(release)
+	lifecycle.Go("stuck-job", func(ctx context.Context) {
+		<-release
+	})
+
+	if lifecycle.Stop(50 * time.Millisecond) {
+		t.Fatal("Stop reported every job stopped while one is stuck")
+	}
+	if lifecycle.Context().Err() == nil {
+		t.Fatal("Stop did not cancel the root context")
+	}
+}
@@ -4,4 +4,5 @@ type ContextKey string
 
 const (
 	REQUEST_ID_KEY ContextKey = "request_id"
+	USER_ID_KEY    ContextKey = "user_id"
 )